@@ -0,0 +1,134 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=acpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// AccessControlPolicy is an Inherited Attached Policy. It provides a way to allow or deny the requests based on
+// the IP address of the client, for a Gateway or an HTTPRoute.
+type AccessControlPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the AccessControlPolicy.
+	Spec AccessControlPolicySpec `json:"spec"`
+
+	// Status defines the state of the AccessControlPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessControlPolicyList contains a list of AccessControlPolicies.
+type AccessControlPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessControlPolicy `json:"items"`
+}
+
+// AccessControlPolicySpec defines the desired state of the AccessControlPolicy.
+type AccessControlPolicySpec struct {
+	// TargetRef identifies an API object to apply the policy to.
+	// Object must be in the same namespace as the policy.
+	// The sectionName can target a single HTTP or HTTPS listener of a Gateway. The rules of a policy that targets
+	// a listener take precedence over the rules of a policy that targets the whole Gateway for the servers of
+	// the listener.
+	//
+	// Support: Gateway, HTTPRoute
+	TargetRef gatewayv1alpha2.PolicyTargetReferenceWithSectionName `json:"targetRef"`
+
+	// Rules are the rules that allow or deny the requests. The rules are checked in order until the first rule
+	// that matches the IP address of the client is found.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	Rules []AccessControlRule `json:"rules"`
+
+	// DefaultAction is the action for the requests whose client IP address doesn't match any rule.
+	// Default: Allow.
+	//
+	// +optional
+	DefaultAction *AccessControlAction `json:"defaultAction,omitempty"`
+
+	// RealIP configures how the IP address of the client is determined when the requests are received through
+	// trusted proxies or load balancers. Without it, the IP address of the client is the address of the peer
+	// of the connection.
+	// The real IP address of the client also replaces the address of the peer in the access log and in the
+	// X-Real-IP and X-Forwarded-For headers sent to the backends.
+	//
+	// +optional
+	RealIP *RealIP `json:"realIP,omitempty"`
+}
+
+// AccessControlRule allows or denies the requests from a range of IP addresses.
+type AccessControlRule struct {
+	// Action is the action for the requests whose client IP address matches the rule.
+	Action AccessControlAction `json:"action"`
+
+	// CIDR is the range of IP addresses that the rule matches.
+	CIDR CIDR `json:"cidr"`
+}
+
+// AccessControlAction is the action for the requests that match an access control rule.
+//
+// +kubebuilder:validation:Enum=Allow;Deny
+type AccessControlAction string
+
+const (
+	// AccessControlActionAllow allows the requests.
+	AccessControlActionAllow AccessControlAction = "Allow"
+
+	// AccessControlActionDeny denies the requests with the 403 status code.
+	AccessControlActionDeny AccessControlAction = "Deny"
+)
+
+// RealIP configures how the real IP address of the client is determined.
+type RealIP struct {
+	// Header is the request header whose value is used as the IP address of the client.
+	// Default: X-Forwarded-For.
+	//
+	// +optional
+	Header *RealIPHeader `json:"header,omitempty"`
+
+	// TrustedAddresses are the ranges of IP addresses of the proxies that are trusted to send the correct
+	// IP address of the client in the header. The header of the requests from other addresses is ignored.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	TrustedAddresses []CIDR `json:"trustedAddresses"`
+
+	// Recursive enables the recursive search of the IP address of the client: the last address in the header
+	// that doesn't belong to the trusted addresses is used, instead of the last address in the header.
+	//
+	// +optional
+	Recursive *bool `json:"recursive,omitempty"`
+}
+
+// RealIPHeader is the request header that holds the IP address of the client.
+//
+// +kubebuilder:validation:Enum=X-Forwarded-For;X-Real-IP
+type RealIPHeader string
+
+const (
+	// RealIPHeaderXForwardedFor is the X-Forwarded-For header.
+	RealIPHeaderXForwardedFor RealIPHeader = "X-Forwarded-For"
+
+	// RealIPHeaderXRealIP is the X-Real-IP header.
+	RealIPHeaderXRealIP RealIPHeader = "X-Real-IP"
+)
+
+// CIDR is a range of IPv4 or IPv6 addresses in the CIDR notation, or a single IP address.
+// Examples: 10.0.0.0/8, 2001:db8::/32, 192.168.1.1.
+//
+// +kubebuilder:validation:MinLength=1
+// +kubebuilder:validation:MaxLength=43
+// +kubebuilder:validation:Pattern=`^[0-9a-fA-F:.]+(/[0-9]{1,3})?$`
+type CIDR string
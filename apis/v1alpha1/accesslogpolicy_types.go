@@ -0,0 +1,172 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=alpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// AccessLogPolicy is an Inherited Attached Policy. It provides a way to write the access logs of a Gateway
+// or an HTTPRoute as JSON entries to stdout or to a syslog server.
+// The access logs of a Gateway also include the requests that don't match any HTTPRoute.
+type AccessLogPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the AccessLogPolicy.
+	Spec AccessLogPolicySpec `json:"spec"`
+
+	// Status defines the state of the AccessLogPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessLogPolicyList contains a list of AccessLogPolicies.
+type AccessLogPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessLogPolicy `json:"items"`
+}
+
+// AccessLogPolicySpec defines the desired state of the AccessLogPolicy.
+type AccessLogPolicySpec struct {
+	// TargetRef identifies an API object to apply the policy to.
+	// Object must be in the same namespace as the policy.
+	//
+	// Support: Gateway, HTTPRoute
+	TargetRef gatewayv1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// Enabled turns the access logs on or off. An HTTPRoute policy can turn off the access logs
+	// enabled by a Gateway policy.
+	// Default: true.
+	//
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Format defines the format of the log entries. The format of an HTTPRoute policy replaces
+	// the format of a Gateway policy.
+	// Default: JSON entries with the default fields.
+	//
+	// +optional
+	Format *AccessLogFormat `json:"format,omitempty"`
+
+	// Destination defines where the log entries are written. The destination of an HTTPRoute policy replaces
+	// the destination of a Gateway policy.
+	// Default: stdout.
+	//
+	// +optional
+	Destination *AccessLogDestination `json:"destination,omitempty"`
+}
+
+// AccessLogFormat defines the format of the log entries. Every entry is a JSON object on a single line.
+// The values of the NGINX variables are escaped as JSON strings.
+type AccessLogFormat struct {
+	// Fields are the fields of the JSON object. If empty, the default fields are logged: time, remote_addr,
+	// request_method, request_uri, protocol, status, body_bytes_sent, request_time, host, http_referer,
+	// http_user_agent, upstream_addr, upstream_status and upstream_response_time.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=32
+	Fields []AccessLogField `json:"fields,omitempty"`
+
+	// TraceContext adds the trace_id and span_id fields with the IDs of the OpenTelemetry trace and span of
+	// the request. The IDs are empty if the request is not traced. See ObservabilityPolicy.
+	//
+	// +optional
+	TraceContext *bool `json:"traceContext,omitempty"`
+
+	// RouteInfo adds the route_namespace and route_name fields with the namespace and the name of the HTTPRoute
+	// that matches the request. The fields are empty if the request doesn't match any HTTPRoute.
+	//
+	// +optional
+	RouteInfo *bool `json:"routeInfo,omitempty"`
+}
+
+// AccessLogField defines a field of the JSON log entries.
+type AccessLogField struct {
+	// Name is the name of the field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_][a-zA-Z0-9_.-]*$`
+	Name string `json:"name"`
+
+	// Value is the value of the field: text with NGINX variables, for example, "$request_method $uri".
+	// See https://nginx.org/en/docs/varindex.html.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[^"'\\]*$`
+	Value string `json:"value"`
+}
+
+// AccessLogDestination defines where the log entries are written.
+//
+// +kubebuilder:validation:XValidation:message="syslog must be specified if and only if type is Syslog",rule="self.type == 'Syslog' ? has(self.syslog) : !has(self.syslog)"
+//
+//nolint:lll
+type AccessLogDestination struct {
+	// Type is the type of the destination.
+	Type AccessLogDestinationType `json:"type"`
+
+	// Syslog defines the syslog server. Only applicable to the Syslog type.
+	//
+	// +optional
+	Syslog *SyslogDestination `json:"syslog,omitempty"`
+}
+
+// AccessLogDestinationType defines the type of the destination of the log entries.
+//
+// +kubebuilder:validation:Enum=Stdout;Syslog
+type AccessLogDestinationType string
+
+const (
+	// AccessLogDestinationStdout writes the log entries to the stdout of the NGINX container.
+	AccessLogDestinationStdout AccessLogDestinationType = "Stdout"
+
+	// AccessLogDestinationSyslog sends the log entries to a syslog server over UDP.
+	AccessLogDestinationSyslog AccessLogDestinationType = "Syslog"
+)
+
+// SyslogDestination defines the syslog server that receives the log entries.
+type SyslogDestination struct {
+	// Server is the address of the syslog server: a hostname or an IP address with an optional port.
+	// IPv6 addresses must be enclosed in brackets. The default port is 514.
+	// Examples: syslog.logging.svc.cluster.local, 10.0.0.1:1514, [::1]:514.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^(\[[0-9a-fA-F:.]+\]|[a-zA-Z0-9.-]+)(:[0-9]{1,5})?$`
+	Server string `json:"server"`
+
+	// Facility is the syslog facility of the log entries.
+	// Default: https://nginx.org/en/docs/syslog.html.
+	//
+	// +optional
+	Facility *SyslogFacility `json:"facility,omitempty"`
+
+	// Tag is the syslog tag of the log entries.
+	// Default: https://nginx.org/en/docs/syslog.html.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=32
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_]+$`
+	Tag *string `json:"tag,omitempty"`
+}
+
+// SyslogFacility is a syslog facility.
+//
+// +kubebuilder:validation:Enum=kern;user;mail;daemon;auth;intern;lpr;news;uucp;clock;authpriv;ftp;ntp;audit;alert;cron;local0;local1;local2;local3;local4;local5;local6;local7
+//
+//nolint:lll
+type SyslogFacility string
@@ -0,0 +1,65 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=bapolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// BasicAuthPolicy is a Direct Attached Policy. It provides a way to protect the requests of an HTTPRoute with
+// the HTTP Basic authentication, using the users and passwords from an htpasswd Secret.
+type BasicAuthPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the BasicAuthPolicy.
+	Spec BasicAuthPolicySpec `json:"spec"`
+
+	// Status defines the state of the BasicAuthPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BasicAuthPolicyList contains a list of BasicAuthPolicies.
+type BasicAuthPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BasicAuthPolicy `json:"items"`
+}
+
+// BasicAuthPolicySpec defines the desired state of the BasicAuthPolicy.
+type BasicAuthPolicySpec struct {
+	// TargetRef identifies an API object to apply the policy to.
+	// Object must be in the same namespace as the policy.
+	//
+	// Support: HTTPRoute
+	TargetRef gatewayv1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// SecretRef references the Secret with the users and passwords.
+	// The Secret must be of the type "nginx.org/htpasswd" and hold the users and passwords in the htpasswd format
+	// under the "htpasswd" key.
+	// A Secret in another namespace requires a ReferenceGrant in that namespace that allows the BasicAuthPolicy
+	// to reference the Secret.
+	//
+	// Support: Secret
+	SecretRef gatewayv1.SecretObjectReference `json:"secretRef"`
+
+	// Realm is the name of the protected area, which is sent to the client in the WWW-Authenticate
+	// response header.
+	// Format: must have all '"' escaped and must not contain any '$' or end with an unescaped '\'.
+	// Default: "Restricted".
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^([^"$\\]|\\[^$])*$`
+	Realm *string `json:"realm,omitempty"`
+}
@@ -0,0 +1,108 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=cachepolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// CachePolicy is a Direct Attached Policy. It provides a way to cache the responses of the backends of
+// an HTTPRoute in NGINX. Only the responses to GET and HEAD requests are cached.
+type CachePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the CachePolicy.
+	Spec CachePolicySpec `json:"spec"`
+
+	// Status defines the state of the CachePolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CachePolicyList contains a list of CachePolicies.
+type CachePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CachePolicy `json:"items"`
+}
+
+// CachePolicySpec defines the desired state of the CachePolicy.
+type CachePolicySpec struct {
+	// TargetRef identifies an API object to apply the policy to.
+	// Object must be in the same namespace as the policy.
+	//
+	// Support: HTTPRoute
+	TargetRef gatewayv1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// ZoneSize is the size of the shared memory zone that keeps the keys and the metadata of the cached responses.
+	// One megabyte zone can keep about 8 thousand keys. Must be at least 8k.
+	// Default: 10m.
+	//
+	// +optional
+	ZoneSize *Size `json:"zoneSize,omitempty"`
+
+	// MaxSize is the maximum size of the cached responses on the disk. When the size is exceeded,
+	// the least recently used responses are removed.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_path.
+	//
+	// +optional
+	MaxSize *Size `json:"maxSize,omitempty"`
+
+	// Inactive is the time after which the cached responses that were not accessed are removed,
+	// regardless of their freshness.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_path.
+	//
+	// +optional
+	Inactive *Duration `json:"inactive,omitempty"`
+
+	// Valid defines the caching time of the responses per response status code.
+	// Without it, only the responses with caching headers, like Cache-Control or Expires, are cached.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=8
+	Valid []CacheValid `json:"valid,omitempty"`
+
+	// Key is the key of the cached responses. The key consists of one or more NGINX variables,
+	// for example, $scheme$host$request_uri.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_key.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^(\$[a-z0-9_]+)+$`
+	Key *string `json:"key,omitempty"`
+
+	// BypassHeaders are the request headers that bypass the cache. If any of the headers is present in a request
+	// with a value other than "0", the response is taken from the backend and is not cached.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=16
+	BypassHeaders []HeaderName `json:"bypassHeaders,omitempty"`
+}
+
+// CacheValid defines the caching time of the responses with the given status codes.
+type CacheValid struct {
+	// Codes are the response status codes. If empty, the 200, 301, and 302 responses are cached.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=16
+	Codes []CacheStatusCode `json:"codes,omitempty"`
+
+	// Time is the caching time of the responses.
+	Time Duration `json:"time"`
+}
+
+// CacheStatusCode is a response status code of the cached responses.
+//
+// +kubebuilder:validation:Minimum=100
+// +kubebuilder:validation:Maximum=599
+type CacheStatusCode int32
@@ -51,6 +51,28 @@ type ClientSettingsPolicySpec struct {
 	//
 	// +optional
 	KeepAlive *ClientKeepAlive `json:"keepAlive,omitempty"`
+
+	// Override defines the settings that the policies attached to the lower levels of the hierarchy can't change.
+	// The body and keep-alive settings above are defaults: the settings of an HTTPRoute policy take precedence over
+	// the settings of a Gateway policy. The override settings of a Gateway policy take precedence over the settings
+	// of an HTTPRoute policy. Within a policy, the override settings take precedence over the default settings.
+	//
+	// +optional
+	Override *ClientSettingsOverride `json:"override,omitempty"`
+}
+
+// ClientSettingsOverride contains the client settings that the policies attached to the lower levels of
+// the hierarchy can't change.
+type ClientSettingsOverride struct {
+	// Body defines the client request body settings.
+	//
+	// +optional
+	Body *ClientBody `json:"body,omitempty"`
+
+	// KeepAlive defines the keep-alive settings.
+	//
+	// +optional
+	KeepAlive *ClientKeepAlive `json:"keepAlive,omitempty"`
 }
 
 // ClientBody contains the settings for the client request body.
@@ -68,6 +90,8 @@ type ClientBody struct {
 	// two successive read operations, not for the transmission of the whole request body.
 	// If a client does not transmit anything within this time, the request is terminated with the
 	// 408 (Request Time-out) error.
+	// If the request timeout of an HTTPRoute rule is set, it takes precedence, because it limits reading
+	// the request body too. In that case, the Overridden condition of the policy is set.
 	// Default: https://nginx.org/en/docs/http/ngx_http_core_module.html#client_body_timeout.
 	//
 	// +optional
@@ -0,0 +1,84 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=cmpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// CompressionPolicy is an Inherited Attached Policy. It provides a way to compress the responses with gzip
+// for a Gateway or an HTTPRoute.
+type CompressionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the CompressionPolicy.
+	Spec CompressionPolicySpec `json:"spec"`
+
+	// Status defines the state of the CompressionPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CompressionPolicyList contains a list of CompressionPolicies.
+type CompressionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CompressionPolicy `json:"items"`
+}
+
+// CompressionPolicySpec defines the desired state of the CompressionPolicy.
+type CompressionPolicySpec struct {
+	// TargetRef identifies an API object to apply the policy to.
+	// Object must be in the same namespace as the policy.
+	//
+	// Support: Gateway, HTTPRoute
+	TargetRef gatewayv1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// Enabled turns the compression on or off. An HTTPRoute policy can turn off the compression
+	// enabled by a Gateway policy.
+	// Default: true.
+	//
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MinLength is the minimum length of the responses in bytes that are compressed.
+	// The length is determined from the Content-Length response header.
+	// Default: https://nginx.org/en/docs/http/ngx_http_gzip_module.html#gzip_min_length.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=104857600
+	MinLength *int32 `json:"minLength,omitempty"`
+
+	// Level is the compression level, from 1 (fastest) to 9 (best compression).
+	// Default: https://nginx.org/en/docs/http/ngx_http_gzip_module.html#gzip_comp_level.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=9
+	Level *int32 `json:"level,omitempty"`
+
+	// Types are the MIME types of the responses that are compressed, in addition to "text/html",
+	// which is always compressed. The "*" type compresses the responses of any type.
+	// Default: https://nginx.org/en/docs/http/ngx_http_gzip_module.html#gzip_types.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=32
+	Types []MIMEType `json:"types,omitempty"`
+}
+
+// MIMEType is a MIME type without parameters, or "*" for any type.
+// Examples: application/json, text/css, image/svg+xml, *.
+//
+// +kubebuilder:validation:MaxLength=127
+// +kubebuilder:validation:Pattern=`^(\*|[a-z0-9][a-z0-9.+-]*/[a-z0-9][a-z0-9.+-]*)$`
+type MIMEType string
@@ -0,0 +1,102 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=corspolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// CORSPolicy is a Direct Attached Policy. It provides a way to handle the Cross-Origin Resource Sharing (CORS)
+// requests of an HTTPRoute: NGINX responds to the preflight requests and adds the CORS headers to the responses
+// for the allowed origins.
+type CORSPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the CORSPolicy.
+	Spec CORSPolicySpec `json:"spec"`
+
+	// Status defines the state of the CORSPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CORSPolicyList contains a list of CORSPolicies.
+type CORSPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CORSPolicy `json:"items"`
+}
+
+// CORSPolicySpec defines the desired state of the CORSPolicy.
+type CORSPolicySpec struct {
+	// TargetRef identifies an API object to apply the policy to.
+	// Object must be in the same namespace as the policy.
+	//
+	// Support: HTTPRoute
+	TargetRef gatewayv1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// AllowOrigins are the origins that are allowed to access the HTTPRoute.
+	// An origin is a scheme and a host with an optional port, like "https://example.com:8443".
+	// The host can start with a "*." wildcard, which matches a single DNS label, like "https://*.example.com".
+	// The "*" origin allows all origins.
+	//
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	AllowOrigins []CORSOrigin `json:"allowOrigins"`
+
+	// AllowMethods are the methods that are allowed in the CORS requests.
+	// Default: GET, HEAD, POST.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=9
+	AllowMethods []gatewayv1.HTTPMethod `json:"allowMethods,omitempty"`
+
+	// AllowHeaders are the request headers that are allowed in the CORS requests, in addition to the
+	// CORS-safelisted request headers.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=64
+	AllowHeaders []HeaderName `json:"allowHeaders,omitempty"`
+
+	// ExposeHeaders are the response headers that the browser makes available to the scripts, in addition to
+	// the CORS-safelisted response headers.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=64
+	ExposeHeaders []HeaderName `json:"exposeHeaders,omitempty"`
+
+	// AllowCredentials allows the CORS requests to include credentials, like cookies.
+	// If the "*" origin is allowed together with credentials, the origin of the request is returned instead of "*",
+	// because the browsers reject the "*" origin for the requests with credentials.
+	//
+	// +optional
+	AllowCredentials *bool `json:"allowCredentials,omitempty"`
+
+	// MaxAge is the time in seconds during which the browser can cache the response to a preflight request.
+	// Default: the browser default.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=86400
+	MaxAge *int32 `json:"maxAge,omitempty"`
+}
+
+// CORSOrigin is an origin of the CORS requests, or "*" for all origins.
+// Examples: https://example.com, http://localhost:8080, https://*.example.com, *.
+//
+// +kubebuilder:validation:MaxLength=253
+// +kubebuilder:validation:Pattern=`^(\*|https?://(\*\.)?[a-z0-9]([a-z0-9.-]*[a-z0-9])?(:[0-9]{1,5})?)$`
+type CORSOrigin string
@@ -0,0 +1,121 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=eppolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// ErrorPagePolicy is an Inherited Attached Policy. It provides a way to replace the error responses of a Gateway
+// or an HTTPRoute with custom error pages, either proxied from a Service or served from the content of a ConfigMap.
+// The error pages of a Gateway also apply to the requests that don't match any HTTPRoute.
+type ErrorPagePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ErrorPagePolicy.
+	Spec ErrorPagePolicySpec `json:"spec"`
+
+	// Status defines the state of the ErrorPagePolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ErrorPagePolicyList contains a list of ErrorPagePolicies.
+type ErrorPagePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ErrorPagePolicy `json:"items"`
+}
+
+// ErrorPagePolicySpec defines the desired state of the ErrorPagePolicy.
+type ErrorPagePolicySpec struct {
+	// TargetRef identifies an API object to apply the policy to.
+	// Object must be in the same namespace as the policy.
+	//
+	// Support: Gateway, HTTPRoute
+	TargetRef gatewayv1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// Pages are the error pages. The pages of an HTTPRoute policy replace the pages of a Gateway policy
+	// for the same status codes. The pages of the Gateway policy still apply to the other status codes.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Pages []ErrorPage `json:"pages"`
+}
+
+// ErrorPage defines the error page of the responses with the given status codes.
+// Exactly one of BackendRef or Content must be specified.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of backendRef or content must be specified",rule="has(self.backendRef) != has(self.content)"
+//
+//nolint:lll
+type ErrorPage struct {
+	// Codes are the status codes of the error responses, from 400 to 599. They include the responses of the
+	// backends and the responses generated by NGINX, for example, when no route matches a request or when
+	// a backend is unavailable.
+	//
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	Codes []ErrorPageStatusCode `json:"codes"`
+
+	// ResponseCode is the status code of the response with the error page. If not set, the status code of
+	// the error response is kept.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	ResponseCode *int32 `json:"responseCode,omitempty"`
+
+	// BackendRef references the Service that serves the error page. The port is required.
+	// The erroneous requests are proxied to the Service as GET requests with their original URI. The status code
+	// of the error response is passed in the X-Error-Code header.
+	// A Service in another namespace requires a ReferenceGrant in that namespace that allows the ErrorPagePolicy
+	// to reference the Service.
+	// If the Service cannot be resolved, the error page is not configured.
+	//
+	// Support: Service
+	//
+	// +optional
+	// +kubebuilder:validation:XValidation:message="port is required",rule="has(self.port)"
+	BackendRef *gatewayv1.BackendObjectReference `json:"backendRef,omitempty"`
+
+	// Content is the content of the error page, stored in a ConfigMap.
+	//
+	// +optional
+	Content *ErrorPageContent `json:"content,omitempty"`
+}
+
+// ErrorPageContent defines the content of an error page stored in a ConfigMap.
+type ErrorPageContent struct {
+	// ConfigMapName is the name of the ConfigMap in the namespace of the policy that stores the content.
+	ConfigMapName gatewayv1.ObjectName `json:"configMapName"`
+
+	// Key is the key of the content in the data of the ConfigMap.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	Key string `json:"key"`
+
+	// ContentType is the MIME type of the content.
+	// Default: text/html.
+	//
+	// +optional
+	ContentType *MIMEType `json:"contentType,omitempty"`
+}
+
+// ErrorPageStatusCode is a status code of the error responses.
+//
+// +kubebuilder:validation:Minimum=400
+// +kubebuilder:validation:Maximum=599
+type ErrorPageStatusCode int32
@@ -0,0 +1,86 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=eapolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// ExternalAuthPolicy is a Direct Attached Policy. It provides a way to authorize the requests of an HTTPRoute
+// with an external authorization service. Before a request is proxied, NGINX sends a subrequest to the service:
+// a 2xx response allows the request, a 401 or 403 response denies it.
+type ExternalAuthPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ExternalAuthPolicy.
+	Spec ExternalAuthPolicySpec `json:"spec"`
+
+	// Status defines the state of the ExternalAuthPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ExternalAuthPolicyList contains a list of ExternalAuthPolicies.
+type ExternalAuthPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ExternalAuthPolicy `json:"items"`
+}
+
+// ExternalAuthPolicySpec defines the desired state of the ExternalAuthPolicy.
+type ExternalAuthPolicySpec struct {
+	// TargetRef identifies an API object to apply the policy to.
+	// Object must be in the same namespace as the policy.
+	//
+	// Support: HTTPRoute
+	TargetRef gatewayv1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// BackendRef references the Service of the authorization service. The port is required.
+	// A Service in another namespace requires a ReferenceGrant in that namespace that allows the ExternalAuthPolicy
+	// to reference the Service.
+	// If the Service cannot be resolved, the requests are denied with the 500 status code.
+	//
+	// Support: Service
+	//
+	// +kubebuilder:validation:XValidation:message="port is required",rule="has(self.port)"
+	BackendRef gatewayv1.BackendObjectReference `json:"backendRef"`
+
+	// Path is the path of the authorization requests. If not set, the URI of the original request is used.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Pattern=`^/[^\s{};$"'\\]*$`
+	Path *string `json:"path,omitempty"`
+
+	// ForwardRequestHeaders are the headers of the original request that are forwarded to the authorization
+	// service. If not set, all headers are forwarded.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=16
+	ForwardRequestHeaders []HeaderName `json:"forwardRequestHeaders,omitempty"`
+
+	// CopyResponseHeaders are the headers of the response of the authorization service that are set in the
+	// request to the backends of the HTTPRoute, for example, the X-User header with the authenticated user.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=16
+	CopyResponseHeaders []HeaderName `json:"copyResponseHeaders,omitempty"`
+}
+
+// HeaderName is the name of an HTTP header.
+//
+// +kubebuilder:validation:MinLength=1
+// +kubebuilder:validation:MaxLength=256
+// +kubebuilder:validation:Pattern=`^[A-Za-z0-9-]+$`
+type HeaderName string
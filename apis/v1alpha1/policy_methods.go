@@ -0,0 +1,209 @@
+package v1alpha1
+
+import (
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// The following methods implement the policies.Policy interface of NGF, which extends client.Object with
+// the methods that are common among all NGF Policies.
+
+// GetTargetRef returns the reference to the target of the ClientSettingsPolicy.
+func (p *ClientSettingsPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// GetPolicyStatus returns the status of the ClientSettingsPolicy.
+func (p *ClientSettingsPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+// SetPolicyStatus sets the status of the ClientSettingsPolicy.
+func (p *ClientSettingsPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+// GetTargetRef returns the reference to the target of the ObservabilityPolicy.
+func (p *ObservabilityPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// GetPolicyStatus returns the status of the ObservabilityPolicy.
+func (p *ObservabilityPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+// SetPolicyStatus sets the status of the ObservabilityPolicy.
+func (p *ObservabilityPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+// GetTargetRef returns the reference to the target of the RateLimitPolicy.
+func (p *RateLimitPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// GetPolicyStatus returns the status of the RateLimitPolicy.
+func (p *RateLimitPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+// SetPolicyStatus sets the status of the RateLimitPolicy.
+func (p *RateLimitPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+// GetTargetRef returns the reference to the target of the UpstreamSettingsPolicy.
+func (p *UpstreamSettingsPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// GetPolicyStatus returns the status of the UpstreamSettingsPolicy.
+func (p *UpstreamSettingsPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+// SetPolicyStatus sets the status of the UpstreamSettingsPolicy.
+func (p *UpstreamSettingsPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+// GetTargetRef returns the reference to the target of the RetryPolicy.
+func (p *RetryPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// GetPolicyStatus returns the status of the RetryPolicy.
+func (p *RetryPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+// SetPolicyStatus sets the status of the RetryPolicy.
+func (p *RetryPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+// GetTargetRef returns the reference to the target of the BasicAuthPolicy.
+func (p *BasicAuthPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// GetPolicyStatus returns the status of the BasicAuthPolicy.
+func (p *BasicAuthPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+// SetPolicyStatus sets the status of the BasicAuthPolicy.
+func (p *BasicAuthPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+// GetTargetRef returns the reference to the target of the ExternalAuthPolicy.
+func (p *ExternalAuthPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// GetPolicyStatus returns the status of the ExternalAuthPolicy.
+func (p *ExternalAuthPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+// SetPolicyStatus sets the status of the ExternalAuthPolicy.
+func (p *ExternalAuthPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+// GetTargetRef returns the reference to the target of the AccessControlPolicy.
+func (p *AccessControlPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef.PolicyTargetReference
+}
+
+// GetSectionName returns the section of the target of the AccessControlPolicy.
+func (p *AccessControlPolicy) GetSectionName() *v1.SectionName {
+	return p.Spec.TargetRef.SectionName
+}
+
+// GetPolicyStatus returns the status of the AccessControlPolicy.
+func (p *AccessControlPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+// SetPolicyStatus sets the status of the AccessControlPolicy.
+func (p *AccessControlPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+// GetTargetRef returns the reference to the target of the CORSPolicy.
+func (p *CORSPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// GetPolicyStatus returns the status of the CORSPolicy.
+func (p *CORSPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+// SetPolicyStatus sets the status of the CORSPolicy.
+func (p *CORSPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+// GetTargetRef returns the reference to the target of the CachePolicy.
+func (p *CachePolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// GetPolicyStatus returns the status of the CachePolicy.
+func (p *CachePolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+// SetPolicyStatus sets the status of the CachePolicy.
+func (p *CachePolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+// GetTargetRef returns the reference to the target of the CompressionPolicy.
+func (p *CompressionPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// GetPolicyStatus returns the status of the CompressionPolicy.
+func (p *CompressionPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+// SetPolicyStatus sets the status of the CompressionPolicy.
+func (p *CompressionPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+// GetTargetRef returns the reference to the target of the ErrorPagePolicy.
+func (p *ErrorPagePolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// GetPolicyStatus returns the status of the ErrorPagePolicy.
+func (p *ErrorPagePolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+// SetPolicyStatus sets the status of the ErrorPagePolicy.
+func (p *ErrorPagePolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+// GetTargetRef returns the reference to the target of the AccessLogPolicy.
+func (p *AccessLogPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// GetPolicyStatus returns the status of the AccessLogPolicy.
+func (p *AccessLogPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+// SetPolicyStatus sets the status of the AccessLogPolicy.
+func (p *AccessLogPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
@@ -0,0 +1,130 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=rlpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// RateLimitPolicy is an Inherited Attached Policy. It provides a way to limit the rate of the requests
+// that NGINX Gateway Fabric processes for a Gateway or an HTTPRoute.
+type RateLimitPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the RateLimitPolicy.
+	Spec RateLimitPolicySpec `json:"spec"`
+
+	// Status defines the state of the RateLimitPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RateLimitPolicyList contains a list of RateLimitPolicies.
+type RateLimitPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RateLimitPolicy `json:"items"`
+}
+
+// RateLimitPolicySpec defines the desired state of the RateLimitPolicy.
+type RateLimitPolicySpec struct {
+	// TargetRef identifies an API object to apply the policy to.
+	// Object must be in the same namespace as the policy.
+	//
+	// Support: Gateway, HTTPRoute
+	TargetRef gatewayv1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// Key defines the key by which the requests are limited. The rate limit applies to the requests
+	// with the same value of the key. Requests with an empty value of the key are not limited.
+	// By default, the requests are limited per client IP address.
+	//
+	// +optional
+	Key *RateLimitKey `json:"key,omitempty"`
+
+	// Rate is the maximum rate of the requests per key.
+	Rate RequestRate `json:"rate"`
+
+	// Burst is the maximum number of requests that exceed the rate and are queued.
+	// Requests that exceed the burst are rejected.
+	// Default: https://nginx.org/en/docs/http/ngx_http_limit_req_module.html#limit_req.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Burst *int32 `json:"burst,omitempty"`
+
+	// NoDelay defines whether the requests that are queued within the burst are processed without delay.
+	// Default: https://nginx.org/en/docs/http/ngx_http_limit_req_module.html#limit_req.
+	//
+	// +optional
+	NoDelay *bool `json:"noDelay,omitempty"`
+
+	// RejectCode is the status code that is returned for the rejected requests.
+	// Default: https://nginx.org/en/docs/http/ngx_http_limit_req_module.html#limit_req_status.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=400
+	// +kubebuilder:validation:Maximum=599
+	RejectCode *int32 `json:"rejectCode,omitempty"`
+}
+
+// RateLimitKey defines the key by which the requests are limited.
+//
+// +kubebuilder:validation:XValidation:message="header must be specified if and only if type is Header",rule="self.type == 'Header' ? has(self.header) : !has(self.header)"
+// +kubebuilder:validation:XValidation:message="jwtClaim must be specified if and only if type is JWTClaim",rule="self.type == 'JWTClaim' ? has(self.jwtClaim) : !has(self.jwtClaim)"
+//
+//nolint:lll
+type RateLimitKey struct {
+	// Type is the type of the key.
+	Type RateLimitKeyType `json:"type"`
+
+	// Header is the name of the request header whose value is the key. Only applicable to the Header type.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9-]+$`
+	Header *string `json:"header,omitempty"`
+
+	// JWTClaim is the name of the JWT claim whose value is the key. Only applicable to the JWTClaim type.
+	// The claim is only available if the request is authenticated with a JWT by the NGINX JWT authentication
+	// (auth_jwt), which NGINX Gateway Fabric doesn't configure. NGINX doesn't limit the requests with an empty key,
+	// so the requests without a claim value are not limited.
+	// Support: NGINX Plus.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_]+$`
+	JWTClaim *string `json:"jwtClaim,omitempty"`
+}
+
+// RateLimitKeyType defines the type of the key by which the requests are limited.
+//
+// +kubebuilder:validation:Enum=ClientIP;Header;JWTClaim
+type RateLimitKeyType string
+
+const (
+	// RateLimitKeyClientIP limits the requests per client IP address.
+	RateLimitKeyClientIP RateLimitKeyType = "ClientIP"
+
+	// RateLimitKeyHeader limits the requests per value of a request header.
+	RateLimitKeyHeader RateLimitKeyType = "Header"
+
+	// RateLimitKeyJWTClaim limits the requests per value of a JWT claim.
+	RateLimitKeyJWTClaim RateLimitKeyType = "JWTClaim"
+)
+
+// RequestRate is a string value representing a rate of requests. The rate is specified in requests per second
+// (r/s) or requests per minute (r/m).
+// Examples: 10r/s, 300r/m.
+//
+// +kubebuilder:validation:Pattern=`^\d{1,6}r/(s|m)$`
+type RequestRate string
@@ -40,6 +40,28 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ObservabilityPolicyList{},
 		&ClientSettingsPolicy{},
 		&ClientSettingsPolicyList{},
+		&RateLimitPolicy{},
+		&RateLimitPolicyList{},
+		&UpstreamSettingsPolicy{},
+		&UpstreamSettingsPolicyList{},
+		&RetryPolicy{},
+		&RetryPolicyList{},
+		&BasicAuthPolicy{},
+		&BasicAuthPolicyList{},
+		&ExternalAuthPolicy{},
+		&ExternalAuthPolicyList{},
+		&AccessControlPolicy{},
+		&AccessControlPolicyList{},
+		&CORSPolicy{},
+		&CORSPolicyList{},
+		&CachePolicy{},
+		&CachePolicyList{},
+		&CompressionPolicy{},
+		&CompressionPolicyList{},
+		&ErrorPagePolicy{},
+		&ErrorPagePolicyList{},
+		&AccessLogPolicy{},
+		&AccessLogPolicyList{},
 	)
 	// AddToGroupVersion allows the serialization of client types like ListOptions.
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
@@ -0,0 +1,117 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=rtpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// RetryPolicy is an Inherited Attached Policy. It provides a way to retry the requests that fail to be processed
+// by an upstream server on the next upstream server, for a Gateway or an HTTPRoute.
+type RetryPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the RetryPolicy.
+	Spec RetryPolicySpec `json:"spec"`
+
+	// Status defines the state of the RetryPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RetryPolicyList contains a list of RetryPolicies.
+type RetryPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RetryPolicy `json:"items"`
+}
+
+// RetryPolicySpec defines the desired state of the RetryPolicy.
+type RetryPolicySpec struct {
+	// TargetRef identifies an API object to apply the policy to.
+	// Object must be in the same namespace as the policy.
+	//
+	// Support: Gateway, HTTPRoute
+	TargetRef gatewayv1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// Attempts is the maximum number of attempts to pass a request to the upstream servers,
+	// including the first attempt.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_next_upstream_tries.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Attempts *int32 `json:"attempts,omitempty"`
+
+	// RetryOn defines in which cases a request is passed to the next upstream server.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_next_upstream.
+	//
+	// +optional
+	RetryOn *RetryOn `json:"retryOn,omitempty"`
+
+	// TotalTimeout limits the total time of all the attempts to pass a request to the upstream servers,
+	// counted from the start of the first attempt. Once it has passed, the request is not passed to
+	// the next upstream server. It doesn't limit the time of a single attempt.
+	// If the request timeout of an HTTPRoute rule is set, it takes precedence, because a request cannot be
+	// retried after it timed out. In that case, the Overridden condition of the policy is set.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_next_upstream_timeout.
+	//
+	// +optional
+	TotalTimeout *Duration `json:"totalTimeout,omitempty"`
+}
+
+// RetryOn defines in which cases a request is passed to the next upstream server.
+//
+// +kubebuilder:validation:XValidation:message="at least one of conditions or statusCodes must be specified",rule="has(self.conditions) || has(self.statusCodes)"
+//
+//nolint:lll
+type RetryOn struct {
+	// Conditions are the conditions in which a request is passed to the next upstream server.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=4
+	Conditions []RetryCondition `json:"conditions,omitempty"`
+
+	// StatusCodes are the response status codes with which a request is passed to the next upstream server.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=7
+	StatusCodes []RetryStatusCode `json:"statusCodes,omitempty"`
+}
+
+// RetryCondition defines a condition in which a request is passed to the next upstream server.
+//
+// +kubebuilder:validation:Enum=Error;Timeout;InvalidHeader;NonIdempotent
+type RetryCondition string
+
+const (
+	// RetryConditionError retries the request if an error occurred while establishing a connection with the
+	// upstream server, passing the request to it, or reading the response header.
+	RetryConditionError RetryCondition = "Error"
+
+	// RetryConditionTimeout retries the request if a timeout occurred while establishing a connection with the
+	// upstream server, passing the request to it, or reading the response header.
+	RetryConditionTimeout RetryCondition = "Timeout"
+
+	// RetryConditionInvalidHeader retries the request if the upstream server returned an empty or invalid response.
+	RetryConditionInvalidHeader RetryCondition = "InvalidHeader"
+
+	// RetryConditionNonIdempotent allows retrying the requests with a non-idempotent method
+	// (POST, LOCK, PATCH). Without it, such requests are not retried once they were sent to an upstream server.
+	RetryConditionNonIdempotent RetryCondition = "NonIdempotent"
+)
+
+// RetryStatusCode is a response status code with which a request is passed to the next upstream server.
+//
+// +kubebuilder:validation:Enum=403;404;429;500;502;503;504
+type RetryStatusCode int32
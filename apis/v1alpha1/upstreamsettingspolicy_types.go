@@ -0,0 +1,139 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=uspolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// UpstreamSettingsPolicy is a Direct Attached Policy. It provides a way to configure the behavior of
+// the connection between NGINX and the upstream applications.
+type UpstreamSettingsPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the UpstreamSettingsPolicy.
+	Spec UpstreamSettingsPolicySpec `json:"spec"`
+
+	// Status defines the state of the UpstreamSettingsPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UpstreamSettingsPolicyList contains a list of UpstreamSettingsPolicies.
+type UpstreamSettingsPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UpstreamSettingsPolicy `json:"items"`
+}
+
+// UpstreamSettingsPolicySpec defines the desired state of the UpstreamSettingsPolicy.
+type UpstreamSettingsPolicySpec struct {
+	// TargetRef identifies an API object to apply the policy to.
+	// Object must be in the same namespace as the policy.
+	// The settings apply to the upstreams of all ports of the Service.
+	//
+	// Support: Service
+	TargetRef gatewayv1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// LoadBalancing defines the load balancing method of the upstreams.
+	// By default, the random method with the "two least_conn" parameters is used.
+	//
+	// +optional
+	LoadBalancing *LoadBalancing `json:"loadBalancing,omitempty"`
+
+	// KeepAlive defines the keep-alive settings of the connections to the upstream servers.
+	//
+	// +optional
+	KeepAlive *UpstreamKeepAlive `json:"keepAlive,omitempty"`
+
+	// ZoneSize is the size of the shared memory zone of an upstream.
+	// Default: 512k for NGINX and 1m for NGINX Plus.
+	//
+	// +optional
+	ZoneSize *Size `json:"zoneSize,omitempty"`
+}
+
+// LoadBalancing defines the load balancing method of an upstream.
+//
+// +kubebuilder:validation:XValidation:message="key must be specified if and only if method is Hash",rule="self.method == 'Hash' ? has(self.key) : !has(self.key)"
+// +kubebuilder:validation:XValidation:message="consistent can only be specified if method is Hash",rule="!(has(self.consistent) && self.method != 'Hash')"
+//
+//nolint:lll
+type LoadBalancing struct {
+	// Method is the load balancing method.
+	Method LoadBalancingMethod `json:"method"`
+
+	// Key is the key of the Hash method. The key consists of one or more NGINX variables,
+	// for example, $request_uri or $remote_addr$http_user_agent.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^(\$[a-z0-9_]+)+$`
+	Key *string `json:"key,omitempty"`
+
+	// Consistent enables the ketama consistent hashing of the Hash method, which minimizes
+	// the remapping of the keys when servers are added to or removed from the upstream.
+	//
+	// +optional
+	Consistent *bool `json:"consistent,omitempty"`
+}
+
+// LoadBalancingMethod defines the load balancing method of an upstream.
+//
+// +kubebuilder:validation:Enum=RoundRobin;LeastConn;IPHash;Hash;Random
+type LoadBalancingMethod string
+
+const (
+	// LoadBalancingRoundRobin distributes the requests between the servers in turn.
+	LoadBalancingRoundRobin LoadBalancingMethod = "RoundRobin"
+
+	// LoadBalancingLeastConn passes a request to the server with the least number of active connections.
+	LoadBalancingLeastConn LoadBalancingMethod = "LeastConn"
+
+	// LoadBalancingIPHash distributes the requests between the servers based on the client IP addresses.
+	LoadBalancingIPHash LoadBalancingMethod = "IPHash"
+
+	// LoadBalancingHash distributes the requests between the servers based on the hashed key.
+	LoadBalancingHash LoadBalancingMethod = "Hash"
+
+	// LoadBalancingRandom passes a request to a randomly selected server.
+	LoadBalancingRandom LoadBalancingMethod = "Random"
+)
+
+// UpstreamKeepAlive defines the keep-alive settings of the connections to the upstream servers.
+type UpstreamKeepAlive struct {
+	// Connections is the maximum number of idle keep-alive connections to the upstream servers
+	// that are preserved in the cache of each worker process. Setting it enables the keep-alive connections.
+	// Default: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#keepalive.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Connections *int32 `json:"connections,omitempty"`
+
+	// Requests is the maximum number of requests that can be served through one keep-alive connection.
+	// Default: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#keepalive_requests.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Requests *int32 `json:"requests,omitempty"`
+
+	// Time is the maximum time during which requests can be processed through one keep-alive connection.
+	// Default: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#keepalive_time.
+	//
+	// +optional
+	Time *Duration `json:"time,omitempty"`
+
+	// Timeout is the timeout during which an idle keep-alive connection to an upstream server stays open.
+	// Default: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#keepalive_timeout.
+	//
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+}
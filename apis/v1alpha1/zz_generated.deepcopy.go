@@ -5,92 +5,183 @@
 package v1alpha1
 
 import (
-	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/gateway-api/apis/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClientBody) DeepCopyInto(out *ClientBody) {
+func (in *AccessControlPolicy) DeepCopyInto(out *AccessControlPolicy) {
 	*out = *in
-	if in.MaxSize != nil {
-		in, out := &in.MaxSize, &out.MaxSize
-		*out = new(Size)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessControlPolicy.
+func (in *AccessControlPolicy) DeepCopy() *AccessControlPolicy {
+	if in == nil {
+		return nil
 	}
-	if in.Timeout != nil {
-		in, out := &in.Timeout, &out.Timeout
-		*out = new(Duration)
-		**out = **in
+	out := new(AccessControlPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessControlPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
+	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientBody.
-func (in *ClientBody) DeepCopy() *ClientBody {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessControlPolicyList) DeepCopyInto(out *AccessControlPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessControlPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessControlPolicyList.
+func (in *AccessControlPolicyList) DeepCopy() *AccessControlPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClientBody)
+	out := new(AccessControlPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessControlPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClientKeepAlive) DeepCopyInto(out *ClientKeepAlive) {
+func (in *AccessControlPolicySpec) DeepCopyInto(out *AccessControlPolicySpec) {
 	*out = *in
-	if in.Requests != nil {
-		in, out := &in.Requests, &out.Requests
-		*out = new(int32)
-		**out = **in
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AccessControlRule, len(*in))
+		copy(*out, *in)
 	}
-	if in.Time != nil {
-		in, out := &in.Time, &out.Time
-		*out = new(Duration)
+	if in.DefaultAction != nil {
+		in, out := &in.DefaultAction, &out.DefaultAction
+		*out = new(AccessControlAction)
 		**out = **in
 	}
-	if in.Timeout != nil {
-		in, out := &in.Timeout, &out.Timeout
-		*out = new(ClientKeepAliveTimeout)
+	if in.RealIP != nil {
+		in, out := &in.RealIP, &out.RealIP
+		*out = new(RealIP)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientKeepAlive.
-func (in *ClientKeepAlive) DeepCopy() *ClientKeepAlive {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessControlPolicySpec.
+func (in *AccessControlPolicySpec) DeepCopy() *AccessControlPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClientKeepAlive)
+	out := new(AccessControlPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClientKeepAliveTimeout) DeepCopyInto(out *ClientKeepAliveTimeout) {
+func (in *AccessControlRule) DeepCopyInto(out *AccessControlRule) {
 	*out = *in
-	if in.Server != nil {
-		in, out := &in.Server, &out.Server
-		*out = new(Duration)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessControlRule.
+func (in *AccessControlRule) DeepCopy() *AccessControlRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessControlRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogDestination) DeepCopyInto(out *AccessLogDestination) {
+	*out = *in
+	if in.Syslog != nil {
+		in, out := &in.Syslog, &out.Syslog
+		*out = new(SyslogDestination)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogDestination.
+func (in *AccessLogDestination) DeepCopy() *AccessLogDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogField) DeepCopyInto(out *AccessLogField) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogField.
+func (in *AccessLogField) DeepCopy() *AccessLogField {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogField)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogFormat) DeepCopyInto(out *AccessLogFormat) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]AccessLogField, len(*in))
+		copy(*out, *in)
+	}
+	if in.TraceContext != nil {
+		in, out := &in.TraceContext, &out.TraceContext
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Header != nil {
-		in, out := &in.Header, &out.Header
-		*out = new(Duration)
+	if in.RouteInfo != nil {
+		in, out := &in.RouteInfo, &out.RouteInfo
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientKeepAliveTimeout.
-func (in *ClientKeepAliveTimeout) DeepCopy() *ClientKeepAliveTimeout {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogFormat.
+func (in *AccessLogFormat) DeepCopy() *AccessLogFormat {
 	if in == nil {
 		return nil
 	}
-	out := new(ClientKeepAliveTimeout)
+	out := new(AccessLogFormat)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClientSettingsPolicy) DeepCopyInto(out *ClientSettingsPolicy) {
+func (in *AccessLogPolicy) DeepCopyInto(out *AccessLogPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -98,18 +189,18 @@ func (in *ClientSettingsPolicy) DeepCopyInto(out *ClientSettingsPolicy) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSettingsPolicy.
-func (in *ClientSettingsPolicy) DeepCopy() *ClientSettingsPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogPolicy.
+func (in *AccessLogPolicy) DeepCopy() *AccessLogPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ClientSettingsPolicy)
+	out := new(AccessLogPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClientSettingsPolicy) DeepCopyObject() runtime.Object {
+func (in *AccessLogPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -117,31 +208,31 @@ func (in *ClientSettingsPolicy) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClientSettingsPolicyList) DeepCopyInto(out *ClientSettingsPolicyList) {
+func (in *AccessLogPolicyList) DeepCopyInto(out *AccessLogPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClientSettingsPolicy, len(*in))
+		*out = make([]AccessLogPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSettingsPolicyList.
-func (in *ClientSettingsPolicyList) DeepCopy() *ClientSettingsPolicyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogPolicyList.
+func (in *AccessLogPolicyList) DeepCopy() *AccessLogPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClientSettingsPolicyList)
+	out := new(AccessLogPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClientSettingsPolicyList) DeepCopyObject() runtime.Object {
+func (in *AccessLogPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -149,53 +240,1093 @@ func (in *ClientSettingsPolicyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClientSettingsPolicySpec) DeepCopyInto(out *ClientSettingsPolicySpec) {
+func (in *AccessLogPolicySpec) DeepCopyInto(out *AccessLogPolicySpec) {
 	*out = *in
 	in.TargetRef.DeepCopyInto(&out.TargetRef)
-	if in.Body != nil {
-		in, out := &in.Body, &out.Body
-		*out = new(ClientBody)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(AccessLogFormat)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.KeepAlive != nil {
-		in, out := &in.KeepAlive, &out.KeepAlive
-		*out = new(ClientKeepAlive)
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		*out = new(AccessLogDestination)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSettingsPolicySpec.
-func (in *ClientSettingsPolicySpec) DeepCopy() *ClientSettingsPolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogPolicySpec.
+func (in *AccessLogPolicySpec) DeepCopy() *AccessLogPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClientSettingsPolicySpec)
+	out := new(AccessLogPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Logging) DeepCopyInto(out *Logging) {
+func (in *BasicAuthPolicy) DeepCopyInto(out *BasicAuthPolicy) {
 	*out = *in
-	if in.Level != nil {
-		in, out := &in.Level, &out.Level
-		*out = new(ControllerLogLevel)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BasicAuthPolicy.
+func (in *BasicAuthPolicy) DeepCopy() *BasicAuthPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BasicAuthPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BasicAuthPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BasicAuthPolicyList) DeepCopyInto(out *BasicAuthPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BasicAuthPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BasicAuthPolicyList.
+func (in *BasicAuthPolicyList) DeepCopy() *BasicAuthPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(BasicAuthPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BasicAuthPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BasicAuthPolicySpec) DeepCopyInto(out *BasicAuthPolicySpec) {
+	*out = *in
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	in.SecretRef.DeepCopyInto(&out.SecretRef)
+	if in.Realm != nil {
+		in, out := &in.Realm, &out.Realm
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Logging.
-func (in *Logging) DeepCopy() *Logging {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BasicAuthPolicySpec.
+func (in *BasicAuthPolicySpec) DeepCopy() *BasicAuthPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Logging)
+	out := new(BasicAuthPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CORSPolicy) DeepCopyInto(out *CORSPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSPolicy.
+func (in *CORSPolicy) DeepCopy() *CORSPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CORSPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CORSPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CORSPolicyList) DeepCopyInto(out *CORSPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CORSPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSPolicyList.
+func (in *CORSPolicyList) DeepCopy() *CORSPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(CORSPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CORSPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CORSPolicySpec) DeepCopyInto(out *CORSPolicySpec) {
+	*out = *in
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.AllowOrigins != nil {
+		in, out := &in.AllowOrigins, &out.AllowOrigins
+		*out = make([]CORSOrigin, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowMethods != nil {
+		in, out := &in.AllowMethods, &out.AllowMethods
+		*out = make([]v1.HTTPMethod, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowHeaders != nil {
+		in, out := &in.AllowHeaders, &out.AllowHeaders
+		*out = make([]HeaderName, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExposeHeaders != nil {
+		in, out := &in.ExposeHeaders, &out.ExposeHeaders
+		*out = make([]HeaderName, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowCredentials != nil {
+		in, out := &in.AllowCredentials, &out.AllowCredentials
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSPolicySpec.
+func (in *CORSPolicySpec) DeepCopy() *CORSPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CORSPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CachePolicy) DeepCopyInto(out *CachePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePolicy.
+func (in *CachePolicy) DeepCopy() *CachePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CachePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CachePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CachePolicyList) DeepCopyInto(out *CachePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CachePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePolicyList.
+func (in *CachePolicyList) DeepCopy() *CachePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(CachePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CachePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CachePolicySpec) DeepCopyInto(out *CachePolicySpec) {
+	*out = *in
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.ZoneSize != nil {
+		in, out := &in.ZoneSize, &out.ZoneSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.Inactive != nil {
+		in, out := &in.Inactive, &out.Inactive
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Valid != nil {
+		in, out := &in.Valid, &out.Valid
+		*out = make([]CacheValid, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.BypassHeaders != nil {
+		in, out := &in.BypassHeaders, &out.BypassHeaders
+		*out = make([]HeaderName, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePolicySpec.
+func (in *CachePolicySpec) DeepCopy() *CachePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CachePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheValid) DeepCopyInto(out *CacheValid) {
+	*out = *in
+	if in.Codes != nil {
+		in, out := &in.Codes, &out.Codes
+		*out = make([]CacheStatusCode, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheValid.
+func (in *CacheValid) DeepCopy() *CacheValid {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheValid)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientBody) DeepCopyInto(out *ClientBody) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientBody.
+func (in *ClientBody) DeepCopy() *ClientBody {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientBody)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientKeepAlive) DeepCopyInto(out *ClientKeepAlive) {
+	*out = *in
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(ClientKeepAliveTimeout)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientKeepAlive.
+func (in *ClientKeepAlive) DeepCopy() *ClientKeepAlive {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientKeepAlive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientKeepAliveTimeout) DeepCopyInto(out *ClientKeepAliveTimeout) {
+	*out = *in
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientKeepAliveTimeout.
+func (in *ClientKeepAliveTimeout) DeepCopy() *ClientKeepAliveTimeout {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientKeepAliveTimeout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientSettingsOverride) DeepCopyInto(out *ClientSettingsOverride) {
+	*out = *in
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(ClientBody)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeepAlive != nil {
+		in, out := &in.KeepAlive, &out.KeepAlive
+		*out = new(ClientKeepAlive)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSettingsOverride.
+func (in *ClientSettingsOverride) DeepCopy() *ClientSettingsOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientSettingsOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientSettingsPolicy) DeepCopyInto(out *ClientSettingsPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSettingsPolicy.
+func (in *ClientSettingsPolicy) DeepCopy() *ClientSettingsPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientSettingsPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClientSettingsPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientSettingsPolicyList) DeepCopyInto(out *ClientSettingsPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClientSettingsPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSettingsPolicyList.
+func (in *ClientSettingsPolicyList) DeepCopy() *ClientSettingsPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientSettingsPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClientSettingsPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientSettingsPolicySpec) DeepCopyInto(out *ClientSettingsPolicySpec) {
+	*out = *in
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(ClientBody)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeepAlive != nil {
+		in, out := &in.KeepAlive, &out.KeepAlive
+		*out = new(ClientKeepAlive)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Override != nil {
+		in, out := &in.Override, &out.Override
+		*out = new(ClientSettingsOverride)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSettingsPolicySpec.
+func (in *ClientSettingsPolicySpec) DeepCopy() *ClientSettingsPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientSettingsPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompressionPolicy) DeepCopyInto(out *CompressionPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompressionPolicy.
+func (in *CompressionPolicy) DeepCopy() *CompressionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CompressionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CompressionPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompressionPolicyList) DeepCopyInto(out *CompressionPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CompressionPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompressionPolicyList.
+func (in *CompressionPolicyList) DeepCopy() *CompressionPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(CompressionPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CompressionPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompressionPolicySpec) DeepCopyInto(out *CompressionPolicySpec) {
+	*out = *in
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinLength != nil {
+		in, out := &in.MinLength, &out.MinLength
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]MIMEType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompressionPolicySpec.
+func (in *CompressionPolicySpec) DeepCopy() *CompressionPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CompressionPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPage) DeepCopyInto(out *ErrorPage) {
+	*out = *in
+	if in.Codes != nil {
+		in, out := &in.Codes, &out.Codes
+		*out = make([]ErrorPageStatusCode, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseCode != nil {
+		in, out := &in.ResponseCode, &out.ResponseCode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BackendRef != nil {
+		in, out := &in.BackendRef, &out.BackendRef
+		*out = new(v1.BackendObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = new(ErrorPageContent)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPage.
+func (in *ErrorPage) DeepCopy() *ErrorPage {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPageContent) DeepCopyInto(out *ErrorPageContent) {
+	*out = *in
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(MIMEType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPageContent.
+func (in *ErrorPageContent) DeepCopy() *ErrorPageContent {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPageContent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPagePolicy) DeepCopyInto(out *ErrorPagePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPagePolicy.
+func (in *ErrorPagePolicy) DeepCopy() *ErrorPagePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPagePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ErrorPagePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPagePolicyList) DeepCopyInto(out *ErrorPagePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ErrorPagePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPagePolicyList.
+func (in *ErrorPagePolicyList) DeepCopy() *ErrorPagePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPagePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ErrorPagePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPagePolicySpec) DeepCopyInto(out *ErrorPagePolicySpec) {
+	*out = *in
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.Pages != nil {
+		in, out := &in.Pages, &out.Pages
+		*out = make([]ErrorPage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPagePolicySpec.
+func (in *ErrorPagePolicySpec) DeepCopy() *ErrorPagePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPagePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalAuthPolicy) DeepCopyInto(out *ExternalAuthPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAuthPolicy.
+func (in *ExternalAuthPolicy) DeepCopy() *ExternalAuthPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalAuthPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalAuthPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalAuthPolicyList) DeepCopyInto(out *ExternalAuthPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ExternalAuthPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAuthPolicyList.
+func (in *ExternalAuthPolicyList) DeepCopy() *ExternalAuthPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalAuthPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalAuthPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalAuthPolicySpec) DeepCopyInto(out *ExternalAuthPolicySpec) {
+	*out = *in
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.ForwardRequestHeaders != nil {
+		in, out := &in.ForwardRequestHeaders, &out.ForwardRequestHeaders
+		*out = make([]HeaderName, len(*in))
+		copy(*out, *in)
+	}
+	if in.CopyResponseHeaders != nil {
+		in, out := &in.CopyResponseHeaders, &out.CopyResponseHeaders
+		*out = make([]HeaderName, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAuthPolicySpec.
+func (in *ExternalAuthPolicySpec) DeepCopy() *ExternalAuthPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalAuthPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancing) DeepCopyInto(out *LoadBalancing) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Consistent != nil {
+		in, out := &in.Consistent, &out.Consistent
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancing.
+func (in *LoadBalancing) DeepCopy() *LoadBalancing {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Logging) DeepCopyInto(out *Logging) {
+	*out = *in
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(ControllerLogLevel)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Logging.
+func (in *Logging) DeepCopy() *Logging {
+	if in == nil {
+		return nil
+	}
+	out := new(Logging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxGateway) DeepCopyInto(out *NginxGateway) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGateway.
+func (in *NginxGateway) DeepCopy() *NginxGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NginxGateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxGatewayList) DeepCopyInto(out *NginxGatewayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NginxGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewayList.
+func (in *NginxGatewayList) DeepCopy() *NginxGatewayList {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxGatewayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NginxGatewayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxGatewaySpec) DeepCopyInto(out *NginxGatewaySpec) {
+	*out = *in
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(Logging)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewaySpec.
+func (in *NginxGatewaySpec) DeepCopy() *NginxGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxGatewayStatus) DeepCopyInto(out *NginxGatewayStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewayStatus.
+func (in *NginxGatewayStatus) DeepCopy() *NginxGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxProxy) DeepCopyInto(out *NginxProxy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxy.
+func (in *NginxProxy) DeepCopy() *NginxProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NginxProxy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxProxyList) DeepCopyInto(out *NginxProxyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NginxProxy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxyList.
+func (in *NginxProxyList) DeepCopy() *NginxProxyList {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxProxyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NginxProxyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxProxySpec) DeepCopyInto(out *NginxProxySpec) {
+	*out = *in
+	if in.Telemetry != nil {
+		in, out := &in.Telemetry, &out.Telemetry
+		*out = new(Telemetry)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxySpec.
+func (in *NginxProxySpec) DeepCopy() *NginxProxySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxProxySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxGateway) DeepCopyInto(out *NginxGateway) {
+func (in *ObservabilityPolicy) DeepCopyInto(out *ObservabilityPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -203,18 +1334,18 @@ func (in *NginxGateway) DeepCopyInto(out *NginxGateway) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGateway.
-func (in *NginxGateway) DeepCopy() *NginxGateway {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicy.
+func (in *ObservabilityPolicy) DeepCopy() *ObservabilityPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxGateway)
+	out := new(ObservabilityPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NginxGateway) DeepCopyObject() runtime.Object {
+func (in *ObservabilityPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -222,31 +1353,31 @@ func (in *NginxGateway) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxGatewayList) DeepCopyInto(out *NginxGatewayList) {
+func (in *ObservabilityPolicyList) DeepCopyInto(out *ObservabilityPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NginxGateway, len(*in))
+		*out = make([]ObservabilityPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewayList.
-func (in *NginxGatewayList) DeepCopy() *NginxGatewayList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicyList.
+func (in *ObservabilityPolicyList) DeepCopy() *ObservabilityPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxGatewayList)
+	out := new(ObservabilityPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NginxGatewayList) DeepCopyObject() runtime.Object {
+func (in *ObservabilityPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -254,67 +1385,72 @@ func (in *NginxGatewayList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxGatewaySpec) DeepCopyInto(out *NginxGatewaySpec) {
+func (in *ObservabilityPolicySpec) DeepCopyInto(out *ObservabilityPolicySpec) {
 	*out = *in
-	if in.Logging != nil {
-		in, out := &in.Logging, &out.Logging
-		*out = new(Logging)
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(Tracing)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewaySpec.
-func (in *NginxGatewaySpec) DeepCopy() *NginxGatewaySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicySpec.
+func (in *ObservabilityPolicySpec) DeepCopy() *ObservabilityPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxGatewaySpec)
+	out := new(ObservabilityPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxGatewayStatus) DeepCopyInto(out *NginxGatewayStatus) {
+func (in *RateLimitKey) DeepCopyInto(out *RateLimitKey) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(string)
+		**out = **in
+	}
+	if in.JWTClaim != nil {
+		in, out := &in.JWTClaim, &out.JWTClaim
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewayStatus.
-func (in *NginxGatewayStatus) DeepCopy() *NginxGatewayStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitKey.
+func (in *RateLimitKey) DeepCopy() *RateLimitKey {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxGatewayStatus)
+	out := new(RateLimitKey)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxProxy) DeepCopyInto(out *NginxProxy) {
+func (in *RateLimitPolicy) DeepCopyInto(out *RateLimitPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxy.
-func (in *NginxProxy) DeepCopy() *NginxProxy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitPolicy.
+func (in *RateLimitPolicy) DeepCopy() *RateLimitPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxProxy)
+	out := new(RateLimitPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NginxProxy) DeepCopyObject() runtime.Object {
+func (in *RateLimitPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -322,31 +1458,31 @@ func (in *NginxProxy) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxProxyList) DeepCopyInto(out *NginxProxyList) {
+func (in *RateLimitPolicyList) DeepCopyInto(out *RateLimitPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NginxProxy, len(*in))
+		*out = make([]RateLimitPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxyList.
-func (in *NginxProxyList) DeepCopy() *NginxProxyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitPolicyList.
+func (in *RateLimitPolicyList) DeepCopy() *RateLimitPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxProxyList)
+	out := new(RateLimitPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NginxProxyList) DeepCopyObject() runtime.Object {
+func (in *RateLimitPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -354,27 +1490,98 @@ func (in *NginxProxyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxProxySpec) DeepCopyInto(out *NginxProxySpec) {
+func (in *RateLimitPolicySpec) DeepCopyInto(out *RateLimitPolicySpec) {
 	*out = *in
-	if in.Telemetry != nil {
-		in, out := &in.Telemetry, &out.Telemetry
-		*out = new(Telemetry)
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(RateLimitKey)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NoDelay != nil {
+		in, out := &in.NoDelay, &out.NoDelay
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RejectCode != nil {
+		in, out := &in.RejectCode, &out.RejectCode
+		*out = new(int32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxySpec.
-func (in *NginxProxySpec) DeepCopy() *NginxProxySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitPolicySpec.
+func (in *RateLimitPolicySpec) DeepCopy() *RateLimitPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxProxySpec)
+	out := new(RateLimitPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityPolicy) DeepCopyInto(out *ObservabilityPolicy) {
+func (in *RealIP) DeepCopyInto(out *RealIP) {
+	*out = *in
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(RealIPHeader)
+		**out = **in
+	}
+	if in.TrustedAddresses != nil {
+		in, out := &in.TrustedAddresses, &out.TrustedAddresses
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
+	if in.Recursive != nil {
+		in, out := &in.Recursive, &out.Recursive
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RealIP.
+func (in *RealIP) DeepCopy() *RealIP {
+	if in == nil {
+		return nil
+	}
+	out := new(RealIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryOn) DeepCopyInto(out *RetryOn) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]RetryCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]RetryStatusCode, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryOn.
+func (in *RetryOn) DeepCopy() *RetryOn {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryOn)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -382,18 +1589,18 @@ func (in *ObservabilityPolicy) DeepCopyInto(out *ObservabilityPolicy) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicy.
-func (in *ObservabilityPolicy) DeepCopy() *ObservabilityPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityPolicy)
+	out := new(RetryPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObservabilityPolicy) DeepCopyObject() runtime.Object {
+func (in *RetryPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -401,31 +1608,31 @@ func (in *ObservabilityPolicy) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityPolicyList) DeepCopyInto(out *ObservabilityPolicyList) {
+func (in *RetryPolicyList) DeepCopyInto(out *RetryPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ObservabilityPolicy, len(*in))
+		*out = make([]RetryPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicyList.
-func (in *ObservabilityPolicyList) DeepCopy() *ObservabilityPolicyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicyList.
+func (in *RetryPolicyList) DeepCopy() *RetryPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityPolicyList)
+	out := new(RetryPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObservabilityPolicyList) DeepCopyObject() runtime.Object {
+func (in *RetryPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -433,22 +1640,32 @@ func (in *ObservabilityPolicyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityPolicySpec) DeepCopyInto(out *ObservabilityPolicySpec) {
+func (in *RetryPolicySpec) DeepCopyInto(out *RetryPolicySpec) {
 	*out = *in
 	in.TargetRef.DeepCopyInto(&out.TargetRef)
-	if in.Tracing != nil {
-		in, out := &in.Tracing, &out.Tracing
-		*out = new(Tracing)
+	if in.Attempts != nil {
+		in, out := &in.Attempts, &out.Attempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = new(RetryOn)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TotalTimeout != nil {
+		in, out := &in.TotalTimeout, &out.TotalTimeout
+		*out = new(Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicySpec.
-func (in *ObservabilityPolicySpec) DeepCopy() *ObservabilityPolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicySpec.
+func (in *RetryPolicySpec) DeepCopy() *RetryPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityPolicySpec)
+	out := new(RetryPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -468,6 +1685,31 @@ func (in *SpanAttribute) DeepCopy() *SpanAttribute {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyslogDestination) DeepCopyInto(out *SyslogDestination) {
+	*out = *in
+	if in.Facility != nil {
+		in, out := &in.Facility, &out.Facility
+		*out = new(SyslogFacility)
+		**out = **in
+	}
+	if in.Tag != nil {
+		in, out := &in.Tag, &out.Tag
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogDestination.
+func (in *SyslogDestination) DeepCopy() *SyslogDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(SyslogDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Telemetry) DeepCopyInto(out *Telemetry) {
 	*out = *in
@@ -562,3 +1804,128 @@ func (in *Tracing) DeepCopy() *Tracing {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamKeepAlive) DeepCopyInto(out *UpstreamKeepAlive) {
+	*out = *in
+	if in.Connections != nil {
+		in, out := &in.Connections, &out.Connections
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamKeepAlive.
+func (in *UpstreamKeepAlive) DeepCopy() *UpstreamKeepAlive {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamKeepAlive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamSettingsPolicy) DeepCopyInto(out *UpstreamSettingsPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamSettingsPolicy.
+func (in *UpstreamSettingsPolicy) DeepCopy() *UpstreamSettingsPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamSettingsPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpstreamSettingsPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamSettingsPolicyList) DeepCopyInto(out *UpstreamSettingsPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UpstreamSettingsPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamSettingsPolicyList.
+func (in *UpstreamSettingsPolicyList) DeepCopy() *UpstreamSettingsPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamSettingsPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpstreamSettingsPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamSettingsPolicySpec) DeepCopyInto(out *UpstreamSettingsPolicySpec) {
+	*out = *in
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.LoadBalancing != nil {
+		in, out := &in.LoadBalancing, &out.LoadBalancing
+		*out = new(LoadBalancing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeepAlive != nil {
+		in, out := &in.KeepAlive, &out.KeepAlive
+		*out = new(UpstreamKeepAlive)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneSize != nil {
+		in, out := &in.ZoneSize, &out.ZoneSize
+		*out = new(Size)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamSettingsPolicySpec.
+func (in *UpstreamSettingsPolicySpec) DeepCopy() *UpstreamSettingsPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamSettingsPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
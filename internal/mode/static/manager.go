@@ -25,6 +25,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	ctlr "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	ctrlcfg "sigs.k8s.io/controller-runtime/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
@@ -50,6 +51,20 @@ import (
 	ngxvalidation "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file"
 	ngxruntime "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/runtime"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/accesscontrol"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/accesslog"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/basicauth"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/cache"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/clientsettings"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/compression"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/cors"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/errorpage"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/externalauth"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/observability"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/ratelimit"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/retry"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/upstreamsettings"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
@@ -116,6 +131,8 @@ func StartManager(cfg config.Config) error {
 		Logger:           cfg.Logger.WithName("changeProcessor"),
 		Validators: validation.Validators{
 			HTTPFieldsValidator: ngxvalidation.HTTPValidator{},
+			GenericValidator:    ngxvalidation.GenericValidator{},
+			PolicyValidator:     createPolicyManager(ngxvalidation.GenericValidator{}, cfg.Plus),
 		},
 		EventRecorder:  recorder,
 		Scheme:         scheme,
@@ -271,6 +288,88 @@ func StartManager(cfg config.Config) error {
 	return mgr.Start(ctx)
 }
 
+// createPolicyManager creates the Manager of the NGF Policy kinds, which validates and merges the Policies.
+// Some Policy settings are only supported by NGINX Plus, so the validation depends on plus.
+func createPolicyManager(genericValidator validation.GenericValidator, plus bool) *policies.Manager {
+	mustExtractGVK := func(obj client.Object) schema.GroupVersionKind {
+		gvk, err := apiutil.GVKForObject(obj, scheme)
+		if err != nil {
+			panic(fmt.Errorf("failed to get GVK for object %T: %w", obj, err))
+		}
+		return gvk
+	}
+
+	cfgs := []policies.ManagerConfig{
+		{
+			GVK:       mustExtractGVK(&ngfAPI.ClientSettingsPolicy{}),
+			Validator: clientsettings.NewValidator(),
+			Merger:    clientsettings.NewMerger(),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.ObservabilityPolicy{}),
+			Validator: observability.NewValidator(genericValidator),
+			Merger:    policies.DirectMerger{},
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.RateLimitPolicy{}),
+			Validator: ratelimit.NewValidator(plus),
+			Merger:    ratelimit.NewMerger(),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.UpstreamSettingsPolicy{}),
+			Validator: upstreamsettings.NewValidator(),
+			Merger:    upstreamsettings.NewMerger(),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.RetryPolicy{}),
+			Validator: retry.NewValidator(),
+			Merger:    retry.NewMerger(),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.BasicAuthPolicy{}),
+			Validator: basicauth.NewValidator(genericValidator),
+			Merger:    policies.DirectMerger{},
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.ExternalAuthPolicy{}),
+			Validator: externalauth.NewValidator(),
+			Merger:    policies.DirectMerger{},
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.AccessControlPolicy{}),
+			Validator: accesscontrol.NewValidator(),
+			Merger:    accesscontrol.NewMerger(),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.CORSPolicy{}),
+			Validator: cors.NewValidator(),
+			Merger:    policies.DirectMerger{},
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.CachePolicy{}),
+			Validator: cache.NewValidator(),
+			Merger:    policies.DirectMerger{},
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.CompressionPolicy{}),
+			Validator: compression.NewValidator(),
+			Merger:    compression.NewMerger(),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.ErrorPagePolicy{}),
+			Validator: errorpage.NewValidator(),
+			Merger:    errorpage.NewMerger(),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.AccessLogPolicy{}),
+			Validator: accesslog.NewValidator(),
+			Merger:    accesslog.NewMerger(),
+		},
+	}
+
+	return policies.NewManager(mustExtractGVK, cfgs...)
+}
+
 func createManager(cfg config.Config, nginxChecker *nginxConfiguredOnStartChecker) (manager.Manager, error) {
 	options := manager.Options{
 		Scheme:  scheme,
@@ -386,6 +485,11 @@ func registerControllers(
 		{
 			objectType: &apiv1.Secret{},
 		},
+		{
+			// FIXME(ciarams87): If possible, use only metadata predicate
+			// https://github.com/nginxinc/nginx-gateway-fabric/issues/1545
+			objectType: &apiv1.ConfigMap{},
+		},
 		{
 			objectType: &discoveryV1.EndpointSlice{},
 			options: []controller.Option{
@@ -405,6 +509,90 @@ func registerControllers(
 				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
 			},
 		},
+		{
+			objectType: &ngfAPI.ClientSettingsPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.ObservabilityPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.RateLimitPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.UpstreamSettingsPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.RetryPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.BasicAuthPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.ExternalAuthPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.AccessControlPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.CORSPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.CachePolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.CompressionPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.ErrorPagePolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.AccessLogPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.NginxProxy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
 		{
 			objectType: &crdWithGVK,
 			options: []controller.Option{
@@ -424,13 +612,48 @@ func registerControllers(
 					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
 				},
 			},
+		}
+		controllerRegCfgs = append(controllerRegCfgs, backendTLSObjs...)
+
+		grpcRouteObjs := []ctlrCfg{
 			{
-				// FIXME(ciarams87): If possible, use only metadata predicate
-				// https://github.com/nginxinc/nginx-gateway-fabric/issues/1545
-				objectType: &apiv1.ConfigMap{},
+				objectType: &gatewayv1alpha2.GRPCRoute{},
+				options: []controller.Option{
+					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+				},
 			},
 		}
-		controllerRegCfgs = append(controllerRegCfgs, backendTLSObjs...)
+		controllerRegCfgs = append(controllerRegCfgs, grpcRouteObjs...)
+
+		tlsRouteObjs := []ctlrCfg{
+			{
+				objectType: &gatewayv1alpha2.TLSRoute{},
+				options: []controller.Option{
+					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+				},
+			},
+		}
+		controllerRegCfgs = append(controllerRegCfgs, tlsRouteObjs...)
+
+		tcpRouteObjs := []ctlrCfg{
+			{
+				objectType: &gatewayv1alpha2.TCPRoute{},
+				options: []controller.Option{
+					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+				},
+			},
+		}
+		controllerRegCfgs = append(controllerRegCfgs, tcpRouteObjs...)
+
+		udpRouteObjs := []ctlrCfg{
+			{
+				objectType: &gatewayv1alpha2.UDPRoute{},
+				options: []controller.Option{
+					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+				},
+			},
+		}
+		controllerRegCfgs = append(controllerRegCfgs, udpRouteObjs...)
 	}
 
 	if cfg.ConfigName != "" {
@@ -589,14 +812,36 @@ func prepareFirstEventBatchPreparerArgs(
 		&apiv1.ServiceList{},
 		&apiv1.SecretList{},
 		&apiv1.NamespaceList{},
+		&apiv1.ConfigMapList{},
 		&discoveryV1.EndpointSliceList{},
 		&gatewayv1.HTTPRouteList{},
 		&gatewayv1beta1.ReferenceGrantList{},
+		&ngfAPI.ClientSettingsPolicyList{},
+		&ngfAPI.ObservabilityPolicyList{},
+		&ngfAPI.RateLimitPolicyList{},
+		&ngfAPI.UpstreamSettingsPolicyList{},
+		&ngfAPI.RetryPolicyList{},
+		&ngfAPI.BasicAuthPolicyList{},
+		&ngfAPI.ExternalAuthPolicyList{},
+		&ngfAPI.AccessControlPolicyList{},
+		&ngfAPI.CORSPolicyList{},
+		&ngfAPI.CachePolicyList{},
+		&ngfAPI.CompressionPolicyList{},
+		&ngfAPI.ErrorPagePolicyList{},
+		&ngfAPI.AccessLogPolicyList{},
+		&ngfAPI.NginxProxyList{},
 		partialObjectMetadataList,
 	}
 
 	if enableExperimentalFeatures {
-		objectLists = append(objectLists, &gatewayv1alpha2.BackendTLSPolicyList{}, &apiv1.ConfigMapList{})
+		objectLists = append(
+			objectLists,
+			&gatewayv1alpha2.BackendTLSPolicyList{},
+			&gatewayv1alpha2.GRPCRouteList{},
+			&gatewayv1alpha2.TLSRouteList{},
+			&gatewayv1alpha2.TCPRouteList{},
+			&gatewayv1alpha2.UDPRouteList{},
+		)
 	}
 
 	if gwNsName == nil {
@@ -16,6 +16,7 @@ import (
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/config"
 )
 
@@ -48,10 +49,25 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&apiv1.ServiceList{},
 				&apiv1.SecretList{},
 				&apiv1.NamespaceList{},
+				&apiv1.ConfigMapList{},
 				&discoveryV1.EndpointSliceList{},
 				&gatewayv1.HTTPRouteList{},
 				&gatewayv1.GatewayList{},
 				&gatewayv1beta1.ReferenceGrantList{},
+				&ngfAPI.ClientSettingsPolicyList{},
+				&ngfAPI.ObservabilityPolicyList{},
+				&ngfAPI.RateLimitPolicyList{},
+				&ngfAPI.UpstreamSettingsPolicyList{},
+				&ngfAPI.RetryPolicyList{},
+				&ngfAPI.BasicAuthPolicyList{},
+				&ngfAPI.ExternalAuthPolicyList{},
+				&ngfAPI.AccessControlPolicyList{},
+				&ngfAPI.CORSPolicyList{},
+				&ngfAPI.CachePolicyList{},
+				&ngfAPI.CompressionPolicyList{},
+				&ngfAPI.ErrorPagePolicyList{},
+				&ngfAPI.AccessLogPolicyList{},
+				&ngfAPI.NginxProxyList{},
 				partialObjectMetadataList,
 			},
 		},
@@ -69,9 +85,24 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&apiv1.ServiceList{},
 				&apiv1.SecretList{},
 				&apiv1.NamespaceList{},
+				&apiv1.ConfigMapList{},
 				&discoveryV1.EndpointSliceList{},
 				&gatewayv1.HTTPRouteList{},
 				&gatewayv1beta1.ReferenceGrantList{},
+				&ngfAPI.ClientSettingsPolicyList{},
+				&ngfAPI.ObservabilityPolicyList{},
+				&ngfAPI.RateLimitPolicyList{},
+				&ngfAPI.UpstreamSettingsPolicyList{},
+				&ngfAPI.RetryPolicyList{},
+				&ngfAPI.BasicAuthPolicyList{},
+				&ngfAPI.ExternalAuthPolicyList{},
+				&ngfAPI.AccessControlPolicyList{},
+				&ngfAPI.CORSPolicyList{},
+				&ngfAPI.CachePolicyList{},
+				&ngfAPI.CompressionPolicyList{},
+				&ngfAPI.ErrorPagePolicyList{},
+				&ngfAPI.AccessLogPolicyList{},
+				&ngfAPI.NginxProxyList{},
 				partialObjectMetadataList,
 			},
 		},
@@ -93,8 +124,26 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&discoveryV1.EndpointSliceList{},
 				&gatewayv1.HTTPRouteList{},
 				&gatewayv1beta1.ReferenceGrantList{},
+				&ngfAPI.ClientSettingsPolicyList{},
+				&ngfAPI.ObservabilityPolicyList{},
+				&ngfAPI.RateLimitPolicyList{},
+				&ngfAPI.UpstreamSettingsPolicyList{},
+				&ngfAPI.RetryPolicyList{},
+				&ngfAPI.BasicAuthPolicyList{},
+				&ngfAPI.ExternalAuthPolicyList{},
+				&ngfAPI.AccessControlPolicyList{},
+				&ngfAPI.CORSPolicyList{},
+				&ngfAPI.CachePolicyList{},
+				&ngfAPI.CompressionPolicyList{},
+				&ngfAPI.ErrorPagePolicyList{},
+				&ngfAPI.AccessLogPolicyList{},
+				&ngfAPI.NginxProxyList{},
 				partialObjectMetadataList,
 				&gatewayv1alpha2.BackendTLSPolicyList{},
+				&gatewayv1alpha2.GRPCRouteList{},
+				&gatewayv1alpha2.TLSRouteList{},
+				&gatewayv1alpha2.TCPRouteList{},
+				&gatewayv1alpha2.UDPRouteList{},
 			},
 			experimentalEnabled: true,
 		},
@@ -0,0 +1,156 @@
+package config
+
+import (
+	"strings"
+	gotemplate "text/template"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var logFormatsTemplate = gotemplate.Must(
+	gotemplate.New("logFormats").Parse(logFormatsTemplateText),
+)
+
+const (
+	// stdoutLogPath is the path of the access logs that are written to the stdout of the NGINX container.
+	stdoutLogPath = "/dev/stdout"
+
+	// routeNamespaceVariable is the variable with the namespace of the route that matches the request.
+	routeNamespaceVariable = "ngf_route_namespace"
+	// routeNameVariable is the variable with the name of the route that matches the request.
+	routeNameVariable = "ngf_route_name"
+)
+
+// defaultLogFields are the fields of the log entries of a format without fields.
+var defaultLogFields = []dataplane.LogField{
+	{Name: "time", Value: "$time_iso8601"},
+	{Name: "remote_addr", Value: "$remote_addr"},
+	{Name: "request_method", Value: "$request_method"},
+	{Name: "request_uri", Value: "$request_uri"},
+	{Name: "protocol", Value: "$server_protocol"},
+	{Name: "status", Value: "$status"},
+	{Name: "body_bytes_sent", Value: "$body_bytes_sent"},
+	{Name: "request_time", Value: "$request_time"},
+	{Name: "host", Value: "$host"},
+	{Name: "http_referer", Value: "$http_referer"},
+	{Name: "http_user_agent", Value: "$http_user_agent"},
+	{Name: "upstream_addr", Value: "$upstream_addr"},
+	{Name: "upstream_status", Value: "$upstream_status"},
+	{Name: "upstream_response_time", Value: "$upstream_response_time"},
+}
+
+func executeLogFormats(conf dataplane.Configuration) []byte {
+	if len(conf.LogFormats) == 0 {
+		return nil
+	}
+
+	return execute(logFormatsTemplate, createLogFormats(conf.LogFormats))
+}
+
+func createLogFormats(formats []dataplane.LogFormat) []http.LogFormat {
+	logFormats := make([]http.LogFormat, 0, len(formats))
+
+	for _, format := range formats {
+		logFormats = append(logFormats, http.LogFormat{
+			Name:   format.Name,
+			Format: createLogFormatJSON(format),
+		})
+	}
+
+	return logFormats
+}
+
+// createLogFormatJSON creates the JSON object of the log entries of a format. The values of the fields are
+// JSON strings, because NGINX escapes the values of the variables as strings.
+func createLogFormatJSON(format dataplane.LogFormat) string {
+	fields := format.Fields
+	if len(fields) == 0 {
+		fields = defaultLogFields
+	}
+
+	if format.TraceContext {
+		fields = append(
+			fields[:len(fields):len(fields)],
+			dataplane.LogField{Name: "trace_id", Value: "$otel_trace_id"},
+			dataplane.LogField{Name: "span_id", Value: "$otel_span_id"},
+		)
+	}
+
+	if format.RouteInfo {
+		fields = append(
+			fields[:len(fields):len(fields)],
+			dataplane.LogField{Name: "route_namespace", Value: "$" + routeNamespaceVariable},
+			dataplane.LogField{Name: "route_name", Value: "$" + routeNameVariable},
+		)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("{")
+
+	for i, f := range fields {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		sb.WriteString(`"` + f.Name + `":"` + f.Value + `"`)
+	}
+
+	sb.WriteString("}")
+
+	return sb.String()
+}
+
+// createAccessLog converts the access log of a server or a rule into an NGINX access log.
+func createAccessLog(accessLog *dataplane.AccessLog) *http.AccessLog {
+	if accessLog == nil {
+		return nil
+	}
+
+	if accessLog.Format == nil {
+		return &http.AccessLog{}
+	}
+
+	return &http.AccessLog{
+		Format: accessLog.Format.Name,
+		Path:   getAccessLogPath(accessLog.Syslog),
+	}
+}
+
+// getAccessLogPath returns the path of the access logs: either stdout or a syslog server.
+func getAccessLogPath(syslog *dataplane.SyslogServer) string {
+	if syslog == nil {
+		return stdoutLogPath
+	}
+
+	path := "syslog:server=" + syslog.Address
+	if syslog.Facility != "" {
+		path += ",facility=" + syslog.Facility
+	}
+	if syslog.Tag != "" {
+		path += ",tag=" + syslog.Tag
+	}
+
+	return path
+}
+
+// logsRouteInfo returns true if the entries of the access log include the namespace and the name of the route.
+func logsRouteInfo(accessLog *dataplane.AccessLog) bool {
+	return accessLog != nil && accessLog.Format != nil && accessLog.Format.RouteInfo
+}
+
+// createRouteVariables creates the variables with the namespace and the name of a route for the access logs.
+// The variables of a server are empty, so that the requests that don't match any route are logged without
+// the route.
+func createRouteVariables(source *metav1.ObjectMeta) []http.Variable {
+	var namespace, name string
+	if source != nil {
+		namespace, name = source.Namespace, source.Name
+	}
+
+	return []http.Variable{
+		{Name: routeNamespaceVariable, Value: namespace},
+		{Name: routeNameVariable, Value: name},
+	}
+}
@@ -0,0 +1,7 @@
+package config
+
+var logFormatsTemplateText = `
+{{- range $f := . }}
+log_format {{ $f.Name }} escape=json '{{ $f.Format }}';
+{{- end }}
+`
@@ -0,0 +1,128 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteLogFormats(t *testing.T) {
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		LogFormats: []dataplane.LogFormat{
+			{
+				Name: "test_default",
+			},
+			{
+				Name: "test_full",
+				Fields: []dataplane.LogField{
+					{Name: "status", Value: "$status"},
+					{Name: "request", Value: "$request_method $uri"},
+				},
+				TraceContext: true,
+				RouteInfo:    true,
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		`log_format test_default escape=json '{"time":"$time_iso8601","remote_addr":"$remote_addr",` +
+			`"request_method":"$request_method","request_uri":"$request_uri","protocol":"$server_protocol",` +
+			`"status":"$status","body_bytes_sent":"$body_bytes_sent","request_time":"$request_time",` +
+			`"host":"$host","http_referer":"$http_referer","http_user_agent":"$http_user_agent",` +
+			`"upstream_addr":"$upstream_addr","upstream_status":"$upstream_status",` +
+			`"upstream_response_time":"$upstream_response_time"}';`: 1,
+		`log_format test_full escape=json '{"status":"$status","request":"$request_method $uri",` +
+			`"trace_id":"$otel_trace_id","span_id":"$otel_span_id",` +
+			`"route_namespace":"$ngf_route_namespace","route_name":"$ngf_route_name"}';`: 1,
+	}
+
+	result := string(executeLogFormats(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(result, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+
+	g.Expect(executeLogFormats(dataplane.Configuration{})).To(BeEmpty())
+
+	// the default fields are not modified by the fields added to a format
+	g.Expect(defaultLogFields).To(HaveLen(14))
+}
+
+func TestCreateAccessLog(t *testing.T) {
+	tests := []struct {
+		accessLog *dataplane.AccessLog
+		expected  *http.AccessLog
+		msg       string
+	}{
+		{
+			msg:       "nil access log",
+			accessLog: nil,
+			expected:  nil,
+		},
+		{
+			msg:       "access log turned off",
+			accessLog: &dataplane.AccessLog{},
+			expected:  &http.AccessLog{},
+		},
+		{
+			msg: "stdout",
+			accessLog: &dataplane.AccessLog{
+				Format: &dataplane.LogFormat{Name: "test_logs"},
+			},
+			expected: &http.AccessLog{
+				Format: "test_logs",
+				Path:   "/dev/stdout",
+			},
+		},
+		{
+			msg: "syslog",
+			accessLog: &dataplane.AccessLog{
+				Format: &dataplane.LogFormat{Name: "test_logs"},
+				Syslog: &dataplane.SyslogServer{Address: "10.0.0.1:1514"},
+			},
+			expected: &http.AccessLog{
+				Format: "test_logs",
+				Path:   "syslog:server=10.0.0.1:1514",
+			},
+		},
+		{
+			msg: "syslog with facility and tag",
+			accessLog: &dataplane.AccessLog{
+				Format: &dataplane.LogFormat{Name: "test_logs"},
+				Syslog: &dataplane.SyslogServer{Address: "syslog.example.com", Facility: "local0", Tag: "ngf"},
+			},
+			expected: &http.AccessLog{
+				Format: "test_logs",
+				Path:   "syslog:server=syslog.example.com,facility=local0,tag=ngf",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(createAccessLog(tc.accessLog)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestCreateRouteVariables(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(createRouteVariables(&metav1.ObjectMeta{Namespace: "test", Name: "hr"})).To(Equal([]http.Variable{
+		{Name: "ngf_route_namespace", Value: "test"},
+		{Name: "ngf_route_name", Value: "hr"},
+	}))
+
+	g.Expect(createRouteVariables(nil)).To(Equal([]http.Variable{
+		{Name: "ngf_route_namespace", Value: ""},
+		{Name: "ngf_route_name", Value: ""},
+	}))
+}
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+	gotemplate "text/template"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var cacheZonesTemplate = gotemplate.Must(
+	gotemplate.New("cacheZones").Parse(cacheZonesTemplateText),
+)
+
+const (
+	// cacheZoneSize is the default size of the shared memory zone of the keys of a cache.
+	// One megabyte zone can keep about 8 thousand keys.
+	cacheZoneSize = "10m"
+	// cacheDir is the directory of the cached responses. Every zone has its own subdirectory.
+	cacheDir = "/var/cache/nginx"
+)
+
+func executeCacheZones(conf dataplane.Configuration) []byte {
+	if len(conf.CacheZones) == 0 {
+		return nil
+	}
+
+	return execute(cacheZonesTemplate, createCacheZones(conf.CacheZones))
+}
+
+func createCacheZones(zones []dataplane.CacheZone) []http.CacheZone {
+	cacheZones := make([]http.CacheZone, 0, len(zones))
+
+	for _, zone := range zones {
+		size := zone.Size
+		if size == "" {
+			size = cacheZoneSize
+		}
+
+		cacheZones = append(cacheZones, http.CacheZone{
+			Name:     zone.Name,
+			Path:     cacheDir + "/" + zone.Name,
+			Size:     size,
+			MaxSize:  zone.MaxSize,
+			Inactive: zone.Inactive,
+		})
+	}
+
+	return cacheZones
+}
+
+// createCache converts the cache of a rule into an NGINX cache.
+func createCache(cache *dataplane.Cache) *http.Cache {
+	if cache == nil {
+		return nil
+	}
+
+	result := &http.Cache{
+		ZoneName: cache.Zone.Name,
+		Key:      cache.Key,
+	}
+
+	for _, v := range cache.Valid {
+		codes := make([]string, 0, len(v.Codes))
+		for _, code := range v.Codes {
+			codes = append(codes, strconv.Itoa(int(code)))
+		}
+
+		result.Valid = append(result.Valid, http.CacheValid{
+			Codes: strings.Join(codes, " "),
+			Time:  v.Time,
+		})
+	}
+
+	bypass := make([]string, 0, len(cache.BypassHeaders))
+	for _, h := range cache.BypassHeaders {
+		bypass = append(bypass, "$http_"+convertStringToSafeVariableName(strings.ToLower(h)))
+	}
+
+	result.Bypass = strings.Join(bypass, " ")
+
+	return result
+}
@@ -0,0 +1,9 @@
+package config
+
+var cacheZonesTemplateText = `
+{{- range $z := . }}
+proxy_cache_path {{ $z.Path }} levels=1:2 keys_zone={{ $z.Name }}:{{ $z.Size }}
+    {{- if $z.MaxSize }} max_size={{ $z.MaxSize }}{{ end }}
+    {{- if $z.Inactive }} inactive={{ $z.Inactive }}{{ end }} use_temp_path=off;
+{{- end }}
+`
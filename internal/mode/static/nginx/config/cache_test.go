@@ -0,0 +1,95 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteCacheZones(t *testing.T) {
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		CacheZones: []dataplane.CacheZone{
+			{
+				Name: "test_default",
+			},
+			{
+				Name:     "test_full",
+				Size:     "1m",
+				MaxSize:  "1g",
+				Inactive: "600s",
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"proxy_cache_path /var/cache/nginx/test_default levels=1:2 keys_zone=test_default:10m " +
+			"use_temp_path=off;": 1,
+		"proxy_cache_path /var/cache/nginx/test_full levels=1:2 keys_zone=test_full:1m max_size=1g " +
+			"inactive=600s use_temp_path=off;": 1,
+	}
+
+	result := string(executeCacheZones(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(result, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+
+	g.Expect(executeCacheZones(dataplane.Configuration{})).To(BeEmpty())
+}
+
+func TestCreateCache(t *testing.T) {
+	tests := []struct {
+		cache    *dataplane.Cache
+		expected *http.Cache
+		msg      string
+	}{
+		{
+			msg:      "nil cache",
+			cache:    nil,
+			expected: nil,
+		},
+		{
+			msg: "default cache",
+			cache: &dataplane.Cache{
+				Zone: dataplane.CacheZone{Name: "test_cache"},
+			},
+			expected: &http.Cache{
+				ZoneName: "test_cache",
+			},
+		},
+		{
+			msg: "full cache",
+			cache: &dataplane.Cache{
+				Zone: dataplane.CacheZone{Name: "test_cache"},
+				Key:  "$host$request_uri",
+				Valid: []dataplane.CacheValid{
+					{Codes: []int32{200, 301}, Time: "60s"},
+					{Time: "10s"},
+				},
+				BypassHeaders: []string{"Cache-Bypass", "Authorization"},
+			},
+			expected: &http.Cache{
+				ZoneName: "test_cache",
+				Key:      "$host$request_uri",
+				Valid: []http.CacheValid{
+					{Codes: "200 301", Time: "60s"},
+					{Time: "10s"},
+				},
+				Bypass: "$http_cache_bypass $http_authorization",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(createCache(test.cache)).To(Equal(test.expected))
+		})
+	}
+}
@@ -15,18 +15,26 @@ const (
 
 	// httpFolder is the folder where NGINX HTTP configuration files are stored.
 	httpFolder = configFolder + "/conf.d"
+	// streamFolder is the folder where NGINX Stream configuration files are stored.
+	streamFolder = configFolder + "/stream-conf.d"
 	// secretsFolder is the folder where secrets (like TLS certs/keys) are stored.
 	secretsFolder = configFolder + "/secrets"
 
 	// httpConfigFile is the path to the configuration file with HTTP configuration.
 	httpConfigFile = httpFolder + "/http.conf"
 
+	// streamConfigFile is the path to the configuration file with Stream configuration.
+	streamConfigFile = streamFolder + "/stream.conf"
+
+	// streamUpstreamsConfigFile is the path to the configuration file with Stream upstreams.
+	streamUpstreamsConfigFile = streamFolder + "/upstreams.conf"
+
 	// configVersionFile is the path to the config version configuration file.
 	configVersionFile = httpFolder + "/config-version.conf"
 )
 
 // ConfigFolders is a list of folders where NGINX configuration files are stored.
-var ConfigFolders = []string{httpFolder, secretsFolder}
+var ConfigFolders = []string{httpFolder, streamFolder, secretsFolder}
 
 // Generator generates NGINX configuration files.
 // This interface is used for testing purposes only.
@@ -39,10 +47,12 @@ type Generator interface {
 //
 // It generates files to be written to the following locations, which must exist and available for writing:
 // - httpFolder, for HTTP configuration files.
+// - streamFolder, for Stream configuration files.
 // - secretsFolder, for secrets.
 //
 // It also expects that the main NGINX configuration file nginx.conf is located in configFolder and nginx.conf
-// includes (https://nginx.org/en/docs/ngx_core_module.html#include) the files from httpFolder.
+// includes (https://nginx.org/en/docs/ngx_core_module.html#include) the files from httpFolder in the http context
+// and the files from streamFolder in the stream context.
 type GeneratorImpl struct {
 	plus bool
 }
@@ -60,7 +70,7 @@ type executeFunc func(configuration dataplane.Configuration) []byte
 // In case of invalid configuration, NGINX will fail to reload or could be configured with malicious configuration.
 // To validate, use the validators from the validation package.
 func (g GeneratorImpl) Generate(conf dataplane.Configuration) []file.File {
-	files := make([]file.File, 0, len(conf.SSLKeyPairs)+1 /* http config */)
+	files := make([]file.File, 0, len(conf.SSLKeyPairs)+3 /* http, stream and stream upstreams config */)
 
 	for id, pair := range conf.SSLKeyPairs {
 		files = append(files, generatePEM(id, pair.Cert, pair.Key))
@@ -68,12 +78,24 @@ func (g GeneratorImpl) Generate(conf dataplane.Configuration) []file.File {
 
 	files = append(files, g.generateHTTPConfig(conf))
 
+	files = append(files, g.generateStreamConfig(conf))
+
+	files = append(files, g.generateStreamUpstreamsConfig(conf))
+
 	files = append(files, generateConfigVersion(conf.Version))
 
 	for id, bundle := range conf.CertBundles {
 		files = append(files, generateCertBundle(id, bundle))
 	}
 
+	for id, userFile := range conf.AuthUserFiles {
+		files = append(files, generateAuthUserFile(id, userFile))
+	}
+
+	for id, page := range conf.ErrorPageFiles {
+		files = append(files, generateErrorPageFile(id, page))
+	}
+
 	return files
 }
 
@@ -106,6 +128,30 @@ func generateCertBundleFileName(id dataplane.CertBundleID) string {
 	return filepath.Join(secretsFolder, string(id)+".crt")
 }
 
+func generateAuthUserFile(id dataplane.AuthUserFileID, userFile []byte) file.File {
+	return file.File{
+		Content: userFile,
+		Path:    generateAuthUserFileName(id),
+		Type:    file.TypeSecret,
+	}
+}
+
+func generateAuthUserFileName(id dataplane.AuthUserFileID) string {
+	return filepath.Join(secretsFolder, string(id)+".htpasswd")
+}
+
+func generateErrorPageFile(id dataplane.ErrorPageFileID, page []byte) file.File {
+	return file.File{
+		Content: page,
+		Path:    generateErrorPageFileName(id),
+		Type:    file.TypeRegular,
+	}
+}
+
+func generateErrorPageFileName(id dataplane.ErrorPageFileID) string {
+	return filepath.Join(secretsFolder, string(id)+".page")
+}
+
 func (g GeneratorImpl) generateHTTPConfig(conf dataplane.Configuration) file.File {
 	var c []byte
 	for _, execute := range g.getExecuteFuncs() {
@@ -121,6 +167,10 @@ func (g GeneratorImpl) generateHTTPConfig(conf dataplane.Configuration) file.Fil
 
 func (g GeneratorImpl) getExecuteFuncs() []executeFunc {
 	return []executeFunc{
+		executeTelemetry,
+		executeRateLimitZones,
+		executeCacheZones,
+		executeLogFormats,
 		g.executeUpstreams,
 		executeSplitClients,
 		executeServers,
@@ -128,6 +178,35 @@ func (g GeneratorImpl) getExecuteFuncs() []executeFunc {
 	}
 }
 
+func (g GeneratorImpl) generateStreamConfig(conf dataplane.Configuration) file.File {
+	var c []byte
+	for _, execute := range g.getStreamExecuteFuncs() {
+		c = append(c, execute(conf)...)
+	}
+
+	return file.File{
+		Content: c,
+		Path:    streamConfigFile,
+		Type:    file.TypeRegular,
+	}
+}
+
+func (g GeneratorImpl) getStreamExecuteFuncs() []executeFunc {
+	return []executeFunc{
+		executeStreamSplitClients,
+		executeStreamServers,
+		executeStreamMaps,
+	}
+}
+
+func (g GeneratorImpl) generateStreamUpstreamsConfig(conf dataplane.Configuration) file.File {
+	return file.File{
+		Content: g.executeStreamUpstreams(conf),
+		Path:    streamUpstreamsConfigFile,
+		Type:    file.TypeRegular,
+	}
+}
+
 // generateConfigVersion writes the config version file.
 func generateConfigVersion(configVersion int) file.File {
 	c := executeVersion(configVersion)
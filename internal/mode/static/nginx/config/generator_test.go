@@ -10,6 +10,7 @@ import (
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
 )
 
 func TestGenerate(t *testing.T) {
@@ -53,6 +54,36 @@ func TestGenerate(t *testing.T) {
 			},
 		},
 		BackendGroups: []dataplane.BackendGroup{bg},
+		TLSPassthroughServers: []dataplane.Layer4VirtualServer{
+			{
+				Hostname: "app.example.com",
+				Port:     8443,
+				BackendGroup: dataplane.BackendGroup{
+					Source:   types.NamespacedName{Namespace: "test", Name: "tr"},
+					Backends: []dataplane.Backend{{UpstreamName: "stream_up", Valid: true, Weight: 1}},
+				},
+			},
+		},
+		TCPServers: []dataplane.Layer4VirtualServer{
+			{
+				Port: 5432,
+				BackendGroup: dataplane.BackendGroup{
+					Source:   types.NamespacedName{Namespace: "test", Name: "tcp"},
+					Backends: []dataplane.Backend{{UpstreamName: "stream_up", Valid: true, Weight: 1}},
+				},
+			},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{
+				Name: "stream_up",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "1.1.1.1",
+						Port:    443,
+					},
+				},
+			},
+		},
 		SSLKeyPairs: map[dataplane.SSLKeyPairID]dataplane.SSLKeyPair{
 			"test-keypair": {
 				Cert: []byte("test-cert"),
@@ -62,6 +93,33 @@ func TestGenerate(t *testing.T) {
 		CertBundles: map[dataplane.CertBundleID]dataplane.CertBundle{
 			"test-certbundle": []byte("test-cert"),
 		},
+		AuthUserFiles: map[dataplane.AuthUserFileID]dataplane.AuthUserFile{
+			"test-userfile": []byte("user:password"),
+		},
+		ErrorPageFiles: map[dataplane.ErrorPageFileID]dataplane.ErrorPageFile{
+			"test-errorpage": []byte("<h1>Not Found</h1>"),
+		},
+		Telemetry: dataplane.Telemetry{
+			Endpoint:    "my-otel.svc:4317",
+			ServiceName: "ngf:test:gateway",
+		},
+		RateLimitZones: []dataplane.RateLimitZone{
+			{
+				Name:    "test_limit",
+				KeyType: dataplane.RateLimitKeyClientIP,
+				Rate:    "10r/s",
+			},
+		},
+		CacheZones: []dataplane.CacheZone{
+			{
+				Name: "test_cache",
+			},
+		},
+		LogFormats: []dataplane.LogFormat{
+			{
+				Name: "test_logs",
+			},
+		},
 	}
 	g := NewWithT(t)
 
@@ -70,7 +128,7 @@ func TestGenerate(t *testing.T) {
 
 	files := generator.Generate(conf)
 
-	g.Expect(files).To(HaveLen(4))
+	g.Expect(files).To(HaveLen(8))
 
 	g.Expect(files[0]).To(Equal(file.File{
 		Type:    file.TypeSecret,
@@ -87,13 +145,44 @@ func TestGenerate(t *testing.T) {
 	g.Expect(httpCfg).To(ContainSubstring("listen 443"))
 	g.Expect(httpCfg).To(ContainSubstring("upstream"))
 	g.Expect(httpCfg).To(ContainSubstring("split_clients"))
+	g.Expect(httpCfg).To(ContainSubstring("otel_exporter"))
+	g.Expect(httpCfg).To(ContainSubstring("limit_req_zone"))
+	g.Expect(httpCfg).To(ContainSubstring("proxy_cache_path"))
+	g.Expect(httpCfg).To(ContainSubstring("log_format"))
 
 	g.Expect(files[2].Type).To(Equal(file.TypeRegular))
-	g.Expect(files[2].Path).To(Equal("/etc/nginx/conf.d/config-version.conf"))
-	configVersion := string(files[2].Content)
+	g.Expect(files[2].Path).To(Equal("/etc/nginx/stream-conf.d/stream.conf"))
+	streamCfg := string(files[2].Content)
+	g.Expect(streamCfg).To(ContainSubstring("listen 8443"))
+	g.Expect(streamCfg).To(ContainSubstring("ssl_preread on"))
+	g.Expect(streamCfg).To(ContainSubstring("map $ssl_preread_server_name"))
+	g.Expect(streamCfg).To(ContainSubstring("listen 5432"))
+	g.Expect(streamCfg).To(ContainSubstring("proxy_pass stream_up"))
+
+	g.Expect(files[3].Type).To(Equal(file.TypeRegular))
+	g.Expect(files[3].Path).To(Equal("/etc/nginx/stream-conf.d/upstreams.conf"))
+	streamUpstreamsCfg := string(files[3].Content)
+	g.Expect(streamUpstreamsCfg).To(ContainSubstring("upstream stream_up"))
+	g.Expect(streamUpstreamsCfg).To(ContainSubstring("upstream invalid-backend-ref"))
+
+	g.Expect(files[4].Type).To(Equal(file.TypeRegular))
+	g.Expect(files[4].Path).To(Equal("/etc/nginx/conf.d/config-version.conf"))
+	configVersion := string(files[4].Content)
 	g.Expect(configVersion).To(ContainSubstring(fmt.Sprintf("return 200 %d", conf.Version)))
 
-	g.Expect(files[3].Path).To(Equal("/etc/nginx/secrets/test-certbundle.crt"))
-	certBundle := string(files[3].Content)
+	g.Expect(files[5].Path).To(Equal("/etc/nginx/secrets/test-certbundle.crt"))
+	certBundle := string(files[5].Content)
 	g.Expect(certBundle).To(Equal("test-cert"))
+
+	g.Expect(files[6]).To(Equal(file.File{
+		Type:    file.TypeSecret,
+		Path:    "/etc/nginx/secrets/test-userfile.htpasswd",
+		Content: []byte("user:password"),
+	}))
+
+	g.Expect(files[7]).To(Equal(file.File{
+		Type:    file.TypeRegular,
+		Path:    "/etc/nginx/secrets/test-errorpage.page",
+		Content: []byte("<h1>Not Found</h1>"),
+	}))
 }
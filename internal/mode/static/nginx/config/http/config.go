@@ -2,11 +2,21 @@ package http
 
 // Server holds all configuration for an HTTP server.
 type Server struct {
-	SSL           *SSL
-	ServerName    string
-	Locations     []Location
+	SSL            *SSL
+	ClientSettings *ClientSettings
+	RateLimit      *RateLimit
+	Retry          *Retry
+	AccessControl  *AccessControl
+	Compression    *Compression
+	AccessLog      *AccessLog
+	ServerName     string
+	Locations      []Location
+	ErrorPages     []ErrorPage
+	// Variables are the variables that are set for all requests of the server.
+	Variables     []Variable
 	IsDefaultHTTP bool
 	IsDefaultSSL  bool
+	GRPC          bool
 	Port          int32
 }
 
@@ -14,11 +24,45 @@ type Server struct {
 type Location struct {
 	Return          *Return
 	ProxySSLVerify  *ProxySSLVerify
+	ProxyTimeouts   *ProxyTimeouts
+	ClientSettings  *ClientSettings
+	Tracing         *Tracing
+	RateLimit       *RateLimit
+	Retry           *Retry
+	BasicAuth       *BasicAuth
+	AuthRequest     *AuthRequest
+	AccessControl   *AccessControl
+	CORS            *CORS
+	Cache           *Cache
+	Compression     *Compression
+	AccessLog       *AccessLog
 	Path            string
 	ProxyPass       string
 	HTTPMatchVar    string
+	MirrorPath      string
+	BackendLocation string
+	// Alias is the file that the location responds with.
+	Alias string
+	// DefaultType is the MIME type of the responses of the location.
+	DefaultType     string
 	Rewrites        []string
 	ProxySetHeaders []Header
+	// ErrorPages are the error pages of the location. If set, the error pages of the server are not inherited.
+	ErrorPages []ErrorPage
+	// Variables are the variables that are set for the requests of the location.
+	Variables       []Variable
+	ResponseHeaders ResponseHeaders
+	GRPC            bool
+	Internal        bool
+	// InterceptErrors passes the error responses of the proxied server to the error pages.
+	InterceptErrors bool
+	// RecursiveErrorPages enables the error pages in a location that is reached by a redirect of another
+	// error page, like a backend location.
+	RecursiveErrorPages bool
+	// NoRequestHeaders disables passing the headers of the original request to the proxied server.
+	NoRequestHeaders bool
+	// NoRequestBody disables passing the body of the original request to the proxied server.
+	NoRequestBody bool
 }
 
 // Header defines a HTTP header to be passed to the proxied server.
@@ -27,6 +71,220 @@ type Header struct {
 	Value string
 }
 
+// ResponseHeaders holds all response headers to be added, set, or removed.
+type ResponseHeaders struct {
+	Add    []Header
+	Set    []Header
+	Remove []string
+}
+
+// ProxyTimeouts holds the timeouts for proxying requests to the upstream servers, and the timeouts for reading
+// the request body from and sending the response to the client.
+// An empty value means the NGINX default is used.
+type ProxyTimeouts struct {
+	ConnectTimeout      string
+	ReadTimeout         string
+	SendTimeout         string
+	NextUpstreamTimeout string
+	ClientBodyTimeout   string
+	ClientSendTimeout   string
+}
+
+// Retry holds the settings of passing the failed requests to the next upstream server.
+// An empty value means the NGINX default is used.
+type Retry struct {
+	Tries        *int32
+	NextUpstream string
+	Timeout      string
+}
+
+// BasicAuth holds the configuration of the HTTP Basic authentication.
+type BasicAuth struct {
+	Realm    string
+	UserFile string
+}
+
+// AccessControl holds the configuration of allowing or denying the requests based on the IP address of the client.
+type AccessControl struct {
+	// RealIP holds the configuration of determining the real IP address of the client. If nil, the address of
+	// the peer of the connection is used.
+	RealIP *RealIP
+	// Rules are the allow and deny rules, checked in order.
+	Rules []AccessRule
+}
+
+// AccessRule allows or denies the requests from an address.
+type AccessRule struct {
+	// Action is either "allow" or "deny".
+	Action string
+	// Address is an IP address, a CIDR range, or "all".
+	Address string
+}
+
+// RealIP holds the configuration of determining the real IP address of the client from a request header.
+type RealIP struct {
+	Header           string
+	TrustedAddresses []string
+	Recursive        bool
+}
+
+// CORS holds the configuration of handling the Cross-Origin Resource Sharing requests.
+type CORS struct {
+	// OriginVariable is the variable with the value of the Access-Control-Allow-Origin header. It is empty if the
+	// origin of the request is not allowed.
+	OriginVariable string
+	// PreflightVariable is the variable that is 1 if the request is a preflight request from an allowed origin.
+	PreflightVariable string
+	// AllowMethods is the value of the Access-Control-Allow-Methods header.
+	AllowMethods string
+	// AllowHeaders is the value of the Access-Control-Allow-Headers header. If empty, the header is not added.
+	AllowHeaders string
+	// ExposeHeaders is the value of the Access-Control-Expose-Headers header. If empty, the header is not added.
+	ExposeHeaders string
+	// MaxAge is the value of the Access-Control-Max-Age header. If empty, the header is not added.
+	MaxAge string
+	// AllowCredentials adds the Access-Control-Allow-Credentials header.
+	AllowCredentials bool
+}
+
+// Compression holds the configuration of compressing the responses with gzip.
+// A nil or empty value means the NGINX default is used.
+type Compression struct {
+	MinLength *int32
+	Level     *int32
+	// Types are the MIME types of the compressed responses, separated by spaces.
+	Types   string
+	Enabled bool
+}
+
+// AuthRequest holds the configuration of the authorization of requests by an external service.
+type AuthRequest struct {
+	// URI is the URI of the internal location that sends the authorization requests.
+	URI string
+	// Sets are the variables that are set from the response of the authorization service.
+	Sets []AuthRequestSet
+}
+
+// ErrorPage holds the configuration of an error page that replaces the error responses with the given status codes.
+type ErrorPage struct {
+	// ResponseCode is the status code of the response with the page. If nil, the status code is kept.
+	ResponseCode *int32
+	// Path is the path of the internal location that serves the page.
+	Path  string
+	Codes []int32
+}
+
+// AccessLog holds the configuration of writing the access logs.
+type AccessLog struct {
+	// Format is the name of the log format. If empty, the access logs are turned off.
+	Format string
+	// Path is the file or the syslog server that the log entries are written to.
+	Path string
+}
+
+// LogFormat holds the configuration of a format of the access log entries.
+type LogFormat struct {
+	Name string
+	// Format is the format of the entries with NGINX variables. The values of the variables are escaped as JSON.
+	Format string
+}
+
+// Variable holds the name and the value of a variable that is set with the set directive.
+type Variable struct {
+	Name  string
+	Value string
+}
+
+// AuthRequestSet sets a variable to a value from the response of the authorization service.
+type AuthRequestSet struct {
+	Variable string
+	Value    string
+}
+
+// ClientSettings holds the settings of the connection between the client and NGINX.
+// An empty value means the NGINX default is used.
+type ClientSettings struct {
+	KeepAliveRequests      *int32
+	BodyMaxSize            string
+	BodyTimeout            string
+	KeepAliveTime          string
+	KeepAliveServerTimeout string
+	KeepAliveHeaderTimeout string
+}
+
+// RateLimitZone holds the configuration of a shared memory zone that keeps the state of a rate limit.
+type RateLimitZone struct {
+	Name string
+	// Key is the variable whose value the requests are limited by.
+	Key  string
+	Size string
+	Rate string
+}
+
+// CacheZone holds the configuration of the storage of the cached responses.
+// An empty value means the NGINX default is used.
+type CacheZone struct {
+	Name string
+	// Path is the directory of the cached responses.
+	Path     string
+	Size     string
+	MaxSize  string
+	Inactive string
+}
+
+// Cache holds the configuration of caching the responses of the proxied server.
+type Cache struct {
+	ZoneName string
+	// Key is the key of the cached responses. If empty, the NGINX default is used.
+	Key   string
+	Valid []CacheValid
+	// Bypass holds the variables of the request headers that bypass the cache, separated by spaces.
+	Bypass string
+}
+
+// CacheValid holds the caching time of the responses with the given status codes.
+type CacheValid struct {
+	// Codes are the status codes, separated by spaces. If empty, the NGINX default codes are used.
+	Codes string
+	Time  string
+}
+
+// RateLimit holds the configuration of a rate limit.
+// A nil value means the NGINX default is used.
+type RateLimit struct {
+	Burst      *int32
+	RejectCode *int32
+	ZoneName   string
+	NoDelay    bool
+}
+
+// Tracing holds the OpenTelemetry tracing configuration.
+// An empty value means the NGINX default is used.
+type Tracing struct {
+	// Enable is the value of the otel_trace directive: on, off or a variable.
+	Enable         string
+	Context        string
+	SpanName       string
+	SpanAttributes []SpanAttribute
+}
+
+// SpanAttribute is a custom attribute that is added to a span.
+type SpanAttribute struct {
+	Key   string
+	Value string
+}
+
+// Telemetry holds the OpenTelemetry configuration of the http context.
+// An empty value means the NGINX default is used.
+type Telemetry struct {
+	Endpoint       string
+	ServiceName    string
+	Interval       string
+	SpanAttributes []SpanAttribute
+	BatchSize      int32
+	BatchCount     int32
+}
+
 // Return represents an HTTP return.
 type Return struct {
 	Body string
@@ -53,9 +311,20 @@ const (
 
 // Upstream holds all configuration for an HTTP upstream.
 type Upstream struct {
-	Name     string
-	ZoneSize string // format: 512k, 1m
-	Servers  []UpstreamServer
+	KeepAlive *UpstreamKeepAlive
+	Name      string
+	ZoneSize  string // format: 512k, 1m
+	// LoadBalancingMethod is the load balancing directive. If empty, the round robin method is used.
+	LoadBalancingMethod string
+	Servers             []UpstreamServer
+}
+
+// UpstreamKeepAlive holds the keep-alive configuration of the connections to the servers of an HTTP upstream.
+type UpstreamKeepAlive struct {
+	Connections *int32
+	Requests    *int32
+	Time        string
+	Timeout     string
 }
 
 // UpstreamServer holds all configuration for an HTTP upstream server.
@@ -1,33 +1,52 @@
 package config
 
 import (
+	"regexp"
+	"slices"
+	"sort"
 	"strings"
 	gotemplate "text/template"
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/stream"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 )
 
-var mapsTemplate = gotemplate.Must(gotemplate.New("maps").Parse(mapsTemplateText))
+var (
+	mapsTemplate       = gotemplate.Must(gotemplate.New("maps").Parse(mapsTemplateText))
+	streamMapsTemplate = gotemplate.Must(gotemplate.New("streamMaps").Parse(streamMapsTemplateText))
+)
 
 func executeMaps(conf dataplane.Configuration) []byte {
-	maps := buildAddHeaderMaps(append(conf.HTTPServers, conf.SSLServers...))
+	servers := append(conf.HTTPServers, conf.SSLServers...)
+
+	maps := buildAddHeaderMaps(servers)
+	maps = append(maps, buildCORSMaps(servers)...)
+
 	return execute(mapsTemplate, maps)
 }
 
 func buildAddHeaderMaps(servers []dataplane.VirtualServer) []http.Map {
 	addHeaderNames := make(map[string]struct{})
 
+	addHeaders := func(filters *dataplane.HTTPFilters) {
+		if filters == nil || filters.RequestHeaderModifiers == nil {
+			return
+		}
+		for _, addHeader := range filters.RequestHeaderModifiers.Add {
+			lowerName := strings.ToLower(addHeader.Name)
+			if _, ok := addHeaderNames[lowerName]; !ok {
+				addHeaderNames[lowerName] = struct{}{}
+			}
+		}
+	}
+
 	for _, s := range servers {
 		for _, pr := range s.PathRules {
 			for _, mr := range pr.MatchRules {
-				if mr.Filters.RequestHeaderModifiers != nil {
-					for _, addHeader := range mr.Filters.RequestHeaderModifiers.Add {
-						lowerName := strings.ToLower(addHeader.Name)
-						if _, ok := addHeaderNames[lowerName]; !ok {
-							addHeaderNames[lowerName] = struct{}{}
-						}
-					}
+				addHeaders(&mr.Filters)
+				for _, b := range mr.BackendGroup.Backends {
+					addHeaders(b.Filters)
 				}
 			}
 		}
@@ -66,3 +85,138 @@ func createAddHeadersMap(name string) http.Map {
 		Parameters: params,
 	}
 }
+
+// buildCORSMaps creates two maps for every CORS settings of the servers. The first map sets a variable to the value
+// of the Access-Control-Allow-Origin header for the origin of the request, or to an empty string if the origin is
+// not allowed, so that NGINX doesn't add the header. The second map sets a variable to 1 if the request is
+// a preflight request from an allowed origin, which NGINX answers itself.
+func buildCORSMaps(servers []dataplane.VirtualServer) []http.Map {
+	corsSettings := make(map[string]*dataplane.CORS)
+
+	for _, s := range servers {
+		for _, pr := range s.PathRules {
+			for _, mr := range pr.MatchRules {
+				if mr.Policies.CORS != nil {
+					corsSettings[mr.Policies.CORS.Name] = mr.Policies.CORS
+				}
+			}
+		}
+	}
+
+	names := make([]string, 0, len(corsSettings))
+	for name := range corsSettings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	maps := make([]http.Map, 0, len(names))
+	for _, name := range names {
+		maps = append(maps, createCORSMap(corsSettings[name]), createCORSPreflightMap(corsSettings[name]))
+	}
+
+	return maps
+}
+
+func createCORSMap(cors *dataplane.CORS) http.Map {
+	cm := http.Map{
+		Source:   "$http_origin",
+		Variable: "$" + generateCORSOriginVariableName(cors.Name),
+	}
+
+	// The "*" origin can't be used for the requests with credentials, so the origin of the request is allowed
+	// instead.
+	if slices.Contains(cors.AllowOrigins, "*") {
+		result := `"*"`
+		if cors.AllowCredentials {
+			result = "$http_origin"
+		}
+
+		cm.Parameters = []http.MapParameter{{Value: "default", Result: result}}
+		return cm
+	}
+
+	cm.Parameters = make([]http.MapParameter, 0, len(cors.AllowOrigins)+1)
+	cm.Parameters = append(cm.Parameters, http.MapParameter{Value: "default", Result: "''"})
+
+	for _, origin := range cors.AllowOrigins {
+		cm.Parameters = append(cm.Parameters, http.MapParameter{
+			Value:  createCORSOriginMatch(origin),
+			Result: "$http_origin",
+		})
+	}
+
+	return cm
+}
+
+// createCORSPreflightMap creates the map that detects the preflight requests: the OPTIONS requests with the Origin
+// and Access-Control-Request-Method headers. The preflight requests from the origins that are not allowed and the
+// other OPTIONS requests are passed to the backends.
+func createCORSPreflightMap(cors *dataplane.CORS) http.Map {
+	originVar := "$" + generateCORSOriginVariableName(cors.Name)
+
+	return http.Map{
+		Source:   `"$request_method $http_access_control_request_method $http_origin ` + originVar + `"`,
+		Variable: "$" + generateCORSPreflightVariableName(cors.Name),
+		Parameters: []http.MapParameter{
+			{Value: "default", Result: "0"},
+			{Value: `"~^OPTIONS [^ ]+ [^ ]+ [^ ]+$"`, Result: "1"},
+		},
+	}
+}
+
+// createCORSOriginMatch creates the map parameter value that matches an origin. An origin with a "*." wildcard
+// is converted into a regular expression, where the wildcard matches a single DNS label.
+func createCORSOriginMatch(origin string) string {
+	scheme, host, found := strings.Cut(origin, "://*.")
+	if !found {
+		return origin
+	}
+
+	return "~^" + regexp.QuoteMeta(scheme+"://") + `[^./]+\.` + regexp.QuoteMeta(host) + "$"
+}
+
+func executeStreamMaps(conf dataplane.Configuration) []byte {
+	maps := createStreamMaps(conf.TLSPassthroughServers, getStreamUpstreamsWithEndpoints(conf.StreamUpstreams))
+	return execute(streamMapsTemplate, maps)
+}
+
+// createStreamMaps creates a map for every port with TLS passthrough servers. The map chooses the upstream
+// based on the SNI of the connection. The connections with an unknown SNI are proxied to the invalid backend upstream,
+// so that NGINX closes them.
+func createStreamMaps(
+	tlsPassthroughServers []dataplane.Layer4VirtualServer,
+	upstreams map[string]struct{},
+) []stream.Map {
+	ports := getTLSPassthroughPorts(tlsPassthroughServers)
+
+	maps := make([]stream.Map, 0, len(ports))
+
+	for _, port := range ports {
+		params := []stream.MapParameter{
+			{
+				Value:  "default",
+				Result: invalidBackendRef,
+			},
+		}
+
+		for _, s := range tlsPassthroughServers {
+			if s.Port != port {
+				continue
+			}
+
+			params = append(params, stream.MapParameter{
+				Value:  s.Hostname,
+				Result: layer4BackendGroupName(s.BackendGroup, upstreams),
+			})
+		}
+
+		maps = append(maps, stream.Map{
+			Source:       "$ssl_preread_server_name",
+			Variable:     "$" + generateTLSPassthroughVariableName(port),
+			Parameters:   params,
+			UseHostnames: true,
+		})
+	}
+
+	return maps
+}
@@ -24,4 +24,25 @@ map $http_upgrade $connection_upgrade {
     default upgrade;
     '' close;
 }
+
+# Set $connection_keepalive variable to upgrade when the $http_upgrade header is set, otherwise, set it to an empty
+# string. Unlike $connection_upgrade, it keeps the connections to the upstreams with keep-alive connections open.
+# See https://nginx.org/en/docs/http/ngx_http_upstream_module.html#keepalive.
+map $http_upgrade $connection_keepalive {
+    default upgrade;
+    '' '';
+}
+`
+
+var streamMapsTemplateText = `
+{{- range $m := . }}
+map {{ $m.Source }} {{ $m.Variable }} {
+    {{- if $m.UseHostnames }}
+    hostnames;
+    {{- end }}
+    {{ range $p := $m.Parameters }}
+    {{ $p.Value }} {{ $p.Result }};
+    {{- end }}
+}
+{{ end -}}
 `
@@ -5,9 +5,12 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/stream"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
 )
 
 func TestExecuteMaps(t *testing.T) {
@@ -86,6 +89,7 @@ func TestExecuteMaps(t *testing.T) {
 		"map ${http_my_set_header} $my_set_header_header_var {":               0,
 		"map $http_host $gw_api_compliant_host {":                             1,
 		"map $http_upgrade $connection_upgrade {":                             1,
+		"map $http_upgrade $connection_keepalive {":                           1,
 	}
 
 	maps := string(executeMaps(conf))
@@ -143,6 +147,25 @@ func TestBuildAddHeaderMaps(t *testing.T) {
 						},
 					},
 				},
+				{
+					BackendGroup: dataplane.BackendGroup{
+						Backends: []dataplane.Backend{
+							{
+								Filters: &dataplane.HTTPFilters{
+									RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
+										Add: []dataplane.HTTPHeader{
+											{
+												Name:  "my-backend-add-header",
+												Value: "some-value-123",
+											},
+										},
+									},
+								},
+							},
+							{},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -181,8 +204,284 @@ func TestBuildAddHeaderMaps(t *testing.T) {
 				},
 			},
 		},
+		{
+			Source:   "${http_my_backend_add_header}",
+			Variable: "$my_backend_add_header_header_var",
+			Parameters: []http.MapParameter{
+				{Value: "default", Result: "''"},
+				{
+					Value:  "~.*",
+					Result: "${http_my_backend_add_header},",
+				},
+			},
+		},
 	}
 	maps := buildAddHeaderMaps(testServers)
 
 	g.Expect(maps).To(ConsistOf(expectedMap))
 }
+
+func TestBuildCORSMaps(t *testing.T) {
+	g := NewWithT(t)
+
+	exactCORS := &dataplane.CORS{
+		Name:         "test_exact",
+		AllowOrigins: []string{"https://example.com", "http://localhost:8080", "https://*.example.com"},
+	}
+	anyCORS := &dataplane.CORS{
+		Name:         "test_any",
+		AllowOrigins: []string{"https://example.com", "*"},
+	}
+	anyWithCredentialsCORS := &dataplane.CORS{
+		Name:             "test_any-credentials",
+		AllowOrigins:     []string{"*"},
+		AllowCredentials: true,
+	}
+
+	servers := []dataplane.VirtualServer{
+		{
+			PathRules: []dataplane.PathRule{
+				{
+					MatchRules: []dataplane.MatchRule{
+						{Policies: dataplane.RulePolicies{CORS: exactCORS}},
+						{Policies: dataplane.RulePolicies{CORS: anyCORS}},
+						{},
+					},
+				},
+			},
+		},
+		{
+			PathRules: []dataplane.PathRule{
+				{
+					MatchRules: []dataplane.MatchRule{
+						{Policies: dataplane.RulePolicies{CORS: exactCORS}},
+						{Policies: dataplane.RulePolicies{CORS: anyWithCredentialsCORS}},
+					},
+				},
+			},
+		},
+	}
+
+	createPreflightMap := func(name string) http.Map {
+		return http.Map{
+			Source: `"$request_method $http_access_control_request_method $http_origin $` +
+				generateCORSOriginVariableName(name) + `"`,
+			Variable: "$" + generateCORSPreflightVariableName(name),
+			Parameters: []http.MapParameter{
+				{Value: "default", Result: "0"},
+				{Value: `"~^OPTIONS [^ ]+ [^ ]+ [^ ]+$"`, Result: "1"},
+			},
+		}
+	}
+
+	expectedMaps := []http.Map{
+		{
+			Source:     "$http_origin",
+			Variable:   "$" + generateCORSOriginVariableName("test_any"),
+			Parameters: []http.MapParameter{{Value: "default", Result: `"*"`}},
+		},
+		createPreflightMap("test_any"),
+		{
+			Source:     "$http_origin",
+			Variable:   "$" + generateCORSOriginVariableName("test_any-credentials"),
+			Parameters: []http.MapParameter{{Value: "default", Result: "$http_origin"}},
+		},
+		createPreflightMap("test_any-credentials"),
+		{
+			Source:   "$http_origin",
+			Variable: "$" + generateCORSOriginVariableName("test_exact"),
+			Parameters: []http.MapParameter{
+				{Value: "default", Result: "''"},
+				{Value: "https://example.com", Result: "$http_origin"},
+				{Value: "http://localhost:8080", Result: "$http_origin"},
+				{Value: `~^https://[^./]+\.example\.com$`, Result: "$http_origin"},
+			},
+		},
+		createPreflightMap("test_exact"),
+	}
+
+	g.Expect(buildCORSMaps(servers)).To(Equal(expectedMaps))
+}
+
+func TestCreateCORSOriginMatch(t *testing.T) {
+	tests := []struct {
+		origin   string
+		expected string
+	}{
+		{
+			origin:   "https://example.com",
+			expected: "https://example.com",
+		},
+		{
+			origin:   "https://*.example.com",
+			expected: `~^https://[^./]+\.example\.com$`,
+		},
+		{
+			origin:   "http://*.my-app.example.com:8080",
+			expected: `~^http://[^./]+\.my-app\.example\.com:8080$`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.origin, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(createCORSOriginMatch(test.origin)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestExecuteStreamMaps(t *testing.T) {
+	g := NewWithT(t)
+
+	createBackendGroup := func(name string, upstreamName string) dataplane.BackendGroup {
+		return dataplane.BackendGroup{
+			Source: types.NamespacedName{Namespace: "test", Name: name},
+			Backends: []dataplane.Backend{
+				{
+					UpstreamName: upstreamName,
+					Weight:       1,
+					Valid:        true,
+				},
+			},
+		}
+	}
+
+	conf := dataplane.Configuration{
+		TLSPassthroughServers: []dataplane.Layer4VirtualServer{
+			{
+				Hostname:     "example.com",
+				Port:         8081,
+				BackendGroup: createBackendGroup("tr-1", "backend1"),
+			},
+			{
+				Hostname:     "cafe.example.com",
+				Port:         8081,
+				BackendGroup: createBackendGroup("tr-2", "backend2"),
+			},
+			{
+				Hostname:     "app.example.com",
+				Port:         8082,
+				BackendGroup: createBackendGroup("tr-3", "backend1"),
+			},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{
+				Name:      "backend1",
+				Endpoints: []resolver.Endpoint{{Address: "1.1.1.1", Port: 80}},
+			},
+			{
+				Name:      "backend2",
+				Endpoints: []resolver.Endpoint{{Address: "2.2.2.2", Port: 80}},
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"map $ssl_preread_server_name $tls_passthrough_upstream_8081 {": 1,
+		"map $ssl_preread_server_name $tls_passthrough_upstream_8082 {": 1,
+		"hostnames;":                    2,
+		"default invalid-backend-ref;":  2,
+		"example.com backend1;":         2,
+		"cafe.example.com backend2;":    1,
+		"app.example.com backend1;":     1,
+		"$ssl_preread_server_name $tls": 2,
+	}
+
+	maps := string(executeStreamMaps(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(maps, expSubStr)).To(Equal(expCount))
+	}
+}
+
+func TestCreateStreamMaps(t *testing.T) {
+	g := NewWithT(t)
+
+	createBackendGroup := func(name string, backends ...dataplane.Backend) dataplane.BackendGroup {
+		return dataplane.BackendGroup{
+			Source:   types.NamespacedName{Namespace: "test", Name: name},
+			Backends: backends,
+		}
+	}
+
+	validBackend := dataplane.Backend{
+		UpstreamName: "backend1",
+		Weight:       1,
+		Valid:        true,
+	}
+
+	tlsPassthroughServers := []dataplane.Layer4VirtualServer{
+		{
+			Hostname:     "example.com",
+			Port:         8081,
+			BackendGroup: createBackendGroup("tr-1", validBackend),
+		},
+		{
+			Hostname: "no-endpoints.example.com",
+			Port:     8081,
+			BackendGroup: createBackendGroup(
+				"tr-2",
+				dataplane.Backend{UpstreamName: "backend-no-endpoints", Weight: 1, Valid: true},
+			),
+		},
+		{
+			Hostname: "invalid-backend.example.com",
+			Port:     8081,
+			BackendGroup: createBackendGroup(
+				"tr-3",
+				dataplane.Backend{UpstreamName: "invalid", Weight: 1, Valid: false},
+			),
+		},
+		{
+			Hostname:     "app.example.com",
+			Port:         8082,
+			BackendGroup: createBackendGroup("tr-4", validBackend),
+		},
+	}
+
+	upstreams := map[string]struct{}{
+		"backend1": {},
+	}
+
+	expected := []stream.Map{
+		{
+			Source:   "$ssl_preread_server_name",
+			Variable: "$tls_passthrough_upstream_8081",
+			Parameters: []stream.MapParameter{
+				{
+					Value:  "default",
+					Result: "invalid-backend-ref",
+				},
+				{
+					Value:  "example.com",
+					Result: "backend1",
+				},
+				{
+					Value:  "no-endpoints.example.com",
+					Result: "invalid-backend-ref",
+				},
+				{
+					Value:  "invalid-backend.example.com",
+					Result: "invalid-backend-ref",
+				},
+			},
+			UseHostnames: true,
+		},
+		{
+			Source:   "$ssl_preread_server_name",
+			Variable: "$tls_passthrough_upstream_8082",
+			Parameters: []stream.MapParameter{
+				{
+					Value:  "default",
+					Result: "invalid-backend-ref",
+				},
+				{
+					Value:  "app.example.com",
+					Result: "backend1",
+				},
+			},
+			UseHostnames: true,
+		},
+	}
+
+	g.Expect(createStreamMaps(tlsPassthroughServers, upstreams)).To(Equal(expected))
+}
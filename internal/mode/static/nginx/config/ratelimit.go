@@ -0,0 +1,69 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	gotemplate "text/template"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var rateLimitZonesTemplate = gotemplate.Must(
+	gotemplate.New("rateLimitZones").Parse(rateLimitZonesTemplateText),
+)
+
+// rateLimitZoneSize is the size of the shared memory zone of a rate limit.
+// One megabyte zone can keep about 16 thousand states of $binary_remote_addr keys.
+const rateLimitZoneSize = "10m"
+
+func executeRateLimitZones(conf dataplane.Configuration) []byte {
+	if len(conf.RateLimitZones) == 0 {
+		return nil
+	}
+
+	return execute(rateLimitZonesTemplate, createRateLimitZones(conf.RateLimitZones))
+}
+
+func createRateLimitZones(zones []dataplane.RateLimitZone) []http.RateLimitZone {
+	rateLimitZones := make([]http.RateLimitZone, 0, len(zones))
+
+	for _, zone := range zones {
+		rateLimitZones = append(rateLimitZones, http.RateLimitZone{
+			Name: zone.Name,
+			Key:  getRateLimitKeyVariable(zone),
+			Size: rateLimitZoneSize,
+			Rate: zone.Rate,
+		})
+	}
+
+	return rateLimitZones
+}
+
+// getRateLimitKeyVariable returns the NGINX variable that holds the key of the rate limit zone.
+func getRateLimitKeyVariable(zone dataplane.RateLimitZone) string {
+	switch zone.KeyType {
+	case dataplane.RateLimitKeyClientIP:
+		return "$binary_remote_addr"
+	case dataplane.RateLimitKeyHeader:
+		return "$http_" + convertStringToSafeVariableName(strings.ToLower(zone.KeyName))
+	case dataplane.RateLimitKeyJWTClaim:
+		return "$jwt_claim_" + zone.KeyName
+	default:
+		panic(fmt.Sprintf("unknown rate limit key type %s", zone.KeyType))
+	}
+}
+
+// createRateLimit converts the rate limit of a server or a rule into an NGINX rate limit.
+func createRateLimit(rateLimit *dataplane.RateLimit) *http.RateLimit {
+	if rateLimit == nil {
+		return nil
+	}
+
+	return &http.RateLimit{
+		ZoneName:   rateLimit.Zone.Name,
+		Burst:      rateLimit.Burst,
+		RejectCode: rateLimit.RejectCode,
+		NoDelay:    rateLimit.NoDelay,
+	}
+}
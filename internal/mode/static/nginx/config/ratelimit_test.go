@@ -0,0 +1,112 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteRateLimitZones(t *testing.T) {
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		RateLimitZones: []dataplane.RateLimitZone{
+			{
+				Name:    "test_client-ip",
+				KeyType: dataplane.RateLimitKeyClientIP,
+				Rate:    "10r/s",
+			},
+			{
+				Name:    "test_header",
+				KeyType: dataplane.RateLimitKeyHeader,
+				KeyName: "X-Api-Key",
+				Rate:    "100r/m",
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"limit_req_zone $binary_remote_addr zone=test_client-ip:10m rate=10r/s;": 1,
+		"limit_req_zone $http_x_api_key zone=test_header:10m rate=100r/m;":       1,
+	}
+
+	result := string(executeRateLimitZones(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(result, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+
+	g.Expect(executeRateLimitZones(dataplane.Configuration{})).To(BeEmpty())
+}
+
+func TestGetRateLimitKeyVariable(t *testing.T) {
+	tests := []struct {
+		msg      string
+		expected string
+		zone     dataplane.RateLimitZone
+	}{
+		{
+			msg:      "client IP",
+			zone:     dataplane.RateLimitZone{KeyType: dataplane.RateLimitKeyClientIP},
+			expected: "$binary_remote_addr",
+		},
+		{
+			msg:      "header",
+			zone:     dataplane.RateLimitZone{KeyType: dataplane.RateLimitKeyHeader, KeyName: "X-Forwarded-For"},
+			expected: "$http_x_forwarded_for",
+		},
+		{
+			msg:      "JWT claim",
+			zone:     dataplane.RateLimitZone{KeyType: dataplane.RateLimitKeyJWTClaim, KeyName: "sub"},
+			expected: "$jwt_claim_sub",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(getRateLimitKeyVariable(test.zone)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestGetRateLimitKeyVariablePanics(t *testing.T) {
+	g := NewWithT(t)
+
+	getVariable := func() {
+		getRateLimitKeyVariable(dataplane.RateLimitZone{KeyType: "unknown"})
+	}
+
+	g.Expect(getVariable).To(Panic())
+}
+
+func TestCreateRateLimit(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(createRateLimit(nil)).To(BeNil())
+
+	rateLimit := &dataplane.RateLimit{
+		Zone: dataplane.RateLimitZone{
+			Name:    "test_limit",
+			KeyType: dataplane.RateLimitKeyClientIP,
+			Rate:    "10r/s",
+		},
+		Burst:      helpers.GetPointer[int32](20),
+		RejectCode: helpers.GetPointer[int32](429),
+		NoDelay:    true,
+	}
+
+	expected := &http.RateLimit{
+		ZoneName:   "test_limit",
+		Burst:      helpers.GetPointer[int32](20),
+		RejectCode: helpers.GetPointer[int32](429),
+		NoDelay:    true,
+	}
+
+	g.Expect(createRateLimit(rateLimit)).To(Equal(expected))
+}
@@ -3,8 +3,11 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 	gotemplate "text/template"
+	"time"
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
@@ -38,6 +41,22 @@ var baseHeaders = []http.Header{
 	},
 }
 
+// grpcBaseHeaders contains the constant headers set in each location block for gRPC requests
+var grpcBaseHeaders = []http.Header{
+	{
+		Name:  "Host",
+		Value: "$gw_api_compliant_host",
+	},
+	{
+		Name:  "X-Forwarded-For",
+		Value: "$proxy_add_x_forwarded_for",
+	},
+	{
+		Name:  "Authority",
+		Value: "$gw_api_compliant_host",
+	},
+}
+
 func executeServers(conf dataplane.Configuration) []byte {
 	servers := createServers(conf.HTTPServers, conf.SSLServers)
 
@@ -46,18 +65,43 @@ func executeServers(conf dataplane.Configuration) []byte {
 
 func createServers(httpServers, sslServers []dataplane.VirtualServer) []http.Server {
 	servers := make([]http.Server, 0, len(httpServers)+len(sslServers))
+	grpcPorts := findGRPCPorts(httpServers, sslServers)
 
 	for _, s := range httpServers {
-		servers = append(servers, createServer(s))
+		server := createServer(s)
+		_, server.GRPC = grpcPorts[s.Port]
+		servers = append(servers, server)
 	}
 
 	for _, s := range sslServers {
-		servers = append(servers, createSSLServer(s))
+		server := createSSLServer(s)
+		_, server.GRPC = grpcPorts[s.Port]
+		servers = append(servers, server)
 	}
 
 	return servers
 }
 
+// findGRPCPorts returns the ports of the servers that have at least one gRPC path rule.
+// HTTP/2 must be enabled for all servers on such ports, including the default server, because NGINX uses
+// the configuration of the default server to negotiate the protocol of a cleartext connection.
+func findGRPCPorts(httpServers, sslServers []dataplane.VirtualServer) map[int32]struct{} {
+	grpcPorts := make(map[int32]struct{})
+
+	for _, servers := range [][]dataplane.VirtualServer{httpServers, sslServers} {
+		for _, s := range servers {
+			for _, r := range s.PathRules {
+				if r.GRPC {
+					grpcPorts[s.Port] = struct{}{}
+					break
+				}
+			}
+		}
+	}
+
+	return grpcPorts
+}
+
 func createSSLServer(virtualServer dataplane.VirtualServer) http.Server {
 	if virtualServer.IsDefault {
 		return http.Server{
@@ -66,29 +110,66 @@ func createSSLServer(virtualServer dataplane.VirtualServer) http.Server {
 		}
 	}
 
+	errorPages, errorPageLocs := createErrorPages(virtualServer.ErrorPages, createServerErrorPagePath)
+
 	return http.Server{
 		ServerName: virtualServer.Hostname,
 		SSL: &http.SSL{
 			Certificate:    generatePEMFileName(virtualServer.SSL.KeyPairID),
 			CertificateKey: generatePEMFileName(virtualServer.SSL.KeyPairID),
 		},
-		Locations: createLocations(virtualServer.PathRules, virtualServer.Port),
-		Port:      virtualServer.Port,
+		Locations: append(
+			createLocations(virtualServer.PathRules, virtualServer.Port, errorPages, virtualServer.AccessLog),
+			errorPageLocs...,
+		),
+		ErrorPages:     errorPages,
+		Port:           virtualServer.Port,
+		ClientSettings: createClientSettings(virtualServer.ClientSettings),
+		RateLimit:      createRateLimit(virtualServer.RateLimit),
+		Retry:          createRetry(virtualServer.Retry),
+		AccessControl:  createAccessControl(virtualServer.AccessControl),
+		Compression:    createCompression(virtualServer.Compression),
+		AccessLog:      createAccessLog(virtualServer.AccessLog),
+		Variables:      createServerVariables(virtualServer),
 	}
 }
 
 func createServer(virtualServer dataplane.VirtualServer) http.Server {
+	errorPages, errorPageLocs := createErrorPages(virtualServer.ErrorPages, createServerErrorPagePath)
+
 	if virtualServer.IsDefault {
-		return http.Server{
+		server := http.Server{
 			IsDefaultHTTP: true,
 			Port:          virtualServer.Port,
+			AccessLog:     createAccessLog(virtualServer.AccessLog),
+			Variables:     createServerVariables(virtualServer),
+		}
+
+		// the default server responds with 404 in a location, so that the locations of the error pages
+		// can be reached
+		if len(errorPages) > 0 {
+			server.ErrorPages = errorPages
+			server.Locations = append(errorPageLocs, createDefaultRootLocation())
 		}
+
+		return server
 	}
 
 	return http.Server{
 		ServerName: virtualServer.Hostname,
-		Locations:  createLocations(virtualServer.PathRules, virtualServer.Port),
-		Port:       virtualServer.Port,
+		Locations: append(
+			createLocations(virtualServer.PathRules, virtualServer.Port, errorPages, virtualServer.AccessLog),
+			errorPageLocs...,
+		),
+		ErrorPages:     errorPages,
+		Port:           virtualServer.Port,
+		ClientSettings: createClientSettings(virtualServer.ClientSettings),
+		RateLimit:      createRateLimit(virtualServer.RateLimit),
+		Retry:          createRetry(virtualServer.Retry),
+		AccessControl:  createAccessControl(virtualServer.AccessControl),
+		Compression:    createCompression(virtualServer.Compression),
+		AccessLog:      createAccessLog(virtualServer.AccessLog),
+		Variables:      createServerVariables(virtualServer),
 	}
 }
 
@@ -99,15 +180,30 @@ type rewriteConfig struct {
 	Rewrite string
 }
 
-func createLocations(pathRules []dataplane.PathRule, listenerPort int32) []http.Location {
+// createServerVariables creates the variables that are set for all requests of a server.
+func createServerVariables(virtualServer dataplane.VirtualServer) []http.Variable {
+	if logsRouteInfo(virtualServer.AccessLog) {
+		return createRouteVariables(nil)
+	}
+
+	return nil
+}
+
+func createLocations(
+	pathRules []dataplane.PathRule,
+	listenerPort int32,
+	serverErrorPages []http.ErrorPage,
+	serverAccessLog *dataplane.AccessLog,
+) []http.Location {
 	maxLocs, pathsAndTypes := getMaxLocationCountAndPathMap(pathRules)
 	locs := make([]http.Location, 0, maxLocs)
 	var rootPathExists bool
 
-	for pathRuleIdx, rule := range pathRules {
+	for _, pathRuleIdx := range getPathRulesOrder(pathRules) {
+		rule := pathRules[pathRuleIdx]
 		matches := make([]httpMatch, 0, len(rule.MatchRules))
 
-		if rule.Path == rootPath {
+		if rule.Path == rootPath && rule.PathType != dataplane.PathTypeRegularExpression {
 			rootPathExists = true
 		}
 
@@ -121,7 +217,87 @@ func createLocations(pathRules []dataplane.PathRule, listenerPort int32) []http.
 				matches = append(matches, match)
 			}
 
-			buildLocations = updateLocationsForFilters(r.Filters, buildLocations, r, listenerPort, rule.Path)
+			backendLocs := createBackendLocations(pathRuleIdx, matchRuleIdx, r, listenerPort, rule.Path, rule.GRPC)
+			if len(backendLocs) > 0 {
+				backendLocation := createBackendLocationPath(
+					pathRuleIdx,
+					matchRuleIdx,
+					"$"+convertStringToSafeVariableName(r.BackendGroup.Name()),
+				)
+				for i := range buildLocations {
+					buildLocations[i].BackendLocation = backendLocation
+				}
+			} else {
+				buildLocations = updateLocationsForFilters(
+					r.Filters,
+					buildLocations,
+					r,
+					listenerPort,
+					rule.Path,
+					rule.GRPC,
+				)
+			}
+
+			policies := createLocationPolicies(r, serverAccessLog)
+			applyLocationPolicies(buildLocations, policies)
+			applyLocationPolicies(backendLocs, policies)
+
+			proxyLocations := buildLocations
+			if len(backendLocs) > 0 {
+				proxyLocations = backendLocs
+			}
+
+			mirrorPath := createMirrorPath(pathRuleIdx, matchRuleIdx)
+			if mirrorLoc, ok := createMirrorLocation(mirrorPath, r.Filters); ok {
+				// the requests are mirrored from the locations that proxy them
+				for i := range proxyLocations {
+					proxyLocations[i].MirrorPath = mirrorPath
+				}
+				locs = append(locs, mirrorLoc)
+			}
+
+			// gRPC responses are not cached, because NGINX only caches the responses of the proxy module
+			if cache := createCache(r.Policies.Cache); cache != nil && !rule.GRPC {
+				for i := range proxyLocations {
+					proxyLocations[i].Cache = cache
+				}
+			}
+
+			if r.Policies.ExternalAuth != nil {
+				// the requests are authorized in the locations that proxy them, so that the headers copied from
+				// the response of the authorization service are set in the requests to the backends
+				authPath := createExternalAuthPath(pathRuleIdx, matchRuleIdx)
+				authRequest, authHeaders := createAuthRequest(authPath, r.Policies.ExternalAuth)
+				for i := range proxyLocations {
+					proxyLocations[i].AuthRequest = authRequest
+					// clip the slice so that append doesn't modify the headers shared with other locations
+					proxyLocations[i].ProxySetHeaders = append(
+						slices.Clip(proxyLocations[i].ProxySetHeaders),
+						authHeaders...,
+					)
+				}
+				locs = append(locs, createExternalAuthLocation(authPath, r.Policies.ExternalAuth))
+			}
+
+			// the error pages are configured in the locations that proxy the requests, because NGINX generates the
+			// error responses of the rule there
+			errorPages, errorPageLocs := createErrorPages(r.Policies.ErrorPages, func(idx int) string {
+				return createRuleErrorPagePath(idx, pathRuleIdx, matchRuleIdx)
+			})
+			if len(errorPages) > 0 || len(serverErrorPages) > 0 {
+				if len(errorPages) > 0 {
+					errorPages = mergeErrorPages(errorPages, serverErrorPages)
+				}
+				for i := range proxyLocations {
+					proxyLocations[i].ErrorPages = errorPages
+					proxyLocations[i].InterceptErrors = true
+					// the backend locations are reached by the error page that redirects to them
+					proxyLocations[i].RecursiveErrorPages = len(backendLocs) > 0
+				}
+				locs = append(locs, errorPageLocs...)
+			}
+
+			locs = append(locs, backendLocs...)
 			locs = append(locs, buildLocations...)
 		}
 
@@ -141,6 +317,91 @@ func createLocations(pathRules []dataplane.PathRule, listenerPort int32) []http.
 	return locs
 }
 
+// locationPolicies holds the Policy settings of a rule that are applied in all the locations that serve
+// the requests of the rule.
+type locationPolicies struct {
+	clientSettings *http.ClientSettings
+	tracing        *http.Tracing
+	rateLimit      *http.RateLimit
+	retry          *http.Retry
+	compression    *http.Compression
+	accessControl  *http.AccessControl
+	cors           *http.CORS
+	basicAuth      *http.BasicAuth
+	accessLog      *http.AccessLog
+	variables      []http.Variable
+}
+
+// createLocationPolicies creates the locationPolicies of a rule. The access log of the server applies if
+// the rule doesn't configure its own access log.
+func createLocationPolicies(rule dataplane.MatchRule, serverAccessLog *dataplane.AccessLog) locationPolicies {
+	rulePolicies := rule.Policies
+
+	policies := locationPolicies{
+		clientSettings: createClientSettings(rulePolicies.ClientSettings),
+		tracing:        createTracing(rulePolicies.Tracing),
+		rateLimit:      createRateLimit(rulePolicies.RateLimit),
+		retry:          createRetry(rulePolicies.Retry),
+		compression:    createCompression(rulePolicies.Compression),
+		accessControl:  createAccessControl(rulePolicies.AccessControl),
+		cors:           createCORS(rulePolicies.CORS),
+		basicAuth:      createBasicAuth(rulePolicies.BasicAuth),
+		accessLog:      createAccessLog(rulePolicies.AccessLog),
+	}
+
+	effectiveAccessLog := rulePolicies.AccessLog
+	if effectiveAccessLog == nil {
+		effectiveAccessLog = serverAccessLog
+	}
+
+	if logsRouteInfo(effectiveAccessLog) {
+		policies.variables = createRouteVariables(rule.Source)
+	}
+
+	return policies
+}
+
+// applyLocationPolicies applies the Policy settings of a rule to the locations that serve the requests of the rule.
+// The settings of the rule replace the settings of the server, which are inherited by the locations otherwise.
+func applyLocationPolicies(locs []http.Location, policies locationPolicies) {
+	for i := range locs {
+		if policies.clientSettings != nil {
+			locs[i].ClientSettings = createLocationClientSettings(policies.clientSettings, locs[i].ProxyTimeouts)
+		}
+		// tracing is configured in the same locations as the client settings
+		if policies.tracing != nil {
+			locs[i].Tracing = policies.tracing
+		}
+		if policies.rateLimit != nil {
+			locs[i].RateLimit = policies.rateLimit
+		}
+		if policies.retry != nil {
+			locs[i].Retry = createLocationRetry(policies.retry, locs[i].ProxyTimeouts)
+		}
+		if policies.compression != nil {
+			locs[i].Compression = policies.compression
+		}
+		if policies.accessControl != nil {
+			locs[i].AccessControl = policies.accessControl
+		}
+		// the preflight requests are answered in the first location that handles the requests, and the CORS headers
+		// are added in the location that produces the response
+		if policies.cors != nil {
+			locs[i].CORS = policies.cors
+		}
+		// the mirror location is not authenticated, because NGINX doesn't check the access of subrequests
+		if policies.basicAuth != nil {
+			locs[i].BasicAuth = policies.basicAuth
+		}
+		if policies.accessLog != nil {
+			locs[i].AccessLog = policies.accessLog
+		}
+		if policies.variables != nil {
+			locs[i].Variables = policies.variables
+		}
+	}
+}
+
 // pathAndTypeMap contains a map of paths and any path types defined for that path
 // for example, {/foo: {exact: {}, prefix: {}}}
 type pathAndTypeMap map[string]map[dataplane.PathType]struct{}
@@ -150,12 +411,24 @@ type pathAndTypeMap map[string]map[dataplane.PathType]struct{}
 // 2. Each path rule may have an additional location if it contains non-path-only matches.
 // 3. Each prefix path rule may have an additional location if it doesn't contain trailing slash.
 // 4. There may be an additional location for the default root path.
+// 5. Each match rule may have an additional internal location for a RequestMirror filter.
 // We also return a map of all paths and their types.
 func getMaxLocationCountAndPathMap(pathRules []dataplane.PathRule) (int, pathAndTypeMap) {
 	maxLocs := 1
 	pathsAndTypes := make(pathAndTypeMap)
 	for _, rule := range pathRules {
 		maxLocs += len(rule.MatchRules) + 2
+		for _, r := range rule.MatchRules {
+			if r.Filters.RequestMirror != nil {
+				maxLocs++
+			}
+			if r.Policies.ExternalAuth != nil {
+				maxLocs++
+			}
+			if backendGroupNeedsBackendLocations(r.BackendGroup) {
+				maxLocs += len(r.BackendGroup.Backends)
+			}
+		}
 		if pathsAndTypes[rule.Path] == nil {
 			pathsAndTypes[rule.Path] = map[dataplane.PathType]struct{}{
 				rule.PathType: {},
@@ -168,6 +441,36 @@ func getMaxLocationCountAndPathMap(pathRules []dataplane.PathRule) (int, pathAnd
 	return maxLocs, pathsAndTypes
 }
 
+// getPathRulesOrder returns the indexes of the path rules in the order their locations are created.
+// NGINX chooses an exact location first. Otherwise, it checks the regular expression locations in the order they
+// appear in the configuration, and the first matching one takes precedence over any prefix location.
+// To give precedence to the more specific regular expressions, the regular expression locations are created after
+// all other locations, from the longest to the shortest regular expression.
+func getPathRulesOrder(pathRules []dataplane.PathRule) []int {
+	order := make([]int, 0, len(pathRules))
+	for i := range pathRules {
+		order = append(order, i)
+	}
+
+	slices.SortStableFunc(order, func(i, j int) int {
+		iRegex := pathRules[i].PathType == dataplane.PathTypeRegularExpression
+		jRegex := pathRules[j].PathType == dataplane.PathTypeRegularExpression
+
+		switch {
+		case iRegex && !jRegex:
+			return 1
+		case !iRegex && jRegex:
+			return -1
+		case iRegex && jRegex:
+			return len(pathRules[j].Path) - len(pathRules[i].Path)
+		default:
+			return 0
+		}
+	})
+
+	return order
+}
+
 func initializeExternalLocations(
 	rule dataplane.PathRule,
 	pathsAndTypes pathAndTypeMap,
@@ -229,6 +532,7 @@ func updateLocationsForFilters(
 	matchRule dataplane.MatchRule,
 	listenerPort int32,
 	path string,
+	grpc bool,
 ) []http.Location {
 	if filters.InvalidFilter != nil {
 		for i := range buildLocations {
@@ -245,8 +549,20 @@ func updateLocationsForFilters(
 		return buildLocations
 	}
 
+	// The filters of a single backend are applied in the same location as the filters of the rule.
+	// Multiple backends with filters get their own locations, see createBackendLocations.
+	ruleFilters := matchRule.Filters
+	if len(matchRule.BackendGroup.Backends) == 1 {
+		ruleFilters = mergeBackendFilters(ruleFilters, matchRule.BackendGroup.Backends[0])
+	}
+
 	rewrites := createRewritesValForRewriteFilter(filters.RequestURLRewrite, path)
-	proxySetHeaders := generateProxySetHeaders(&matchRule.Filters)
+	proxySetHeaders := generateProxySetHeaders(&ruleFilters, grpc)
+	if !grpc && backendsUseKeepAlive(matchRule.BackendGroup.Backends) {
+		setKeepAliveConnectionHeader(proxySetHeaders)
+	}
+	responseHeaders := generateResponseHeaders(&ruleFilters)
+	proxyTimeouts := createProxyTimeouts(matchRule.Timeouts)
 	for i := range buildLocations {
 		if rewrites != nil {
 			if rewrites.Rewrite != "" {
@@ -254,25 +570,648 @@ func updateLocationsForFilters(
 			}
 		}
 		buildLocations[i].ProxySetHeaders = proxySetHeaders
+		buildLocations[i].ResponseHeaders = responseHeaders
+		buildLocations[i].ProxyTimeouts = proxyTimeouts
 		buildLocations[i].ProxySSLVerify = createProxyTLSFromBackends(matchRule.BackendGroup.Backends)
 		proxyPass := createProxyPass(
 			matchRule.BackendGroup,
 			matchRule.Filters.RequestURLRewrite,
-			generateProtocolString(buildLocations[i].ProxySSLVerify),
+			generateProtocolString(buildLocations[i].ProxySSLVerify, grpc),
+			grpc,
 		)
 		buildLocations[i].ProxyPass = proxyPass
+		buildLocations[i].GRPC = grpc
 	}
 
 	return buildLocations
 }
 
-func generateProtocolString(ssl *http.ProxySSLVerify) string {
+func generateProtocolString(ssl *http.ProxySSLVerify, grpc bool) string {
+	if grpc {
+		if ssl != nil {
+			return "grpcs"
+		}
+		return "grpc"
+	}
 	if ssl != nil {
 		return "https"
 	}
 	return "http"
 }
 
+func createMirrorPath(pathRuleIdx, matchRuleIdx int) string {
+	return fmt.Sprintf("/_ngf-internal-mirror-rule%d-route%d", pathRuleIdx, matchRuleIdx)
+}
+
+// createExternalAuthPath returns the path of the internal location that sends the authorization requests of a rule.
+func createExternalAuthPath(pathRuleIdx, matchRuleIdx int) string {
+	return fmt.Sprintf("/_ngf-internal-auth-rule%d-route%d", pathRuleIdx, matchRuleIdx)
+}
+
+// createServerErrorPagePath returns the path of the internal location that serves an error page of a server.
+func createServerErrorPagePath(pageIdx int) string {
+	return fmt.Sprintf("/_ngf-internal-error-page%d", pageIdx)
+}
+
+// createRuleErrorPagePath returns the path of the internal location that serves an error page of a rule.
+func createRuleErrorPagePath(pageIdx, pathRuleIdx, matchRuleIdx int) string {
+	return fmt.Sprintf("/_ngf-internal-error-page%d-rule%d-route%d", pageIdx, pathRuleIdx, matchRuleIdx)
+}
+
+// createBackendLocationPath returns the path of the named location of a backend of a rule.
+// The backend is identified by the split client value of the backend, which is the name of its upstream.
+func createBackendLocationPath(pathRuleIdx, matchRuleIdx int, backend string) string {
+	return fmt.Sprintf("@rule%d-route%d-%s", pathRuleIdx, matchRuleIdx, backend)
+}
+
+// backendGroupNeedsBackendLocations returns true if the requests are split between multiple backends and at least
+// one of the backends has filters. In that case, every backend needs its own location to apply its filters.
+// If all the weights are 0, the requests are proxied to the invalid backend upstream, so there is nothing to
+// apply the filters to.
+func backendGroupNeedsBackendLocations(group dataplane.BackendGroup) bool {
+	if !backendGroupNeedsSplit(group) {
+		return false
+	}
+
+	var hasFilters bool
+	var totalWeight int32
+
+	for _, b := range group.Backends {
+		if b.Filters != nil {
+			hasFilters = true
+		}
+		totalWeight += b.Weight
+	}
+
+	return hasFilters && totalWeight > 0
+}
+
+// createBackendLocations creates a named location for every backend of a rule that needs backend locations.
+// The split client variable of the backend group chooses the location the request is passed to. Every location
+// is configured like the location of the rule, but proxies the requests only to its backend and additionally
+// applies the filters of its backend.
+// It returns nil if the rule doesn't need backend locations or the requests are not proxied
+// (for example, because of a redirect).
+func createBackendLocations(
+	pathRuleIdx,
+	matchRuleIdx int,
+	matchRule dataplane.MatchRule,
+	listenerPort int32,
+	path string,
+	grpc bool,
+) []http.Location {
+	if !backendGroupNeedsBackendLocations(matchRule.BackendGroup) {
+		return nil
+	}
+
+	if matchRule.Filters.InvalidFilter != nil || matchRule.Filters.RequestRedirect != nil {
+		return nil
+	}
+
+	locs := make([]http.Location, 0, len(matchRule.BackendGroup.Backends))
+	// multiple backends can have the same split client value, for example, all invalid backends
+	seen := make(map[string]struct{})
+
+	for _, b := range matchRule.BackendGroup.Backends {
+		backend := getSplitClientValue(b)
+		if _, exists := seen[backend]; exists {
+			continue
+		}
+		seen[backend] = struct{}{}
+
+		backendRule := matchRule
+		backendRule.BackendGroup.Backends = []dataplane.Backend{b}
+
+		backendLocs := updateLocationsForFilters(
+			backendRule.Filters,
+			[]http.Location{createMatchLocation(createBackendLocationPath(pathRuleIdx, matchRuleIdx, backend))},
+			backendRule,
+			listenerPort,
+			path,
+			grpc,
+		)
+		locs = append(locs, backendLocs...)
+	}
+
+	return locs
+}
+
+// mergeBackendFilters merges the filters of a backend into the filters of its rule.
+// The header modifiers of the backend take precedence: if a header is modified by the backend, the modifications
+// of that header by the rule are dropped.
+func mergeBackendFilters(filters dataplane.HTTPFilters, backend dataplane.Backend) dataplane.HTTPFilters {
+	if backend.Filters == nil {
+		return filters
+	}
+
+	filters.RequestHeaderModifiers = mergeHeaderFilters(
+		filters.RequestHeaderModifiers,
+		backend.Filters.RequestHeaderModifiers,
+	)
+	filters.ResponseHeaderModifiers = mergeHeaderFilters(
+		filters.ResponseHeaderModifiers,
+		backend.Filters.ResponseHeaderModifiers,
+	)
+
+	return filters
+}
+
+func mergeHeaderFilters(ruleFilter, backendFilter *dataplane.HTTPHeaderFilter) *dataplane.HTTPHeaderFilter {
+	if ruleFilter == nil {
+		return backendFilter
+	}
+	if backendFilter == nil {
+		return ruleFilter
+	}
+
+	backendHeaders := make(map[string]struct{})
+	for _, h := range backendFilter.Add {
+		backendHeaders[strings.ToLower(h.Name)] = struct{}{}
+	}
+	for _, h := range backendFilter.Set {
+		backendHeaders[strings.ToLower(h.Name)] = struct{}{}
+	}
+	for _, h := range backendFilter.Remove {
+		backendHeaders[strings.ToLower(h)] = struct{}{}
+	}
+
+	isBackendHeader := func(name string) bool {
+		_, exists := backendHeaders[strings.ToLower(name)]
+		return exists
+	}
+
+	merged := &dataplane.HTTPHeaderFilter{}
+
+	for _, h := range ruleFilter.Add {
+		if !isBackendHeader(h.Name) {
+			merged.Add = append(merged.Add, h)
+		}
+	}
+	for _, h := range ruleFilter.Set {
+		if !isBackendHeader(h.Name) {
+			merged.Set = append(merged.Set, h)
+		}
+	}
+	for _, h := range ruleFilter.Remove {
+		if !isBackendHeader(h) {
+			merged.Remove = append(merged.Remove, h)
+		}
+	}
+
+	merged.Add = append(merged.Add, backendFilter.Add...)
+	merged.Set = append(merged.Set, backendFilter.Set...)
+	merged.Remove = append(merged.Remove, backendFilter.Remove...)
+
+	return merged
+}
+
+// createMirrorLocation creates an internal location that proxies the requests mirrored by the mirror directive
+// to the backend of the RequestMirror filter. The mirror directive only accepts a URI, so unlike the internal
+// locations of the matches, the location can't be a named location.
+// It returns false if the requests are not proxied (for example, because of a redirect) or the mirror backend
+// is invalid. In that case, the requests are not mirrored, but the rule is still configured.
+func createMirrorLocation(mirrorPath string, filters dataplane.HTTPFilters) (http.Location, bool) {
+	if filters.InvalidFilter != nil || filters.RequestRedirect != nil {
+		return http.Location{}, false
+	}
+
+	mirror := filters.RequestMirror
+	if mirror == nil || !mirror.Backend.Valid {
+		return http.Location{}, false
+	}
+
+	proxySSLVerify := createProxySSLVerify(mirror.Backend.VerifyTLS)
+	protocol := generateProtocolString(proxySSLVerify, false)
+
+	proxySetHeaders := generateProxySetHeaders(nil, false)
+	if mirror.Backend.KeepAlive {
+		setKeepAliveConnectionHeader(proxySetHeaders)
+	}
+
+	// $request_uri of the mirror subrequest is the URI of the original request.
+	return http.Location{
+		Path:            exactPath(mirrorPath),
+		Internal:        true,
+		ProxyPass:       protocol + "://" + mirror.Backend.UpstreamName + "$request_uri",
+		ProxySetHeaders: proxySetHeaders,
+		ProxySSLVerify:  proxySSLVerify,
+	}, true
+}
+
+// createAuthRequest creates the authorization of the requests by the internal location with the authPath.
+// It also returns the headers that set the copied headers of the response of the authorization service
+// in the requests to the backends.
+func createAuthRequest(authPath string, externalAuth *dataplane.ExternalAuth) (*http.AuthRequest, []http.Header) {
+	authRequest := &http.AuthRequest{URI: authPath}
+	headers := make([]http.Header, 0, len(externalAuth.CopyResponseHeaders))
+
+	for _, h := range externalAuth.CopyResponseHeaders {
+		variable := "$" + generateAuthResponseHeaderVariableName(h)
+
+		authRequest.Sets = append(authRequest.Sets, http.AuthRequestSet{
+			Variable: variable,
+			Value:    "$upstream_http_" + strings.ToLower(convertStringToSafeVariableName(h)),
+		})
+		// If the authorization service doesn't return the header, the header of the client is removed,
+		// so that the client can't impersonate a user.
+		headers = append(headers, http.Header{Name: h, Value: variable})
+	}
+
+	return authRequest, headers
+}
+
+// createExternalAuthLocation creates an internal location that proxies the authorization requests to the
+// authorization service. The body of the original request is never passed, and only the selected headers are
+// passed if the headers are selected.
+// If the backend of the authorization service is invalid, the location responds with 500, so that the requests
+// are denied.
+func createExternalAuthLocation(authPath string, externalAuth *dataplane.ExternalAuth) http.Location {
+	if !externalAuth.Backend.Valid {
+		return http.Location{
+			Path:     exactPath(authPath),
+			Internal: true,
+			Return:   &http.Return{Code: http.StatusInternalServerError},
+		}
+	}
+
+	proxySetHeaders := generateProxySetHeaders(nil, false)
+	if externalAuth.Backend.KeepAlive {
+		setKeepAliveConnectionHeader(proxySetHeaders)
+	}
+
+	proxySetHeaders = append(
+		proxySetHeaders,
+		http.Header{Name: "Content-Length", Value: ""},
+		http.Header{Name: "X-Original-URI", Value: "$request_uri"},
+		http.Header{Name: "X-Original-Method", Value: "$request_method"},
+	)
+
+	for _, h := range externalAuth.ForwardRequestHeaders {
+		proxySetHeaders = append(proxySetHeaders, http.Header{
+			Name:  h,
+			Value: "$http_" + strings.ToLower(convertStringToSafeVariableName(h)),
+		})
+	}
+
+	// $request_uri of the authorization subrequest is the URI of the original request.
+	uri := externalAuth.Path
+	if uri == "" {
+		uri = "$request_uri"
+	}
+
+	return http.Location{
+		Path:             exactPath(authPath),
+		Internal:         true,
+		ProxyPass:        "http://" + externalAuth.Backend.UpstreamName + uri,
+		ProxySetHeaders:  proxySetHeaders,
+		NoRequestHeaders: len(externalAuth.ForwardRequestHeaders) > 0,
+		NoRequestBody:    true,
+	}
+}
+
+// createErrorPages creates the error pages and the internal locations that serve them.
+// The paths of the locations are created from the indexes of the pages.
+func createErrorPages(pages []dataplane.ErrorPage, createPath func(int) string) ([]http.ErrorPage, []http.Location) {
+	if len(pages) == 0 {
+		return nil, nil
+	}
+
+	errorPages := make([]http.ErrorPage, 0, len(pages))
+	locs := make([]http.Location, 0, len(pages))
+
+	for i, page := range pages {
+		path := createPath(i)
+
+		errorPages = append(errorPages, http.ErrorPage{
+			Codes:        page.Codes,
+			ResponseCode: page.ResponseCode,
+			Path:         path,
+		})
+		locs = append(locs, createErrorPageLocation(path, page))
+	}
+
+	return errorPages, locs
+}
+
+// createErrorPageLocation creates an internal location that serves an error page, either from a file or from
+// a backend. NGINX redirects the erroneous requests to the location as GET requests, so the body of the original
+// request is not passed to the backend.
+func createErrorPageLocation(path string, page dataplane.ErrorPage) http.Location {
+	if page.Backend == nil {
+		return http.Location{
+			Path:        exactPath(path),
+			Internal:    true,
+			Alias:       generateErrorPageFileName(page.FileID),
+			DefaultType: page.ContentType,
+		}
+	}
+
+	proxySetHeaders := generateProxySetHeaders(nil, false)
+	if page.Backend.KeepAlive {
+		setKeepAliveConnectionHeader(proxySetHeaders)
+	}
+
+	proxySetHeaders = append(
+		proxySetHeaders,
+		http.Header{Name: "Content-Length", Value: ""},
+		http.Header{Name: "X-Error-Code", Value: "$status"},
+	)
+
+	return http.Location{
+		Path:            exactPath(path),
+		Internal:        true,
+		ProxyPass:       "http://" + page.Backend.UpstreamName + "$request_uri",
+		ProxySetHeaders: proxySetHeaders,
+		NoRequestBody:   true,
+	}
+}
+
+// mergeErrorPages returns the error pages of a rule followed by the error pages of the server for the status codes
+// that the rule doesn't replace, because NGINX doesn't inherit the error pages of the server in a location that
+// configures its own error pages.
+func mergeErrorPages(rulePages, serverPages []http.ErrorPage) []http.ErrorPage {
+	ruleCodes := make(map[int32]struct{})
+	for _, page := range rulePages {
+		for _, code := range page.Codes {
+			ruleCodes[code] = struct{}{}
+		}
+	}
+
+	pages := rulePages
+
+	for _, page := range serverPages {
+		codes := make([]int32, 0, len(page.Codes))
+		for _, code := range page.Codes {
+			if _, exists := ruleCodes[code]; !exists {
+				codes = append(codes, code)
+			}
+		}
+
+		if len(codes) > 0 {
+			page.Codes = codes
+			pages = append(pages, page)
+		}
+	}
+
+	return pages
+}
+
+// createRetry converts the retry settings of a server or a rule into NGINX retry settings.
+func createRetry(retry *dataplane.Retry) *http.Retry {
+	if retry == nil {
+		return nil
+	}
+
+	nextUpstream := make([]string, 0, len(retry.Conditions)+len(retry.StatusCodes))
+	for _, c := range retry.Conditions {
+		nextUpstream = append(nextUpstream, getNextUpstreamCondition(c))
+	}
+	for _, code := range retry.StatusCodes {
+		nextUpstream = append(nextUpstream, fmt.Sprintf("http_%d", code))
+	}
+
+	return &http.Retry{
+		Tries:        retry.Attempts,
+		NextUpstream: strings.Join(nextUpstream, " "),
+		Timeout:      retry.TotalTimeout,
+	}
+}
+
+func getNextUpstreamCondition(condition dataplane.RetryCondition) string {
+	switch condition {
+	case dataplane.RetryConditionError:
+		return "error"
+	case dataplane.RetryConditionTimeout:
+		return "timeout"
+	case dataplane.RetryConditionInvalidHeader:
+		return "invalid_header"
+	case dataplane.RetryConditionNonIdempotent:
+		return "non_idempotent"
+	default:
+		panic(fmt.Sprintf("unknown retry condition %s", condition))
+	}
+}
+
+// createLocationRetry returns the retry settings of a location with the proxy timeouts.
+// The request timeout of a rule limits the time for passing a request to the next upstream server as well,
+// so it takes precedence over the total retry timeout. NGINX doesn't allow to configure both in the same location.
+// The RetryPolicy reports that its total timeout is overridden in its Overridden condition.
+func createLocationRetry(retry *http.Retry, proxyTimeouts *http.ProxyTimeouts) *http.Retry {
+	if retry.Timeout == "" || proxyTimeouts == nil || proxyTimeouts.NextUpstreamTimeout == "" {
+		return retry
+	}
+
+	locRetry := *retry
+	locRetry.Timeout = ""
+
+	return &locRetry
+}
+
+// createLocationClientSettings returns the client settings of a location with proxy timeouts. The client body
+// timeout derived from the request timeout takes precedence over the body timeout of the client settings, because
+// NGINX rejects a location with two client_body_timeout directives.
+// The ClientSettingsPolicy reports that its body timeout is overridden in its Overridden condition.
+func createLocationClientSettings(
+	clientSettings *http.ClientSettings,
+	proxyTimeouts *http.ProxyTimeouts,
+) *http.ClientSettings {
+	if clientSettings.BodyTimeout == "" || proxyTimeouts == nil || proxyTimeouts.ClientBodyTimeout == "" {
+		return clientSettings
+	}
+
+	locClientSettings := *clientSettings
+	locClientSettings.BodyTimeout = ""
+
+	return &locClientSettings
+}
+
+// createProxyTimeouts converts the timeouts of a rule into NGINX proxy timeouts.
+// The backendRequest timeout limits every phase (connect, send, and read) of a request to an upstream server.
+// If it is not set, the request timeout is used instead, because a single request to an upstream server cannot
+// take longer than the whole request. A zero duration means no timeout, so the NGINX default is kept.
+//
+// NGINX cannot limit the duration of a whole request, so the request timeout is approximated by limiting every
+// phase of the request: reading the request body from the client, passing the request to the upstream servers,
+// including the time allowed for passing it to the next upstream server, and sending the response to the client.
+// Most of these timeouts limit the time between two successive read or write operations rather than the whole
+// phase, so a request that keeps making progress, for example, a slow upload or a streamed response, can last
+// longer than the request timeout.
+func createProxyTimeouts(timeouts *dataplane.HTTPTimeouts) *http.ProxyTimeouts {
+	if timeouts == nil {
+		return nil
+	}
+
+	var proxyTimeouts http.ProxyTimeouts
+
+	backendRequest := timeouts.BackendRequest
+	if backendRequest == nil {
+		backendRequest = timeouts.Request
+	}
+
+	if t := formatTimeout(backendRequest); t != "" {
+		proxyTimeouts.ConnectTimeout = t
+		proxyTimeouts.ReadTimeout = t
+		proxyTimeouts.SendTimeout = t
+	}
+
+	if t := formatTimeout(timeouts.Request); t != "" {
+		proxyTimeouts.NextUpstreamTimeout = t
+		proxyTimeouts.ClientBodyTimeout = t
+		proxyTimeouts.ClientSendTimeout = t
+	}
+
+	if proxyTimeouts == (http.ProxyTimeouts{}) {
+		return nil
+	}
+
+	return &proxyTimeouts
+}
+
+// createClientSettings converts the client settings of a server or a rule into NGINX client settings.
+func createClientSettings(settings *dataplane.ClientSettings) *http.ClientSettings {
+	if settings == nil {
+		return nil
+	}
+
+	return &http.ClientSettings{
+		BodyMaxSize:            settings.BodyMaxSize,
+		BodyTimeout:            settings.BodyTimeout,
+		KeepAliveRequests:      settings.KeepAliveRequests,
+		KeepAliveTime:          settings.KeepAliveTime,
+		KeepAliveServerTimeout: settings.KeepAliveServerTimeout,
+		KeepAliveHeaderTimeout: settings.KeepAliveHeaderTimeout,
+	}
+}
+
+// createCompression converts the compression settings of a server or a rule into NGINX compression settings.
+func createCompression(compression *dataplane.Compression) *http.Compression {
+	if compression == nil {
+		return nil
+	}
+
+	return &http.Compression{
+		Enabled:   compression.Enabled,
+		MinLength: compression.MinLength,
+		Level:     compression.Level,
+		Types:     strings.Join(compression.Types, " "),
+	}
+}
+
+// createAccessControl converts the access control settings of a server or a rule into NGINX access rules.
+// The default action is added as the last rule, which matches all addresses.
+func createAccessControl(accessControl *dataplane.AccessControl) *http.AccessControl {
+	if accessControl == nil {
+		return nil
+	}
+
+	rules := make([]http.AccessRule, 0, len(accessControl.Rules)+1)
+	for _, rule := range accessControl.Rules {
+		rules = append(rules, http.AccessRule{
+			Action:  string(rule.Action),
+			Address: rule.CIDR,
+		})
+	}
+
+	if accessControl.DefaultAction != "" {
+		rules = append(rules, http.AccessRule{
+			Action:  string(accessControl.DefaultAction),
+			Address: "all",
+		})
+	}
+
+	result := &http.AccessControl{Rules: rules}
+
+	if realIP := accessControl.RealIP; realIP != nil {
+		result.RealIP = &http.RealIP{
+			Header:           realIP.Header,
+			TrustedAddresses: realIP.TrustedAddresses,
+			Recursive:        realIP.Recursive,
+		}
+	}
+
+	return result
+}
+
+// createCORS converts the CORS settings of a rule into NGINX CORS configuration.
+func createCORS(cors *dataplane.CORS) *http.CORS {
+	if cors == nil {
+		return nil
+	}
+
+	result := &http.CORS{
+		OriginVariable:    "$" + generateCORSOriginVariableName(cors.Name),
+		PreflightVariable: "$" + generateCORSPreflightVariableName(cors.Name),
+		AllowMethods:      strings.Join(cors.AllowMethods, ", "),
+		AllowHeaders:      strings.Join(cors.AllowHeaders, ", "),
+		ExposeHeaders:     strings.Join(cors.ExposeHeaders, ", "),
+		AllowCredentials:  cors.AllowCredentials,
+	}
+
+	if cors.MaxAge != nil {
+		result.MaxAge = strconv.Itoa(int(*cors.MaxAge))
+	}
+
+	return result
+}
+
+// createBasicAuth converts the basic authentication settings of a rule into NGINX basic authentication settings.
+func createBasicAuth(basicAuth *dataplane.BasicAuth) *http.BasicAuth {
+	if basicAuth == nil {
+		return nil
+	}
+
+	return &http.BasicAuth{
+		Realm:    basicAuth.Realm,
+		UserFile: generateAuthUserFileName(basicAuth.UserFileID),
+	}
+}
+
+// createTracing converts the tracing settings of a rule into NGINX tracing configuration.
+func createTracing(tracing *dataplane.Tracing) *http.Tracing {
+	if tracing == nil {
+		return nil
+	}
+
+	t := &http.Tracing{
+		Enable:   getOtelTraceValue(tracing),
+		Context:  tracing.Context,
+		SpanName: tracing.SpanName,
+	}
+
+	for _, attr := range tracing.SpanAttributes {
+		t.SpanAttributes = append(t.SpanAttributes, http.SpanAttribute{Key: attr.Key, Value: attr.Value})
+	}
+
+	return t
+}
+
+// getOtelTraceValue returns the value of the otel_trace directive for the tracing settings.
+// A ratio other than 0 or 100 is sampled through the split client variable of that ratio.
+func getOtelTraceValue(tracing *dataplane.Tracing) string {
+	if tracing.Strategy == dataplane.TraceStrategyParent {
+		return "$otel_parent_sampled"
+	}
+
+	switch {
+	case tracing.Ratio == nil || *tracing.Ratio >= 100:
+		return "on"
+	case *tracing.Ratio <= 0:
+		return "off"
+	default:
+		return "$" + otelRatioVariableName(*tracing.Ratio)
+	}
+}
+
+// formatTimeout formats a duration as an NGINX time value in milliseconds.
+// It returns an empty string if the duration is nil or zero.
+func formatTimeout(d *time.Duration) string {
+	if d == nil || *d == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%dms", d.Milliseconds())
+}
+
 func createProxyTLSFromBackends(backends []dataplane.Backend) *http.ProxySSLVerify {
 	if len(backends) == 0 {
 		return nil
@@ -404,8 +1343,12 @@ type httpMatch struct {
 	RedirectPath string `json:"redirectPath,omitempty"`
 	// Headers is a list of HTTPHeaders name value pairs with the format "{name}:{value}".
 	Headers []string `json:"headers,omitempty"`
+	// HeadersRegex is a list of HTTPHeaders name regular expression pairs with the format "{name}:{regex}".
+	HeadersRegex []string `json:"headersRegex,omitempty"`
 	// QueryParams is a list of HTTPQueryParams name value pairs with the format "{name}={value}".
 	QueryParams []string `json:"params,omitempty"`
+	// QueryParamsRegex is a list of HTTPQueryParams name regular expression pairs with the format "{name}={regex}".
+	QueryParamsRegex []string `json:"paramsRegex,omitempty"`
 	// Any represents a match with no match conditions.
 	Any bool `json:"any,omitempty"`
 }
@@ -425,28 +1368,31 @@ func createHTTPMatch(match dataplane.Match, redirectPath string) httpMatch {
 	}
 
 	if match.Headers != nil {
-		headers := make([]string, 0, len(match.Headers))
 		headerNames := make(map[string]struct{})
 
 		for _, h := range match.Headers {
 			// duplicate header names are not permitted by the spec
 			// only configure the first entry for every header name (case-insensitive)
 			lowerName := strings.ToLower(h.Name)
-			if _, ok := headerNames[lowerName]; !ok {
-				headers = append(headers, createHeaderKeyValString(h))
-				headerNames[lowerName] = struct{}{}
+			if _, ok := headerNames[lowerName]; ok {
+				continue
+			}
+			headerNames[lowerName] = struct{}{}
+
+			if h.Type == dataplane.MatchTypeRegularExpression {
+				hm.HeadersRegex = append(hm.HeadersRegex, createHeaderKeyValString(h))
+			} else {
+				hm.Headers = append(hm.Headers, createHeaderKeyValString(h))
 			}
 		}
-		hm.Headers = headers
 	}
 
-	if match.QueryParams != nil {
-		params := make([]string, 0, len(match.QueryParams))
-
-		for _, p := range match.QueryParams {
-			params = append(params, createQueryParamKeyValString(p))
+	for _, p := range match.QueryParams {
+		if p.Type == dataplane.MatchTypeRegularExpression {
+			hm.QueryParamsRegex = append(hm.QueryParamsRegex, createQueryParamKeyValString(p))
+		} else {
+			hm.QueryParams = append(hm.QueryParams, createQueryParamKeyValString(p))
 		}
-		hm.QueryParams = params
 	}
 
 	return hm
@@ -458,7 +1404,8 @@ func createQueryParamKeyValString(p dataplane.HTTPQueryParamMatch) string {
 	return p.Name + "=" + p.Value
 }
 
-// The name and values are delimited by ":". A name and value can always be recovered using strings.Split(arg, ":").
+// The name and values are delimited by ":". A name and value can always be recovered using
+// strings.SplitN(arg, ":", 2), because a header name cannot contain ":".
 // Header names are case-insensitive and header values are case-sensitive.
 // Ex. foo:bar == FOO:bar, but foo:bar != foo:BAR,
 // We preserve the case of the name here because NGINX allows us to look up the header names in a case-insensitive
@@ -475,9 +1422,11 @@ func createProxyPass(
 	backendGroup dataplane.BackendGroup,
 	filter *dataplane.HTTPURLRewriteFilter,
 	protocol string,
+	grpc bool,
 ) string {
 	var requestURI string
-	if filter == nil || filter.Path == nil {
+	// grpc_pass doesn't support a URI
+	if !grpc && (filter == nil || filter.Path == nil) {
 		requestURI = "$request_uri"
 	}
 
@@ -495,9 +1444,15 @@ func createMatchLocation(path string) http.Location {
 	}
 }
 
-func generateProxySetHeaders(filters *dataplane.HTTPFilters) []http.Header {
-	headers := make([]http.Header, len(baseHeaders))
-	copy(headers, baseHeaders)
+func generateProxySetHeaders(filters *dataplane.HTTPFilters, grpc bool) []http.Header {
+	var headers []http.Header
+	if grpc {
+		headers = make([]http.Header, len(grpcBaseHeaders))
+		copy(headers, grpcBaseHeaders)
+	} else {
+		headers = make([]http.Header, len(baseHeaders))
+		copy(headers, baseHeaders)
+	}
 
 	if filters != nil && filters.RequestURLRewrite != nil && filters.RequestURLRewrite.Hostname != nil {
 		for i, header := range headers {
@@ -535,6 +1490,28 @@ func generateProxySetHeaders(filters *dataplane.HTTPFilters) []http.Header {
 	return append(proxySetHeaders, headers...)
 }
 
+// backendsUseKeepAlive returns true if any of the backends uses keep-alive connections to its upstream.
+func backendsUseKeepAlive(backends []dataplane.Backend) bool {
+	for _, b := range backends {
+		if b.KeepAlive {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setKeepAliveConnectionHeader replaces the value of the base Connection header, so that NGINX doesn't close
+// the keep-alive connections to the upstream servers after every request. WebSocket upgrades are still supported.
+func setKeepAliveConnectionHeader(headers []http.Header) {
+	for i, header := range headers {
+		if header.Name == "Connection" && header.Value == "$connection_upgrade" {
+			headers[i].Value = "$connection_keepalive"
+			return
+		}
+	}
+}
+
 func convertAddHeaders(headers []dataplane.HTTPHeader) []http.Header {
 	locHeaders := make([]http.Header, 0, len(headers))
 	for _, h := range headers {
@@ -558,6 +1535,20 @@ func convertSetHeaders(headers []dataplane.HTTPHeader) []http.Header {
 	return locHeaders
 }
 
+func generateResponseHeaders(filters *dataplane.HTTPFilters) http.ResponseHeaders {
+	if filters == nil || filters.ResponseHeaderModifiers == nil {
+		return http.ResponseHeaders{}
+	}
+
+	headerFilter := filters.ResponseHeaderModifiers
+
+	return http.ResponseHeaders{
+		Add:    convertSetHeaders(headerFilter.Add),
+		Set:    convertSetHeaders(headerFilter.Set),
+		Remove: headerFilter.Remove,
+	}
+}
+
 func convertMatchesToString(matches []httpMatch) string {
 	// FIXME(sberman): De-dupe matches and associated locations
 	// so we don't need nginx/njs to perform unnecessary matching.
@@ -568,18 +1559,33 @@ func convertMatchesToString(matches []httpMatch) string {
 		panic(fmt.Errorf("could not marshal http match: %w", err))
 	}
 
-	return string(b)
+	// The result is used as a value of the set directive, which treats "$" as the start of an NGINX variable.
+	// "$" can only appear in the JSON strings (for example, as a regular expression anchor), so we replace it with
+	// its JSON unicode escape sequence, which NJS decodes back to "$" when parsing the matches.
+	return strings.ReplaceAll(string(b), "$", `\u0024`)
 }
 
 func exactPath(path string) string {
 	return fmt.Sprintf("= %s", path)
 }
 
+// regexLocationEscaper escapes a regular expression, so that NGINX parses it back to the same regular expression
+// from a double-quoted string.
+var regexLocationEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// regexPath returns the path of a case-sensitive regular expression location. The regular expression is quoted,
+// because it can include characters like '{' or ';' that NGINX doesn't allow in unquoted values.
+func regexPath(regex string) string {
+	return fmt.Sprintf(`~ "%s"`, regexLocationEscaper.Replace(regex))
+}
+
 // createPath builds the location path depending on the path type.
 func createPath(rule dataplane.PathRule) string {
 	switch rule.PathType {
 	case dataplane.PathTypeExact:
 		return exactPath(rule.Path)
+	case dataplane.PathTypeRegularExpression:
+		return regexPath(rule.Path)
 	default:
 		return rule.Path
 	}
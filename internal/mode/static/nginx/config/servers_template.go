@@ -5,12 +5,25 @@ var serversTemplateText = `
     {{ if $s.IsDefaultSSL -}}
 server {
     listen {{ $s.Port }} ssl default_server;
+        {{- if $s.GRPC }}
+    http2 on;
+        {{- end }}
 
     ssl_reject_handshake on;
 }
-    {{- else if $s.IsDefaultHTTP }}
+    {{- else if and $s.IsDefaultHTTP (not $s.ErrorPages) }}
 server {
     listen {{ $s.Port }} default_server;
+        {{- if $s.GRPC }}
+    http2 on;
+        {{- end }}
+        {{- with $s.AccessLog }}
+
+    access_log {{ if .Format }}{{ .Path }} {{ .Format }}{{ else }}off{{ end }};
+        {{- end }}
+        {{- range $s.Variables }}
+    set ${{ .Name }} "{{ .Value }}";
+        {{- end }}
 
     default_type text/html;
     return 404;
@@ -26,13 +39,217 @@ server {
         return 421;
     }
         {{- else }}
-    listen {{ $s.Port }};
+    listen {{ $s.Port }}{{ if $s.IsDefaultHTTP }} default_server{{ end }};
         {{- end }}
 
+        {{- if $s.GRPC }}
+    http2 on;
+        {{- end }}
+
+        {{- if $s.ServerName }}
+
     server_name {{ $s.ServerName }};
+        {{- end }}
+        {{- with $s.AccessLog }}
+    access_log {{ if .Format }}{{ .Path }} {{ .Format }}{{ else }}off{{ end }};
+        {{- end }}
+        {{- range $s.Variables }}
+    set ${{ .Name }} "{{ .Value }}";
+        {{- end }}
+        {{- with $s.ClientSettings }}
+            {{- if .BodyMaxSize }}
+    client_max_body_size {{ .BodyMaxSize }};
+            {{- end }}
+            {{- if .BodyTimeout }}
+    client_body_timeout {{ .BodyTimeout }};
+            {{- end }}
+            {{- if .KeepAliveRequests }}
+    keepalive_requests {{ .KeepAliveRequests }};
+            {{- end }}
+            {{- if .KeepAliveTime }}
+    keepalive_time {{ .KeepAliveTime }};
+            {{- end }}
+            {{- if .KeepAliveServerTimeout }}
+    keepalive_timeout {{ .KeepAliveServerTimeout }}{{ if .KeepAliveHeaderTimeout }} {{ .KeepAliveHeaderTimeout }}{{ end }};
+            {{- end }}
+        {{- end }}
+        {{- with $s.RateLimit }}
+    limit_req zone={{ .ZoneName }}{{ if .Burst }} burst={{ .Burst }}{{ end }}{{ if .NoDelay }} nodelay{{ end }};
+            {{- if .RejectCode }}
+    limit_req_status {{ .RejectCode }};
+            {{- end }}
+        {{- end }}
+        {{- with $s.Retry }}
+            {{- if .NextUpstream }}
+    proxy_next_upstream {{ .NextUpstream }};
+            {{- end }}
+            {{- if .Tries }}
+    proxy_next_upstream_tries {{ .Tries }};
+            {{- end }}
+            {{- if .Timeout }}
+    proxy_next_upstream_timeout {{ .Timeout }};
+            {{- end }}
+            {{- if $s.GRPC }}
+                {{- if .NextUpstream }}
+    grpc_next_upstream {{ .NextUpstream }};
+                {{- end }}
+                {{- if .Tries }}
+    grpc_next_upstream_tries {{ .Tries }};
+                {{- end }}
+                {{- if .Timeout }}
+    grpc_next_upstream_timeout {{ .Timeout }};
+                {{- end }}
+            {{- end }}
+        {{- end }}
+        {{- with $s.AccessControl }}
+            {{- with .RealIP }}
+                {{- range $a := .TrustedAddresses }}
+    set_real_ip_from {{ $a }};
+                {{- end }}
+    real_ip_header {{ .Header }};
+                {{- if .Recursive }}
+    real_ip_recursive on;
+                {{- end }}
+            {{- end }}
+            {{- range $r := .Rules }}
+    {{ $r.Action }} {{ $r.Address }};
+            {{- end }}
+        {{- end }}
+        {{- with $s.Compression }}
+    gzip {{ if .Enabled }}on{{ else }}off{{ end }};
+            {{- if .Enabled }}
+    gzip_vary on;
+                {{- if .Level }}
+    gzip_comp_level {{ .Level }};
+                {{- end }}
+                {{- if .MinLength }}
+    gzip_min_length {{ .MinLength }};
+                {{- end }}
+                {{- if .Types }}
+    gzip_types {{ .Types }};
+                {{- end }}
+            {{- end }}
+        {{- end }}
+        {{- range $s.ErrorPages }}
+    error_page{{ range .Codes }} {{ . }}{{ end }}{{ with .ResponseCode }} ={{ . }}{{ end }} {{ .Path }};
+        {{- end }}
 
         {{ range $l := $s.Locations }}
     location {{ $l.Path }} {
+        {{- if $l.Internal }}
+        internal;
+        {{- end }}
+        {{- with $l.AccessLog }}
+        access_log {{ if .Format }}{{ .Path }} {{ .Format }}{{ else }}off{{ end }};
+        {{- end }}
+        {{- range $l.Variables }}
+        set ${{ .Name }} "{{ .Value }}";
+        {{- end }}
+        {{- with $l.ClientSettings }}
+            {{- if .BodyMaxSize }}
+        client_max_body_size {{ .BodyMaxSize }};
+            {{- end }}
+            {{- if .BodyTimeout }}
+        client_body_timeout {{ .BodyTimeout }};
+            {{- end }}
+            {{- if .KeepAliveRequests }}
+        keepalive_requests {{ .KeepAliveRequests }};
+            {{- end }}
+            {{- if .KeepAliveTime }}
+        keepalive_time {{ .KeepAliveTime }};
+            {{- end }}
+            {{- if .KeepAliveServerTimeout }}
+        keepalive_timeout {{ .KeepAliveServerTimeout }}{{ if .KeepAliveHeaderTimeout }} {{ .KeepAliveHeaderTimeout }}{{ end }};
+            {{- end }}
+        {{- end }}
+        {{- with $l.Tracing }}
+        otel_trace {{ .Enable }};
+            {{- if .Context }}
+        otel_trace_context {{ .Context }};
+            {{- end }}
+            {{- if .SpanName }}
+        otel_span_name "{{ .SpanName }}";
+            {{- end }}
+            {{- range $a := .SpanAttributes }}
+        otel_span_attr "{{ $a.Key }}" "{{ $a.Value }}";
+            {{- end }}
+        {{- end }}
+        {{- with $l.AccessControl }}
+            {{- with .RealIP }}
+                {{- range $a := .TrustedAddresses }}
+        set_real_ip_from {{ $a }};
+                {{- end }}
+        real_ip_header {{ .Header }};
+                {{- if .Recursive }}
+        real_ip_recursive on;
+                {{- end }}
+            {{- end }}
+            {{- range $r := .Rules }}
+        {{ $r.Action }} {{ $r.Address }};
+            {{- end }}
+        {{- end }}
+        {{- with $l.Compression }}
+        gzip {{ if .Enabled }}on{{ else }}off{{ end }};
+            {{- if .Enabled }}
+        gzip_vary on;
+                {{- if .Level }}
+        gzip_comp_level {{ .Level }};
+                {{- end }}
+                {{- if .MinLength }}
+        gzip_min_length {{ .MinLength }};
+                {{- end }}
+                {{- if .Types }}
+        gzip_types {{ .Types }};
+                {{- end }}
+            {{- end }}
+        {{- end }}
+        {{- with $l.AuthRequest }}
+        auth_request {{ .URI }};
+            {{- range $s := .Sets }}
+        auth_request_set {{ $s.Variable }} {{ $s.Value }};
+            {{- end }}
+        {{- end }}
+        {{- with $l.BasicAuth }}
+        auth_basic "{{ .Realm }}";
+        auth_basic_user_file {{ .UserFile }};
+        {{- end }}
+        {{- with $l.RateLimit }}
+        limit_req zone={{ .ZoneName }}{{ if .Burst }} burst={{ .Burst }}{{ end }}{{ if .NoDelay }} nodelay{{ end }};
+            {{- if .RejectCode }}
+        limit_req_status {{ .RejectCode }};
+            {{- end }}
+        {{- end }}
+        {{- with $l.CORS }}
+        if ({{ .PreflightVariable }}) {
+            add_header Access-Control-Allow-Origin {{ .OriginVariable }} always;
+            add_header Access-Control-Allow-Methods "{{ .AllowMethods }}" always;
+            {{- if .AllowHeaders }}
+            add_header Access-Control-Allow-Headers "{{ .AllowHeaders }}" always;
+            {{- end }}
+            {{- if .AllowCredentials }}
+            add_header Access-Control-Allow-Credentials true always;
+            {{- end }}
+            {{- if .MaxAge }}
+            add_header Access-Control-Max-Age {{ .MaxAge }} always;
+            {{- end }}
+            add_header Vary Origin always;
+            return 204;
+        }
+        add_header Access-Control-Allow-Origin {{ .OriginVariable }} always;
+            {{- if .AllowCredentials }}
+        add_header Access-Control-Allow-Credentials true always;
+            {{- end }}
+            {{- if .ExposeHeaders }}
+        add_header Access-Control-Expose-Headers "{{ .ExposeHeaders }}" always;
+            {{- end }}
+        add_header Vary Origin always;
+        {{- end }}
+        {{- range $l.ErrorPages }}
+        error_page{{ range .Codes }} {{ . }}{{ end }}{{ with .ResponseCode }} ={{ . }}{{ end }} {{ .Path }};
+        {{- end }}
+        {{- if $l.RecursiveErrorPages }}
+        recursive_error_pages on;
+        {{- end }}
         {{- range $r := $l.Rewrites }}
         rewrite {{ $r }};
         {{- end }}
@@ -41,21 +258,102 @@ server {
         return {{ $l.Return.Code }} "{{ $l.Return.Body }}";
         {{- end }}
 
+        {{- if $l.DefaultType }}
+        default_type {{ $l.DefaultType }};
+        {{- end }}
+        {{- if $l.Alias }}
+        alias {{ $l.Alias }};
+        {{- end }}
+
         {{- if $l.HTTPMatchVar }}
         set $http_matches {{ $l.HTTPMatchVar | printf "%q" }};
         js_content httpmatches.redirect;
         {{- end }}
 
+        {{- if $l.BackendLocation }}
+        error_page 418 = {{ $l.BackendLocation }};
+        return 418;
+        {{- end }}
+
         {{- if $l.ProxyPass -}}
-            {{ range $h := $l.ProxySetHeaders }}
-        proxy_set_header {{ $h.Name }} "{{ $h.Value }}";
+            {{ $proxyOrGRPC := "proxy" }}{{ if $l.GRPC }}{{ $proxyOrGRPC = "grpc" }}{{ end }}
+            {{- range $h := $l.ProxySetHeaders }}
+        {{ $proxyOrGRPC }}_set_header {{ $h.Name }} "{{ $h.Value }}";
             {{- end }}
+            {{- if not $l.GRPC }}
         proxy_http_version 1.1;
-        proxy_pass {{ $l.ProxyPass }};
+            {{- end }}
+            {{- if $l.MirrorPath }}
+        mirror {{ $l.MirrorPath }};
+            {{- end }}
+            {{- if $l.NoRequestHeaders }}
+        proxy_pass_request_headers off;
+            {{- end }}
+            {{- if $l.NoRequestBody }}
+        proxy_pass_request_body off;
+            {{- end }}
+        {{ $proxyOrGRPC }}_pass {{ $l.ProxyPass }};
+            {{- if $l.InterceptErrors }}
+        {{ $proxyOrGRPC }}_intercept_errors on;
+            {{- end }}
+            {{- with $l.ProxyTimeouts }}
+                {{- if .ConnectTimeout }}
+        {{ $proxyOrGRPC }}_connect_timeout {{ .ConnectTimeout }};
+                {{- end }}
+                {{- if .ReadTimeout }}
+        {{ $proxyOrGRPC }}_read_timeout {{ .ReadTimeout }};
+                {{- end }}
+                {{- if .SendTimeout }}
+        {{ $proxyOrGRPC }}_send_timeout {{ .SendTimeout }};
+                {{- end }}
+                {{- if .NextUpstreamTimeout }}
+        {{ $proxyOrGRPC }}_next_upstream_timeout {{ .NextUpstreamTimeout }};
+                {{- end }}
+                {{- if .ClientBodyTimeout }}
+        client_body_timeout {{ .ClientBodyTimeout }};
+                {{- end }}
+                {{- if .ClientSendTimeout }}
+        send_timeout {{ .ClientSendTimeout }};
+                {{- end }}
+            {{- end }}
+            {{- with $l.Retry }}
+                {{- if .NextUpstream }}
+        {{ $proxyOrGRPC }}_next_upstream {{ .NextUpstream }};
+                {{- end }}
+                {{- if .Tries }}
+        {{ $proxyOrGRPC }}_next_upstream_tries {{ .Tries }};
+                {{- end }}
+                {{- if .Timeout }}
+        {{ $proxyOrGRPC }}_next_upstream_timeout {{ .Timeout }};
+                {{- end }}
+            {{- end }}
+            {{- with $l.Cache }}
+        proxy_cache {{ .ZoneName }};
+                {{- if .Key }}
+        proxy_cache_key "{{ .Key }}";
+                {{- end }}
+                {{- range $v := .Valid }}
+        proxy_cache_valid {{ if $v.Codes }}{{ $v.Codes }} {{ end }}{{ $v.Time }};
+                {{- end }}
+                {{- if .Bypass }}
+        proxy_cache_bypass {{ .Bypass }};
+        proxy_no_cache {{ .Bypass }};
+                {{- end }}
+            {{- end }}
+            {{- range $h := $l.ResponseHeaders.Add }}
+        add_header {{ $h.Name }} "{{ $h.Value }}" always;
+            {{- end }}
+            {{- range $h := $l.ResponseHeaders.Set }}
+        {{ $proxyOrGRPC }}_hide_header {{ $h.Name }};
+        add_header {{ $h.Name }} "{{ $h.Value }}" always;
+            {{- end }}
+            {{- range $h := $l.ResponseHeaders.Remove }}
+        {{ $proxyOrGRPC }}_hide_header {{ $h }};
+            {{- end }}
             {{- if $l.ProxySSLVerify }}
-        proxy_ssl_verify on;
-        proxy_ssl_name {{ $l.ProxySSLVerify.Name }};
-        proxy_ssl_trusted_certificate {{ $l.ProxySSLVerify.TrustedCertificate }};
+        {{ $proxyOrGRPC }}_ssl_verify on;
+        {{ $proxyOrGRPC }}_ssl_name {{ $l.ProxySSLVerify.Name }};
+        {{ $proxyOrGRPC }}_ssl_trusted_certificate {{ $l.ProxySSLVerify.TrustedCertificate }};
             {{- end }}
         {{- end }}
     }
@@ -3,10 +3,13 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
@@ -69,411 +72,757 @@ func TestExecuteServers(t *testing.T) {
 	}
 }
 
-func TestExecuteForDefaultServers(t *testing.T) {
-	testcases := []struct {
-		msg       string
-		httpPorts []int
-		sslPorts  []int
-		conf      dataplane.Configuration
-	}{
-		{
-			conf: dataplane.Configuration{},
-			msg:  "no default servers",
-		},
-		{
-			conf: dataplane.Configuration{
-				HTTPServers: []dataplane.VirtualServer{
+func TestExecuteServersForGRPC(t *testing.T) {
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+				Port:      8080,
+			},
+			{
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
 					{
-						IsDefault: true,
-						Port:      80,
+						Path:     "/helloworld.Greeter/SayHello",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							{
+								BackendGroup: dataplane.BackendGroup{
+									Source:  types.NamespacedName{Namespace: "test", Name: "gr"},
+									RuleIdx: 0,
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_foo_80",
+											Valid:        true,
+											Weight:       1,
+										},
+									},
+								},
+							},
+						},
+						GRPC: true,
 					},
 				},
 			},
-			httpPorts: []int{80},
-			msg:       "only HTTP default server",
+			{
+				Hostname: "cafe.example.com",
+				Port:     8080,
+			},
+			{
+				IsDefault: true,
+				Port:      8081,
+			},
 		},
-		{
-			conf: dataplane.Configuration{
-				SSLServers: []dataplane.VirtualServer{
+	}
+
+	expSubStrings := map[string]int{
+		"http2 on;":                     3,
+		"grpc_pass grpc://test_foo_80;": 1,
+		"grpc_set_header Authority \"$gw_api_compliant_host\";": 1,
+		"proxy_pass":         0,
+		"proxy_http_version": 0,
+	}
+
+	g := NewWithT(t)
+	servers := string(executeServers(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(servers, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestExecuteServersWithResponseHeaders(t *testing.T) {
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
 					{
-						IsDefault: true,
-						Port:      443,
+						Path:     "/",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								BackendGroup: dataplane.BackendGroup{
+									Source:  types.NamespacedName{Namespace: "test", Name: "hr"},
+									RuleIdx: 0,
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_foo_80",
+											Valid:        true,
+											Weight:       1,
+										},
+									},
+								},
+								Filters: dataplane.HTTPFilters{
+									ResponseHeaderModifiers: &dataplane.HTTPHeaderFilter{
+										Add: []dataplane.HTTPHeader{
+											{Name: "X-Frame-Options", Value: "DENY"},
+										},
+										Set: []dataplane.HTTPHeader{
+											{Name: "Cache-Control", Value: "no-store"},
+										},
+										Remove: []string{"X-Powered-By"},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
-			sslPorts: []int{443},
-			msg:      "only HTTPS default server",
 		},
-		{
-			conf: dataplane.Configuration{
-				HTTPServers: []dataplane.VirtualServer{
-					{
-						IsDefault: true,
-						Port:      80,
-					},
+	}
+
+	expSubStrings := map[string]int{
+		`add_header X-Frame-Options "DENY" always;`:   1,
+		`add_header Cache-Control "no-store" always;`: 1,
+		"proxy_hide_header Cache-Control;":            1,
+		"proxy_hide_header X-Powered-By;":             1,
+	}
+
+	g := NewWithT(t)
+	servers := string(executeServers(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(servers, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestExecuteServersWithRequestMirror(t *testing.T) {
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
 					{
-						IsDefault: true,
-						Port:      8080,
+						Path:     "/",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								BackendGroup: dataplane.BackendGroup{
+									Source:  types.NamespacedName{Namespace: "test", Name: "hr"},
+									RuleIdx: 0,
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_foo_80",
+											Valid:        true,
+											Weight:       1,
+										},
+									},
+								},
+								Filters: dataplane.HTTPFilters{
+									RequestMirror: &dataplane.HTTPRequestMirrorFilter{
+										Backend: dataplane.Backend{
+											UpstreamName: "test_mirror_80",
+											Valid:        true,
+											Weight:       1,
+										},
+									},
+								},
+							},
+						},
 					},
 				},
-				SSLServers: []dataplane.VirtualServer{
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"location = /_ngf-internal-mirror-rule0-route0 {": 1,
+		"internal;": 1,
+		"mirror /_ngf-internal-mirror-rule0-route0;":    1,
+		"proxy_pass http://test_mirror_80$request_uri;": 1,
+		"proxy_pass http://test_foo_80$request_uri;":    1,
+	}
+
+	g := NewWithT(t)
+	servers := string(executeServers(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(servers, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestExecuteServersWithBackendFilters(t *testing.T) {
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
 					{
-						IsDefault: true,
-						Port:      443,
+						Path:     "/",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								BackendGroup: dataplane.BackendGroup{
+									Source:  types.NamespacedName{Namespace: "test", Name: "hr"},
+									RuleIdx: 0,
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_foo_80",
+											Valid:        true,
+											Weight:       90,
+										},
+										{
+											UpstreamName: "test_canary_80",
+											Valid:        true,
+											Weight:       10,
+											Filters: &dataplane.HTTPFilters{
+												RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
+													Set: []dataplane.HTTPHeader{{Name: "X-Canary", Value: "true"}},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 					{
-						IsDefault: true,
-						Port:      8443,
+						Path:     "/single",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							{
+								BackendGroup: dataplane.BackendGroup{
+									Source:  types.NamespacedName{Namespace: "test", Name: "hr"},
+									RuleIdx: 1,
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_single_80",
+											Valid:        true,
+											Weight:       1,
+											Filters: &dataplane.HTTPFilters{
+												ResponseHeaderModifiers: &dataplane.HTTPHeaderFilter{
+													Add: []dataplane.HTTPHeader{{Name: "X-Backend", Value: "single"}},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
-			httpPorts: []int{80, 8080},
-			sslPorts:  []int{443, 8443},
-			msg:       "multiple HTTP and HTTPS default servers",
 		},
 	}
 
-	sslDefaultFmt := "listen %d ssl default_server"
-	httpDefaultFmt := "listen %d default_server"
-
-	for _, tc := range testcases {
-		t.Run(tc.msg, func(t *testing.T) {
-			g := NewWithT(t)
-
-			cfg := string(executeServers(tc.conf))
-
-			for _, expPort := range tc.httpPorts {
-				g.Expect(cfg).To(ContainSubstring(fmt.Sprintf(httpDefaultFmt, expPort)))
-			}
+	expSubStrings := map[string]int{
+		"proxy_pass http://test_single_80$request_uri;":   1,
+		`add_header X-Backend "single" always;`:           1,
+		"error_page 418 = @rule0-route0-$test__hr_rule0;": 1,
+		"return 418;":                                    1,
+		"location @rule0-route0-test_foo_80 {":           1,
+		"location @rule0-route0-test_canary_80 {":        1,
+		"proxy_pass http://test_foo_80$request_uri;":     1,
+		"proxy_pass http://test_canary_80$request_uri;":  1,
+		"proxy_pass http://$test__hr_rule0$request_uri;": 0,
+		`proxy_set_header X-Canary "true";`:              1,
+	}
 
-			for _, expPort := range tc.sslPorts {
-				g.Expect(cfg).To(ContainSubstring(fmt.Sprintf(sslDefaultFmt, expPort)))
-			}
-		})
+	g := NewWithT(t)
+	servers := string(executeServers(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(servers, expSubStr)).To(Equal(expCount), expSubStr)
 	}
 }
 
-func TestCreateServers(t *testing.T) {
-	const (
-		sslKeyPairID = "test-keypair"
-	)
-
-	hrNsName := types.NamespacedName{Namespace: "test", Name: "route1"}
+func TestExecuteServersWithTimeouts(t *testing.T) {
+	createMatchRule := func(grpc bool) dataplane.MatchRule {
+		return dataplane.MatchRule{
+			BackendGroup: dataplane.BackendGroup{
+				Source:  types.NamespacedName{Namespace: "test", Name: "hr"},
+				RuleIdx: 0,
+				Backends: []dataplane.Backend{
+					{
+						UpstreamName: "test_foo_80",
+						Valid:        true,
+						Weight:       1,
+					},
+				},
+			},
+			Timeouts: &dataplane.HTTPTimeouts{
+				Request:        helpers.GetPointer(10 * time.Second),
+				BackendRequest: helpers.GetPointer(500 * time.Millisecond),
+			},
+		}
+	}
 
-	fooGroup := dataplane.BackendGroup{
-		Source:  hrNsName,
-		RuleIdx: 0,
-		Backends: []dataplane.Backend{
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
 			{
-				UpstreamName: "test_foo_80",
-				Valid:        true,
-				Weight:       1,
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:       "/",
+						PathType:   dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{createMatchRule(false)},
+					},
+					{
+						Path:       "/helloworld.Greeter/SayHello",
+						PathType:   dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{createMatchRule(true)},
+						GRPC:       true,
+					},
+				},
 			},
 		},
 	}
 
-	// barGroup has two backends, which should generate a proxy pass with a variable.
-	barGroup := dataplane.BackendGroup{
-		Source:  hrNsName,
-		RuleIdx: 1,
-		Backends: []dataplane.Backend{
-			{
-				UpstreamName: "test_bar_80",
-				Valid:        true,
-				Weight:       50,
+	expSubStrings := map[string]int{
+		"proxy_connect_timeout 500ms;":         1,
+		"proxy_read_timeout 500ms;":            1,
+		"proxy_send_timeout 500ms;":            1,
+		"proxy_next_upstream_timeout 10000ms;": 1,
+		"grpc_connect_timeout 500ms;":          1,
+		"grpc_read_timeout 500ms;":             1,
+		"grpc_send_timeout 500ms;":             1,
+		"grpc_next_upstream_timeout 10000ms;":  1,
+		"client_body_timeout 10000ms;":         2,
+		"send_timeout 10000ms;":                2,
+	}
+
+	g := NewWithT(t)
+	servers := string(executeServers(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(servers, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestExecuteServersWithClientSettings(t *testing.T) {
+	matchRule := dataplane.MatchRule{
+		BackendGroup: dataplane.BackendGroup{
+			Source:  types.NamespacedName{Namespace: "test", Name: "hr"},
+			RuleIdx: 0,
+			Backends: []dataplane.Backend{
+				{
+					UpstreamName: "test_foo_80",
+					Valid:        true,
+					Weight:       1,
+				},
 			},
-			{
-				UpstreamName: "test_bar2_80",
-				Valid:        true,
-				Weight:       50,
+		},
+		Policies: dataplane.RulePolicies{
+			ClientSettings: &dataplane.ClientSettings{
+				BodyMaxSize:            "1m",
+				KeepAliveServerTimeout: "30s",
+				KeepAliveHeaderTimeout: "20s",
 			},
 		},
 	}
 
-	// baz group has an invalid backend, which should generate a proxy pass to the invalid ref backend.
-	bazGroup := dataplane.BackendGroup{
-		Source:  hrNsName,
-		RuleIdx: 2,
-		Backends: []dataplane.Backend{
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
 			{
-				UpstreamName: "test_baz_80",
-				Valid:        false,
-				Weight:       1,
+				IsDefault: true,
+				Port:      8080,
 			},
-		},
-	}
-
-	btpGroup := dataplane.BackendGroup{
-		Source:  hrNsName,
-		RuleIdx: 3,
-		Backends: []dataplane.Backend{
 			{
-				UpstreamName: "test_btp_80",
-				Valid:        true,
-				Weight:       1,
-				VerifyTLS: &dataplane.VerifyTLS{
-					CertBundleID: "test-btp",
-					Hostname:     "test-btp.example.com",
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:       "/",
+						PathType:   dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{matchRule},
+					},
+				},
+				ClientSettings: &dataplane.ClientSettings{
+					BodyMaxSize:            "10m",
+					BodyTimeout:            "10s",
+					KeepAliveRequests:      helpers.GetPointer[int32](100),
+					KeepAliveTime:          "5m",
+					KeepAliveServerTimeout: "1m",
 				},
 			},
 		},
 	}
 
-	filterGroup1 := dataplane.BackendGroup{Source: hrNsName, RuleIdx: 3}
-
-	filterGroup2 := dataplane.BackendGroup{Source: hrNsName, RuleIdx: 4}
+	expSubStrings := map[string]int{
+		"client_max_body_size 10m;":  1,
+		"client_max_body_size 1m;":   1,
+		"client_body_timeout 10s;":   1,
+		"keepalive_requests 100;":    1,
+		"keepalive_time 5m;":         1,
+		"keepalive_timeout 1m;":      1,
+		"keepalive_timeout 30s 20s;": 1,
+	}
 
-	invalidFilterGroup := dataplane.BackendGroup{Source: hrNsName, RuleIdx: 5}
+	g := NewWithT(t)
+	servers := string(executeServers(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(servers, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
 
-	cafePathRules := []dataplane.PathRule{
-		{
-			Path:     "/",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
+func TestExecuteServersWithRateLimit(t *testing.T) {
+	matchRule := dataplane.MatchRule{
+		BackendGroup: dataplane.BackendGroup{
+			Source:  types.NamespacedName{Namespace: "test", Name: "hr"},
+			RuleIdx: 0,
+			Backends: []dataplane.Backend{
 				{
-					Match: dataplane.Match{
-						Method: helpers.GetPointer("POST"),
-					},
-					BackendGroup: fooGroup,
-				},
-				{
-					Match: dataplane.Match{
-						Method: helpers.GetPointer("PATCH"),
-					},
-					BackendGroup: fooGroup,
-				},
-				{
-					// should generate an "any" httpmatch since other matches exists for /
-					Match:        dataplane.Match{},
-					BackendGroup: fooGroup,
+					UpstreamName: "test_foo_80",
+					Valid:        true,
+					Weight:       1,
 				},
 			},
 		},
-		{
-			Path:     "/test",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					// A match with all possible fields set
-					Match: dataplane.Match{
-						Method: helpers.GetPointer("GET"),
-						Headers: []dataplane.HTTPHeaderMatch{
-							{
-								Name:  "Version",
-								Value: "V1",
-							},
-							{
-								Name:  "test",
-								Value: "foo",
-							},
-							{
-								Name:  "my-header",
-								Value: "my-value",
-							},
-						},
-						QueryParams: []dataplane.HTTPQueryParamMatch{
-							{
-								// query names and values should not be normalized to lowercase
-								Name:  "GrEat",
-								Value: "EXAMPLE",
-							},
-							{
-								Name:  "test",
-								Value: "foo=bar",
-							},
-						},
-					},
-					BackendGroup: barGroup,
-				},
+		Policies: dataplane.RulePolicies{
+			RateLimit: &dataplane.RateLimit{
+				Zone:    dataplane.RateLimitZone{Name: "test_route-limit"},
+				Burst:   helpers.GetPointer[int32](5),
+				NoDelay: true,
 			},
 		},
-		{
-			Path:     "/path-only",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match:        dataplane.Match{},
-					BackendGroup: bazGroup,
-				},
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+				Port:      8080,
 			},
-		},
-		{
-			Path:     "/backend-tls-policy",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match:        dataplane.Match{},
-					BackendGroup: btpGroup,
+			{
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:       "/",
+						PathType:   dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{matchRule},
+					},
+				},
+				RateLimit: &dataplane.RateLimit{
+					Zone:       dataplane.RateLimitZone{Name: "test_gateway-limit"},
+					RejectCode: helpers.GetPointer[int32](429),
 				},
 			},
 		},
-		{
-			Path:     "/redirect-implicit-port",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match: dataplane.Match{},
-					Filters: dataplane.HTTPFilters{
-						RequestRedirect: &dataplane.HTTPRequestRedirectFilter{
-							Hostname: helpers.GetPointer("foo.example.com"),
-						},
+	}
+
+	expSubStrings := map[string]int{
+		"limit_req zone=test_gateway-limit;":               1,
+		"limit_req zone=test_route-limit burst=5 nodelay;": 1,
+		"limit_req_status 429;":                            1,
+	}
+
+	g := NewWithT(t)
+	servers := string(executeServers(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(servers, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestExecuteServersWithBasicAuth(t *testing.T) {
+	createMatchRule := func(basicAuth *dataplane.BasicAuth) dataplane.MatchRule {
+		return dataplane.MatchRule{
+			BackendGroup: dataplane.BackendGroup{
+				Source:  types.NamespacedName{Namespace: "test", Name: "hr"},
+				RuleIdx: 0,
+				Backends: []dataplane.Backend{
+					{
+						UpstreamName: "test_foo_80",
+						Valid:        true,
+						Weight:       1,
 					},
-					BackendGroup: filterGroup1,
 				},
 			},
-		},
-		{
-			Path:     "/redirect-explicit-port",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match: dataplane.Match{},
-					Filters: dataplane.HTTPFilters{
-						RequestRedirect: &dataplane.HTTPRequestRedirectFilter{
-							Hostname: helpers.GetPointer("bar.example.com"),
-							Port:     helpers.GetPointer[int32](8080),
+			Policies: dataplane.RulePolicies{
+				BasicAuth: basicAuth,
+			},
+		}
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+				Port:      8080,
+			},
+			{
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/dashboard",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							createMatchRule(&dataplane.BasicAuth{
+								Realm:      "Dashboards",
+								UserFileID: "auth_user_file_test_htpasswd",
+							}),
 						},
 					},
-					BackendGroup: filterGroup2,
+					{
+						Path:       "/public",
+						PathType:   dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{createMatchRule(nil)},
+					},
 				},
 			},
 		},
-		{
-			Path:     "/redirect-with-headers",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match: dataplane.Match{
-						Headers: []dataplane.HTTPHeaderMatch{
+	}
+
+	// the prefix path /dashboard is configured in two locations, /dashboard/ and = /dashboard
+	expSubStrings := map[string]int{
+		`auth_basic "Dashboards";`: 2,
+		"auth_basic_user_file /etc/nginx/secrets/auth_user_file_test_htpasswd.htpasswd;": 2,
+	}
+
+	g := NewWithT(t)
+	servers := string(executeServers(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(servers, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestExecuteServersWithExternalAuth(t *testing.T) {
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/app",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
 							{
-								Name:  "redirect",
-								Value: "this",
+								BackendGroup: dataplane.BackendGroup{
+									Source:  types.NamespacedName{Namespace: "test", Name: "hr"},
+									RuleIdx: 0,
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_foo_80",
+											Valid:        true,
+											Weight:       1,
+										},
+									},
+								},
+								Policies: dataplane.RulePolicies{
+									ExternalAuth: &dataplane.ExternalAuth{
+										Path:                  "/oauth2/auth",
+										ForwardRequestHeaders: []string{"Cookie"},
+										CopyResponseHeaders:   []string{"X-User"},
+										Backend: dataplane.Backend{
+											UpstreamName: "test_authz_4180",
+											Valid:        true,
+										},
+									},
+								},
 							},
 						},
 					},
-					Filters: dataplane.HTTPFilters{
-						RequestRedirect: &dataplane.HTTPRequestRedirectFilter{
-							Hostname: helpers.GetPointer("foo.example.com"),
-							Port:     helpers.GetPointer[int32](8080),
-						},
-					},
-					BackendGroup: filterGroup1,
 				},
 			},
 		},
-		{
-			Path:     "/rewrite",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match: dataplane.Match{},
-					Filters: dataplane.HTTPFilters{
-						RequestURLRewrite: &dataplane.HTTPURLRewriteFilter{
-							Hostname: helpers.GetPointer("new.example.com"),
-							Path: &dataplane.HTTPPathModifier{
-								Type:        dataplane.ReplaceFullPath,
-								Replacement: "/replacement",
-							},
-						},
+	}
+
+	expSubStrings := map[string]int{
+		"auth_request /_ngf-internal-auth-rule0-route0;":                1,
+		"auth_request_set $auth_response_x_user $upstream_http_x_user;": 1,
+		`proxy_set_header X-User "$auth_response_x_user";`:              1,
+		"location = /_ngf-internal-auth-rule0-route0 {":                 1,
+		"proxy_pass http://test_authz_4180/oauth2/auth;":                1,
+		`proxy_set_header X-Original-URI "$request_uri";`:               1,
+		`proxy_set_header Cookie "$http_cookie";`:                       1,
+		"proxy_pass_request_headers off;":                               1,
+		"proxy_pass_request_body off;":                                  1,
+		`proxy_set_header Content-Length "";`:                           1,
+	}
+
+	g := NewWithT(t)
+	servers := string(executeServers(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(servers, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestExecuteServersWithAccessControl(t *testing.T) {
+	createMatchRule := func(accessControl *dataplane.AccessControl) dataplane.MatchRule {
+		return dataplane.MatchRule{
+			BackendGroup: dataplane.BackendGroup{
+				Source:  types.NamespacedName{Namespace: "test", Name: "hr"},
+				RuleIdx: 0,
+				Backends: []dataplane.Backend{
+					{
+						UpstreamName: "test_foo_80",
+						Valid:        true,
+						Weight:       1,
 					},
-					BackendGroup: fooGroup,
 				},
 			},
-		},
-		{
-			Path:     "/rewrite-with-headers",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match: dataplane.Match{
-						Headers: []dataplane.HTTPHeaderMatch{
-							{
-								Name:  "rewrite",
-								Value: "this",
-							},
+			Policies: dataplane.RulePolicies{
+				AccessControl: accessControl,
+			},
+		}
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/admin",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							createMatchRule(&dataplane.AccessControl{
+								Rules: []dataplane.AccessControlRule{
+									{Action: dataplane.AccessControlActionDeny, CIDR: "10.8.1.1"},
+									{Action: dataplane.AccessControlActionAllow, CIDR: "10.8.0.0/16"},
+								},
+								DefaultAction: dataplane.AccessControlActionDeny,
+								RealIP: &dataplane.RealIP{
+									Header:           "X-Forwarded-For",
+									TrustedAddresses: []string{"172.16.0.0/12"},
+									Recursive:        true,
+								},
+							}),
 						},
 					},
-					Filters: dataplane.HTTPFilters{
-						RequestURLRewrite: &dataplane.HTTPURLRewriteFilter{
-							Hostname: helpers.GetPointer("new.example.com"),
-							Path: &dataplane.HTTPPathModifier{
-								Type:        dataplane.ReplacePrefixMatch,
-								Replacement: "/prefix-replacement",
-							},
-						},
+					{
+						Path:       "/public",
+						PathType:   dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{createMatchRule(nil)},
 					},
-					BackendGroup: fooGroup,
 				},
-			},
-		},
-		{
-			Path:     "/invalid-filter",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match: dataplane.Match{},
-					Filters: dataplane.HTTPFilters{
-						InvalidFilter: &dataplane.InvalidHTTPFilter{},
+				AccessControl: &dataplane.AccessControl{
+					Rules: []dataplane.AccessControlRule{
+						{Action: dataplane.AccessControlActionDeny, CIDR: "192.168.0.0/16"},
 					},
-					BackendGroup: invalidFilterGroup,
 				},
 			},
 		},
-		{
-			Path:     "/invalid-filter-with-headers",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match: dataplane.Match{
-						Headers: []dataplane.HTTPHeaderMatch{
-							{
-								Name:  "filter",
-								Value: "this",
-							},
-						},
-					},
-					Filters: dataplane.HTTPFilters{
-						InvalidFilter: &dataplane.InvalidHTTPFilter{},
+	}
+
+	// the server directives are indented with 4 spaces, the location directives with 8 spaces
+	expSubStrings := map[string]int{
+		"\n    deny 192.168.0.0/16;": 1,
+		"\n        deny 10.8.1.1;\n        allow 10.8.0.0/16;\n        deny all;": 1,
+		"\n        set_real_ip_from 172.16.0.0/12;":                               1,
+		"\n        real_ip_header X-Forwarded-For;":                               1,
+		"\n        real_ip_recursive on;":                                         1,
+	}
+
+	g := NewWithT(t)
+	servers := string(executeServers(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(servers, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestExecuteServersWithCompression(t *testing.T) {
+	createMatchRule := func(compression *dataplane.Compression) dataplane.MatchRule {
+		return dataplane.MatchRule{
+			BackendGroup: dataplane.BackendGroup{
+				Source:  types.NamespacedName{Namespace: "test", Name: "hr"},
+				RuleIdx: 0,
+				Backends: []dataplane.Backend{
+					{
+						UpstreamName: "test_foo_80",
+						Valid:        true,
+						Weight:       1,
 					},
-					BackendGroup: invalidFilterGroup,
 				},
 			},
-		},
-		{
-			Path:     "/exact",
-			PathType: dataplane.PathTypeExact,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match:        dataplane.Match{},
-					BackendGroup: fooGroup,
-				},
+			Policies: dataplane.RulePolicies{
+				Compression: compression,
 			},
-		},
-		{
-			Path:     "/test",
-			PathType: dataplane.PathTypeExact,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match: dataplane.Match{
-						Method: helpers.GetPointer("GET"),
+		}
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/catalog",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							createMatchRule(&dataplane.Compression{
+								Enabled:   true,
+								MinLength: helpers.GetPointer[int32](0),
+								Level:     helpers.GetPointer[int32](9),
+								Types:     []string{"application/json", "text/css"},
+							}),
+						},
+					},
+					{
+						Path:     "/images",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							createMatchRule(&dataplane.Compression{Enabled: false}),
+						},
+					},
+					{
+						Path:       "/other",
+						PathType:   dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{createMatchRule(nil)},
 					},
-					BackendGroup: fooGroup,
+				},
+				Compression: &dataplane.Compression{
+					Enabled: true,
+					Types:   []string{"application/json"},
 				},
 			},
 		},
-		{
-			Path:     "/proxy-set-headers",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match:        dataplane.Match{},
-					BackendGroup: fooGroup,
-					Filters: dataplane.HTTPFilters{
-						RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
-							Add: []dataplane.HTTPHeader{
-								{
-									Name:  "my-header",
-									Value: "some-value-123",
+	}
+
+	// the server directives are indented with 4 spaces, the location directives with 8 spaces
+	expSubStrings := map[string]int{
+		"\n    gzip on;\n    gzip_vary on;\n    gzip_types application/json;": 1,
+		"\n        gzip on;\n        gzip_vary on;\n        gzip_comp_level 9;\n" +
+			"        gzip_min_length 0;\n        gzip_types application/json text/css;": 1,
+		"\n        gzip off;": 1,
+		"gzip_vary on;":       2,
+	}
+
+	g := NewWithT(t)
+	servers := string(executeServers(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(servers, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestExecuteServersWithCORS(t *testing.T) {
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/api",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							{
+								BackendGroup: dataplane.BackendGroup{
+									Source:  types.NamespacedName{Namespace: "test", Name: "hr"},
+									RuleIdx: 0,
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_foo_80",
+											Valid:        true,
+											Weight:       1,
+										},
+									},
+								},
+								Policies: dataplane.RulePolicies{
+									CORS: &dataplane.CORS{
+										Name:             "test_cors",
+										AllowOrigins:     []string{"https://example.com"},
+										AllowMethods:     []string{"GET", "PUT"},
+										AllowHeaders:     []string{"Authorization", "Content-Type"},
+										ExposeHeaders:    []string{"X-Request-Id"},
+										AllowCredentials: true,
+										MaxAge:           helpers.GetPointer[int32](600),
+									},
 								},
 							},
 						},
@@ -483,1344 +832,3465 @@ func TestCreateServers(t *testing.T) {
 		},
 	}
 
-	httpServers := []dataplane.VirtualServer{
-		{
-			IsDefault: true,
-			Port:      8080,
-		},
-		{
-			Hostname:  "cafe.example.com",
-			PathRules: cafePathRules,
-			Port:      8080,
-		},
-	}
+	originVar := "$" + generateCORSOriginVariableName("test_cors")
+	preflightVar := "$" + generateCORSPreflightVariableName("test_cors")
 
-	sslServers := []dataplane.VirtualServer{
-		{
-			IsDefault: true,
-			Port:      8443,
-		},
-		{
-			Hostname:  "cafe.example.com",
-			SSL:       &dataplane.SSL{KeyPairID: sslKeyPairID},
-			PathRules: cafePathRules,
-			Port:      8443,
-		},
+	expSubStrings := map[string]int{
+		"if (" + preflightVar + ") {":                                                   1,
+		"add_header Access-Control-Allow-Origin " + originVar + " always;":              2,
+		`add_header Access-Control-Allow-Methods "GET, PUT" always;`:                    1,
+		`add_header Access-Control-Allow-Headers "Authorization, Content-Type" always;`: 1,
+		"add_header Access-Control-Allow-Credentials true always;":                      2,
+		"add_header Access-Control-Max-Age 600 always;":                                 1,
+		`add_header Access-Control-Expose-Headers "X-Request-Id" always;`:               1,
+		"add_header Vary Origin always;":                                                2,
+		"return 204;":                                                                   1,
 	}
 
-	expectedMatchString := func(m []httpMatch) string {
-		g := NewWithT(t)
-		b, err := json.Marshal(m)
-		g.Expect(err).ToNot(HaveOccurred())
-		return string(b)
+	g := NewWithT(t)
+	servers := string(executeServers(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(servers, expSubStr)).To(Equal(expCount), expSubStr)
 	}
+}
 
-	slashMatches := []httpMatch{
-		{Method: "POST", RedirectPath: "@rule0-route0"},
-		{Method: "PATCH", RedirectPath: "@rule0-route1"},
-		{Any: true, RedirectPath: "@rule0-route2"},
-	}
-	testMatches := []httpMatch{
-		{
-			Method:       "GET",
-			Headers:      []string{"Version:V1", "test:foo", "my-header:my-value"},
-			QueryParams:  []string{"GrEat=EXAMPLE", "test=foo=bar"},
-			RedirectPath: "@rule1-route0",
+func TestExecuteServersWithCache(t *testing.T) {
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/catalog",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								BackendGroup: dataplane.BackendGroup{
+									Source:  types.NamespacedName{Namespace: "test", Name: "hr"},
+									RuleIdx: 0,
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_foo_80",
+											Valid:        true,
+											Weight:       1,
+										},
+									},
+								},
+								Policies: dataplane.RulePolicies{
+									Cache: &dataplane.Cache{
+										Zone: dataplane.CacheZone{Name: "test_cache"},
+										Key:  "$host$request_uri",
+										Valid: []dataplane.CacheValid{
+											{Codes: []int32{200, 301}, Time: "60s"},
+											{Time: "10s"},
+										},
+										BypassHeaders: []string{"Cache-Bypass"},
+									},
+								},
+							},
+						},
+					},
+					{
+						Path:     "/orders",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								BackendGroup: dataplane.BackendGroup{
+									Source:  types.NamespacedName{Namespace: "test", Name: "hr"},
+									RuleIdx: 1,
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_foo_80",
+											Valid:        true,
+											Weight:       1,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
-	exactMatches := []httpMatch{
-		{
-			Method:       "GET",
-			RedirectPath: "@rule12-route0",
-		},
+
+	expSubStrings := map[string]int{
+		"proxy_cache test_cache;":                2,
+		`proxy_cache_key "$host$request_uri";`:   2,
+		"proxy_cache_valid 200 301 60s;":         2,
+		"proxy_cache_valid 10s;":                 2,
+		"proxy_cache_bypass $http_cache_bypass;": 2,
+		"proxy_no_cache $http_cache_bypass;":     2,
 	}
-	redirectHeaderMatches := []httpMatch{
-		{
-			Headers:      []string{"redirect:this"},
-			RedirectPath: "@rule6-route0",
-		},
+
+	g := NewWithT(t)
+	servers := string(executeServers(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(servers, expSubStr)).To(Equal(expCount), expSubStr)
 	}
-	rewriteHeaderMatches := []httpMatch{
-		{
-			Headers:      []string{"rewrite:this"},
-			RedirectPath: "@rule8-route0",
+}
+
+func TestExecuteServersWithUpstreamKeepAlive(t *testing.T) {
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								BackendGroup: dataplane.BackendGroup{
+									Source:  types.NamespacedName{Namespace: "test", Name: "hr"},
+									RuleIdx: 0,
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_foo_80",
+											Valid:        true,
+											Weight:       1,
+											KeepAlive:    true,
+										},
+									},
+								},
+								Filters: dataplane.HTTPFilters{
+									RequestMirror: &dataplane.HTTPRequestMirrorFilter{
+										Backend: dataplane.Backend{
+											UpstreamName: "test_mirror_80",
+											Valid:        true,
+											Weight:       1,
+											KeepAlive:    true,
+										},
+									},
+								},
+							},
+						},
+					},
+					{
+						Path:     "/no-keepalive",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								BackendGroup: dataplane.BackendGroup{
+									Source:  types.NamespacedName{Namespace: "test", Name: "hr"},
+									RuleIdx: 1,
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_bar_80",
+											Valid:        true,
+											Weight:       1,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
-	rewriteProxySetHeaders := []http.Header{
-		{
-			Name:  "Host",
-			Value: "new.example.com",
-		},
-		{
-			Name:  "X-Forwarded-For",
-			Value: "$proxy_add_x_forwarded_for",
-		},
-		{
-			Name:  "Upgrade",
-			Value: "$http_upgrade",
-		},
-		{
-			Name:  "Connection",
-			Value: "$connection_upgrade",
-		},
+
+	expSubStrings := map[string]int{
+		`proxy_set_header Connection "$connection_keepalive";`: 2,
+		`proxy_set_header Connection "$connection_upgrade";`:   2,
 	}
-	invalidFilterHeaderMatches := []httpMatch{
-		{
-			Headers:      []string{"filter:this"},
-			RedirectPath: "@rule10-route0",
-		},
+
+	g := NewWithT(t)
+	servers := string(executeServers(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(servers, expSubStr)).To(Equal(expCount), expSubStr)
 	}
+}
 
-	getExpectedLocations := func(isHTTPS bool) []http.Location {
-		port := 8080
-		if isHTTPS {
-			port = 8443
+func TestExecuteServersWithRetry(t *testing.T) {
+	createMatchRule := func(name string, retry *dataplane.Retry) dataplane.MatchRule {
+		return dataplane.MatchRule{
+			BackendGroup: dataplane.BackendGroup{
+				Source:  types.NamespacedName{Namespace: "test", Name: name},
+				RuleIdx: 0,
+				Backends: []dataplane.Backend{
+					{
+						UpstreamName: "test_" + name + "_80",
+						Valid:        true,
+						Weight:       1,
+					},
+				},
+			},
+			Policies: dataplane.RulePolicies{
+				Retry: retry,
+			},
 		}
+	}
 
-		return []http.Location{
-			{
-				Path:            "@rule0-route0",
-				ProxyPass:       "http://test_foo_80$request_uri",
-				ProxySetHeaders: baseHeaders,
-			},
+	grpcRule := createMatchRule("grpc", &dataplane.Retry{
+		Attempts:     helpers.GetPointer[int32](5),
+		TotalTimeout: "5s",
+		Conditions:   []dataplane.RetryCondition{dataplane.RetryConditionError},
+	})
+	grpcRule.Timeouts = &dataplane.HTTPTimeouts{Request: helpers.GetPointer(10 * time.Second)}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
 			{
-				Path:            "@rule0-route1",
-				ProxyPass:       "http://test_foo_80$request_uri",
-				ProxySetHeaders: baseHeaders,
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:       "/helloworld.Greeter/SayHello",
+						PathType:   dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{grpcRule},
+						GRPC:       true,
+					},
+					{
+						Path:       "/http",
+						PathType:   dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{createMatchRule("http", nil)},
+					},
+				},
+				Retry: &dataplane.Retry{
+					Attempts:     helpers.GetPointer[int32](2),
+					TotalTimeout: "10s",
+					StatusCodes:  []int32{502, 503},
+				},
 			},
-			{
-				Path:            "@rule0-route2",
-				ProxyPass:       "http://test_foo_80$request_uri",
-				ProxySetHeaders: baseHeaders,
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"proxy_next_upstream http_502 http_503;": 1,
+		"proxy_next_upstream_tries 2;":           1,
+		"proxy_next_upstream_timeout 10s;":       1,
+		"grpc_next_upstream http_502 http_503;":  1,
+		"grpc_next_upstream_tries 2;":            1,
+		"grpc_next_upstream_timeout 10s;":        1,
+		"grpc_next_upstream error;":              1,
+		"grpc_next_upstream_tries 5;":            1,
+		"grpc_next_upstream_timeout 5s;":         0,
+		"grpc_next_upstream_timeout 10000ms;":    1,
+	}
+
+	g := NewWithT(t)
+	servers := string(executeServers(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(servers, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestExecuteServersWithTracing(t *testing.T) {
+	createMatchRule := func(ruleIdx int, tracing *dataplane.Tracing) dataplane.MatchRule {
+		return dataplane.MatchRule{
+			BackendGroup: dataplane.BackendGroup{
+				Source:  types.NamespacedName{Namespace: "test", Name: "hr"},
+				RuleIdx: ruleIdx,
+				Backends: []dataplane.Backend{
+					{
+						UpstreamName: "test_foo_80",
+						Valid:        true,
+						Weight:       1,
+					},
+				},
 			},
-			{
-				Path:         "/",
-				HTTPMatchVar: expectedMatchString(slashMatches),
+			Policies: dataplane.RulePolicies{
+				Tracing: tracing,
 			},
+		}
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
 			{
-				Path:            "@rule1-route0",
-				ProxyPass:       "http://$test__route1_rule1$request_uri",
-				ProxySetHeaders: baseHeaders,
+				IsDefault: true,
+				Port:      8080,
 			},
 			{
-				Path:         "/test/",
-				HTTPMatchVar: expectedMatchString(testMatches),
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/ratio",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							createMatchRule(0, &dataplane.Tracing{
+								Strategy: dataplane.TraceStrategyRatio,
+								Ratio:    helpers.GetPointer[int32](25),
+								Context:  "propagate",
+								SpanName: "my-span",
+								SpanAttributes: []dataplane.SpanAttribute{
+									{Key: "key1", Value: "value1"},
+									{Key: "key2", Value: "value2"},
+								},
+							}),
+						},
+					},
+					{
+						Path:     "/parent",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							createMatchRule(1, &dataplane.Tracing{
+								Strategy: dataplane.TraceStrategyParent,
+								Context:  "extract",
+							}),
+						},
+					},
+					{
+						Path:       "/no-tracing",
+						PathType:   dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{createMatchRule(2, nil)},
+					},
+				},
 			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"otel_trace $otel_ratio_25;":       2,
+		"otel_trace $otel_parent_sampled;": 2,
+		"otel_trace_context propagate;":    2,
+		"otel_trace_context extract;":      2,
+		`otel_span_name "my-span";`:        2,
+		`otel_span_attr "key1" "value1";`:  2,
+		`otel_span_attr "key2" "value2";`:  2,
+		"otel_trace ":                      4,
+		"otel_span_name ":                  2,
+	}
+
+	g := NewWithT(t)
+	servers := string(executeServers(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(servers, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestExecuteServersWithErrorPages(t *testing.T) {
+	notFoundPage := dataplane.ErrorPage{
+		Codes:       []int32{404},
+		FileID:      "error_page_abc",
+		ContentType: "text/html",
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
 			{
-				Path:            "/path-only/",
-				ProxyPass:       "http://invalid-backend-ref$request_uri",
-				ProxySetHeaders: baseHeaders,
+				IsDefault:  true,
+				Port:       8080,
+				ErrorPages: []dataplane.ErrorPage{notFoundPage},
 			},
 			{
-				Path:            "= /path-only",
-				ProxyPass:       "http://invalid-backend-ref$request_uri",
-				ProxySetHeaders: baseHeaders,
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/app",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							{
+								BackendGroup: dataplane.BackendGroup{
+									Source:  types.NamespacedName{Namespace: "test", Name: "hr"},
+									RuleIdx: 0,
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_foo_80",
+											Valid:        true,
+											Weight:       1,
+										},
+									},
+								},
+								Policies: dataplane.RulePolicies{
+									ErrorPages: []dataplane.ErrorPage{
+										{
+											Codes:        []int32{404, 503},
+											ResponseCode: helpers.GetPointer[int32](200),
+											Backend: &dataplane.Backend{
+												UpstreamName: "test_errors_80",
+												Valid:        true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					{
+						Path:     "/other",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							{
+								BackendGroup: dataplane.BackendGroup{
+									Source:  types.NamespacedName{Namespace: "test", Name: "hr"},
+									RuleIdx: 1,
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_foo_80",
+											Valid:        true,
+											Weight:       1,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				ErrorPages: []dataplane.ErrorPage{
+					notFoundPage,
+					{
+						Codes:       []int32{500, 502},
+						FileID:      "error_page_def",
+						ContentType: "application/json",
+					},
+				},
 			},
-			{
-				Path:            "/backend-tls-policy/",
-				ProxyPass:       "https://test_btp_80$request_uri",
-				ProxySetHeaders: baseHeaders,
-				ProxySSLVerify: &http.ProxySSLVerify{
-					Name:               "test-btp.example.com",
-					TrustedCertificate: "/etc/nginx/secrets/test-btp.crt",
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"listen 8080 default_server;":                                      1,
+		"return 404;":                                                      0,
+		"error_page 404 /_ngf-internal-error-page0;":                       2,
+		"error_page 500 502 /_ngf-internal-error-page1;":                   2,
+		"error_page 404 503 =200 /_ngf-internal-error-page0-rule0-route0;": 1,
+		"location = /_ngf-internal-error-page0 {":                          2,
+		"location = /_ngf-internal-error-page1 {":                          1,
+		"location = /_ngf-internal-error-page0-rule0-route0 {":             1,
+		"alias /etc/nginx/secrets/error_page_abc.page;":                    2,
+		"alias /etc/nginx/secrets/error_page_def.page;":                    1,
+		"default_type text/html;":                                          2,
+		"default_type application/json;":                                   1,
+		"proxy_pass http://test_errors_80$request_uri;":                    1,
+		`proxy_set_header X-Error-Code "$status";`:                         1,
+		"proxy_intercept_errors on;":                                       2,
+		"internal;":                                                        4,
+		`return 404 "";`:                                                   2,
+	}
+
+	g := NewWithT(t)
+	servers := string(executeServers(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(servers, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestExecuteServersWithAccessLog(t *testing.T) {
+	routeInfoFormat := &dataplane.LogFormat{Name: "test_gw", RouteInfo: true}
+
+	createMatchRule := func(accessLog *dataplane.AccessLog) dataplane.MatchRule {
+		return dataplane.MatchRule{
+			Source: &metav1.ObjectMeta{Namespace: "test", Name: "hr"},
+			BackendGroup: dataplane.BackendGroup{
+				Source:  types.NamespacedName{Namespace: "test", Name: "hr"},
+				RuleIdx: 0,
+				Backends: []dataplane.Backend{
+					{
+						UpstreamName: "test_foo_80",
+						Valid:        true,
+						Weight:       1,
+					},
 				},
 			},
+			Policies: dataplane.RulePolicies{
+				AccessLog: accessLog,
+			},
+		}
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
 			{
-				Path:            "= /backend-tls-policy",
-				ProxyPass:       "https://test_btp_80$request_uri",
-				ProxySetHeaders: baseHeaders,
-				ProxySSLVerify: &http.ProxySSLVerify{
-					Name:               "test-btp.example.com",
-					TrustedCertificate: "/etc/nginx/secrets/test-btp.crt",
-				},
+				IsDefault: true,
+				Port:      8080,
+				AccessLog: &dataplane.AccessLog{Format: routeInfoFormat},
 			},
 			{
-				Path: "/redirect-implicit-port/",
-				Return: &http.Return{
-					Code: 302,
-					Body: fmt.Sprintf("$scheme://foo.example.com:%d$request_uri", port),
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:       "/catalog",
+						PathType:   dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{createMatchRule(nil)},
+					},
+					{
+						Path:     "/orders",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							createMatchRule(&dataplane.AccessLog{
+								Format: &dataplane.LogFormat{Name: "test_hr"},
+								Syslog: &dataplane.SyslogServer{Address: "10.0.0.1:1514", Tag: "ngf"},
+							}),
+						},
+					},
+					{
+						Path:       "/health",
+						PathType:   dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{createMatchRule(&dataplane.AccessLog{})},
+					},
 				},
+				AccessLog: &dataplane.AccessLog{Format: routeInfoFormat},
 			},
-			{
-				Path: "= /redirect-implicit-port",
-				Return: &http.Return{
-					Code: 302,
-					Body: fmt.Sprintf("$scheme://foo.example.com:%d$request_uri", port),
+		},
+	}
+
+	// the server directives are indented with 4 spaces, the location directives with 8 spaces
+	expSubStrings := map[string]int{
+		"\n    access_log /dev/stdout test_gw;":                                             2,
+		"\n        access_log syslog:server=10.0.0.1:1514,tag=ngf test_hr;":                 1,
+		"\n        access_log off;":                                                         1,
+		"\n    set $ngf_route_namespace \"\";\n    set $ngf_route_name \"\";":               2,
+		"\n        set $ngf_route_namespace \"test\";\n        set $ngf_route_name \"hr\";": 1,
+	}
+
+	g := NewWithT(t)
+	servers := string(executeServers(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(servers, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestExecuteForDefaultServers(t *testing.T) {
+	testcases := []struct {
+		msg       string
+		httpPorts []int
+		sslPorts  []int
+		conf      dataplane.Configuration
+	}{
+		{
+			conf: dataplane.Configuration{},
+			msg:  "no default servers",
+		},
+		{
+			conf: dataplane.Configuration{
+				HTTPServers: []dataplane.VirtualServer{
+					{
+						IsDefault: true,
+						Port:      80,
+					},
 				},
 			},
-			{
-				Path: "/redirect-explicit-port/",
-				Return: &http.Return{
-					Code: 302,
-					Body: "$scheme://bar.example.com:8080$request_uri",
+			httpPorts: []int{80},
+			msg:       "only HTTP default server",
+		},
+		{
+			conf: dataplane.Configuration{
+				SSLServers: []dataplane.VirtualServer{
+					{
+						IsDefault: true,
+						Port:      443,
+					},
 				},
 			},
-			{
-				Path: "= /redirect-explicit-port",
-				Return: &http.Return{
-					Code: 302,
-					Body: "$scheme://bar.example.com:8080$request_uri",
+			sslPorts: []int{443},
+			msg:      "only HTTPS default server",
+		},
+		{
+			conf: dataplane.Configuration{
+				HTTPServers: []dataplane.VirtualServer{
+					{
+						IsDefault: true,
+						Port:      80,
+					},
+					{
+						IsDefault: true,
+						Port:      8080,
+					},
+				},
+				SSLServers: []dataplane.VirtualServer{
+					{
+						IsDefault: true,
+						Port:      443,
+					},
+					{
+						IsDefault: true,
+						Port:      8443,
+					},
 				},
 			},
-			{
-				Path: "@rule6-route0",
-				Return: &http.Return{
-					Body: "$scheme://foo.example.com:8080$request_uri",
-					Code: 302,
-				},
+			httpPorts: []int{80, 8080},
+			sslPorts:  []int{443, 8443},
+			msg:       "multiple HTTP and HTTPS default servers",
+		},
+	}
+
+	sslDefaultFmt := "listen %d ssl default_server"
+	httpDefaultFmt := "listen %d default_server"
+
+	for _, tc := range testcases {
+		t.Run(tc.msg, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cfg := string(executeServers(tc.conf))
+
+			for _, expPort := range tc.httpPorts {
+				g.Expect(cfg).To(ContainSubstring(fmt.Sprintf(httpDefaultFmt, expPort)))
+			}
+
+			for _, expPort := range tc.sslPorts {
+				g.Expect(cfg).To(ContainSubstring(fmt.Sprintf(sslDefaultFmt, expPort)))
+			}
+		})
+	}
+}
+
+func TestCreateServers(t *testing.T) {
+	const (
+		sslKeyPairID = "test-keypair"
+	)
+
+	hrNsName := types.NamespacedName{Namespace: "test", Name: "route1"}
+
+	fooGroup := dataplane.BackendGroup{
+		Source:  hrNsName,
+		RuleIdx: 0,
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_foo_80",
+				Valid:        true,
+				Weight:       1,
+			},
+		},
+	}
+
+	// barGroup has two backends, which should generate a proxy pass with a variable.
+	barGroup := dataplane.BackendGroup{
+		Source:  hrNsName,
+		RuleIdx: 1,
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_bar_80",
+				Valid:        true,
+				Weight:       50,
+			},
+			{
+				UpstreamName: "test_bar2_80",
+				Valid:        true,
+				Weight:       50,
+			},
+		},
+	}
+
+	// baz group has an invalid backend, which should generate a proxy pass to the invalid ref backend.
+	bazGroup := dataplane.BackendGroup{
+		Source:  hrNsName,
+		RuleIdx: 2,
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_baz_80",
+				Valid:        false,
+				Weight:       1,
+			},
+		},
+	}
+
+	btpGroup := dataplane.BackendGroup{
+		Source:  hrNsName,
+		RuleIdx: 3,
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_btp_80",
+				Valid:        true,
+				Weight:       1,
+				VerifyTLS: &dataplane.VerifyTLS{
+					CertBundleID: "test-btp",
+					Hostname:     "test-btp.example.com",
+				},
+			},
+		},
+	}
+
+	filterGroup1 := dataplane.BackendGroup{Source: hrNsName, RuleIdx: 3}
+
+	filterGroup2 := dataplane.BackendGroup{Source: hrNsName, RuleIdx: 4}
+
+	invalidFilterGroup := dataplane.BackendGroup{Source: hrNsName, RuleIdx: 5}
+
+	cafePathRules := []dataplane.PathRule{
+		{
+			Path:     "/",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{
+						Method: helpers.GetPointer("POST"),
+					},
+					BackendGroup: fooGroup,
+				},
+				{
+					Match: dataplane.Match{
+						Method: helpers.GetPointer("PATCH"),
+					},
+					BackendGroup: fooGroup,
+				},
+				{
+					// should generate an "any" httpmatch since other matches exists for /
+					Match:        dataplane.Match{},
+					BackendGroup: fooGroup,
+				},
+			},
+		},
+		{
+			Path:     "/test",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					// A match with all possible fields set
+					Match: dataplane.Match{
+						Method: helpers.GetPointer("GET"),
+						Headers: []dataplane.HTTPHeaderMatch{
+							{
+								Name:  "Version",
+								Value: "V1",
+							},
+							{
+								Name:  "test",
+								Value: "foo",
+							},
+							{
+								Name:  "my-header",
+								Value: "my-value",
+							},
+						},
+						QueryParams: []dataplane.HTTPQueryParamMatch{
+							{
+								// query names and values should not be normalized to lowercase
+								Name:  "GrEat",
+								Value: "EXAMPLE",
+							},
+							{
+								Name:  "test",
+								Value: "foo=bar",
+							},
+						},
+					},
+					BackendGroup: barGroup,
+				},
+			},
+		},
+		{
+			Path:     "/path-only",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match:        dataplane.Match{},
+					BackendGroup: bazGroup,
+				},
+			},
+		},
+		{
+			Path:     "/backend-tls-policy",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match:        dataplane.Match{},
+					BackendGroup: btpGroup,
+				},
+			},
+		},
+		{
+			Path:     "/redirect-implicit-port",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{},
+					Filters: dataplane.HTTPFilters{
+						RequestRedirect: &dataplane.HTTPRequestRedirectFilter{
+							Hostname: helpers.GetPointer("foo.example.com"),
+						},
+					},
+					BackendGroup: filterGroup1,
+				},
+			},
+		},
+		{
+			Path:     "/redirect-explicit-port",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{},
+					Filters: dataplane.HTTPFilters{
+						RequestRedirect: &dataplane.HTTPRequestRedirectFilter{
+							Hostname: helpers.GetPointer("bar.example.com"),
+							Port:     helpers.GetPointer[int32](8080),
+						},
+					},
+					BackendGroup: filterGroup2,
+				},
+			},
+		},
+		{
+			Path:     "/redirect-with-headers",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{
+						Headers: []dataplane.HTTPHeaderMatch{
+							{
+								Name:  "redirect",
+								Value: "this",
+							},
+						},
+					},
+					Filters: dataplane.HTTPFilters{
+						RequestRedirect: &dataplane.HTTPRequestRedirectFilter{
+							Hostname: helpers.GetPointer("foo.example.com"),
+							Port:     helpers.GetPointer[int32](8080),
+						},
+					},
+					BackendGroup: filterGroup1,
+				},
+			},
+		},
+		{
+			Path:     "/rewrite",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{},
+					Filters: dataplane.HTTPFilters{
+						RequestURLRewrite: &dataplane.HTTPURLRewriteFilter{
+							Hostname: helpers.GetPointer("new.example.com"),
+							Path: &dataplane.HTTPPathModifier{
+								Type:        dataplane.ReplaceFullPath,
+								Replacement: "/replacement",
+							},
+						},
+					},
+					BackendGroup: fooGroup,
+				},
+			},
+		},
+		{
+			Path:     "/rewrite-with-headers",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{
+						Headers: []dataplane.HTTPHeaderMatch{
+							{
+								Name:  "rewrite",
+								Value: "this",
+							},
+						},
+					},
+					Filters: dataplane.HTTPFilters{
+						RequestURLRewrite: &dataplane.HTTPURLRewriteFilter{
+							Hostname: helpers.GetPointer("new.example.com"),
+							Path: &dataplane.HTTPPathModifier{
+								Type:        dataplane.ReplacePrefixMatch,
+								Replacement: "/prefix-replacement",
+							},
+						},
+					},
+					BackendGroup: fooGroup,
+				},
+			},
+		},
+		{
+			Path:     "/invalid-filter",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{},
+					Filters: dataplane.HTTPFilters{
+						InvalidFilter: &dataplane.InvalidHTTPFilter{},
+					},
+					BackendGroup: invalidFilterGroup,
+				},
+			},
+		},
+		{
+			Path:     "/invalid-filter-with-headers",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{
+						Headers: []dataplane.HTTPHeaderMatch{
+							{
+								Name:  "filter",
+								Value: "this",
+							},
+						},
+					},
+					Filters: dataplane.HTTPFilters{
+						InvalidFilter: &dataplane.InvalidHTTPFilter{},
+					},
+					BackendGroup: invalidFilterGroup,
+				},
+			},
+		},
+		{
+			Path:     "/exact",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match:        dataplane.Match{},
+					BackendGroup: fooGroup,
+				},
+			},
+		},
+		{
+			Path:     "/test",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{
+						Method: helpers.GetPointer("GET"),
+					},
+					BackendGroup: fooGroup,
+				},
+			},
+		},
+		{
+			Path:     "/proxy-set-headers",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match:        dataplane.Match{},
+					BackendGroup: fooGroup,
+					Filters: dataplane.HTTPFilters{
+						RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
+							Add: []dataplane.HTTPHeader{
+								{
+									Name:  "my-header",
+									Value: "some-value-123",
+								},
+							},
+						},
+						ResponseHeaderModifiers: &dataplane.HTTPHeaderFilter{
+							Set: []dataplane.HTTPHeader{
+								{
+									Name:  "Cache-Control",
+									Value: "no-store",
+								},
+							},
+							Remove: []string{"X-Powered-By"},
+						},
+					},
+				},
+			},
+		},
+		{
+			Path:     "/mirror",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match:        dataplane.Match{},
+					BackendGroup: fooGroup,
+					Filters: dataplane.HTTPFilters{
+						RequestMirror: &dataplane.HTTPRequestMirrorFilter{
+							Backend: dataplane.Backend{
+								UpstreamName: "test_mirror_80",
+								Valid:        true,
+								Weight:       1,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Path:     "/invalid-mirror",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match:        dataplane.Match{},
+					BackendGroup: fooGroup,
+					Filters: dataplane.HTTPFilters{
+						RequestMirror: &dataplane.HTTPRequestMirrorFilter{
+							Backend: dataplane.Backend{
+								Weight: 1,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	httpServers := []dataplane.VirtualServer{
+		{
+			IsDefault: true,
+			Port:      8080,
+		},
+		{
+			Hostname:  "cafe.example.com",
+			PathRules: cafePathRules,
+			Port:      8080,
+		},
+	}
+
+	sslServers := []dataplane.VirtualServer{
+		{
+			IsDefault: true,
+			Port:      8443,
+		},
+		{
+			Hostname:  "cafe.example.com",
+			SSL:       &dataplane.SSL{KeyPairID: sslKeyPairID},
+			PathRules: cafePathRules,
+			Port:      8443,
+		},
+	}
+
+	expectedMatchString := func(m []httpMatch) string {
+		g := NewWithT(t)
+		b, err := json.Marshal(m)
+		g.Expect(err).ToNot(HaveOccurred())
+		return string(b)
+	}
+
+	slashMatches := []httpMatch{
+		{Method: "POST", RedirectPath: "@rule0-route0"},
+		{Method: "PATCH", RedirectPath: "@rule0-route1"},
+		{Any: true, RedirectPath: "@rule0-route2"},
+	}
+	testMatches := []httpMatch{
+		{
+			Method:       "GET",
+			Headers:      []string{"Version:V1", "test:foo", "my-header:my-value"},
+			QueryParams:  []string{"GrEat=EXAMPLE", "test=foo=bar"},
+			RedirectPath: "@rule1-route0",
+		},
+	}
+	exactMatches := []httpMatch{
+		{
+			Method:       "GET",
+			RedirectPath: "@rule12-route0",
+		},
+	}
+	redirectHeaderMatches := []httpMatch{
+		{
+			Headers:      []string{"redirect:this"},
+			RedirectPath: "@rule6-route0",
+		},
+	}
+	rewriteHeaderMatches := []httpMatch{
+		{
+			Headers:      []string{"rewrite:this"},
+			RedirectPath: "@rule8-route0",
+		},
+	}
+	rewriteProxySetHeaders := []http.Header{
+		{
+			Name:  "Host",
+			Value: "new.example.com",
+		},
+		{
+			Name:  "X-Forwarded-For",
+			Value: "$proxy_add_x_forwarded_for",
+		},
+		{
+			Name:  "Upgrade",
+			Value: "$http_upgrade",
+		},
+		{
+			Name:  "Connection",
+			Value: "$connection_upgrade",
+		},
+	}
+	invalidFilterHeaderMatches := []httpMatch{
+		{
+			Headers:      []string{"filter:this"},
+			RedirectPath: "@rule10-route0",
+		},
+	}
+
+	getExpectedLocations := func(isHTTPS bool) []http.Location {
+		port := 8080
+		if isHTTPS {
+			port = 8443
+		}
+
+		return []http.Location{
+			{
+				Path:            "@rule0-route0",
+				ProxyPass:       "http://test_foo_80$request_uri",
+				ProxySetHeaders: baseHeaders,
+			},
+			{
+				Path:            "@rule0-route1",
+				ProxyPass:       "http://test_foo_80$request_uri",
+				ProxySetHeaders: baseHeaders,
+			},
+			{
+				Path:            "@rule0-route2",
+				ProxyPass:       "http://test_foo_80$request_uri",
+				ProxySetHeaders: baseHeaders,
+			},
+			{
+				Path:         "/",
+				HTTPMatchVar: expectedMatchString(slashMatches),
+			},
+			{
+				Path:            "@rule1-route0",
+				ProxyPass:       "http://$test__route1_rule1$request_uri",
+				ProxySetHeaders: baseHeaders,
+			},
+			{
+				Path:         "/test/",
+				HTTPMatchVar: expectedMatchString(testMatches),
+			},
+			{
+				Path:            "/path-only/",
+				ProxyPass:       "http://invalid-backend-ref$request_uri",
+				ProxySetHeaders: baseHeaders,
+			},
+			{
+				Path:            "= /path-only",
+				ProxyPass:       "http://invalid-backend-ref$request_uri",
+				ProxySetHeaders: baseHeaders,
+			},
+			{
+				Path:            "/backend-tls-policy/",
+				ProxyPass:       "https://test_btp_80$request_uri",
+				ProxySetHeaders: baseHeaders,
+				ProxySSLVerify: &http.ProxySSLVerify{
+					Name:               "test-btp.example.com",
+					TrustedCertificate: "/etc/nginx/secrets/test-btp.crt",
+				},
+			},
+			{
+				Path:            "= /backend-tls-policy",
+				ProxyPass:       "https://test_btp_80$request_uri",
+				ProxySetHeaders: baseHeaders,
+				ProxySSLVerify: &http.ProxySSLVerify{
+					Name:               "test-btp.example.com",
+					TrustedCertificate: "/etc/nginx/secrets/test-btp.crt",
+				},
+			},
+			{
+				Path: "/redirect-implicit-port/",
+				Return: &http.Return{
+					Code: 302,
+					Body: fmt.Sprintf("$scheme://foo.example.com:%d$request_uri", port),
+				},
+			},
+			{
+				Path: "= /redirect-implicit-port",
+				Return: &http.Return{
+					Code: 302,
+					Body: fmt.Sprintf("$scheme://foo.example.com:%d$request_uri", port),
+				},
+			},
+			{
+				Path: "/redirect-explicit-port/",
+				Return: &http.Return{
+					Code: 302,
+					Body: "$scheme://bar.example.com:8080$request_uri",
+				},
+			},
+			{
+				Path: "= /redirect-explicit-port",
+				Return: &http.Return{
+					Code: 302,
+					Body: "$scheme://bar.example.com:8080$request_uri",
+				},
+			},
+			{
+				Path: "@rule6-route0",
+				Return: &http.Return{
+					Body: "$scheme://foo.example.com:8080$request_uri",
+					Code: 302,
+				},
+			},
+			{
+				Path:         "/redirect-with-headers/",
+				HTTPMatchVar: expectedMatchString(redirectHeaderMatches),
+			},
+			{
+				Path:         "= /redirect-with-headers",
+				HTTPMatchVar: expectedMatchString(redirectHeaderMatches),
+			},
+			{
+				Path:            "/rewrite/",
+				Rewrites:        []string{"^ /replacement break"},
+				ProxyPass:       "http://test_foo_80",
+				ProxySetHeaders: rewriteProxySetHeaders,
+			},
+			{
+				Path:            "= /rewrite",
+				Rewrites:        []string{"^ /replacement break"},
+				ProxyPass:       "http://test_foo_80",
+				ProxySetHeaders: rewriteProxySetHeaders,
+			},
+			{
+				Path:            "@rule8-route0",
+				Rewrites:        []string{"^/rewrite-with-headers(.*)$ /prefix-replacement$1 break"},
+				ProxyPass:       "http://test_foo_80",
+				ProxySetHeaders: rewriteProxySetHeaders,
+			},
+			{
+				Path:         "/rewrite-with-headers/",
+				HTTPMatchVar: expectedMatchString(rewriteHeaderMatches),
+			},
+			{
+				Path:         "= /rewrite-with-headers",
+				HTTPMatchVar: expectedMatchString(rewriteHeaderMatches),
+			},
+			{
+				Path: "/invalid-filter/",
+				Return: &http.Return{
+					Code: http.StatusInternalServerError,
+				},
+			},
+			{
+				Path: "= /invalid-filter",
+				Return: &http.Return{
+					Code: http.StatusInternalServerError,
+				},
+			},
+			{
+				Path: "@rule10-route0",
+				Return: &http.Return{
+					Code: http.StatusInternalServerError,
+				},
+			},
+			{
+				Path:         "/invalid-filter-with-headers/",
+				HTTPMatchVar: expectedMatchString(invalidFilterHeaderMatches),
+			},
+			{
+				Path:         "= /invalid-filter-with-headers",
+				HTTPMatchVar: expectedMatchString(invalidFilterHeaderMatches),
+			},
+			{
+				Path:            "= /exact",
+				ProxyPass:       "http://test_foo_80$request_uri",
+				ProxySetHeaders: baseHeaders,
+			},
+			{
+				Path:            "@rule12-route0",
+				ProxyPass:       "http://test_foo_80$request_uri",
+				ProxySetHeaders: baseHeaders,
+			},
+			{
+				Path:         "= /test",
+				HTTPMatchVar: expectedMatchString(exactMatches),
+			},
+			{
+				Path:      "/proxy-set-headers/",
+				ProxyPass: "http://test_foo_80$request_uri",
+				ProxySetHeaders: []http.Header{
+					{
+						Name:  "my-header",
+						Value: "${my_header_header_var}some-value-123",
+					},
+					{
+						Name:  "Host",
+						Value: "$gw_api_compliant_host",
+					},
+					{
+						Name:  "X-Forwarded-For",
+						Value: "$proxy_add_x_forwarded_for",
+					},
+					{
+						Name:  "Upgrade",
+						Value: "$http_upgrade",
+					},
+					{
+						Name:  "Connection",
+						Value: "$connection_upgrade",
+					},
+				},
+				ResponseHeaders: http.ResponseHeaders{
+					Add: []http.Header{},
+					Set: []http.Header{
+						{
+							Name:  "Cache-Control",
+							Value: "no-store",
+						},
+					},
+					Remove: []string{"X-Powered-By"},
+				},
+			},
+			{
+				Path:      "= /proxy-set-headers",
+				ProxyPass: "http://test_foo_80$request_uri",
+				ProxySetHeaders: []http.Header{
+					{
+						Name:  "my-header",
+						Value: "${my_header_header_var}some-value-123",
+					},
+					{
+						Name:  "Host",
+						Value: "$gw_api_compliant_host",
+					},
+					{
+						Name:  "X-Forwarded-For",
+						Value: "$proxy_add_x_forwarded_for",
+					},
+					{
+						Name:  "Upgrade",
+						Value: "$http_upgrade",
+					},
+					{
+						Name:  "Connection",
+						Value: "$connection_upgrade",
+					},
+				},
+				ResponseHeaders: http.ResponseHeaders{
+					Add: []http.Header{},
+					Set: []http.Header{
+						{
+							Name:  "Cache-Control",
+							Value: "no-store",
+						},
+					},
+					Remove: []string{"X-Powered-By"},
+				},
+			},
+			{
+				Path:            "= /_ngf-internal-mirror-rule14-route0",
+				Internal:        true,
+				ProxyPass:       "http://test_mirror_80$request_uri",
+				ProxySetHeaders: baseHeaders,
+			},
+			{
+				Path:            "= /mirror",
+				ProxyPass:       "http://test_foo_80$request_uri",
+				ProxySetHeaders: baseHeaders,
+				MirrorPath:      "/_ngf-internal-mirror-rule14-route0",
+			},
+			{
+				Path:            "= /invalid-mirror",
+				ProxyPass:       "http://test_foo_80$request_uri",
+				ProxySetHeaders: baseHeaders,
+			},
+		}
+	}
+
+	expectedPEMPath := fmt.Sprintf("/etc/nginx/secrets/%s.pem", sslKeyPairID)
+
+	expectedServers := []http.Server{
+		{
+			IsDefaultHTTP: true,
+			Port:          8080,
+		},
+		{
+			ServerName: "cafe.example.com",
+			Locations:  getExpectedLocations(false),
+			Port:       8080,
+		},
+		{
+			IsDefaultSSL: true,
+			Port:         8443,
+		},
+		{
+			ServerName: "cafe.example.com",
+			SSL: &http.SSL{
+				Certificate:    expectedPEMPath,
+				CertificateKey: expectedPEMPath,
+			},
+			Locations: getExpectedLocations(true),
+			Port:      8443,
+		},
+	}
+
+	g := NewWithT(t)
+
+	result := createServers(httpServers, sslServers)
+	g.Expect(helpers.Diff(expectedServers, result)).To(BeEmpty())
+}
+
+func TestCreateServersConflicts(t *testing.T) {
+	fooGroup := dataplane.BackendGroup{
+		Source:  types.NamespacedName{Namespace: "test", Name: "route"},
+		RuleIdx: 0,
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_foo_80",
+				Valid:        true,
+				Weight:       1,
+			},
+		},
+	}
+	barGroup := dataplane.BackendGroup{
+		Source:  types.NamespacedName{Namespace: "test", Name: "route"},
+		RuleIdx: 0,
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_bar_80",
+				Valid:        true,
+				Weight:       1,
+			},
+		},
+	}
+	bazGroup := dataplane.BackendGroup{
+		Source:  types.NamespacedName{Namespace: "test", Name: "route"},
+		RuleIdx: 0,
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_baz_80",
+				Valid:        true,
+				Weight:       1,
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		rules   []dataplane.PathRule
+		expLocs []http.Location
+	}{
+		{
+			name: "/coffee prefix, /coffee exact",
+			rules: []dataplane.PathRule{
+				{
+					Path:     "/coffee",
+					PathType: dataplane.PathTypePrefix,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Match:        dataplane.Match{},
+							BackendGroup: fooGroup,
+						},
+					},
+				},
+				{
+					Path:     "/coffee",
+					PathType: dataplane.PathTypeExact,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Match:        dataplane.Match{},
+							BackendGroup: barGroup,
+						},
+					},
+				},
+			},
+			expLocs: []http.Location{
+				{
+					Path:            "/coffee/",
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: baseHeaders,
+				},
+				{
+					Path:            "= /coffee",
+					ProxyPass:       "http://test_bar_80$request_uri",
+					ProxySetHeaders: baseHeaders,
+				},
+				createDefaultRootLocation(),
+			},
+		},
+		{
+			name: "/coffee prefix, /coffee/ prefix",
+			rules: []dataplane.PathRule{
+				{
+					Path:     "/coffee",
+					PathType: dataplane.PathTypePrefix,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Match:        dataplane.Match{},
+							BackendGroup: fooGroup,
+						},
+					},
+				},
+				{
+					Path:     "/coffee/",
+					PathType: dataplane.PathTypePrefix,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Match:        dataplane.Match{},
+							BackendGroup: barGroup,
+						},
+					},
+				},
+			},
+			expLocs: []http.Location{
+				{
+					Path:            "= /coffee",
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: baseHeaders,
+				},
+				{
+					Path:            "/coffee/",
+					ProxyPass:       "http://test_bar_80$request_uri",
+					ProxySetHeaders: baseHeaders,
+				},
+				createDefaultRootLocation(),
+			},
+		},
+		{
+			name: "/coffee prefix, /coffee/ prefix, /coffee exact",
+			rules: []dataplane.PathRule{
+				{
+					Path:     "/coffee",
+					PathType: dataplane.PathTypePrefix,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Match:        dataplane.Match{},
+							BackendGroup: fooGroup,
+						},
+					},
+				},
+				{
+					Path:     "/coffee/",
+					PathType: dataplane.PathTypePrefix,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Match:        dataplane.Match{},
+							BackendGroup: barGroup,
+						},
+					},
+				},
+				{
+					Path:     "/coffee",
+					PathType: dataplane.PathTypeExact,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Match:        dataplane.Match{},
+							BackendGroup: bazGroup,
+						},
+					},
+				},
+			},
+			expLocs: []http.Location{
+				{
+					Path:            "/coffee/",
+					ProxyPass:       "http://test_bar_80$request_uri",
+					ProxySetHeaders: baseHeaders,
+				},
+				{
+					Path:            "= /coffee",
+					ProxyPass:       "http://test_baz_80$request_uri",
+					ProxySetHeaders: baseHeaders,
+				},
+				createDefaultRootLocation(),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			httpServers := []dataplane.VirtualServer{
+				{
+					IsDefault: true,
+					Port:      8080,
+				},
+				{
+					Hostname:  "cafe.example.com",
+					PathRules: test.rules,
+					Port:      8080,
+				},
+			}
+			expectedServers := []http.Server{
+				{
+					IsDefaultHTTP: true,
+					Port:          8080,
+				},
+				{
+					ServerName: "cafe.example.com",
+					Locations:  test.expLocs,
+					Port:       8080,
+				},
+			}
+
+			g := NewWithT(t)
+
+			result := createServers(httpServers, []dataplane.VirtualServer{})
+			g.Expect(helpers.Diff(expectedServers, result)).To(BeEmpty())
+		})
+	}
+}
+
+func TestCreateLocationsRootPath(t *testing.T) {
+	hrNsName := types.NamespacedName{Namespace: "test", Name: "route1"}
+
+	fooGroup := dataplane.BackendGroup{
+		Source:  hrNsName,
+		RuleIdx: 0,
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_foo_80",
+				Valid:        true,
+				Weight:       1,
+			},
+		},
+	}
+
+	getPathRules := func(rootPath bool) []dataplane.PathRule {
+		rules := []dataplane.PathRule{
+			{
+				Path: "/path-1",
+				MatchRules: []dataplane.MatchRule{
+					{
+						Match:        dataplane.Match{},
+						BackendGroup: fooGroup,
+					},
+				},
+			},
+			{
+				Path: "/path-2",
+				MatchRules: []dataplane.MatchRule{
+					{
+						Match:        dataplane.Match{},
+						BackendGroup: fooGroup,
+					},
+				},
+			},
+		}
+
+		if rootPath {
+			rules = append(rules, dataplane.PathRule{
+				Path: "/",
+				MatchRules: []dataplane.MatchRule{
+					{
+						Match:        dataplane.Match{},
+						BackendGroup: fooGroup,
+					},
+				},
+			})
+		}
+
+		return rules
+	}
+
+	tests := []struct {
+		name         string
+		pathRules    []dataplane.PathRule
+		expLocations []http.Location
+	}{
+		{
+			name:      "path rules with no root path should generate a default 404 root location",
+			pathRules: getPathRules(false /* rootPath */),
+			expLocations: []http.Location{
+				{
+					Path:            "/path-1",
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: baseHeaders,
+				},
+				{
+					Path:            "/path-2",
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: baseHeaders,
+				},
+				{
+					Path: "/",
+					Return: &http.Return{
+						Code: http.StatusNotFound,
+					},
+				},
+			},
+		},
+		{
+			name:      "path rules with a root path should not generate a default 404 root path",
+			pathRules: getPathRules(true /* rootPath */),
+			expLocations: []http.Location{
+				{
+					Path:            "/path-1",
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: baseHeaders,
+				},
+				{
+					Path:            "/path-2",
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: baseHeaders,
+				},
+				{
+					Path:            "/",
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: baseHeaders,
+				},
+			},
+		},
+		{
+			name: "regular expression locations should be last, from the longest to the shortest",
+			pathRules: []dataplane.PathRule{
+				{
+					Path:     "/",
+					PathType: dataplane.PathTypeRegularExpression,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Match:        dataplane.Match{},
+							BackendGroup: fooGroup,
+						},
+					},
+				},
+				{
+					Path:     `/api/v[0-9]+/"users"\.json`,
+					PathType: dataplane.PathTypeRegularExpression,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Match:        dataplane.Match{},
+							BackendGroup: fooGroup,
+						},
+					},
+				},
+				{
+					Path:     "/path-1",
+					PathType: dataplane.PathTypeExact,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Match:        dataplane.Match{},
+							BackendGroup: fooGroup,
+						},
+					},
+				},
+			},
+			expLocations: []http.Location{
+				{
+					Path:            "= /path-1",
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: baseHeaders,
+				},
+				{
+					Path:            `~ "/api/v[0-9]+/\"users\"\\.json"`,
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: baseHeaders,
+				},
+				{
+					Path:            `~ "/"`,
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: baseHeaders,
+				},
+				{
+					Path: "/",
+					Return: &http.Return{
+						Code: http.StatusNotFound,
+					},
+				},
+			},
+		},
+		{
+			name:      "nil path rules should generate a default 404 root path",
+			pathRules: nil,
+			expLocations: []http.Location{
+				{
+					Path: "/",
+					Return: &http.Return{
+						Code: http.StatusNotFound,
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			locs := createLocations(test.pathRules, 80, nil, nil)
+			g.Expect(locs).To(Equal(test.expLocations))
+		})
+	}
+}
+
+func TestCreateReturnValForRedirectFilter(t *testing.T) {
+	const listenerPortCustom = 123
+	const listenerPortHTTP = 80
+	const listenerPortHTTPS = 443
+
+	tests := []struct {
+		filter       *dataplane.HTTPRequestRedirectFilter
+		expected     *http.Return
+		msg          string
+		listenerPort int32
+	}{
+		{
+			filter:       nil,
+			expected:     nil,
+			listenerPort: listenerPortCustom,
+			msg:          "filter is nil",
+		},
+		{
+			filter:       &dataplane.HTTPRequestRedirectFilter{},
+			listenerPort: listenerPortCustom,
+			expected: &http.Return{
+				Code: http.StatusFound,
+				Body: "$scheme://$host:123$request_uri",
+			},
+			msg: "all fields are empty",
+		},
+		{
+			filter: &dataplane.HTTPRequestRedirectFilter{
+				Scheme:     helpers.GetPointer("https"),
+				Hostname:   helpers.GetPointer("foo.example.com"),
+				Port:       helpers.GetPointer[int32](2022),
+				StatusCode: helpers.GetPointer(301),
+			},
+			listenerPort: listenerPortCustom,
+			expected: &http.Return{
+				Code: 301,
+				Body: "https://foo.example.com:2022$request_uri",
+			},
+			msg: "all fields are set",
+		},
+		{
+			filter: &dataplane.HTTPRequestRedirectFilter{
+				Scheme:     helpers.GetPointer("https"),
+				Hostname:   helpers.GetPointer("foo.example.com"),
+				StatusCode: helpers.GetPointer(301),
+			},
+			listenerPort: listenerPortCustom,
+			expected: &http.Return{
+				Code: 301,
+				Body: "https://foo.example.com$request_uri",
+			},
+			msg: "listenerPort is custom, scheme is set, no port",
+		},
+		{
+			filter: &dataplane.HTTPRequestRedirectFilter{
+				Hostname:   helpers.GetPointer("foo.example.com"),
+				StatusCode: helpers.GetPointer(301),
+			},
+			listenerPort: listenerPortHTTPS,
+			expected: &http.Return{
+				Code: 301,
+				Body: "$scheme://foo.example.com:443$request_uri",
+			},
+			msg: "no scheme, listenerPort https, no port is set",
+		},
+		{
+			filter: &dataplane.HTTPRequestRedirectFilter{
+				Scheme:     helpers.GetPointer("https"),
+				Hostname:   helpers.GetPointer("foo.example.com"),
+				StatusCode: helpers.GetPointer(301),
+			},
+			listenerPort: listenerPortHTTPS,
+			expected: &http.Return{
+				Code: 301,
+				Body: "https://foo.example.com$request_uri",
+			},
+			msg: "scheme is https, listenerPort https, no port is set",
+		},
+		{
+			filter: &dataplane.HTTPRequestRedirectFilter{
+				Scheme:     helpers.GetPointer("http"),
+				Hostname:   helpers.GetPointer("foo.example.com"),
+				StatusCode: helpers.GetPointer(301),
+			},
+			listenerPort: listenerPortHTTP,
+			expected: &http.Return{
+				Code: 301,
+				Body: "http://foo.example.com$request_uri",
+			},
+			msg: "scheme is http, listenerPort http, no port is set",
+		},
+		{
+			filter: &dataplane.HTTPRequestRedirectFilter{
+				Scheme:     helpers.GetPointer("http"),
+				Hostname:   helpers.GetPointer("foo.example.com"),
+				Port:       helpers.GetPointer[int32](80),
+				StatusCode: helpers.GetPointer(301),
+			},
+			listenerPort: listenerPortCustom,
+			expected: &http.Return{
+				Code: 301,
+				Body: "http://foo.example.com$request_uri",
+			},
+			msg: "scheme is http, port http",
+		},
+		{
+			filter: &dataplane.HTTPRequestRedirectFilter{
+				Scheme:     helpers.GetPointer("https"),
+				Hostname:   helpers.GetPointer("foo.example.com"),
+				Port:       helpers.GetPointer[int32](443),
+				StatusCode: helpers.GetPointer(301),
+			},
+			listenerPort: listenerPortCustom,
+			expected: &http.Return{
+				Code: 301,
+				Body: "https://foo.example.com$request_uri",
+			},
+			msg: "scheme is https, port https",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := createReturnValForRedirectFilter(test.filter, test.listenerPort)
+			g.Expect(helpers.Diff(test.expected, result)).To(BeEmpty())
+		})
+	}
+}
+
+func TestCreateRewritesValForRewriteFilter(t *testing.T) {
+	tests := []struct {
+		filter   *dataplane.HTTPURLRewriteFilter
+		expected *rewriteConfig
+		msg      string
+		path     string
+	}{
+		{
+			filter:   nil,
+			expected: nil,
+			msg:      "filter is nil",
+		},
+		{
+			filter:   &dataplane.HTTPURLRewriteFilter{},
+			expected: &rewriteConfig{},
+			msg:      "all fields are empty",
+		},
+		{
+			filter: &dataplane.HTTPURLRewriteFilter{
+				Path: &dataplane.HTTPPathModifier{
+					Type:        dataplane.ReplaceFullPath,
+					Replacement: "/full-path",
+				},
+			},
+			expected: &rewriteConfig{
+				Rewrite: "^ /full-path break",
+			},
+			msg: "full path",
+		},
+		{
+			path: "/original",
+			filter: &dataplane.HTTPURLRewriteFilter{
+				Path: &dataplane.HTTPPathModifier{
+					Type:        dataplane.ReplacePrefixMatch,
+					Replacement: "/prefix-path",
+				},
+			},
+			expected: &rewriteConfig{
+				Rewrite: "^/original(.*)$ /prefix-path$1 break",
+			},
+			msg: "prefix path no trailing slashes",
+		},
+		{
+			path: "/original",
+			filter: &dataplane.HTTPURLRewriteFilter{
+				Path: &dataplane.HTTPPathModifier{
+					Type:        dataplane.ReplacePrefixMatch,
+					Replacement: "",
+				},
+			},
+			expected: &rewriteConfig{
+				Rewrite: "^/original(?:/(.*))?$ /$1 break",
+			},
+			msg: "prefix path empty string",
+		},
+		{
+			path: "/original",
+			filter: &dataplane.HTTPURLRewriteFilter{
+				Path: &dataplane.HTTPPathModifier{
+					Type:        dataplane.ReplacePrefixMatch,
+					Replacement: "/",
+				},
+			},
+			expected: &rewriteConfig{
+				Rewrite: "^/original(?:/(.*))?$ /$1 break",
+			},
+			msg: "prefix path /",
+		},
+		{
+			path: "/original",
+			filter: &dataplane.HTTPURLRewriteFilter{
+				Path: &dataplane.HTTPPathModifier{
+					Type:        dataplane.ReplacePrefixMatch,
+					Replacement: "/trailing/",
+				},
+			},
+			expected: &rewriteConfig{
+				Rewrite: "^/original(?:/(.*))?$ /trailing/$1 break",
+			},
+			msg: "prefix path replacement with trailing /",
+		},
+		{
+			path: "/original/",
+			filter: &dataplane.HTTPURLRewriteFilter{
+				Path: &dataplane.HTTPPathModifier{
+					Type:        dataplane.ReplacePrefixMatch,
+					Replacement: "/prefix-path",
+				},
+			},
+			expected: &rewriteConfig{
+				Rewrite: "^/original/(.*)$ /prefix-path/$1 break",
+			},
+			msg: "prefix path original with trailing /",
+		},
+		{
+			path: "/original/",
+			filter: &dataplane.HTTPURLRewriteFilter{
+				Path: &dataplane.HTTPPathModifier{
+					Type:        dataplane.ReplacePrefixMatch,
+					Replacement: "/trailing/",
+				},
+			},
+			expected: &rewriteConfig{
+				Rewrite: "^/original/(.*)$ /trailing/$1 break",
+			},
+			msg: "prefix path both with trailing slashes",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := createRewritesValForRewriteFilter(test.filter, test.path)
+			g.Expect(helpers.Diff(test.expected, result)).To(BeEmpty())
+		})
+	}
+}
+
+func TestCreateHTTPMatch(t *testing.T) {
+	testPath := "/internal_loc"
+
+	testMethodMatch := helpers.GetPointer("PUT")
+	testHeaderMatches := []dataplane.HTTPHeaderMatch{
+		{
+			Name:  "header-1",
+			Value: "val-1",
+		},
+		{
+			Name:  "header-2",
+			Value: "val-2",
+		},
+		{
+			Name:  "header-3",
+			Value: "val-3",
+		},
+	}
+
+	testDuplicateHeaders := make([]dataplane.HTTPHeaderMatch, 0, 4)
+	duplicateHeaderMatch := dataplane.HTTPHeaderMatch{
+		Name:  "HEADER-2", // header names are case-insensitive
+		Value: "val-2",
+	}
+	testDuplicateHeaders = append(testDuplicateHeaders, testHeaderMatches...)
+	testDuplicateHeaders = append(testDuplicateHeaders, duplicateHeaderMatch)
+
+	testQueryParamMatches := []dataplane.HTTPQueryParamMatch{
+		{
+			Name:  "arg1",
+			Value: "val1",
+		},
+		{
+			Name:  "arg2",
+			Value: "val2=another-val",
+		},
+		{
+			Name:  "arg3",
+			Value: "==val3",
+		},
+	}
+
+	expectedHeaders := []string{"header-1:val-1", "header-2:val-2", "header-3:val-3"}
+	expectedArgs := []string{"arg1=val1", "arg2=val2=another-val", "arg3===val3"}
+
+	tests := []struct {
+		match    dataplane.Match
+		msg      string
+		expected httpMatch
+	}{
+		{
+			match: dataplane.Match{},
+			expected: httpMatch{
+				Any:          true,
+				RedirectPath: testPath,
+			},
+			msg: "path only match",
+		},
+		{
+			match: dataplane.Match{
+				Method: testMethodMatch, // A path match with a method should not set the Any field to true
 			},
-			{
-				Path:         "/redirect-with-headers/",
-				HTTPMatchVar: expectedMatchString(redirectHeaderMatches),
+			expected: httpMatch{
+				Method:       "PUT",
+				RedirectPath: testPath,
 			},
-			{
-				Path:         "= /redirect-with-headers",
-				HTTPMatchVar: expectedMatchString(redirectHeaderMatches),
+			msg: "method only match",
+		},
+		{
+			match: dataplane.Match{
+				Headers: testHeaderMatches,
 			},
-			{
-				Path:            "/rewrite/",
-				Rewrites:        []string{"^ /replacement break"},
-				ProxyPass:       "http://test_foo_80",
-				ProxySetHeaders: rewriteProxySetHeaders,
+			expected: httpMatch{
+				RedirectPath: testPath,
+				Headers:      expectedHeaders,
 			},
-			{
-				Path:            "= /rewrite",
-				Rewrites:        []string{"^ /replacement break"},
-				ProxyPass:       "http://test_foo_80",
-				ProxySetHeaders: rewriteProxySetHeaders,
+			msg: "headers only match",
+		},
+		{
+			match: dataplane.Match{
+				QueryParams: testQueryParamMatches,
 			},
-			{
-				Path:            "@rule8-route0",
-				Rewrites:        []string{"^/rewrite-with-headers(.*)$ /prefix-replacement$1 break"},
-				ProxyPass:       "http://test_foo_80",
-				ProxySetHeaders: rewriteProxySetHeaders,
+			expected: httpMatch{
+				QueryParams:  expectedArgs,
+				RedirectPath: testPath,
 			},
-			{
-				Path:         "/rewrite-with-headers/",
-				HTTPMatchVar: expectedMatchString(rewriteHeaderMatches),
+			msg: "query params only match",
+		},
+		{
+			match: dataplane.Match{
+				Method:      testMethodMatch,
+				QueryParams: testQueryParamMatches,
 			},
-			{
-				Path:         "= /rewrite-with-headers",
-				HTTPMatchVar: expectedMatchString(rewriteHeaderMatches),
+			expected: httpMatch{
+				Method:       "PUT",
+				QueryParams:  expectedArgs,
+				RedirectPath: testPath,
 			},
-			{
-				Path: "/invalid-filter/",
-				Return: &http.Return{
-					Code: http.StatusInternalServerError,
-				},
+			msg: "method and query params match",
+		},
+		{
+			match: dataplane.Match{
+				Method:  testMethodMatch,
+				Headers: testHeaderMatches,
 			},
-			{
-				Path: "= /invalid-filter",
-				Return: &http.Return{
-					Code: http.StatusInternalServerError,
-				},
+			expected: httpMatch{
+				Method:       "PUT",
+				Headers:      expectedHeaders,
+				RedirectPath: testPath,
 			},
-			{
-				Path: "@rule10-route0",
-				Return: &http.Return{
-					Code: http.StatusInternalServerError,
-				},
+			msg: "method and headers match",
+		},
+		{
+			match: dataplane.Match{
+				QueryParams: testQueryParamMatches,
+				Headers:     testHeaderMatches,
 			},
-			{
-				Path:         "/invalid-filter-with-headers/",
-				HTTPMatchVar: expectedMatchString(invalidFilterHeaderMatches),
+			expected: httpMatch{
+				QueryParams:  expectedArgs,
+				Headers:      expectedHeaders,
+				RedirectPath: testPath,
 			},
-			{
-				Path:         "= /invalid-filter-with-headers",
-				HTTPMatchVar: expectedMatchString(invalidFilterHeaderMatches),
+			msg: "query params and headers match",
+		},
+		{
+			match: dataplane.Match{
+				Headers:     testHeaderMatches,
+				QueryParams: testQueryParamMatches,
+				Method:      testMethodMatch,
 			},
-			{
-				Path:            "= /exact",
-				ProxyPass:       "http://test_foo_80$request_uri",
-				ProxySetHeaders: baseHeaders,
+			expected: httpMatch{
+				Method:       "PUT",
+				Headers:      expectedHeaders,
+				QueryParams:  expectedArgs,
+				RedirectPath: testPath,
 			},
-			{
-				Path:            "@rule12-route0",
-				ProxyPass:       "http://test_foo_80$request_uri",
-				ProxySetHeaders: baseHeaders,
+			msg: "method, headers, and query params match",
+		},
+		{
+			match: dataplane.Match{
+				Headers: testDuplicateHeaders,
 			},
-			{
-				Path:         "= /test",
-				HTTPMatchVar: expectedMatchString(exactMatches),
+			expected: httpMatch{
+				Headers:      expectedHeaders,
+				RedirectPath: testPath,
 			},
-			{
-				Path:      "/proxy-set-headers/",
-				ProxyPass: "http://test_foo_80$request_uri",
-				ProxySetHeaders: []http.Header{
-					{
-						Name:  "my-header",
-						Value: "${my_header_header_var}some-value-123",
-					},
-					{
-						Name:  "Host",
-						Value: "$gw_api_compliant_host",
-					},
+			msg: "duplicate header names",
+		},
+		{
+			match: dataplane.Match{
+				Headers: []dataplane.HTTPHeaderMatch{
 					{
-						Name:  "X-Forwarded-For",
-						Value: "$proxy_add_x_forwarded_for",
+						Name:  "header-1",
+						Value: "val-1",
+						Type:  dataplane.MatchTypeExact,
 					},
 					{
-						Name:  "Upgrade",
-						Value: "$http_upgrade",
+						Name:  "header-2",
+						Value: "^val-[0-9]+$",
+						Type:  dataplane.MatchTypeRegularExpression,
 					},
 					{
-						Name:  "Connection",
-						Value: "$connection_upgrade",
+						Name:  "HEADER-2", // header names are case-insensitive
+						Value: "val-2",
+						Type:  dataplane.MatchTypeExact,
 					},
 				},
-			},
-			{
-				Path:      "= /proxy-set-headers",
-				ProxyPass: "http://test_foo_80$request_uri",
-				ProxySetHeaders: []http.Header{
-					{
-						Name:  "my-header",
-						Value: "${my_header_header_var}some-value-123",
-					},
-					{
-						Name:  "Host",
-						Value: "$gw_api_compliant_host",
-					},
-					{
-						Name:  "X-Forwarded-For",
-						Value: "$proxy_add_x_forwarded_for",
-					},
+				QueryParams: []dataplane.HTTPQueryParamMatch{
 					{
-						Name:  "Upgrade",
-						Value: "$http_upgrade",
+						Name:  "arg1",
+						Value: "(on|true)",
+						Type:  dataplane.MatchTypeRegularExpression,
 					},
 					{
-						Name:  "Connection",
-						Value: "$connection_upgrade",
+						Name:  "arg2",
+						Value: "val2",
+						Type:  dataplane.MatchTypeExact,
 					},
 				},
 			},
-		}
+			expected: httpMatch{
+				Headers:          []string{"header-1:val-1"},
+				HeadersRegex:     []string{"header-2:^val-[0-9]+$"},
+				QueryParams:      []string{"arg2=val2"},
+				QueryParamsRegex: []string{"arg1=(on|true)"},
+				RedirectPath:     testPath,
+			},
+			msg: "exact and regex matches",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := createHTTPMatch(tc.match, testPath)
+			g.Expect(helpers.Diff(result, tc.expected)).To(BeEmpty())
+		})
+	}
+}
+
+func TestConvertMatchesToString(t *testing.T) {
+	g := NewWithT(t)
+
+	matches := []httpMatch{
+		{
+			HeadersRegex: []string{"header:^val$"},
+			RedirectPath: "/internal_loc",
+		},
 	}
 
-	expectedPEMPath := fmt.Sprintf("/etc/nginx/secrets/%s.pem", sslKeyPairID)
+	expected := `[{"redirectPath":"/internal_loc","headersRegex":["header:^val\u0024"]}]`
+
+	g.Expect(convertMatchesToString(matches)).To(Equal(expected))
+}
+
+func TestCreateQueryParamKeyValString(t *testing.T) {
+	g := NewWithT(t)
+
+	expected := "key=value"
+
+	result := createQueryParamKeyValString(
+		dataplane.HTTPQueryParamMatch{
+			Name:  "key",
+			Value: "value",
+		},
+	)
+
+	g.Expect(result).To(Equal(expected))
+
+	expected = "KeY=vaLUe=="
+
+	result = createQueryParamKeyValString(
+		dataplane.HTTPQueryParamMatch{
+			Name:  "KeY",
+			Value: "vaLUe==",
+		},
+	)
+
+	g.Expect(result).To(Equal(expected))
+}
+
+func TestCreateHeaderKeyValString(t *testing.T) {
+	g := NewWithT(t)
 
-	expectedServers := []http.Server{
+	expected := "kEy:vALUe"
+
+	result := createHeaderKeyValString(
+		dataplane.HTTPHeaderMatch{
+			Name:  "kEy",
+			Value: "vALUe",
+		},
+	)
+
+	g.Expect(result).To(Equal(expected))
+}
+
+func TestIsPathOnlyMatch(t *testing.T) {
+	tests := []struct {
+		msg      string
+		match    dataplane.Match
+		expected bool
+	}{
 		{
-			IsDefaultHTTP: true,
-			Port:          8080,
+			match:    dataplane.Match{},
+			expected: true,
+			msg:      "path only match",
 		},
 		{
-			ServerName: "cafe.example.com",
-			Locations:  getExpectedLocations(false),
-			Port:       8080,
+			match: dataplane.Match{
+				Method: helpers.GetPointer("GET"),
+			},
+			expected: false,
+			msg:      "method defined in match",
 		},
 		{
-			IsDefaultSSL: true,
-			Port:         8443,
+			match: dataplane.Match{
+				Headers: []dataplane.HTTPHeaderMatch{
+					{
+						Name:  "header",
+						Value: "val",
+					},
+				},
+			},
+			expected: false,
+			msg:      "headers defined in match",
 		},
 		{
-			ServerName: "cafe.example.com",
-			SSL: &http.SSL{
-				Certificate:    expectedPEMPath,
-				CertificateKey: expectedPEMPath,
+			match: dataplane.Match{
+				QueryParams: []dataplane.HTTPQueryParamMatch{
+					{
+						Name:  "arg",
+						Value: "val",
+					},
+				},
 			},
-			Locations: getExpectedLocations(true),
-			Port:      8443,
+			expected: false,
+			msg:      "query params defined in match",
 		},
 	}
 
-	g := NewWithT(t)
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			g := NewWithT(t)
 
-	result := createServers(httpServers, sslServers)
-	g.Expect(helpers.Diff(expectedServers, result)).To(BeEmpty())
+			result := isPathOnlyMatch(tc.match)
+			g.Expect(result).To(Equal(tc.expected))
+		})
+	}
 }
 
-func TestCreateServersConflicts(t *testing.T) {
-	fooGroup := dataplane.BackendGroup{
-		Source:  types.NamespacedName{Namespace: "test", Name: "route"},
-		RuleIdx: 0,
-		Backends: []dataplane.Backend{
-			{
-				UpstreamName: "test_foo_80",
-				Valid:        true,
-				Weight:       1,
-			},
-		},
-	}
-	barGroup := dataplane.BackendGroup{
-		Source:  types.NamespacedName{Namespace: "test", Name: "route"},
-		RuleIdx: 0,
-		Backends: []dataplane.Backend{
-			{
-				UpstreamName: "test_bar_80",
-				Valid:        true,
-				Weight:       1,
-			},
-		},
-	}
-	bazGroup := dataplane.BackendGroup{
-		Source:  types.NamespacedName{Namespace: "test", Name: "route"},
-		RuleIdx: 0,
-		Backends: []dataplane.Backend{
-			{
-				UpstreamName: "test_baz_80",
-				Valid:        true,
-				Weight:       1,
-			},
-		},
-	}
+func TestCreateProxyPass(t *testing.T) {
+	g := NewWithT(t)
 
 	tests := []struct {
-		name    string
-		rules   []dataplane.PathRule
-		expLocs []http.Location
+		rewrite  *dataplane.HTTPURLRewriteFilter
+		expected string
+		grp      dataplane.BackendGroup
+		grpc     bool
 	}{
 		{
-			name: "/coffee prefix, /coffee exact",
-			rules: []dataplane.PathRule{
-				{
-					Path:     "/coffee",
-					PathType: dataplane.PathTypePrefix,
-					MatchRules: []dataplane.MatchRule{
-						{
-							Match:        dataplane.Match{},
-							BackendGroup: fooGroup,
-						},
-					},
-				},
-				{
-					Path:     "/coffee",
-					PathType: dataplane.PathTypeExact,
-					MatchRules: []dataplane.MatchRule{
-						{
-							Match:        dataplane.Match{},
-							BackendGroup: barGroup,
-						},
+			expected: "http://10.0.0.1:80$request_uri",
+			grp: dataplane.BackendGroup{
+				Backends: []dataplane.Backend{
+					{
+						UpstreamName: "10.0.0.1:80",
+						Valid:        true,
+						Weight:       1,
 					},
 				},
 			},
-			expLocs: []http.Location{
-				{
-					Path:            "/coffee/",
-					ProxyPass:       "http://test_foo_80$request_uri",
-					ProxySetHeaders: baseHeaders,
-				},
-				{
-					Path:            "= /coffee",
-					ProxyPass:       "http://test_bar_80$request_uri",
-					ProxySetHeaders: baseHeaders,
-				},
-				createDefaultRootLocation(),
-			},
 		},
 		{
-			name: "/coffee prefix, /coffee/ prefix",
-			rules: []dataplane.PathRule{
-				{
-					Path:     "/coffee",
-					PathType: dataplane.PathTypePrefix,
-					MatchRules: []dataplane.MatchRule{
-						{
-							Match:        dataplane.Match{},
-							BackendGroup: fooGroup,
-						},
+			expected: "http://$ns1__bg_rule0$request_uri",
+			grp: dataplane.BackendGroup{
+				Source: types.NamespacedName{Namespace: "ns1", Name: "bg"},
+				Backends: []dataplane.Backend{
+					{
+						UpstreamName: "my-variable",
+						Valid:        true,
+						Weight:       1,
 					},
-				},
-				{
-					Path:     "/coffee/",
-					PathType: dataplane.PathTypePrefix,
-					MatchRules: []dataplane.MatchRule{
-						{
-							Match:        dataplane.Match{},
-							BackendGroup: barGroup,
-						},
+					{
+						UpstreamName: "my-variable2",
+						Valid:        true,
+						Weight:       1,
 					},
 				},
 			},
-			expLocs: []http.Location{
-				{
-					Path:            "= /coffee",
-					ProxyPass:       "http://test_foo_80$request_uri",
-					ProxySetHeaders: baseHeaders,
-				},
-				{
-					Path:            "/coffee/",
-					ProxyPass:       "http://test_bar_80$request_uri",
-					ProxySetHeaders: baseHeaders,
+		},
+		{
+			expected: "http://10.0.0.1:80",
+			rewrite: &dataplane.HTTPURLRewriteFilter{
+				Path: &dataplane.HTTPPathModifier{},
+			},
+			grp: dataplane.BackendGroup{
+				Backends: []dataplane.Backend{
+					{
+						UpstreamName: "10.0.0.1:80",
+						Valid:        true,
+						Weight:       1,
+					},
 				},
-				createDefaultRootLocation(),
 			},
 		},
 		{
-			name: "/coffee prefix, /coffee/ prefix, /coffee exact",
-			rules: []dataplane.PathRule{
-				{
-					Path:     "/coffee",
-					PathType: dataplane.PathTypePrefix,
-					MatchRules: []dataplane.MatchRule{
-						{
-							Match:        dataplane.Match{},
-							BackendGroup: fooGroup,
-						},
+			expected: "grpc://10.0.0.1:80",
+			grp: dataplane.BackendGroup{
+				Backends: []dataplane.Backend{
+					{
+						UpstreamName: "10.0.0.1:80",
+						Valid:        true,
+						Weight:       1,
 					},
 				},
-				{
-					Path:     "/coffee/",
-					PathType: dataplane.PathTypePrefix,
-					MatchRules: []dataplane.MatchRule{
+			},
+			grpc: true,
+		},
+	}
+
+	for _, tc := range tests {
+		result := createProxyPass(tc.grp, tc.rewrite, generateProtocolString(nil, tc.grpc), tc.grpc)
+		g.Expect(result).To(Equal(tc.expected))
+	}
+}
+
+func TestCreateMatchLocation(t *testing.T) {
+	g := NewWithT(t)
+
+	expected := http.Location{
+		Path: "/path",
+	}
+
+	result := createMatchLocation("/path")
+	g.Expect(result).To(Equal(expected))
+}
+
+func TestGenerateProxySetHeaders(t *testing.T) {
+	tests := []struct {
+		filters         *dataplane.HTTPFilters
+		msg             string
+		expectedHeaders []http.Header
+		grpc            bool
+	}{
+		{
+			msg: "header filter",
+			filters: &dataplane.HTTPFilters{
+				RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
+					Add: []dataplane.HTTPHeader{
 						{
-							Match:        dataplane.Match{},
-							BackendGroup: barGroup,
+							Name:  "Authorization",
+							Value: "my-auth",
 						},
 					},
-				},
-				{
-					Path:     "/coffee",
-					PathType: dataplane.PathTypeExact,
-					MatchRules: []dataplane.MatchRule{
+					Set: []dataplane.HTTPHeader{
 						{
-							Match:        dataplane.Match{},
-							BackendGroup: bazGroup,
+							Name:  "Accept-Encoding",
+							Value: "gzip",
 						},
 					},
+					Remove: []string{"my-header"},
 				},
 			},
-			expLocs: []http.Location{
+			expectedHeaders: []http.Header{
 				{
-					Path:            "/coffee/",
-					ProxyPass:       "http://test_bar_80$request_uri",
-					ProxySetHeaders: baseHeaders,
+					Name:  "Authorization",
+					Value: "${authorization_header_var}my-auth",
 				},
 				{
-					Path:            "= /coffee",
-					ProxyPass:       "http://test_baz_80$request_uri",
-					ProxySetHeaders: baseHeaders,
+					Name:  "Accept-Encoding",
+					Value: "gzip",
 				},
-				createDefaultRootLocation(),
-			},
-		},
-	}
-
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			httpServers := []dataplane.VirtualServer{
 				{
-					IsDefault: true,
-					Port:      8080,
+					Name:  "my-header",
+					Value: "",
 				},
 				{
-					Hostname:  "cafe.example.com",
-					PathRules: test.rules,
-					Port:      8080,
+					Name:  "Host",
+					Value: "$gw_api_compliant_host",
 				},
-			}
-			expectedServers := []http.Server{
 				{
-					IsDefaultHTTP: true,
-					Port:          8080,
+					Name:  "X-Forwarded-For",
+					Value: "$proxy_add_x_forwarded_for",
 				},
 				{
-					ServerName: "cafe.example.com",
-					Locations:  test.expLocs,
-					Port:       8080,
+					Name:  "Upgrade",
+					Value: "$http_upgrade",
+				},
+				{
+					Name:  "Connection",
+					Value: "$connection_upgrade",
 				},
-			}
-
-			g := NewWithT(t)
-
-			result := createServers(httpServers, []dataplane.VirtualServer{})
-			g.Expect(helpers.Diff(expectedServers, result)).To(BeEmpty())
-		})
-	}
-}
-
-func TestCreateLocationsRootPath(t *testing.T) {
-	hrNsName := types.NamespacedName{Namespace: "test", Name: "route1"}
-
-	fooGroup := dataplane.BackendGroup{
-		Source:  hrNsName,
-		RuleIdx: 0,
-		Backends: []dataplane.Backend{
-			{
-				UpstreamName: "test_foo_80",
-				Valid:        true,
-				Weight:       1,
 			},
 		},
-	}
-
-	getPathRules := func(rootPath bool) []dataplane.PathRule {
-		rules := []dataplane.PathRule{
-			{
-				Path: "/path-1",
-				MatchRules: []dataplane.MatchRule{
-					{
-						Match:        dataplane.Match{},
-						BackendGroup: fooGroup,
+		{
+			msg: "with url rewrite hostname",
+			filters: &dataplane.HTTPFilters{
+				RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
+					Add: []dataplane.HTTPHeader{
+						{
+							Name:  "Authorization",
+							Value: "my-auth",
+						},
 					},
 				},
-			},
-			{
-				Path: "/path-2",
-				MatchRules: []dataplane.MatchRule{
-					{
-						Match:        dataplane.Match{},
-						BackendGroup: fooGroup,
-					},
+				RequestURLRewrite: &dataplane.HTTPURLRewriteFilter{
+					Hostname: helpers.GetPointer("rewrite-hostname"),
 				},
 			},
-		}
-
-		if rootPath {
-			rules = append(rules, dataplane.PathRule{
-				Path: "/",
-				MatchRules: []dataplane.MatchRule{
-					{
-						Match:        dataplane.Match{},
-						BackendGroup: fooGroup,
-					},
+			expectedHeaders: []http.Header{
+				{
+					Name:  "Authorization",
+					Value: "${authorization_header_var}my-auth",
 				},
-			})
-		}
-
-		return rules
-	}
-
-	tests := []struct {
-		name         string
-		pathRules    []dataplane.PathRule
-		expLocations []http.Location
-	}{
-		{
-			name:      "path rules with no root path should generate a default 404 root location",
-			pathRules: getPathRules(false /* rootPath */),
-			expLocations: []http.Location{
 				{
-					Path:            "/path-1",
-					ProxyPass:       "http://test_foo_80$request_uri",
-					ProxySetHeaders: baseHeaders,
+					Name:  "Host",
+					Value: "rewrite-hostname",
 				},
 				{
-					Path:            "/path-2",
-					ProxyPass:       "http://test_foo_80$request_uri",
-					ProxySetHeaders: baseHeaders,
+					Name:  "X-Forwarded-For",
+					Value: "$proxy_add_x_forwarded_for",
 				},
 				{
-					Path: "/",
-					Return: &http.Return{
-						Code: http.StatusNotFound,
-					},
+					Name:  "Upgrade",
+					Value: "$http_upgrade",
+				},
+				{
+					Name:  "Connection",
+					Value: "$connection_upgrade",
 				},
 			},
 		},
 		{
-			name:      "path rules with a root path should not generate a default 404 root path",
-			pathRules: getPathRules(true /* rootPath */),
-			expLocations: []http.Location{
+			msg: "header filter with gRPC",
+			filters: &dataplane.HTTPFilters{
+				RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
+					Set: []dataplane.HTTPHeader{
+						{
+							Name:  "Accept-Encoding",
+							Value: "gzip",
+						},
+					},
+				},
+			},
+			expectedHeaders: []http.Header{
 				{
-					Path:            "/path-1",
-					ProxyPass:       "http://test_foo_80$request_uri",
-					ProxySetHeaders: baseHeaders,
+					Name:  "Accept-Encoding",
+					Value: "gzip",
 				},
 				{
-					Path:            "/path-2",
-					ProxyPass:       "http://test_foo_80$request_uri",
-					ProxySetHeaders: baseHeaders,
+					Name:  "Host",
+					Value: "$gw_api_compliant_host",
 				},
 				{
-					Path:            "/",
-					ProxyPass:       "http://test_foo_80$request_uri",
-					ProxySetHeaders: baseHeaders,
+					Name:  "X-Forwarded-For",
+					Value: "$proxy_add_x_forwarded_for",
 				},
-			},
-		},
-		{
-			name:      "nil path rules should generate a default 404 root path",
-			pathRules: nil,
-			expLocations: []http.Location{
 				{
-					Path: "/",
-					Return: &http.Return{
-						Code: http.StatusNotFound,
-					},
+					Name:  "Authority",
+					Value: "$gw_api_compliant_host",
 				},
 			},
+			grpc: true,
 		},
 	}
 
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
 			g := NewWithT(t)
 
-			locs := createLocations(test.pathRules, 80)
-			g.Expect(locs).To(Equal(test.expLocations))
+			headers := generateProxySetHeaders(tc.filters, tc.grpc)
+			g.Expect(headers).To(Equal(tc.expectedHeaders))
 		})
 	}
 }
 
-func TestCreateReturnValForRedirectFilter(t *testing.T) {
-	const listenerPortCustom = 123
-	const listenerPortHTTP = 80
-	const listenerPortHTTPS = 443
-
+func TestGenerateResponseHeaders(t *testing.T) {
 	tests := []struct {
-		filter       *dataplane.HTTPRequestRedirectFilter
-		expected     *http.Return
-		msg          string
-		listenerPort int32
+		filters  *dataplane.HTTPFilters
+		msg      string
+		expected http.ResponseHeaders
 	}{
 		{
-			filter:       nil,
-			expected:     nil,
-			listenerPort: listenerPortCustom,
-			msg:          "filter is nil",
-		},
-		{
-			filter:       &dataplane.HTTPRequestRedirectFilter{},
-			listenerPort: listenerPortCustom,
-			expected: &http.Return{
-				Code: http.StatusFound,
-				Body: "$scheme://$host:123$request_uri",
-			},
-			msg: "all fields are empty",
-		},
-		{
-			filter: &dataplane.HTTPRequestRedirectFilter{
-				Scheme:     helpers.GetPointer("https"),
-				Hostname:   helpers.GetPointer("foo.example.com"),
-				Port:       helpers.GetPointer[int32](2022),
-				StatusCode: helpers.GetPointer(301),
+			msg: "header filter",
+			filters: &dataplane.HTTPFilters{
+				ResponseHeaderModifiers: &dataplane.HTTPHeaderFilter{
+					Add: []dataplane.HTTPHeader{
+						{
+							Name:  "X-Frame-Options",
+							Value: "DENY",
+						},
+					},
+					Set: []dataplane.HTTPHeader{
+						{
+							Name:  "Cache-Control",
+							Value: "no-store",
+						},
+					},
+					Remove: []string{"X-Powered-By"},
+				},
 			},
-			listenerPort: listenerPortCustom,
-			expected: &http.Return{
-				Code: 301,
-				Body: "https://foo.example.com:2022$request_uri",
+			expected: http.ResponseHeaders{
+				Add: []http.Header{
+					{
+						Name:  "X-Frame-Options",
+						Value: "DENY",
+					},
+				},
+				Set: []http.Header{
+					{
+						Name:  "Cache-Control",
+						Value: "no-store",
+					},
+				},
+				Remove: []string{"X-Powered-By"},
 			},
-			msg: "all fields are set",
 		},
 		{
-			filter: &dataplane.HTTPRequestRedirectFilter{
-				Scheme:     helpers.GetPointer("https"),
-				Hostname:   helpers.GetPointer("foo.example.com"),
-				StatusCode: helpers.GetPointer(301),
-			},
-			listenerPort: listenerPortCustom,
-			expected: &http.Return{
-				Code: 301,
-				Body: "https://foo.example.com$request_uri",
+			msg: "no response header filter",
+			filters: &dataplane.HTTPFilters{
+				RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
+					Remove: []string{"my-header"},
+				},
 			},
-			msg: "listenerPort is custom, scheme is set, no port",
+			expected: http.ResponseHeaders{},
 		},
 		{
-			filter: &dataplane.HTTPRequestRedirectFilter{
-				Hostname:   helpers.GetPointer("foo.example.com"),
-				StatusCode: helpers.GetPointer(301),
-			},
-			listenerPort: listenerPortHTTPS,
-			expected: &http.Return{
-				Code: 301,
-				Body: "$scheme://foo.example.com:443$request_uri",
-			},
-			msg: "no scheme, listenerPort https, no port is set",
+			msg:      "nil filters",
+			filters:  nil,
+			expected: http.ResponseHeaders{},
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			g := NewWithT(t)
+
+			headers := generateResponseHeaders(tc.filters)
+			g.Expect(headers).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestConvertBackendTLSFromGroup(t *testing.T) {
+	g := NewWithT(t)
+
+	tests := []struct {
+		expected *http.ProxySSLVerify
+		msg      string
+		grp      []dataplane.Backend
+	}{
 		{
-			filter: &dataplane.HTTPRequestRedirectFilter{
-				Scheme:     helpers.GetPointer("https"),
-				Hostname:   helpers.GetPointer("foo.example.com"),
-				StatusCode: helpers.GetPointer(301),
+			msg: "tls enabled, one backend",
+			grp: []dataplane.Backend{
+				{
+					UpstreamName: "my-upstream",
+					Valid:        true,
+					Weight:       1,
+					VerifyTLS: &dataplane.VerifyTLS{
+						CertBundleID: "default-my-cert",
+						Hostname:     "my-hostname",
+					},
+				},
 			},
-			listenerPort: listenerPortHTTPS,
-			expected: &http.Return{
-				Code: 301,
-				Body: "https://foo.example.com$request_uri",
+			expected: &http.ProxySSLVerify{
+				TrustedCertificate: "/etc/nginx/secrets/default-my-cert.crt",
+				Name:               "my-hostname",
 			},
-			msg: "scheme is https, listenerPort https, no port is set",
 		},
 		{
-			filter: &dataplane.HTTPRequestRedirectFilter{
-				Scheme:     helpers.GetPointer("http"),
-				Hostname:   helpers.GetPointer("foo.example.com"),
-				StatusCode: helpers.GetPointer(301),
-			},
-			listenerPort: listenerPortHTTP,
-			expected: &http.Return{
-				Code: 301,
-				Body: "http://foo.example.com$request_uri",
+			msg: "tls disabled",
+			grp: []dataplane.Backend{
+				{
+					UpstreamName: "my-upstream",
+					Valid:        true,
+					Weight:       1,
+					VerifyTLS:    nil,
+				},
 			},
-			msg: "scheme is http, listenerPort http, no port is set",
+			expected: nil,
 		},
 		{
-			filter: &dataplane.HTTPRequestRedirectFilter{
-				Scheme:     helpers.GetPointer("http"),
-				Hostname:   helpers.GetPointer("foo.example.com"),
-				Port:       helpers.GetPointer[int32](80),
-				StatusCode: helpers.GetPointer(301),
+			msg: "tls enabled, multiple backends",
+			grp: []dataplane.Backend{
+				{
+					UpstreamName: "my-upstream",
+					Valid:        true,
+					Weight:       1,
+					VerifyTLS: &dataplane.VerifyTLS{
+						CertBundleID: "default-my-cert",
+						Hostname:     "my-hostname",
+					},
+				},
+				{
+					UpstreamName: "my-upstream",
+					Valid:        true,
+					Weight:       2,
+				},
 			},
-			listenerPort: listenerPortCustom,
-			expected: &http.Return{
-				Code: 301,
-				Body: "http://foo.example.com$request_uri",
+			expected: &http.ProxySSLVerify{
+				TrustedCertificate: "/etc/nginx/secrets/default-my-cert.crt",
+				Name:               "my-hostname",
 			},
-			msg: "scheme is http, port http",
 		},
 		{
-			filter: &dataplane.HTTPRequestRedirectFilter{
-				Scheme:     helpers.GetPointer("https"),
-				Hostname:   helpers.GetPointer("foo.example.com"),
-				Port:       helpers.GetPointer[int32](443),
-				StatusCode: helpers.GetPointer(301),
+			msg: "tls enabled, system certs enabled",
+			grp: []dataplane.Backend{
+				{
+					UpstreamName: "my-upstream",
+					Valid:        true,
+					Weight:       1,
+					VerifyTLS: &dataplane.VerifyTLS{
+						Hostname:   "my-hostname",
+						RootCAPath: "/etc/ssl/certs/ca-certificates.crt",
+					},
+				},
 			},
-			listenerPort: listenerPortCustom,
-			expected: &http.Return{
-				Code: 301,
-				Body: "https://foo.example.com$request_uri",
+			expected: &http.ProxySSLVerify{
+				TrustedCertificate: "/etc/ssl/certs/ca-certificates.crt",
+				Name:               "my-hostname",
 			},
-			msg: "scheme is https, port https",
 		},
 	}
 
-	for _, test := range tests {
-		t.Run(test.msg, func(t *testing.T) {
-			g := NewWithT(t)
-
-			result := createReturnValForRedirectFilter(test.filter, test.listenerPort)
-			g.Expect(helpers.Diff(test.expected, result)).To(BeEmpty())
+	for _, tc := range tests {
+		t.Run(tc.msg, func(_ *testing.T) {
+			result := createProxyTLSFromBackends(tc.grp)
+			g.Expect(result).To(Equal(tc.expected))
 		})
 	}
 }
 
-func TestCreateRewritesValForRewriteFilter(t *testing.T) {
+func TestCreateRetry(t *testing.T) {
 	tests := []struct {
-		filter   *dataplane.HTTPURLRewriteFilter
-		expected *rewriteConfig
+		retry    *dataplane.Retry
+		expected *http.Retry
 		msg      string
-		path     string
 	}{
 		{
-			filter:   nil,
+			retry:    nil,
 			expected: nil,
-			msg:      "filter is nil",
+			msg:      "no retry",
 		},
 		{
-			filter:   &dataplane.HTTPURLRewriteFilter{},
-			expected: &rewriteConfig{},
-			msg:      "all fields are empty",
+			retry:    &dataplane.Retry{},
+			expected: &http.Retry{},
+			msg:      "empty retry",
 		},
 		{
-			filter: &dataplane.HTTPURLRewriteFilter{
-				Path: &dataplane.HTTPPathModifier{
-					Type:        dataplane.ReplaceFullPath,
-					Replacement: "/full-path",
+			retry: &dataplane.Retry{
+				Attempts:     helpers.GetPointer[int32](3),
+				TotalTimeout: "10s",
+				Conditions: []dataplane.RetryCondition{
+					dataplane.RetryConditionError,
+					dataplane.RetryConditionTimeout,
+					dataplane.RetryConditionInvalidHeader,
+					dataplane.RetryConditionNonIdempotent,
 				},
+				StatusCodes: []int32{429, 502},
 			},
-			expected: &rewriteConfig{
-				Rewrite: "^ /full-path break",
+			expected: &http.Retry{
+				Tries:        helpers.GetPointer[int32](3),
+				NextUpstream: "error timeout invalid_header non_idempotent http_429 http_502",
+				Timeout:      "10s",
 			},
-			msg: "full path",
+			msg: "full retry",
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(createRetry(tc.retry)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestCreateLocationRetry(t *testing.T) {
+	g := NewWithT(t)
+
+	retry := &http.Retry{
+		Tries:        helpers.GetPointer[int32](3),
+		NextUpstream: "error",
+		Timeout:      "10s",
+	}
+
+	g.Expect(createLocationRetry(retry, nil)).To(BeIdenticalTo(retry))
+	g.Expect(createLocationRetry(retry, &http.ProxyTimeouts{ReadTimeout: "5s"})).To(BeIdenticalTo(retry))
+
+	locRetry := createLocationRetry(retry, &http.ProxyTimeouts{NextUpstreamTimeout: "5s"})
+	g.Expect(locRetry).To(Equal(&http.Retry{
+		Tries:        helpers.GetPointer[int32](3),
+		NextUpstream: "error",
+	}))
+	// the retry settings are shared by the locations, so they must not be modified
+	g.Expect(retry.Timeout).To(Equal("10s"))
+}
+
+func TestCreateProxyTimeouts(t *testing.T) {
+	tests := []struct {
+		timeouts *dataplane.HTTPTimeouts
+		expected *http.ProxyTimeouts
+		msg      string
+	}{
 		{
-			path: "/original",
-			filter: &dataplane.HTTPURLRewriteFilter{
-				Path: &dataplane.HTTPPathModifier{
-					Type:        dataplane.ReplacePrefixMatch,
-					Replacement: "/prefix-path",
-				},
-			},
-			expected: &rewriteConfig{
-				Rewrite: "^/original(.*)$ /prefix-path$1 break",
-			},
-			msg: "prefix path no trailing slashes",
+			timeouts: nil,
+			expected: nil,
+			msg:      "no timeouts",
 		},
 		{
-			path: "/original",
-			filter: &dataplane.HTTPURLRewriteFilter{
-				Path: &dataplane.HTTPPathModifier{
-					Type:        dataplane.ReplacePrefixMatch,
-					Replacement: "",
-				},
-			},
-			expected: &rewriteConfig{
-				Rewrite: "^/original(?:/(.*))?$ /$1 break",
-			},
-			msg: "prefix path empty string",
+			timeouts: &dataplane.HTTPTimeouts{
+				Request:        helpers.GetPointer(time.Minute),
+				BackendRequest: helpers.GetPointer(1500 * time.Millisecond),
+			},
+			expected: &http.ProxyTimeouts{
+				ConnectTimeout:      "1500ms",
+				ReadTimeout:         "1500ms",
+				SendTimeout:         "1500ms",
+				NextUpstreamTimeout: "60000ms",
+				ClientBodyTimeout:   "60000ms",
+				ClientSendTimeout:   "60000ms",
+			},
+			msg: "request and backendRequest timeouts",
 		},
 		{
-			path: "/original",
-			filter: &dataplane.HTTPURLRewriteFilter{
-				Path: &dataplane.HTTPPathModifier{
-					Type:        dataplane.ReplacePrefixMatch,
-					Replacement: "/",
-				},
-			},
-			expected: &rewriteConfig{
-				Rewrite: "^/original(?:/(.*))?$ /$1 break",
-			},
-			msg: "prefix path /",
+			timeouts: &dataplane.HTTPTimeouts{
+				Request: helpers.GetPointer(10 * time.Second),
+			},
+			expected: &http.ProxyTimeouts{
+				ConnectTimeout:      "10000ms",
+				ReadTimeout:         "10000ms",
+				SendTimeout:         "10000ms",
+				NextUpstreamTimeout: "10000ms",
+				ClientBodyTimeout:   "10000ms",
+				ClientSendTimeout:   "10000ms",
+			},
+			msg: "only request timeout",
 		},
 		{
-			path: "/original",
-			filter: &dataplane.HTTPURLRewriteFilter{
-				Path: &dataplane.HTTPPathModifier{
-					Type:        dataplane.ReplacePrefixMatch,
-					Replacement: "/trailing/",
-				},
+			timeouts: &dataplane.HTTPTimeouts{
+				BackendRequest: helpers.GetPointer(5 * time.Second),
 			},
-			expected: &rewriteConfig{
-				Rewrite: "^/original(?:/(.*))?$ /trailing/$1 break",
+			expected: &http.ProxyTimeouts{
+				ConnectTimeout: "5000ms",
+				ReadTimeout:    "5000ms",
+				SendTimeout:    "5000ms",
 			},
-			msg: "prefix path replacement with trailing /",
+			msg: "only backendRequest timeout",
 		},
 		{
-			path: "/original/",
-			filter: &dataplane.HTTPURLRewriteFilter{
-				Path: &dataplane.HTTPPathModifier{
-					Type:        dataplane.ReplacePrefixMatch,
-					Replacement: "/prefix-path",
-				},
+			timeouts: &dataplane.HTTPTimeouts{
+				Request:        helpers.GetPointer(time.Duration(0)),
+				BackendRequest: helpers.GetPointer(5 * time.Second),
 			},
-			expected: &rewriteConfig{
-				Rewrite: "^/original/(.*)$ /prefix-path/$1 break",
+			expected: &http.ProxyTimeouts{
+				ConnectTimeout: "5000ms",
+				ReadTimeout:    "5000ms",
+				SendTimeout:    "5000ms",
 			},
-			msg: "prefix path original with trailing /",
+			msg: "zero request timeout",
 		},
 		{
-			path: "/original/",
-			filter: &dataplane.HTTPURLRewriteFilter{
-				Path: &dataplane.HTTPPathModifier{
-					Type:        dataplane.ReplacePrefixMatch,
-					Replacement: "/trailing/",
-				},
+			timeouts: &dataplane.HTTPTimeouts{
+				Request: helpers.GetPointer(time.Duration(0)),
 			},
-			expected: &rewriteConfig{
-				Rewrite: "^/original/(.*)$ /trailing/$1 break",
-			},
-			msg: "prefix path both with trailing slashes",
+			expected: nil,
+			msg:      "zero timeouts",
 		},
 	}
 
-	for _, test := range tests {
-		t.Run(test.msg, func(t *testing.T) {
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
 			g := NewWithT(t)
-
-			result := createRewritesValForRewriteFilter(test.filter, test.path)
-			g.Expect(helpers.Diff(test.expected, result)).To(BeEmpty())
+
+			result := createProxyTimeouts(tc.timeouts)
+			g.Expect(result).To(Equal(tc.expected))
 		})
 	}
 }
 
-func TestCreateHTTPMatch(t *testing.T) {
-	testPath := "/internal_loc"
+func TestCreateLocationClientSettings(t *testing.T) {
+	clientSettings := &http.ClientSettings{
+		BodyMaxSize: "10m",
+		BodyTimeout: "30s",
+	}
 
-	testMethodMatch := helpers.GetPointer("PUT")
-	testHeaderMatches := []dataplane.HTTPHeaderMatch{
+	tests := []struct {
+		clientSettings *http.ClientSettings
+		proxyTimeouts  *http.ProxyTimeouts
+		expected       *http.ClientSettings
+		msg            string
+	}{
 		{
-			Name:  "header-1",
-			Value: "val-1",
+			clientSettings: clientSettings,
+			proxyTimeouts:  nil,
+			expected:       clientSettings,
+			msg:            "no proxy timeouts",
 		},
 		{
-			Name:  "header-2",
-			Value: "val-2",
+			clientSettings: clientSettings,
+			proxyTimeouts:  &http.ProxyTimeouts{ConnectTimeout: "500ms"},
+			expected:       clientSettings,
+			msg:            "no client body timeout in proxy timeouts",
 		},
 		{
-			Name:  "header-3",
-			Value: "val-3",
+			clientSettings: &http.ClientSettings{BodyMaxSize: "10m"},
+			proxyTimeouts:  &http.ProxyTimeouts{ClientBodyTimeout: "10000ms"},
+			expected:       &http.ClientSettings{BodyMaxSize: "10m"},
+			msg:            "no body timeout in client settings",
+		},
+		{
+			clientSettings: clientSettings,
+			proxyTimeouts:  &http.ProxyTimeouts{ClientBodyTimeout: "10000ms"},
+			expected:       &http.ClientSettings{BodyMaxSize: "10m"},
+			msg:            "client body timeout in proxy timeouts",
 		},
 	}
 
-	testDuplicateHeaders := make([]dataplane.HTTPHeaderMatch, 0, 4)
-	duplicateHeaderMatch := dataplane.HTTPHeaderMatch{
-		Name:  "HEADER-2", // header names are case-insensitive
-		Value: "val-2",
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := createLocationClientSettings(tc.clientSettings, tc.proxyTimeouts)
+			g.Expect(result).To(Equal(tc.expected))
+		})
 	}
-	testDuplicateHeaders = append(testDuplicateHeaders, testHeaderMatches...)
-	testDuplicateHeaders = append(testDuplicateHeaders, duplicateHeaderMatch)
 
-	testQueryParamMatches := []dataplane.HTTPQueryParamMatch{
-		{
-			Name:  "arg1",
-			Value: "val1",
+	// the client settings shared by the locations of a rule are not modified
+	g := NewWithT(t)
+	g.Expect(clientSettings.BodyTimeout).To(Equal("30s"))
+}
+
+func TestCreateLocationPolicies(t *testing.T) {
+	g := NewWithT(t)
+
+	serverAccessLog := &dataplane.AccessLog{Format: &dataplane.LogFormat{Name: "server", RouteInfo: true}}
+	rule := dataplane.MatchRule{
+		Source: &metav1.ObjectMeta{Namespace: "test", Name: "hr"},
+		Policies: dataplane.RulePolicies{
+			RateLimit: &dataplane.RateLimit{Zone: dataplane.RateLimitZone{Name: "zone"}},
 		},
+	}
+
+	result := createLocationPolicies(rule, serverAccessLog)
+	g.Expect(result.rateLimit).To(Equal(&http.RateLimit{ZoneName: "zone"}))
+	g.Expect(result.accessLog).To(BeNil())
+	// the access log of the server logs the route of the requests
+	g.Expect(result.variables).To(Equal(createRouteVariables(rule.Source)))
+
+	rule.Policies.AccessLog = &dataplane.AccessLog{Format: &dataplane.LogFormat{Name: "route"}}
+
+	result = createLocationPolicies(rule, serverAccessLog)
+	g.Expect(result.accessLog).ToNot(BeNil())
+	g.Expect(result.variables).To(BeNil())
+}
+
+func TestApplyLocationPolicies(t *testing.T) {
+	g := NewWithT(t)
+
+	proxyTimeouts := &http.ProxyTimeouts{
+		ClientBodyTimeout:   "10000ms",
+		NextUpstreamTimeout: "10000ms",
+	}
+	serverLocation := http.Location{Path: "/server"}
+
+	locs := []http.Location{
+		{Path: "/", ProxyTimeouts: proxyTimeouts},
+		serverLocation,
+	}
+
+	policies := locationPolicies{
+		clientSettings: &http.ClientSettings{BodyTimeout: "30s"},
+		retry:          &http.Retry{NextUpstream: "error", Timeout: "5s"},
+		cors:           &http.CORS{OriginVariable: "$cors_origin"},
+	}
+
+	applyLocationPolicies(locs, policies)
+
+	g.Expect(locs).To(Equal([]http.Location{
 		{
-			Name:  "arg2",
-			Value: "val2=another-val",
+			Path:          "/",
+			ProxyTimeouts: proxyTimeouts,
+			// the timeouts of the rule replace the body timeout and the retry timeout
+			ClientSettings: &http.ClientSettings{},
+			Retry:          &http.Retry{NextUpstream: "error"},
+			CORS:           policies.cors,
 		},
 		{
-			Name:  "arg3",
-			Value: "==val3",
+			Path:           "/server",
+			ClientSettings: policies.clientSettings,
+			Retry:          policies.retry,
+			CORS:           policies.cors,
 		},
+	}))
+
+	// the locations keep the settings of the server without the settings of the rule
+	locs = []http.Location{serverLocation}
+	applyLocationPolicies(locs, locationPolicies{})
+	g.Expect(locs).To(Equal([]http.Location{serverLocation}))
+}
+
+func TestCreateCompression(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(createCompression(nil)).To(BeNil())
+
+	compression := &dataplane.Compression{
+		Enabled:   true,
+		MinLength: helpers.GetPointer[int32](1024),
+		Level:     helpers.GetPointer[int32](5),
+		Types:     []string{"application/json", "text/css"},
 	}
 
-	expectedHeaders := []string{"header-1:val-1", "header-2:val-2", "header-3:val-3"}
-	expectedArgs := []string{"arg1=val1", "arg2=val2=another-val", "arg3===val3"}
+	expected := &http.Compression{
+		Enabled:   true,
+		MinLength: helpers.GetPointer[int32](1024),
+		Level:     helpers.GetPointer[int32](5),
+		Types:     "application/json text/css",
+	}
+
+	g.Expect(createCompression(compression)).To(Equal(expected))
+	g.Expect(createCompression(&dataplane.Compression{})).To(Equal(&http.Compression{}))
+}
 
+func TestCreateAccessControl(t *testing.T) {
 	tests := []struct {
-		match    dataplane.Match
-		msg      string
-		expected httpMatch
+		accessControl *dataplane.AccessControl
+		expected      *http.AccessControl
+		msg           string
 	}{
 		{
-			match: dataplane.Match{},
-			expected: httpMatch{
-				Any:          true,
-				RedirectPath: testPath,
-			},
-			msg: "path only match",
+			accessControl: nil,
+			expected:      nil,
+			msg:           "no access control",
 		},
 		{
-			match: dataplane.Match{
-				Method: testMethodMatch, // A path match with a method should not set the Any field to true
+			accessControl: &dataplane.AccessControl{
+				Rules: []dataplane.AccessControlRule{
+					{Action: dataplane.AccessControlActionAllow, CIDR: "10.8.0.0/16"},
+				},
 			},
-			expected: httpMatch{
-				Method:       "PUT",
-				RedirectPath: testPath,
+			expected: &http.AccessControl{
+				Rules: []http.AccessRule{
+					{Action: "allow", Address: "10.8.0.0/16"},
+				},
 			},
-			msg: "method only match",
+			msg: "rules without default action",
 		},
 		{
-			match: dataplane.Match{
-				Headers: testHeaderMatches,
+			accessControl: &dataplane.AccessControl{
+				Rules: []dataplane.AccessControlRule{
+					{Action: dataplane.AccessControlActionDeny, CIDR: "10.8.1.1"},
+					{Action: dataplane.AccessControlActionAllow, CIDR: "10.8.0.0/16"},
+				},
+				DefaultAction: dataplane.AccessControlActionDeny,
+				RealIP: &dataplane.RealIP{
+					Header:           "X-Real-IP",
+					TrustedAddresses: []string{"172.16.0.0/12", "fd00::/8"},
+				},
 			},
-			expected: httpMatch{
-				RedirectPath: testPath,
-				Headers:      expectedHeaders,
+			expected: &http.AccessControl{
+				Rules: []http.AccessRule{
+					{Action: "deny", Address: "10.8.1.1"},
+					{Action: "allow", Address: "10.8.0.0/16"},
+					{Action: "deny", Address: "all"},
+				},
+				RealIP: &http.RealIP{
+					Header:           "X-Real-IP",
+					TrustedAddresses: []string{"172.16.0.0/12", "fd00::/8"},
+				},
 			},
-			msg: "headers only match",
+			msg: "rules with default action and real IP",
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := createAccessControl(tc.accessControl)
+			g.Expect(result).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestCreateCORS(t *testing.T) {
+	tests := []struct {
+		cors     *dataplane.CORS
+		expected *http.CORS
+		msg      string
+	}{
 		{
-			match: dataplane.Match{
-				QueryParams: testQueryParamMatches,
-			},
-			expected: httpMatch{
-				QueryParams:  expectedArgs,
-				RedirectPath: testPath,
-			},
-			msg: "query params only match",
+			cors:     nil,
+			expected: nil,
+			msg:      "no CORS",
 		},
 		{
-			match: dataplane.Match{
-				Method:      testMethodMatch,
-				QueryParams: testQueryParamMatches,
+			cors: &dataplane.CORS{
+				Name:         "test_cors",
+				AllowOrigins: []string{"*"},
+				AllowMethods: []string{"GET", "HEAD", "POST"},
 			},
-			expected: httpMatch{
-				Method:       "PUT",
-				QueryParams:  expectedArgs,
-				RedirectPath: testPath,
+			expected: &http.CORS{
+				OriginVariable:    "$" + generateCORSOriginVariableName("test_cors"),
+				PreflightVariable: "$" + generateCORSPreflightVariableName("test_cors"),
+				AllowMethods:      "GET, HEAD, POST",
 			},
-			msg: "method and query params match",
+			msg: "default settings",
 		},
 		{
-			match: dataplane.Match{
-				Method:  testMethodMatch,
-				Headers: testHeaderMatches,
-			},
-			expected: httpMatch{
-				Method:       "PUT",
-				Headers:      expectedHeaders,
-				RedirectPath: testPath,
-			},
-			msg: "method and headers match",
+			cors: &dataplane.CORS{
+				Name:             "test_cors",
+				AllowOrigins:     []string{"https://example.com"},
+				AllowMethods:     []string{"PUT"},
+				AllowHeaders:     []string{"Authorization", "Content-Type"},
+				ExposeHeaders:    []string{"X-Request-Id", "X-Trace-Id"},
+				AllowCredentials: true,
+				MaxAge:           helpers.GetPointer[int32](0),
+			},
+			expected: &http.CORS{
+				OriginVariable:    "$" + generateCORSOriginVariableName("test_cors"),
+				PreflightVariable: "$" + generateCORSPreflightVariableName("test_cors"),
+				AllowMethods:      "PUT",
+				AllowHeaders:      "Authorization, Content-Type",
+				ExposeHeaders:     "X-Request-Id, X-Trace-Id",
+				MaxAge:            "0",
+				AllowCredentials:  true,
+			},
+			msg: "all settings",
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := createCORS(tc.cors)
+			g.Expect(result).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestCreateBasicAuth(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(createBasicAuth(nil)).To(BeNil())
+	g.Expect(createBasicAuth(&dataplane.BasicAuth{
+		Realm:      "Restricted",
+		UserFileID: "auth_user_file_test_htpasswd",
+	})).To(Equal(&http.BasicAuth{
+		Realm:    "Restricted",
+		UserFile: "/etc/nginx/secrets/auth_user_file_test_htpasswd.htpasswd",
+	}))
+}
+
+func TestCreateExternalAuthLocation(t *testing.T) {
+	const authPath = "/_ngf-internal-auth-rule0-route0"
+
+	authHeaders := append(
+		slices.Clone(baseHeaders),
+		http.Header{Name: "Content-Length", Value: ""},
+		http.Header{Name: "X-Original-URI", Value: "$request_uri"},
+		http.Header{Name: "X-Original-Method", Value: "$request_method"},
+	)
+
+	tests := []struct {
+		externalAuth *dataplane.ExternalAuth
+		msg          string
+		expected     http.Location
+	}{
 		{
-			match: dataplane.Match{
-				QueryParams: testQueryParamMatches,
-				Headers:     testHeaderMatches,
+			externalAuth: &dataplane.ExternalAuth{
+				Backend: dataplane.Backend{UpstreamName: "test_authz_80", Valid: true},
 			},
-			expected: httpMatch{
-				QueryParams:  expectedArgs,
-				Headers:      expectedHeaders,
-				RedirectPath: testPath,
+			expected: http.Location{
+				Path:            "= " + authPath,
+				Internal:        true,
+				ProxyPass:       "http://test_authz_80$request_uri",
+				ProxySetHeaders: authHeaders,
+				NoRequestBody:   true,
 			},
-			msg: "query params and headers match",
+			msg: "default path, no forwarded headers",
 		},
 		{
-			match: dataplane.Match{
-				Headers:     testHeaderMatches,
-				QueryParams: testQueryParamMatches,
-				Method:      testMethodMatch,
-			},
-			expected: httpMatch{
-				Method:       "PUT",
-				Headers:      expectedHeaders,
-				QueryParams:  expectedArgs,
-				RedirectPath: testPath,
-			},
-			msg: "method, headers, and query params match",
+			externalAuth: &dataplane.ExternalAuth{
+				Path:                  "/auth",
+				ForwardRequestHeaders: []string{"Authorization", "X-Api-Key"},
+				Backend:               dataplane.Backend{UpstreamName: "test_authz_80", Valid: true},
+			},
+			expected: http.Location{
+				Path:      "= " + authPath,
+				Internal:  true,
+				ProxyPass: "http://test_authz_80/auth",
+				ProxySetHeaders: append(
+					slices.Clone(authHeaders),
+					http.Header{Name: "Authorization", Value: "$http_authorization"},
+					http.Header{Name: "X-Api-Key", Value: "$http_x_api_key"},
+				),
+				NoRequestHeaders: true,
+				NoRequestBody:    true,
+			},
+			msg: "path and forwarded headers",
 		},
 		{
-			match: dataplane.Match{
-				Headers: testDuplicateHeaders,
+			externalAuth: &dataplane.ExternalAuth{
+				Backend: dataplane.Backend{Valid: false},
 			},
-			expected: httpMatch{
-				Headers:      expectedHeaders,
-				RedirectPath: testPath,
+			expected: http.Location{
+				Path:     "= " + authPath,
+				Internal: true,
+				Return:   &http.Return{Code: http.StatusInternalServerError},
 			},
-			msg: "duplicate header names",
+			msg: "invalid backend",
 		},
 	}
+
 	for _, tc := range tests {
 		t.Run(tc.msg, func(t *testing.T) {
 			g := NewWithT(t)
 
-			result := createHTTPMatch(tc.match, testPath)
-			g.Expect(helpers.Diff(result, tc.expected)).To(BeEmpty())
+			result := createExternalAuthLocation(authPath, tc.externalAuth)
+			g.Expect(helpers.Diff(tc.expected, result)).To(BeEmpty())
 		})
 	}
 }
 
-func TestCreateQueryParamKeyValString(t *testing.T) {
+func TestCreateAuthRequest(t *testing.T) {
 	g := NewWithT(t)
 
-	expected := "key=value"
-
-	result := createQueryParamKeyValString(
-		dataplane.HTTPQueryParamMatch{
-			Name:  "key",
-			Value: "value",
-		},
-	)
-
-	g.Expect(result).To(Equal(expected))
-
-	expected = "KeY=vaLUe=="
+	authRequest, headers := createAuthRequest("/auth", &dataplane.ExternalAuth{
+		CopyResponseHeaders: []string{"X-User", "X-Email"},
+	})
 
-	result = createQueryParamKeyValString(
-		dataplane.HTTPQueryParamMatch{
-			Name:  "KeY",
-			Value: "vaLUe==",
+	g.Expect(authRequest).To(Equal(&http.AuthRequest{
+		URI: "/auth",
+		Sets: []http.AuthRequestSet{
+			{Variable: "$auth_response_x_user", Value: "$upstream_http_x_user"},
+			{Variable: "$auth_response_x_email", Value: "$upstream_http_x_email"},
 		},
-	)
-
-	g.Expect(result).To(Equal(expected))
+	}))
+	g.Expect(headers).To(Equal([]http.Header{
+		{Name: "X-User", Value: "$auth_response_x_user"},
+		{Name: "X-Email", Value: "$auth_response_x_email"},
+	}))
 }
 
-func TestCreateHeaderKeyValString(t *testing.T) {
+func TestCreateErrorPages(t *testing.T) {
 	g := NewWithT(t)
 
-	expected := "kEy:vALUe"
+	pages, locs := createErrorPages(nil, createServerErrorPagePath)
+	g.Expect(pages).To(BeNil())
+	g.Expect(locs).To(BeNil())
 
-	result := createHeaderKeyValString(
-		dataplane.HTTPHeaderMatch{
-			Name:  "kEy",
-			Value: "vALUe",
+	pages, locs = createErrorPages(
+		[]dataplane.ErrorPage{
+			{
+				Codes:       []int32{404},
+				FileID:      "error_page_abc",
+				ContentType: "text/plain",
+			},
+			{
+				Codes:        []int32{500, 502},
+				ResponseCode: helpers.GetPointer[int32](200),
+				Backend:      &dataplane.Backend{UpstreamName: "test_errors_80", Valid: true, KeepAlive: true},
+			},
+		},
+		func(idx int) string {
+			return createRuleErrorPagePath(idx, 1, 2)
 		},
 	)
 
-	g.Expect(result).To(Equal(expected))
-}
+	keepAliveHeaders := slices.Clone(baseHeaders)
+	setKeepAliveConnectionHeader(keepAliveHeaders)
 
-func TestIsPathOnlyMatch(t *testing.T) {
-	tests := []struct {
-		msg      string
-		match    dataplane.Match
-		expected bool
-	}{
-		{
-			match:    dataplane.Match{},
-			expected: true,
-			msg:      "path only match",
-		},
+	expPages := []http.ErrorPage{
 		{
-			match: dataplane.Match{
-				Method: helpers.GetPointer("GET"),
-			},
-			expected: false,
-			msg:      "method defined in match",
+			Codes: []int32{404},
+			Path:  "/_ngf-internal-error-page0-rule1-route2",
 		},
 		{
-			match: dataplane.Match{
-				Headers: []dataplane.HTTPHeaderMatch{
-					{
-						Name:  "header",
-						Value: "val",
-					},
-				},
-			},
-			expected: false,
-			msg:      "headers defined in match",
+			Codes:        []int32{500, 502},
+			ResponseCode: helpers.GetPointer[int32](200),
+			Path:         "/_ngf-internal-error-page1-rule1-route2",
 		},
+	}
+	expLocs := []http.Location{
 		{
-			match: dataplane.Match{
-				QueryParams: []dataplane.HTTPQueryParamMatch{
-					{
-						Name:  "arg",
-						Value: "val",
-					},
-				},
-			},
-			expected: false,
-			msg:      "query params defined in match",
+			Path:        "= /_ngf-internal-error-page0-rule1-route2",
+			Internal:    true,
+			Alias:       "/etc/nginx/secrets/error_page_abc.page",
+			DefaultType: "text/plain",
+		},
+		{
+			Path:      "= /_ngf-internal-error-page1-rule1-route2",
+			Internal:  true,
+			ProxyPass: "http://test_errors_80$request_uri",
+			ProxySetHeaders: append(
+				keepAliveHeaders,
+				http.Header{Name: "Content-Length", Value: ""},
+				http.Header{Name: "X-Error-Code", Value: "$status"},
+			),
+			NoRequestBody: true,
 		},
 	}
 
-	for _, tc := range tests {
-		t.Run(tc.msg, func(t *testing.T) {
-			g := NewWithT(t)
-
-			result := isPathOnlyMatch(tc.match)
-			g.Expect(result).To(Equal(tc.expected))
-		})
-	}
+	g.Expect(helpers.Diff(expPages, pages)).To(BeEmpty())
+	g.Expect(helpers.Diff(expLocs, locs)).To(BeEmpty())
 }
 
-func TestCreateProxyPass(t *testing.T) {
+func TestMergeErrorPages(t *testing.T) {
 	g := NewWithT(t)
 
+	rulePages := []http.ErrorPage{
+		{Codes: []int32{404, 503}, Path: "/_ngf-internal-error-page0-rule0-route0"},
+	}
+	serverPages := []http.ErrorPage{
+		{Codes: []int32{404}, Path: "/_ngf-internal-error-page0"},
+		{
+			Codes:        []int32{500, 502, 503},
+			ResponseCode: helpers.GetPointer[int32](200),
+			Path:         "/_ngf-internal-error-page1",
+		},
+	}
+
+	expected := []http.ErrorPage{
+		{Codes: []int32{404, 503}, Path: "/_ngf-internal-error-page0-rule0-route0"},
+		{
+			Codes:        []int32{500, 502},
+			ResponseCode: helpers.GetPointer[int32](200),
+			Path:         "/_ngf-internal-error-page1",
+		},
+	}
+
+	g.Expect(mergeErrorPages(rulePages, serverPages)).To(Equal(expected))
+	g.Expect(serverPages[1].Codes).To(Equal([]int32{500, 502, 503}))
+}
+
+func TestCreateTracing(t *testing.T) {
 	tests := []struct {
-		rewrite  *dataplane.HTTPURLRewriteFilter
-		expected string
-		grp      dataplane.BackendGroup
+		tracing  *dataplane.Tracing
+		expected *http.Tracing
+		msg      string
 	}{
 		{
-			expected: "http://10.0.0.1:80$request_uri",
-			grp: dataplane.BackendGroup{
-				Backends: []dataplane.Backend{
-					{
-						UpstreamName: "10.0.0.1:80",
-						Valid:        true,
-						Weight:       1,
-					},
-				},
+			tracing:  nil,
+			expected: nil,
+			msg:      "no tracing",
+		},
+		{
+			tracing: &dataplane.Tracing{
+				Strategy: dataplane.TraceStrategyRatio,
 			},
+			expected: &http.Tracing{
+				Enable: "on",
+			},
+			msg: "ratio strategy without ratio",
 		},
 		{
-			expected: "http://$ns1__bg_rule0$request_uri",
-			grp: dataplane.BackendGroup{
-				Source: types.NamespacedName{Namespace: "ns1", Name: "bg"},
-				Backends: []dataplane.Backend{
-					{
-						UpstreamName: "my-variable",
-						Valid:        true,
-						Weight:       1,
-					},
-					{
-						UpstreamName: "my-variable2",
-						Valid:        true,
-						Weight:       1,
-					},
-				},
+			tracing: &dataplane.Tracing{
+				Strategy: dataplane.TraceStrategyRatio,
+				Ratio:    helpers.GetPointer[int32](100),
 			},
+			expected: &http.Tracing{
+				Enable: "on",
+			},
+			msg: "ratio of 100",
 		},
 		{
-			expected: "http://10.0.0.1:80",
-			rewrite: &dataplane.HTTPURLRewriteFilter{
-				Path: &dataplane.HTTPPathModifier{},
+			tracing: &dataplane.Tracing{
+				Strategy: dataplane.TraceStrategyRatio,
+				Ratio:    helpers.GetPointer[int32](0),
 			},
-			grp: dataplane.BackendGroup{
-				Backends: []dataplane.Backend{
-					{
-						UpstreamName: "10.0.0.1:80",
-						Valid:        true,
-						Weight:       1,
-					},
+			expected: &http.Tracing{
+				Enable: "off",
+			},
+			msg: "ratio of 0",
+		},
+		{
+			tracing: &dataplane.Tracing{
+				Strategy: dataplane.TraceStrategyRatio,
+				Ratio:    helpers.GetPointer[int32](50),
+				Context:  "inject",
+				SpanName: "my-span",
+				SpanAttributes: []dataplane.SpanAttribute{
+					{Key: "key", Value: "value"},
 				},
 			},
+			expected: &http.Tracing{
+				Enable:   "$otel_ratio_50",
+				Context:  "inject",
+				SpanName: "my-span",
+				SpanAttributes: []http.SpanAttribute{
+					{Key: "key", Value: "value"},
+				},
+			},
+			msg: "ratio with all settings",
+		},
+		{
+			tracing: &dataplane.Tracing{
+				Strategy: dataplane.TraceStrategyParent,
+			},
+			expected: &http.Tracing{
+				Enable: "$otel_parent_sampled",
+			},
+			msg: "parent strategy",
 		},
 	}
 
 	for _, tc := range tests {
-		result := createProxyPass(tc.grp, tc.rewrite, generateProtocolString(nil))
-		g.Expect(result).To(Equal(tc.expected))
+		t.Run(tc.msg, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := createTracing(tc.tracing)
+			g.Expect(result).To(Equal(tc.expected))
+		})
 	}
 }
 
-func TestCreateMatchLocation(t *testing.T) {
-	g := NewWithT(t)
+func TestCreateBackendLocations(t *testing.T) {
+	canaryFilters := &dataplane.HTTPFilters{
+		RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
+			Set: []dataplane.HTTPHeader{{Name: "X-Canary", Value: "true"}},
+		},
+	}
 
-	expected := http.Location{
-		Path: "/path",
+	createMatchRule := func(filters dataplane.HTTPFilters, backends ...dataplane.Backend) dataplane.MatchRule {
+		return dataplane.MatchRule{
+			Filters: filters,
+			BackendGroup: dataplane.BackendGroup{
+				Source:   types.NamespacedName{Namespace: "test", Name: "hr"},
+				RuleIdx:  0,
+				Backends: backends,
+			},
+		}
 	}
 
-	result := createMatchLocation("/path")
-	g.Expect(result).To(Equal(expected))
-}
+	fooBackend := dataplane.Backend{UpstreamName: "test_foo_80", Valid: true, Weight: 1}
+	canaryBackend := dataplane.Backend{UpstreamName: "test_canary_80", Valid: true, Weight: 1, Filters: canaryFilters}
+	invalidBackend := dataplane.Backend{Weight: 1}
 
-func TestGenerateProxySetHeaders(t *testing.T) {
 	tests := []struct {
-		filters         *dataplane.HTTPFilters
-		msg             string
-		expectedHeaders []http.Header
+		msg       string
+		expected  []http.Location
+		matchRule dataplane.MatchRule
 	}{
 		{
-			msg: "header filter",
-			filters: &dataplane.HTTPFilters{
-				RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
-					Add: []dataplane.HTTPHeader{
-						{
-							Name:  "Authorization",
-							Value: "my-auth",
-						},
-					},
-					Set: []dataplane.HTTPHeader{
-						{
-							Name:  "Accept-Encoding",
-							Value: "gzip",
-						},
-					},
-					Remove: []string{"my-header"},
-				},
-			},
-			expectedHeaders: []http.Header{
-				{
-					Name:  "Authorization",
-					Value: "${authorization_header_var}my-auth",
-				},
-				{
-					Name:  "Accept-Encoding",
-					Value: "gzip",
-				},
-				{
-					Name:  "my-header",
-					Value: "",
-				},
-				{
-					Name:  "Host",
-					Value: "$gw_api_compliant_host",
-				},
-				{
-					Name:  "X-Forwarded-For",
-					Value: "$proxy_add_x_forwarded_for",
-				},
-				{
-					Name:  "Upgrade",
-					Value: "$http_upgrade",
-				},
-				{
-					Name:  "Connection",
-					Value: "$connection_upgrade",
-				},
-			},
+			msg:       "no backend filters",
+			matchRule: createMatchRule(dataplane.HTTPFilters{}, fooBackend, fooBackend),
+			expected:  nil,
 		},
 		{
-			msg: "with url rewrite hostname",
-			filters: &dataplane.HTTPFilters{
-				RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
-					Add: []dataplane.HTTPHeader{
-						{
-							Name:  "Authorization",
-							Value: "my-auth",
-						},
+			msg:       "single backend with filters",
+			matchRule: createMatchRule(dataplane.HTTPFilters{}, canaryBackend),
+			expected:  nil,
+		},
+		{
+			msg: "backend filters with redirect",
+			matchRule: createMatchRule(
+				dataplane.HTTPFilters{RequestRedirect: &dataplane.HTTPRequestRedirectFilter{}},
+				fooBackend,
+				canaryBackend,
+			),
+			expected: nil,
+		},
+		{
+			msg: "zero weights",
+			matchRule: createMatchRule(
+				dataplane.HTTPFilters{},
+				dataplane.Backend{UpstreamName: "test_foo_80", Valid: true},
+				dataplane.Backend{UpstreamName: "test_canary_80", Valid: true, Filters: canaryFilters},
+			),
+			expected: nil,
+		},
+		{
+			msg: "multiple backends with filters",
+			matchRule: createMatchRule(
+				dataplane.HTTPFilters{
+					RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
+						Set: []dataplane.HTTPHeader{{Name: "X-Rule", Value: "rule"}},
 					},
 				},
-				RequestURLRewrite: &dataplane.HTTPURLRewriteFilter{
-					Hostname: helpers.GetPointer("rewrite-hostname"),
-				},
-			},
-			expectedHeaders: []http.Header{
-				{
-					Name:  "Authorization",
-					Value: "${authorization_header_var}my-auth",
-				},
-				{
-					Name:  "Host",
-					Value: "rewrite-hostname",
-				},
+				fooBackend,
+				canaryBackend,
+				invalidBackend,
+				invalidBackend,
+			),
+			expected: []http.Location{
 				{
-					Name:  "X-Forwarded-For",
-					Value: "$proxy_add_x_forwarded_for",
+					Path:      "@rule1-route2-test_foo_80",
+					ProxyPass: "http://test_foo_80$request_uri",
+					ProxySetHeaders: append(
+						[]http.Header{{Name: "X-Rule", Value: "rule"}},
+						baseHeaders...,
+					),
 				},
 				{
-					Name:  "Upgrade",
-					Value: "$http_upgrade",
+					Path:      "@rule1-route2-test_canary_80",
+					ProxyPass: "http://test_canary_80$request_uri",
+					ProxySetHeaders: append(
+						[]http.Header{{Name: "X-Rule", Value: "rule"}, {Name: "X-Canary", Value: "true"}},
+						baseHeaders...,
+					),
 				},
 				{
-					Name:  "Connection",
-					Value: "$connection_upgrade",
+					Path:      "@rule1-route2-invalid-backend-ref",
+					ProxyPass: "http://invalid-backend-ref$request_uri",
+					ProxySetHeaders: append(
+						[]http.Header{{Name: "X-Rule", Value: "rule"}},
+						baseHeaders...,
+					),
 				},
 			},
 		},
@@ -1830,97 +4300,61 @@ func TestGenerateProxySetHeaders(t *testing.T) {
 		t.Run(tc.msg, func(t *testing.T) {
 			g := NewWithT(t)
 
-			headers := generateProxySetHeaders(tc.filters)
-			g.Expect(headers).To(Equal(tc.expectedHeaders))
+			locs := createBackendLocations(1, 2, tc.matchRule, 80, "/", false)
+			g.Expect(helpers.Diff(tc.expected, locs)).To(BeEmpty())
 		})
 	}
 }
 
-func TestConvertBackendTLSFromGroup(t *testing.T) {
-	g := NewWithT(t)
+func TestMergeHeaderFilters(t *testing.T) {
+	ruleFilter := &dataplane.HTTPHeaderFilter{
+		Add:    []dataplane.HTTPHeader{{Name: "X-Add", Value: "rule"}, {Name: "X-Rule-Add", Value: "rule"}},
+		Set:    []dataplane.HTTPHeader{{Name: "X-Set", Value: "rule"}},
+		Remove: []string{"X-Remove", "X-Rule-Remove"},
+	}
+	backendFilter := &dataplane.HTTPHeaderFilter{
+		Add:    []dataplane.HTTPHeader{{Name: "x-set", Value: "backend"}},
+		Set:    []dataplane.HTTPHeader{{Name: "X-Add", Value: "backend"}},
+		Remove: []string{"X-REMOVE"},
+	}
 
 	tests := []struct {
-		expected *http.ProxySSLVerify
-		msg      string
-		grp      []dataplane.Backend
+		ruleFilter    *dataplane.HTTPHeaderFilter
+		backendFilter *dataplane.HTTPHeaderFilter
+		expected      *dataplane.HTTPHeaderFilter
+		msg           string
 	}{
 		{
-			msg: "tls enabled, one backend",
-			grp: []dataplane.Backend{
-				{
-					UpstreamName: "my-upstream",
-					Valid:        true,
-					Weight:       1,
-					VerifyTLS: &dataplane.VerifyTLS{
-						CertBundleID: "default-my-cert",
-						Hostname:     "my-hostname",
-					},
-				},
-			},
-			expected: &http.ProxySSLVerify{
-				TrustedCertificate: "/etc/nginx/secrets/default-my-cert.crt",
-				Name:               "my-hostname",
-			},
+			msg:      "no filters",
+			expected: nil,
 		},
 		{
-			msg: "tls disabled",
-			grp: []dataplane.Backend{
-				{
-					UpstreamName: "my-upstream",
-					Valid:        true,
-					Weight:       1,
-					VerifyTLS:    nil,
-				},
-			},
-			expected: nil,
+			msg:        "only rule filter",
+			ruleFilter: ruleFilter,
+			expected:   ruleFilter,
 		},
 		{
-			msg: "tls enabled, multiple backends",
-			grp: []dataplane.Backend{
-				{
-					UpstreamName: "my-upstream",
-					Valid:        true,
-					Weight:       1,
-					VerifyTLS: &dataplane.VerifyTLS{
-						CertBundleID: "default-my-cert",
-						Hostname:     "my-hostname",
-					},
-				},
-				{
-					UpstreamName: "my-upstream",
-					Valid:        true,
-					Weight:       2,
-				},
-			},
-			expected: &http.ProxySSLVerify{
-				TrustedCertificate: "/etc/nginx/secrets/default-my-cert.crt",
-				Name:               "my-hostname",
-			},
+			msg:           "only backend filter",
+			backendFilter: backendFilter,
+			expected:      backendFilter,
 		},
 		{
-			msg: "tls enabled, system certs enabled",
-			grp: []dataplane.Backend{
-				{
-					UpstreamName: "my-upstream",
-					Valid:        true,
-					Weight:       1,
-					VerifyTLS: &dataplane.VerifyTLS{
-						Hostname:   "my-hostname",
-						RootCAPath: "/etc/ssl/certs/ca-certificates.crt",
-					},
-				},
-			},
-			expected: &http.ProxySSLVerify{
-				TrustedCertificate: "/etc/ssl/certs/ca-certificates.crt",
-				Name:               "my-hostname",
+			msg:           "backend filter overrides rule filter",
+			ruleFilter:    ruleFilter,
+			backendFilter: backendFilter,
+			expected: &dataplane.HTTPHeaderFilter{
+				Add:    []dataplane.HTTPHeader{{Name: "X-Rule-Add", Value: "rule"}, {Name: "x-set", Value: "backend"}},
+				Set:    []dataplane.HTTPHeader{{Name: "X-Add", Value: "backend"}},
+				Remove: []string{"X-Rule-Remove", "X-REMOVE"},
 			},
 		},
 	}
 
 	for _, tc := range tests {
-		t.Run(tc.msg, func(_ *testing.T) {
-			result := createProxyTLSFromBackends(tc.grp)
-			g.Expect(result).To(Equal(tc.expected))
+		t.Run(tc.msg, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(mergeHeaderFilters(tc.ruleFilter, tc.backendFilter)).To(Equal(tc.expected))
 		})
 	}
 }
@@ -3,18 +3,34 @@ package config
 import (
 	"fmt"
 	"math"
+	"slices"
 	gotemplate "text/template"
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 )
 
-var splitClientsTemplate = gotemplate.Must(gotemplate.New("split_clients").Parse(splitClientsTemplateText))
+var (
+	splitClientsTemplate          = gotemplate.Must(gotemplate.New("split_clients").Parse(splitClientsTemplateText))
+	otelRatioSplitClientsTemplate = gotemplate.Must(
+		gotemplate.New("otelRatioSplitClients").Parse(otelRatioSplitClientsTemplateText),
+	)
+	streamSplitClientsTemplate = gotemplate.Must(
+		gotemplate.New("streamSplitClients").Parse(streamSplitClientsTemplateText),
+	)
+)
 
 func executeSplitClients(conf dataplane.Configuration) []byte {
 	splitClients := createSplitClients(conf.BackendGroups)
+	result := execute(splitClientsTemplate, splitClients)
+
+	servers := make([]dataplane.VirtualServer, 0, len(conf.HTTPServers)+len(conf.SSLServers))
+	servers = append(servers, conf.HTTPServers...)
+	servers = append(servers, conf.SSLServers...)
 
-	return execute(splitClientsTemplate, splitClients)
+	otelRatioSplitClients := createOtelRatioSplitClients(servers)
+
+	return append(result, execute(otelRatioSplitClientsTemplate, otelRatioSplitClients)...)
 }
 
 func createSplitClients(backendGroups []dataplane.BackendGroup) []http.SplitClient {
@@ -48,6 +64,103 @@ func createSplitClients(backendGroups []dataplane.BackendGroup) []http.SplitClie
 	return splitClients
 }
 
+// createOtelRatioSplitClients creates a split client for every tracing ratio used by the rules of the servers.
+// The split clients are keyed by the trace ID, so that the sampling decision is the same for all spans of a trace.
+// The ratios of 0 and 100 don't need a split client, because tracing is switched off or on for them.
+func createOtelRatioSplitClients(servers []dataplane.VirtualServer) []http.SplitClient {
+	var ratios []int32
+
+	for _, s := range servers {
+		for _, pr := range s.PathRules {
+			for _, mr := range pr.MatchRules {
+				t := mr.Policies.Tracing
+				if t == nil || t.Strategy != dataplane.TraceStrategyRatio || t.Ratio == nil {
+					continue
+				}
+
+				if *t.Ratio <= 0 || *t.Ratio >= 100 || slices.Contains(ratios, *t.Ratio) {
+					continue
+				}
+
+				ratios = append(ratios, *t.Ratio)
+			}
+		}
+	}
+
+	slices.Sort(ratios)
+
+	splitClients := make([]http.SplitClient, 0, len(ratios))
+
+	for _, ratio := range ratios {
+		splitClients = append(splitClients, http.SplitClient{
+			VariableName: otelRatioVariableName(ratio),
+			Distributions: []http.SplitClientDistribution{
+				{
+					Percent: fmt.Sprintf("%.2f", float64(ratio)),
+					Value:   "on",
+				},
+				{
+					Percent: fmt.Sprintf("%.2f", float64(100-ratio)),
+					Value:   "off",
+				},
+			},
+		})
+	}
+
+	return splitClients
+}
+
+func executeStreamSplitClients(conf dataplane.Configuration) []byte {
+	servers := make(
+		[]dataplane.Layer4VirtualServer,
+		0,
+		len(conf.TLSPassthroughServers)+len(conf.TCPServers)+len(conf.UDPServers),
+	)
+	servers = append(servers, conf.TLSPassthroughServers...)
+	servers = append(servers, conf.TCPServers...)
+	servers = append(servers, conf.UDPServers...)
+
+	splitClients := createStreamSplitClients(servers, getStreamUpstreamsWithEndpoints(conf.StreamUpstreams))
+
+	return execute(streamSplitClientsTemplate, splitClients)
+}
+
+// createStreamSplitClients creates the split clients for the backend groups of the layer 4 servers.
+// The same backend group can be used by multiple servers (for example, a TLSRoute with multiple hostnames),
+// so a split client is only created once for every group.
+func createStreamSplitClients(
+	servers []dataplane.Layer4VirtualServer,
+	upstreams map[string]struct{},
+) []http.SplitClient {
+	var splitClients []http.SplitClient
+
+	seen := make(map[string]struct{})
+
+	for _, s := range servers {
+		if !backendGroupNeedsSplit(s.BackendGroup) {
+			continue
+		}
+
+		name := s.BackendGroup.Name()
+		if _, exists := seen[name]; exists {
+			continue
+		}
+		seen[name] = struct{}{}
+
+		distributions := createSplitClientDistributions(s.BackendGroup)
+		for i := range distributions {
+			distributions[i].Value = getLayer4UpstreamName(distributions[i].Value, upstreams)
+		}
+
+		splitClients = append(splitClients, http.SplitClient{
+			VariableName:  convertStringToSafeVariableName(name),
+			Distributions: distributions,
+		})
+	}
+
+	return splitClients
+}
+
 func createSplitClientDistributions(group dataplane.BackendGroup) []http.SplitClientDistribution {
 	if !backendGroupNeedsSplit(group) {
 		return nil
@@ -121,6 +234,27 @@ func backendGroupNeedsSplit(group dataplane.BackendGroup) bool {
 	return len(group.Backends) > 1
 }
 
+// layer4BackendGroupName returns the name of the stream upstream or the variable of the split client for the backend
+// group of a layer 4 server.
+func layer4BackendGroupName(group dataplane.BackendGroup, upstreams map[string]struct{}) string {
+	if backendGroupNeedsSplit(group) {
+		return "$" + convertStringToSafeVariableName(group.Name())
+	}
+
+	return getLayer4UpstreamName(backendGroupName(group), upstreams)
+}
+
+// getLayer4UpstreamName returns the name of the stream upstream if it was generated. Otherwise, it returns the name
+// of the invalid backend upstream. Unlike in the http context, the stream upstreams are not generated for
+// the backends without endpoints.
+func getLayer4UpstreamName(name string, upstreams map[string]struct{}) string {
+	if _, exists := upstreams[name]; exists {
+		return name
+	}
+
+	return invalidBackendRef
+}
+
 // backendGroupName returns the name of the backend group.
 // If the group needs to be split, the name returned is the group name.
 // If the group doesn't need to be split, the name returned is the name of the backend if it is valid.
@@ -13,3 +13,27 @@ split_clients $request_id ${{ $sc.VariableName }} {
 }
 {{ end }}
 `
+
+var streamSplitClientsTemplateText = `
+{{- range $sc := . }}
+split_clients $connection ${{ $sc.VariableName }} {
+    {{- range $d := $sc.Distributions }}
+        {{- if eq $d.Percent "0.00" }}
+    # {{ $d.Percent }}% {{ $d.Value }};
+        {{- else }}
+    {{ $d.Percent }}% {{ $d.Value }};
+        {{- end }}
+    {{- end }}
+}
+{{ end -}}
+`
+
+var otelRatioSplitClientsTemplateText = `
+{{- range $sc := . }}
+split_clients $otel_trace_id ${{ $sc.VariableName }} {
+    {{- range $d := $sc.Distributions }}
+    {{ $d.Percent }}% {{ $d.Value }};
+    {{- end }}
+}
+{{ end -}}
+`
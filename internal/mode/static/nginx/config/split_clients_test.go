@@ -6,8 +6,10 @@ import (
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
 )
 
 func TestExecuteSplitClients(t *testing.T) {
@@ -111,6 +113,100 @@ func TestExecuteSplitClients(t *testing.T) {
 	}
 }
 
+func TestExecuteSplitClientsWithTracing(t *testing.T) {
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				PathRules: []dataplane.PathRule{
+					{
+						MatchRules: []dataplane.MatchRule{
+							{
+								Policies: dataplane.RulePolicies{
+									Tracing: &dataplane.Tracing{
+										Strategy: dataplane.TraceStrategyRatio,
+										Ratio:    helpers.GetPointer[int32](25),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expStrings := []string{
+		"split_clients $otel_trace_id $otel_ratio_25",
+		"25.00% on;",
+		"75.00% off;",
+	}
+
+	g := NewWithT(t)
+	sc := string(executeSplitClients(conf))
+
+	for _, expSubString := range expStrings {
+		g.Expect(sc).To(ContainSubstring(expSubString))
+	}
+	g.Expect(sc).ToNot(ContainSubstring("$request_id"))
+}
+
+func TestCreateOtelRatioSplitClients(t *testing.T) {
+	createServer := func(tracings ...*dataplane.Tracing) dataplane.VirtualServer {
+		matchRules := make([]dataplane.MatchRule, 0, len(tracings))
+		for _, t := range tracings {
+			matchRules = append(matchRules, dataplane.MatchRule{Policies: dataplane.RulePolicies{Tracing: t}})
+		}
+
+		return dataplane.VirtualServer{
+			PathRules: []dataplane.PathRule{{MatchRules: matchRules}},
+		}
+	}
+
+	createRatioTracing := func(ratio *int32) *dataplane.Tracing {
+		return &dataplane.Tracing{
+			Strategy: dataplane.TraceStrategyRatio,
+			Ratio:    ratio,
+		}
+	}
+
+	servers := []dataplane.VirtualServer{
+		createServer(
+			createRatioTracing(helpers.GetPointer[int32](50)),
+			createRatioTracing(helpers.GetPointer[int32](10)),
+			createRatioTracing(nil),
+			nil,
+		),
+		createServer(
+			createRatioTracing(helpers.GetPointer[int32](10)),
+			createRatioTracing(helpers.GetPointer[int32](0)),
+			createRatioTracing(helpers.GetPointer[int32](100)),
+			&dataplane.Tracing{Strategy: dataplane.TraceStrategyParent},
+		),
+	}
+
+	expected := []http.SplitClient{
+		{
+			VariableName: "otel_ratio_10",
+			Distributions: []http.SplitClientDistribution{
+				{Percent: "10.00", Value: "on"},
+				{Percent: "90.00", Value: "off"},
+			},
+		},
+		{
+			VariableName: "otel_ratio_50",
+			Distributions: []http.SplitClientDistribution{
+				{Percent: "50.00", Value: "on"},
+				{Percent: "50.00", Value: "off"},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(createOtelRatioSplitClients(servers)).To(Equal(expected))
+	g.Expect(createOtelRatioSplitClients(nil)).To(BeEmpty())
+}
+
 func TestCreateSplitClients(t *testing.T) {
 	hrNoSplit := types.NamespacedName{Namespace: "test", Name: "hr-no-split"}
 	hrOneSplit := types.NamespacedName{Namespace: "test", Name: "hr-one-split"}
@@ -678,3 +774,196 @@ func TestBackendGroupName(t *testing.T) {
 		})
 	}
 }
+
+func TestExecuteStreamSplitClients(t *testing.T) {
+	bg := dataplane.BackendGroup{
+		Source: types.NamespacedName{Namespace: "test", Name: "tcp"},
+		Backends: []dataplane.Backend{
+			{UpstreamName: "test1", Valid: true, Weight: 1},
+			{UpstreamName: "test2", Valid: true, Weight: 1},
+		},
+	}
+
+	conf := dataplane.Configuration{
+		TCPServers: []dataplane.Layer4VirtualServer{
+			{
+				Port:         5432,
+				BackendGroup: bg,
+			},
+		},
+		UDPServers: []dataplane.Layer4VirtualServer{
+			{
+				Port: 53,
+				BackendGroup: dataplane.BackendGroup{
+					Source: types.NamespacedName{Namespace: "test", Name: "udp"},
+					Backends: []dataplane.Backend{
+						{UpstreamName: "test1", Valid: true, Weight: 3},
+						{UpstreamName: "test2", Valid: true, Weight: 1},
+					},
+				},
+			},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{
+				Name:      "test1",
+				Endpoints: []resolver.Endpoint{{Address: "10.0.0.0", Port: 80}},
+			},
+		},
+	}
+
+	expSubStrings := []string{
+		"split_clients $connection $test__tcp_rule0",
+		"50.00% test1;",
+		"50.00% invalid-backend-ref;",
+		"split_clients $connection $test__udp_rule0",
+		"75.00% test1;",
+		"25.00% invalid-backend-ref;",
+	}
+
+	g := NewWithT(t)
+
+	sc := string(executeStreamSplitClients(conf))
+	for _, expSubString := range expSubStrings {
+		g.Expect(sc).To(ContainSubstring(expSubString))
+	}
+}
+
+func TestCreateStreamSplitClients(t *testing.T) {
+	createBackendGroup := func(name string, backends ...dataplane.Backend) dataplane.BackendGroup {
+		return dataplane.BackendGroup{
+			Source:   types.NamespacedName{Namespace: "test", Name: name},
+			Backends: backends,
+		}
+	}
+
+	backend1 := dataplane.Backend{UpstreamName: "backend1", Valid: true, Weight: 1}
+	backend2 := dataplane.Backend{UpstreamName: "backend2", Valid: true, Weight: 3}
+	invalidBackend := dataplane.Backend{UpstreamName: "invalid", Valid: false, Weight: 1}
+	noEndpointsBackend := dataplane.Backend{UpstreamName: "no-endpoints", Valid: true, Weight: 1}
+
+	trGroup := createBackendGroup("tr", backend1, backend2)
+
+	servers := []dataplane.Layer4VirtualServer{
+		{
+			Hostname:     "app.example.com",
+			Port:         8443,
+			BackendGroup: trGroup,
+		},
+		{
+			// the same group must not produce a second split client
+			Hostname:     "cafe.example.com",
+			Port:         8443,
+			BackendGroup: trGroup,
+		},
+		{
+			// no split needed
+			Port:         5432,
+			BackendGroup: createBackendGroup("tcp-1", backend1),
+		},
+		{
+			Port:         5433,
+			BackendGroup: createBackendGroup("tcp-2", backend1, invalidBackend, noEndpointsBackend),
+		},
+	}
+
+	upstreams := map[string]struct{}{
+		"backend1": {},
+		"backend2": {},
+	}
+
+	expSplitClients := []http.SplitClient{
+		{
+			VariableName: "test__tr_rule0",
+			Distributions: []http.SplitClientDistribution{
+				{
+					Percent: "25.00",
+					Value:   "backend1",
+				},
+				{
+					Percent: "75.00",
+					Value:   "backend2",
+				},
+			},
+		},
+		{
+			VariableName: "test__tcp_2_rule0",
+			Distributions: []http.SplitClientDistribution{
+				{
+					Percent: "33.33",
+					Value:   "backend1",
+				},
+				{
+					Percent: "33.33",
+					Value:   invalidBackendRef,
+				},
+				{
+					Percent: "33.34",
+					Value:   invalidBackendRef,
+				},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(createStreamSplitClients(servers, upstreams)).To(Equal(expSplitClients))
+	g.Expect(createStreamSplitClients(nil, upstreams)).To(BeNil())
+}
+
+func TestLayer4BackendGroupName(t *testing.T) {
+	upstreams := map[string]struct{}{
+		"backend1": {},
+		"backend2": {},
+	}
+
+	tests := []struct {
+		msg      string
+		expected string
+		group    dataplane.BackendGroup
+	}{
+		{
+			msg: "single valid backend with endpoints",
+			group: dataplane.BackendGroup{
+				Backends: []dataplane.Backend{{UpstreamName: "backend1", Valid: true, Weight: 1}},
+			},
+			expected: "backend1",
+		},
+		{
+			msg: "single valid backend without endpoints",
+			group: dataplane.BackendGroup{
+				Backends: []dataplane.Backend{{UpstreamName: "no-endpoints", Valid: true, Weight: 1}},
+			},
+			expected: invalidBackendRef,
+		},
+		{
+			msg: "single invalid backend",
+			group: dataplane.BackendGroup{
+				Backends: []dataplane.Backend{{UpstreamName: "backend1", Valid: false, Weight: 1}},
+			},
+			expected: invalidBackendRef,
+		},
+		{
+			msg:      "no backends",
+			group:    dataplane.BackendGroup{},
+			expected: invalidBackendRef,
+		},
+		{
+			msg: "multiple backends",
+			group: dataplane.BackendGroup{
+				Source: types.NamespacedName{Namespace: "test", Name: "tcp"},
+				Backends: []dataplane.Backend{
+					{UpstreamName: "backend1", Valid: true, Weight: 1},
+					{UpstreamName: "backend2", Valid: true, Weight: 1},
+				},
+			},
+			expected: "$test__tcp_rule0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(layer4BackendGroupName(test.group, upstreams)).To(Equal(test.expected))
+		})
+	}
+}
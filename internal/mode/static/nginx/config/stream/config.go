@@ -0,0 +1,37 @@
+package stream
+
+// Server holds all configuration for a stream server.
+type Server struct {
+	Listen     string
+	ProxyPass  string
+	SSLPreread bool
+	UDP        bool
+}
+
+// Upstream holds all configuration for a stream upstream.
+type Upstream struct {
+	Name     string
+	ZoneSize string // format: 512k, 1m
+	Servers  []UpstreamServer
+}
+
+// UpstreamServer holds all configuration for a stream upstream server.
+type UpstreamServer struct {
+	Address string
+	Down    bool
+}
+
+// Map defines an NGINX map.
+type Map struct {
+	Source     string
+	Variable   string
+	Parameters []MapParameter
+	// UseHostnames indicates that the source values of the map can be hostnames with a prefix or suffix mask.
+	UseHostnames bool
+}
+
+// MapParameter defines a Value and Result pair in a Map.
+type MapParameter struct {
+	Value  string
+	Result string
+}
@@ -0,0 +1,80 @@
+package config
+
+import (
+	"fmt"
+	gotemplate "text/template"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/stream"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var streamServersTemplate = gotemplate.Must(gotemplate.New("streamServers").Parse(streamServersTemplateText))
+
+func executeStreamServers(conf dataplane.Configuration) []byte {
+	streamServers := createStreamServers(
+		conf.TLSPassthroughServers,
+		conf.TCPServers,
+		conf.UDPServers,
+		getStreamUpstreamsWithEndpoints(conf.StreamUpstreams),
+	)
+
+	return execute(streamServersTemplate, streamServers)
+}
+
+// createStreamServers creates the stream servers.
+// For TLS passthrough, it creates a server for every port. The server reads the SNI of the connection without
+// terminating TLS and proxies the connection to the upstream chosen by the map of the port.
+// For TCP and UDP, it creates a server for every TCP and UDP server, which proxies the traffic to the upstream of
+// its backends.
+func createStreamServers(
+	tlsPassthroughServers []dataplane.Layer4VirtualServer,
+	tcpServers []dataplane.Layer4VirtualServer,
+	udpServers []dataplane.Layer4VirtualServer,
+	upstreams map[string]struct{},
+) []stream.Server {
+	ports := getTLSPassthroughPorts(tlsPassthroughServers)
+
+	servers := make([]stream.Server, 0, len(ports)+len(tcpServers)+len(udpServers))
+
+	for _, port := range ports {
+		servers = append(servers, stream.Server{
+			Listen:     fmt.Sprint(port),
+			ProxyPass:  "$" + generateTLSPassthroughVariableName(port),
+			SSLPreread: true,
+		})
+	}
+
+	for _, s := range tcpServers {
+		servers = append(servers, stream.Server{
+			Listen:    fmt.Sprint(s.Port),
+			ProxyPass: layer4BackendGroupName(s.BackendGroup, upstreams),
+		})
+	}
+
+	for _, s := range udpServers {
+		servers = append(servers, stream.Server{
+			Listen:    fmt.Sprint(s.Port),
+			ProxyPass: layer4BackendGroupName(s.BackendGroup, upstreams),
+			UDP:       true,
+		})
+	}
+
+	return servers
+}
+
+// getTLSPassthroughPorts returns the unique ports of the TLS passthrough servers, preserving their order.
+func getTLSPassthroughPorts(tlsPassthroughServers []dataplane.Layer4VirtualServer) []int32 {
+	seen := make(map[int32]struct{})
+	var ports []int32
+
+	for _, s := range tlsPassthroughServers {
+		if _, exists := seen[s.Port]; exists {
+			continue
+		}
+
+		seen[s.Port] = struct{}{}
+		ports = append(ports, s.Port)
+	}
+
+	return ports
+}
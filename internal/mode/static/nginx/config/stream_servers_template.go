@@ -0,0 +1,13 @@
+package config
+
+var streamServersTemplateText = `
+{{- range $s := . }}
+server {
+    listen {{ $s.Listen }}{{ if $s.UDP }} udp{{ end }};
+    {{- if $s.SSLPreread }}
+    ssl_preread on;
+    {{- end }}
+    proxy_pass {{ $s.ProxyPass }};
+}
+{{ end -}}
+`
@@ -0,0 +1,240 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/stream"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
+)
+
+func createLayer4BackendGroup(name string, backends ...dataplane.Backend) dataplane.BackendGroup {
+	return dataplane.BackendGroup{
+		Source:   types.NamespacedName{Namespace: "test", Name: name},
+		Backends: backends,
+	}
+}
+
+func TestExecuteStreamServers(t *testing.T) {
+	backend1 := dataplane.Backend{UpstreamName: "backend1", Weight: 1, Valid: true}
+	backend2 := dataplane.Backend{UpstreamName: "backend2", Weight: 1, Valid: true}
+
+	conf := dataplane.Configuration{
+		TLSPassthroughServers: []dataplane.Layer4VirtualServer{
+			{
+				Hostname:     "example.com",
+				Port:         8081,
+				BackendGroup: createLayer4BackendGroup("tr-1", backend1),
+			},
+			{
+				Hostname:     "cafe.example.com",
+				Port:         8081,
+				BackendGroup: createLayer4BackendGroup("tr-2", backend2),
+			},
+			{
+				Hostname:     "app.example.com",
+				Port:         8082,
+				BackendGroup: createLayer4BackendGroup("tr-3", backend1),
+			},
+		},
+		TCPServers: []dataplane.Layer4VirtualServer{
+			{
+				Port:         5432,
+				BackendGroup: createLayer4BackendGroup("tcp-1", backend1),
+			},
+			{
+				Port:         6379,
+				BackendGroup: createLayer4BackendGroup("tcp-2", backend1, backend2),
+			},
+		},
+		UDPServers: []dataplane.Layer4VirtualServer{
+			{
+				Port:         53,
+				BackendGroup: createLayer4BackendGroup("udp-1", backend1, backend2),
+			},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{
+				Name:      "backend1",
+				Endpoints: []resolver.Endpoint{{Address: "1.1.1.1", Port: 80}},
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"listen 8081;":   1,
+		"listen 8082;":   1,
+		"listen 5432;":   1,
+		"listen 6379;":   1,
+		"listen 53 udp;": 1,
+		"proxy_pass $tls_passthrough_upstream_8081;": 1,
+		"proxy_pass $tls_passthrough_upstream_8082;": 1,
+		"proxy_pass backend1;":                       1,
+		"proxy_pass $test__tcp_2_rule0;":             1,
+		"proxy_pass $test__udp_1_rule0;":             1,
+		"ssl_preread on;":                            2,
+	}
+
+	g := NewWithT(t)
+
+	servers := string(executeStreamServers(conf))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(servers, expSubStr)).To(Equal(expCount))
+	}
+}
+
+func TestCreateStreamServers(t *testing.T) {
+	validBackend := dataplane.Backend{UpstreamName: "backend1", Weight: 1, Valid: true}
+
+	upstreams := map[string]struct{}{
+		"backend1": {},
+	}
+
+	tests := []struct {
+		msg                   string
+		tlsPassthroughServers []dataplane.Layer4VirtualServer
+		tcpServers            []dataplane.Layer4VirtualServer
+		udpServers            []dataplane.Layer4VirtualServer
+		expected              []stream.Server
+	}{
+		{
+			msg:      "no servers",
+			expected: []stream.Server{},
+		},
+		{
+			msg: "TLS passthrough servers on multiple ports",
+			tlsPassthroughServers: []dataplane.Layer4VirtualServer{
+				{
+					Hostname:     "example.com",
+					Port:         8081,
+					BackendGroup: createLayer4BackendGroup("tr-1", validBackend),
+				},
+				{
+					Hostname:     "cafe.example.com",
+					Port:         8081,
+					BackendGroup: createLayer4BackendGroup("tr-2", validBackend),
+				},
+				{
+					Hostname: "app.example.com",
+					Port:     8082,
+				},
+			},
+			expected: []stream.Server{
+				{
+					Listen:     "8081",
+					ProxyPass:  "$tls_passthrough_upstream_8081",
+					SSLPreread: true,
+				},
+				{
+					Listen:     "8082",
+					ProxyPass:  "$tls_passthrough_upstream_8082",
+					SSLPreread: true,
+				},
+			},
+		},
+		{
+			msg: "TCP servers",
+			tcpServers: []dataplane.Layer4VirtualServer{
+				{
+					Port:         5432,
+					BackendGroup: createLayer4BackendGroup("tcp-1", validBackend),
+				},
+				{
+					Port: 5433,
+					BackendGroup: createLayer4BackendGroup(
+						"tcp-2",
+						dataplane.Backend{UpstreamName: "no-endpoints", Weight: 1, Valid: true},
+					),
+				},
+				{
+					Port: 5434,
+					BackendGroup: createLayer4BackendGroup(
+						"tcp-3",
+						dataplane.Backend{UpstreamName: "invalid", Weight: 1, Valid: false},
+					),
+				},
+				{
+					Port:         5435,
+					BackendGroup: createLayer4BackendGroup("tcp-4"),
+				},
+				{
+					Port: 5436,
+					BackendGroup: createLayer4BackendGroup(
+						"tcp-5",
+						validBackend,
+						dataplane.Backend{UpstreamName: "backend2", Weight: 1, Valid: true},
+					),
+				},
+			},
+			expected: []stream.Server{
+				{
+					Listen:    "5432",
+					ProxyPass: "backend1",
+				},
+				{
+					Listen:    "5433",
+					ProxyPass: invalidBackendRef,
+				},
+				{
+					Listen:    "5434",
+					ProxyPass: invalidBackendRef,
+				},
+				{
+					Listen:    "5435",
+					ProxyPass: invalidBackendRef,
+				},
+				{
+					Listen:    "5436",
+					ProxyPass: "$test__tcp_5_rule0",
+				},
+			},
+		},
+		{
+			msg: "TCP and UDP servers on the same port",
+			tcpServers: []dataplane.Layer4VirtualServer{
+				{
+					Port:         53,
+					BackendGroup: createLayer4BackendGroup("dns-tcp", validBackend),
+				},
+			},
+			udpServers: []dataplane.Layer4VirtualServer{
+				{
+					Port:         53,
+					BackendGroup: createLayer4BackendGroup("dns-udp", validBackend),
+				},
+				{
+					Port:         514,
+					BackendGroup: createLayer4BackendGroup("syslog"),
+				},
+			},
+			expected: []stream.Server{
+				{
+					Listen:    "53",
+					ProxyPass: "backend1",
+				},
+				{
+					Listen:    "53",
+					ProxyPass: "backend1",
+					UDP:       true,
+				},
+				{
+					Listen:    "514",
+					ProxyPass: invalidBackendRef,
+					UDP:       true,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(createStreamServers(test.tlsPassthroughServers, test.tcpServers, test.udpServers, upstreams)).
+				To(Equal(test.expected))
+		})
+	}
+}
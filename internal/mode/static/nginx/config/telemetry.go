@@ -0,0 +1,35 @@
+package config
+
+import (
+	gotemplate "text/template"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var telemetryTemplate = gotemplate.Must(gotemplate.New("telemetry").Parse(telemetryTemplateText))
+
+func executeTelemetry(conf dataplane.Configuration) []byte {
+	// the otel_exporter block requires an endpoint, so nothing is generated if telemetry is not configured
+	if conf.Telemetry.Endpoint == "" {
+		return nil
+	}
+
+	return execute(telemetryTemplate, createTelemetry(conf.Telemetry))
+}
+
+func createTelemetry(telemetry dataplane.Telemetry) http.Telemetry {
+	t := http.Telemetry{
+		Endpoint:    telemetry.Endpoint,
+		ServiceName: telemetry.ServiceName,
+		Interval:    telemetry.Interval,
+		BatchSize:   telemetry.BatchSize,
+		BatchCount:  telemetry.BatchCount,
+	}
+
+	for _, attr := range telemetry.SpanAttributes {
+		t.SpanAttributes = append(t.SpanAttributes, http.SpanAttribute{Key: attr.Key, Value: attr.Value})
+	}
+
+	return t
+}
@@ -0,0 +1,21 @@
+package config
+
+var telemetryTemplateText = `
+otel_exporter {
+    endpoint {{ .Endpoint }};
+    {{- if .Interval }}
+    interval {{ .Interval }};
+    {{- end }}
+    {{- if .BatchSize }}
+    batch_size {{ .BatchSize }};
+    {{- end }}
+    {{- if .BatchCount }}
+    batch_count {{ .BatchCount }};
+    {{- end }}
+}
+
+otel_service_name {{ .ServiceName }};
+{{- range $a := .SpanAttributes }}
+otel_span_attr "{{ $a.Key }}" "{{ $a.Value }}";
+{{- end }}
+`
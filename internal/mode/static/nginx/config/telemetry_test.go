@@ -0,0 +1,111 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteTelemetry(t *testing.T) {
+	tests := []struct {
+		expSubStrings map[string]int
+		msg           string
+		telemetry     dataplane.Telemetry
+	}{
+		{
+			msg:           "telemetry is not configured",
+			telemetry:     dataplane.Telemetry{},
+			expSubStrings: map[string]int{},
+		},
+		{
+			msg: "only endpoint is configured",
+			telemetry: dataplane.Telemetry{
+				Endpoint:    "my-otel.svc:4317",
+				ServiceName: "ngf:test:gateway",
+			},
+			expSubStrings: map[string]int{
+				"otel_exporter {":                     1,
+				"endpoint my-otel.svc:4317;":          1,
+				"interval":                            0,
+				"batch_size":                          0,
+				"batch_count":                         0,
+				"otel_service_name ngf:test:gateway;": 1,
+				"otel_span_attr":                      0,
+			},
+		},
+		{
+			msg: "all fields are configured",
+			telemetry: dataplane.Telemetry{
+				Endpoint:    "my-otel.svc:4317",
+				ServiceName: "ngf:test:gateway:my-svc",
+				Interval:    "5s",
+				BatchSize:   512,
+				BatchCount:  4,
+				SpanAttributes: []dataplane.SpanAttribute{
+					{Key: "key1", Value: "value1"},
+					{Key: "key2", Value: "value2"},
+				},
+			},
+			expSubStrings: map[string]int{
+				"otel_exporter {":                            1,
+				"endpoint my-otel.svc:4317;":                 1,
+				"interval 5s;":                               1,
+				"batch_size 512;":                            1,
+				"batch_count 4;":                             1,
+				"otel_service_name ngf:test:gateway:my-svc;": 1,
+				`otel_span_attr "key1" "value1";`:            1,
+				`otel_span_attr "key2" "value2";`:            1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewWithT(t)
+
+			conf := dataplane.Configuration{Telemetry: test.telemetry}
+
+			result := string(executeTelemetry(conf))
+			if len(test.expSubStrings) == 0 {
+				g.Expect(result).To(BeEmpty())
+				return
+			}
+
+			for expSubStr, expCount := range test.expSubStrings {
+				g.Expect(strings.Count(result, expSubStr)).To(Equal(expCount), expSubStr)
+			}
+		})
+	}
+}
+
+func TestCreateTelemetry(t *testing.T) {
+	g := NewWithT(t)
+
+	telemetry := dataplane.Telemetry{
+		Endpoint:    "my-otel.svc:4317",
+		ServiceName: "ngf:test:gateway",
+		Interval:    "5s",
+		BatchSize:   512,
+		BatchCount:  4,
+		SpanAttributes: []dataplane.SpanAttribute{
+			{Key: "key", Value: "value"},
+		},
+	}
+
+	expected := http.Telemetry{
+		Endpoint:    "my-otel.svc:4317",
+		ServiceName: "ngf:test:gateway",
+		Interval:    "5s",
+		BatchSize:   512,
+		BatchCount:  4,
+		SpanAttributes: []http.SpanAttribute{
+			{Key: "key", Value: "value"},
+		},
+	}
+
+	g.Expect(createTelemetry(telemetry)).To(Equal(expected))
+}
@@ -5,10 +5,14 @@ import (
 	gotemplate "text/template"
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/stream"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 )
 
-var upstreamsTemplate = gotemplate.Must(gotemplate.New("upstreams").Parse(upstreamsTemplateText))
+var (
+	upstreamsTemplate       = gotemplate.Must(gotemplate.New("upstreams").Parse(upstreamsTemplateText))
+	streamUpstreamsTemplate = gotemplate.Must(gotemplate.New("streamUpstreams").Parse(streamUpstreamsTemplateText))
+)
 
 const (
 	// nginx502Server is used as a backend for services that cannot be resolved (have no IP address).
@@ -23,6 +27,11 @@ const (
 	plusZoneSize = "1m"
 	// invalidBackendZoneSize is the upstream zone size for the invalid backend upstream.
 	invalidBackendZoneSize = "32k"
+	// invalidBackendStreamServer is the address of the server of the invalid backend upstream in the stream context.
+	// The server is always marked as down, so NGINX never connects to it.
+	invalidBackendStreamServer = "127.0.0.1:1"
+	// defaultLoadBalancingMethod is the load balancing method of the upstreams that don't configure one.
+	defaultLoadBalancingMethod = "random two least_conn"
 )
 
 func (g GeneratorImpl) executeUpstreams(conf dataplane.Configuration) []byte {
@@ -45,41 +54,161 @@ func (g GeneratorImpl) createUpstreams(upstreams []dataplane.Upstream) []http.Up
 }
 
 func (g GeneratorImpl) createUpstream(up dataplane.Upstream) http.Upstream {
-	zoneSize := ossZoneSize
+	upstream := http.Upstream{
+		Name:                up.Name,
+		ZoneSize:            ossZoneSize,
+		LoadBalancingMethod: defaultLoadBalancingMethod,
+	}
 	if g.plus {
-		zoneSize = plusZoneSize
+		upstream.ZoneSize = plusZoneSize
+	}
+
+	if settings := up.Settings; settings != nil {
+		if settings.ZoneSize != "" {
+			upstream.ZoneSize = settings.ZoneSize
+		}
+
+		if settings.LoadBalancingMethod != "" {
+			upstream.LoadBalancingMethod = getLoadBalancingDirective(settings)
+		}
+
+		upstream.KeepAlive = createUpstreamKeepAlive(settings)
 	}
 
 	if len(up.Endpoints) == 0 {
-		return http.Upstream{
-			Name:     up.Name,
-			ZoneSize: zoneSize,
-			Servers: []http.UpstreamServer{
-				{
-					Address: nginx502Server,
-				},
+		upstream.Servers = []http.UpstreamServer{
+			{
+				Address: nginx502Server,
 			},
 		}
+
+		return upstream
 	}
 
-	upstreamServers := make([]http.UpstreamServer, len(up.Endpoints))
+	upstream.Servers = make([]http.UpstreamServer, len(up.Endpoints))
 	for idx, ep := range up.Endpoints {
-		upstreamServers[idx] = http.UpstreamServer{
+		upstream.Servers[idx] = http.UpstreamServer{
 			Address: fmt.Sprintf("%s:%d", ep.Address, ep.Port),
 		}
 	}
 
-	return http.Upstream{
+	return upstream
+}
+
+// getLoadBalancingDirective returns the load balancing directive of the method of the settings.
+// Round robin is the NGINX default, so it doesn't have a directive.
+func getLoadBalancingDirective(settings *dataplane.UpstreamSettings) string {
+	switch settings.LoadBalancingMethod {
+	case dataplane.LoadBalancingLeastConn:
+		return "least_conn"
+	case dataplane.LoadBalancingIPHash:
+		return "ip_hash"
+	case dataplane.LoadBalancingHash:
+		if settings.HashConsistent {
+			return "hash " + settings.HashKey + " consistent"
+		}
+		return "hash " + settings.HashKey
+	case dataplane.LoadBalancingRandom:
+		return "random"
+	default:
+		return ""
+	}
+}
+
+// createUpstreamKeepAlive returns the keep-alive configuration of the settings. It returns nil if the
+// keep-alive connections are not enabled.
+func createUpstreamKeepAlive(settings *dataplane.UpstreamSettings) *http.UpstreamKeepAlive {
+	if settings.KeepAliveConnections == nil {
+		return nil
+	}
+
+	return &http.UpstreamKeepAlive{
+		Connections: settings.KeepAliveConnections,
+		Requests:    settings.KeepAliveRequests,
+		Time:        settings.KeepAliveTime,
+		Timeout:     settings.KeepAliveTimeout,
+	}
+}
+
+func (g GeneratorImpl) executeStreamUpstreams(conf dataplane.Configuration) []byte {
+	upstreams := g.createStreamUpstreams(conf.StreamUpstreams)
+
+	return execute(streamUpstreamsTemplate, upstreams)
+}
+
+// createStreamUpstreams creates the stream upstreams. Unlike the HTTP context, there is no server in the stream
+// context that can respond with an error, so the upstreams without endpoints are not generated. Instead, the
+// connections to such upstreams are proxied to the invalid backend upstream, which closes them.
+func (g GeneratorImpl) createStreamUpstreams(upstreams []dataplane.Upstream) []stream.Upstream {
+	ups := make([]stream.Upstream, 0, len(upstreams)+1)
+
+	for _, u := range upstreams {
+		if len(u.Endpoints) == 0 {
+			continue
+		}
+
+		ups = append(ups, g.createStreamUpstream(u))
+	}
+
+	ups = append(ups, createInvalidBackendRefStreamUpstream())
+
+	return ups
+}
+
+// getStreamUpstreamsWithEndpoints returns the names of the stream upstreams that have endpoints. Only those
+// upstreams are generated.
+func getStreamUpstreamsWithEndpoints(upstreams []dataplane.Upstream) map[string]struct{} {
+	names := make(map[string]struct{}, len(upstreams))
+
+	for _, u := range upstreams {
+		if len(u.Endpoints) > 0 {
+			names[u.Name] = struct{}{}
+		}
+	}
+
+	return names
+}
+
+func (g GeneratorImpl) createStreamUpstream(up dataplane.Upstream) stream.Upstream {
+	zoneSize := ossZoneSize
+	if g.plus {
+		zoneSize = plusZoneSize
+	}
+
+	upstreamServers := make([]stream.UpstreamServer, len(up.Endpoints))
+	for idx, ep := range up.Endpoints {
+		upstreamServers[idx] = stream.UpstreamServer{
+			Address: fmt.Sprintf("%s:%d", ep.Address, ep.Port),
+		}
+	}
+
+	return stream.Upstream{
 		Name:     up.Name,
 		ZoneSize: zoneSize,
 		Servers:  upstreamServers,
 	}
 }
 
-func createInvalidBackendRefUpstream() http.Upstream {
-	return http.Upstream{
+// createInvalidBackendRefStreamUpstream creates the upstream for invalid backends in the stream context.
+// Its only server is marked as down, so NGINX closes the connections proxied to this upstream.
+func createInvalidBackendRefStreamUpstream() stream.Upstream {
+	return stream.Upstream{
 		Name:     invalidBackendRef,
 		ZoneSize: invalidBackendZoneSize,
+		Servers: []stream.UpstreamServer{
+			{
+				Address: invalidBackendStreamServer,
+				Down:    true,
+			},
+		},
+	}
+}
+
+func createInvalidBackendRefUpstream() http.Upstream {
+	return http.Upstream{
+		Name:                invalidBackendRef,
+		ZoneSize:            invalidBackendZoneSize,
+		LoadBalancingMethod: defaultLoadBalancingMethod,
 		Servers: []http.UpstreamServer{
 			{
 				Address: nginx500Server,
@@ -7,11 +7,38 @@ package config
 var upstreamsTemplateText = `
 {{ range $u := . }}
 upstream {{ $u.Name }} {
-    random two least_conn;
+    {{- if $u.LoadBalancingMethod }}
+    {{ $u.LoadBalancingMethod }};
+    {{- end }}
     zone {{ $u.Name }} {{ $u.ZoneSize }};
     {{ range $server := $u.Servers }}
     server {{ $server.Address }};
     {{- end }}
+    {{- with $u.KeepAlive }}
+
+    keepalive {{ .Connections }};
+        {{- if .Requests }}
+    keepalive_requests {{ .Requests }};
+        {{- end }}
+        {{- if .Time }}
+    keepalive_time {{ .Time }};
+        {{- end }}
+        {{- if .Timeout }}
+    keepalive_timeout {{ .Timeout }};
+        {{- end }}
+    {{- end }}
+}
+{{ end -}}
+`
+
+var streamUpstreamsTemplateText = `
+{{- range $u := . }}
+upstream {{ $u.Name }} {
+    random two least_conn;
+    zone {{ $u.Name }} {{ $u.ZoneSize }};
+    {{ range $server := $u.Servers }}
+    server {{ $server.Address }}{{ if $server.Down }} down{{ end }};
+    {{- end }}
 }
 {{ end -}}
 `
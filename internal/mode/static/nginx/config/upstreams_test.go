@@ -5,7 +5,9 @@ import (
 
 	. "github.com/onsi/gomega"
 
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/stream"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
 )
@@ -35,16 +37,44 @@ func TestExecuteUpstreams(t *testing.T) {
 			Name:      "up3",
 			Endpoints: []resolver.Endpoint{},
 		},
+		{
+			Name: "up4",
+			Endpoints: []resolver.Endpoint{
+				{
+					Address: "12.0.0.0",
+					Port:    80,
+				},
+			},
+			Settings: &dataplane.UpstreamSettings{
+				LoadBalancingMethod:  dataplane.LoadBalancingHash,
+				HashKey:              "$request_uri",
+				HashConsistent:       true,
+				ZoneSize:             "2m",
+				KeepAliveConnections: helpers.GetPointer[int32](16),
+				KeepAliveRequests:    helpers.GetPointer[int32](1000),
+				KeepAliveTime:        "1h",
+				KeepAliveTimeout:     "60s",
+			},
+		},
 	}
 
 	expectedSubStrings := []string{
 		"upstream up1",
 		"upstream up2",
 		"upstream up3",
+		"upstream up4",
 		"upstream invalid-backend-ref",
+		"random two least_conn;",
 		"server 10.0.0.0:80;",
 		"server 11.0.0.0:80;",
+		"server 12.0.0.0:80;",
 		"server unix:/var/lib/nginx/nginx-502-server.sock;",
+		"hash $request_uri consistent;",
+		"zone up4 2m;",
+		"keepalive 16;",
+		"keepalive_requests 1000;",
+		"keepalive_time 1h;",
+		"keepalive_timeout 60s;",
 	}
 
 	upstreams := string(gen.executeUpstreams(dataplane.Configuration{Upstreams: stateUpstreams}))
@@ -91,8 +121,9 @@ func TestCreateUpstreams(t *testing.T) {
 
 	expUpstreams := []http.Upstream{
 		{
-			Name:     "up1",
-			ZoneSize: ossZoneSize,
+			Name:                "up1",
+			ZoneSize:            ossZoneSize,
+			LoadBalancingMethod: defaultLoadBalancingMethod,
 			Servers: []http.UpstreamServer{
 				{
 					Address: "10.0.0.0:80",
@@ -106,8 +137,9 @@ func TestCreateUpstreams(t *testing.T) {
 			},
 		},
 		{
-			Name:     "up2",
-			ZoneSize: ossZoneSize,
+			Name:                "up2",
+			ZoneSize:            ossZoneSize,
+			LoadBalancingMethod: defaultLoadBalancingMethod,
 			Servers: []http.UpstreamServer{
 				{
 					Address: "11.0.0.0:80",
@@ -115,8 +147,9 @@ func TestCreateUpstreams(t *testing.T) {
 			},
 		},
 		{
-			Name:     "up3",
-			ZoneSize: ossZoneSize,
+			Name:                "up3",
+			ZoneSize:            ossZoneSize,
+			LoadBalancingMethod: defaultLoadBalancingMethod,
 			Servers: []http.UpstreamServer{
 				{
 					Address: nginx502Server,
@@ -124,8 +157,9 @@ func TestCreateUpstreams(t *testing.T) {
 			},
 		},
 		{
-			Name:     invalidBackendRef,
-			ZoneSize: invalidBackendZoneSize,
+			Name:                invalidBackendRef,
+			ZoneSize:            invalidBackendZoneSize,
+			LoadBalancingMethod: defaultLoadBalancingMethod,
 			Servers: []http.UpstreamServer{
 				{
 					Address: nginx500Server,
@@ -152,8 +186,9 @@ func TestCreateUpstream(t *testing.T) {
 				Endpoints: nil,
 			},
 			expectedUpstream: http.Upstream{
-				Name:     "nil-endpoints",
-				ZoneSize: ossZoneSize,
+				Name:                "nil-endpoints",
+				ZoneSize:            ossZoneSize,
+				LoadBalancingMethod: defaultLoadBalancingMethod,
 				Servers: []http.UpstreamServer{
 					{
 						Address: nginx502Server,
@@ -168,8 +203,9 @@ func TestCreateUpstream(t *testing.T) {
 				Endpoints: []resolver.Endpoint{},
 			},
 			expectedUpstream: http.Upstream{
-				Name:     "no-endpoints",
-				ZoneSize: ossZoneSize,
+				Name:                "no-endpoints",
+				ZoneSize:            ossZoneSize,
+				LoadBalancingMethod: defaultLoadBalancingMethod,
 				Servers: []http.UpstreamServer{
 					{
 						Address: nginx502Server,
@@ -197,8 +233,9 @@ func TestCreateUpstream(t *testing.T) {
 				},
 			},
 			expectedUpstream: http.Upstream{
-				Name:     "multiple-endpoints",
-				ZoneSize: ossZoneSize,
+				Name:                "multiple-endpoints",
+				ZoneSize:            ossZoneSize,
+				LoadBalancingMethod: defaultLoadBalancingMethod,
 				Servers: []http.UpstreamServer{
 					{
 						Address: "10.0.0.1:80",
@@ -213,6 +250,61 @@ func TestCreateUpstream(t *testing.T) {
 			},
 			msg: "multiple endpoints",
 		},
+		{
+			stateUpstream: dataplane.Upstream{
+				Name: "settings",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "10.0.0.1",
+						Port:    80,
+					},
+				},
+				Settings: &dataplane.UpstreamSettings{
+					LoadBalancingMethod:  dataplane.LoadBalancingLeastConn,
+					ZoneSize:             "2m",
+					KeepAliveConnections: helpers.GetPointer[int32](16),
+					KeepAliveRequests:    helpers.GetPointer[int32](1000),
+					KeepAliveTime:        "1h",
+					KeepAliveTimeout:     "60s",
+				},
+			},
+			expectedUpstream: http.Upstream{
+				Name:                "settings",
+				ZoneSize:            "2m",
+				LoadBalancingMethod: "least_conn",
+				KeepAlive: &http.UpstreamKeepAlive{
+					Connections: helpers.GetPointer[int32](16),
+					Requests:    helpers.GetPointer[int32](1000),
+					Time:        "1h",
+					Timeout:     "60s",
+				},
+				Servers: []http.UpstreamServer{
+					{
+						Address: "10.0.0.1:80",
+					},
+				},
+			},
+			msg: "settings",
+		},
+		{
+			stateUpstream: dataplane.Upstream{
+				Name: "settings-no-keepalive",
+				Settings: &dataplane.UpstreamSettings{
+					LoadBalancingMethod: dataplane.LoadBalancingRoundRobin,
+					KeepAliveRequests:   helpers.GetPointer[int32](1000),
+				},
+			},
+			expectedUpstream: http.Upstream{
+				Name:     "settings-no-keepalive",
+				ZoneSize: ossZoneSize,
+				Servers: []http.UpstreamServer{
+					{
+						Address: nginx502Server,
+					},
+				},
+			},
+			msg: "settings without keepalive connections and with round robin",
+		},
 	}
 
 	for _, test := range tests {
@@ -224,6 +316,59 @@ func TestCreateUpstream(t *testing.T) {
 	}
 }
 
+func TestGetLoadBalancingDirective(t *testing.T) {
+	tests := []struct {
+		msg      string
+		settings *dataplane.UpstreamSettings
+		expected string
+	}{
+		{
+			msg:      "round robin",
+			settings: &dataplane.UpstreamSettings{LoadBalancingMethod: dataplane.LoadBalancingRoundRobin},
+			expected: "",
+		},
+		{
+			msg:      "least conn",
+			settings: &dataplane.UpstreamSettings{LoadBalancingMethod: dataplane.LoadBalancingLeastConn},
+			expected: "least_conn",
+		},
+		{
+			msg:      "ip hash",
+			settings: &dataplane.UpstreamSettings{LoadBalancingMethod: dataplane.LoadBalancingIPHash},
+			expected: "ip_hash",
+		},
+		{
+			msg: "hash",
+			settings: &dataplane.UpstreamSettings{
+				LoadBalancingMethod: dataplane.LoadBalancingHash,
+				HashKey:             "$request_uri",
+			},
+			expected: "hash $request_uri",
+		},
+		{
+			msg: "consistent hash",
+			settings: &dataplane.UpstreamSettings{
+				LoadBalancingMethod: dataplane.LoadBalancingHash,
+				HashKey:             "$request_uri",
+				HashConsistent:      true,
+			},
+			expected: "hash $request_uri consistent",
+		},
+		{
+			msg:      "random",
+			settings: &dataplane.UpstreamSettings{LoadBalancingMethod: dataplane.LoadBalancingRandom},
+			expected: "random",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(getLoadBalancingDirective(test.settings)).To(Equal(test.expected))
+		})
+	}
+}
+
 func TestCreateUpstreamPlus(t *testing.T) {
 	gen := GeneratorImpl{plus: true}
 
@@ -237,8 +382,9 @@ func TestCreateUpstreamPlus(t *testing.T) {
 		},
 	}
 	expectedUpstream := http.Upstream{
-		Name:     "multiple-endpoints",
-		ZoneSize: plusZoneSize,
+		Name:                "multiple-endpoints",
+		ZoneSize:            plusZoneSize,
+		LoadBalancingMethod: defaultLoadBalancingMethod,
 		Servers: []http.UpstreamServer{
 			{
 				Address: "10.0.0.1:80",
@@ -251,3 +397,115 @@ func TestCreateUpstreamPlus(t *testing.T) {
 	g := NewWithT(t)
 	g.Expect(result).To(Equal(expectedUpstream))
 }
+
+func TestExecuteStreamUpstreams(t *testing.T) {
+	gen := GeneratorImpl{}
+	stateUpstreams := []dataplane.Upstream{
+		{
+			Name: "up1",
+			Endpoints: []resolver.Endpoint{
+				{
+					Address: "10.0.0.0",
+					Port:    443,
+				},
+			},
+		},
+		{
+			Name:      "up2",
+			Endpoints: []resolver.Endpoint{},
+		},
+	}
+
+	upstreams := string(gen.executeStreamUpstreams(dataplane.Configuration{StreamUpstreams: stateUpstreams}))
+
+	g := NewWithT(t)
+	g.Expect(upstreams).To(ContainSubstring("upstream up1"))
+	g.Expect(upstreams).To(ContainSubstring("zone up1 512k;"))
+	g.Expect(upstreams).To(ContainSubstring("server 10.0.0.0:443;"))
+	g.Expect(upstreams).ToNot(ContainSubstring("upstream up2"))
+	g.Expect(upstreams).To(ContainSubstring("upstream invalid-backend-ref"))
+	g.Expect(upstreams).To(ContainSubstring("server 127.0.0.1:1 down;"))
+}
+
+func TestCreateStreamUpstreams(t *testing.T) {
+	stateUpstreams := []dataplane.Upstream{
+		{
+			Name: "up1",
+			Endpoints: []resolver.Endpoint{
+				{
+					Address: "10.0.0.0",
+					Port:    443,
+				},
+				{
+					Address: "10.0.0.1",
+					Port:    443,
+				},
+			},
+		},
+		{
+			Name: "up2",
+		},
+	}
+
+	tests := []struct {
+		msg      string
+		expected []stream.Upstream
+		plus     bool
+	}{
+		{
+			msg: "oss",
+			expected: []stream.Upstream{
+				{
+					Name:     "up1",
+					ZoneSize: ossZoneSize,
+					Servers: []stream.UpstreamServer{
+						{Address: "10.0.0.0:443"},
+						{Address: "10.0.0.1:443"},
+					},
+				},
+				{
+					Name:     invalidBackendRef,
+					ZoneSize: invalidBackendZoneSize,
+					Servers: []stream.UpstreamServer{
+						{
+							Address: invalidBackendStreamServer,
+							Down:    true,
+						},
+					},
+				},
+			},
+		},
+		{
+			msg:  "plus",
+			plus: true,
+			expected: []stream.Upstream{
+				{
+					Name:     "up1",
+					ZoneSize: plusZoneSize,
+					Servers: []stream.UpstreamServer{
+						{Address: "10.0.0.0:443"},
+						{Address: "10.0.0.1:443"},
+					},
+				},
+				{
+					Name:     invalidBackendRef,
+					ZoneSize: invalidBackendZoneSize,
+					Servers: []stream.UpstreamServer{
+						{
+							Address: invalidBackendStreamServer,
+							Down:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewWithT(t)
+			gen := GeneratorImpl{plus: test.plus}
+			g.Expect(gen.createStreamUpstreams(stateUpstreams)).To(Equal(test.expected))
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package validation
+
+import (
+	"errors"
+	"regexp"
+
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// GenericValidator validates generic values that will propagate into the NGINX configuration.
+// The validation rules are based on how the values are used in the configuration templates of the nginx/config
+// package. Changes to those might require changing the validation rules.
+type GenericValidator struct{}
+
+var _ validation.GenericValidator = GenericValidator{}
+
+// ValidateEscapedStringNoVarExpansion validates a value that is used in a double-quoted string in the NGINX
+// configuration and must not contain any variables.
+func (GenericValidator) ValidateEscapedStringNoVarExpansion(value string) error {
+	return validateEscapedStringNoVarExpansion(value, []string{"my-value", `my \"value\"`})
+}
+
+const (
+	alphaNumericFmt    = `[a-zA-Z0-9_-]+`
+	alphaNumericErrMsg = "must contain only alphanumeric characters or '-' or '_'"
+)
+
+var alphaNumericFmtRegexp = regexp.MustCompile("^" + alphaNumericFmt + "$")
+
+// ValidateServiceName validates a service name that can only contain alphanumeric characters, '-' and '_'.
+func (GenericValidator) ValidateServiceName(name string) error {
+	if !alphaNumericFmtRegexp.MatchString(name) {
+		return errors.New(
+			k8svalidation.RegexError(alphaNumericErrMsg, alphaNumericFmt, "my-service", "my_service", "service123"),
+		)
+	}
+	return nil
+}
+
+const (
+	durationFmt    = `\d{1,4}(ms|s)?`
+	durationErrMsg = "must be a number of up to 4 digits followed by an optional 'ms' or 's' suffix"
+)
+
+var durationFmtRegexp = regexp.MustCompile("^" + durationFmt + "$")
+
+// ValidateNginxDuration validates a duration in the NGINX time format that is limited to milliseconds and seconds.
+func (GenericValidator) ValidateNginxDuration(duration string) error {
+	if !durationFmtRegexp.MatchString(duration) {
+		return errors.New(k8svalidation.RegexError(durationErrMsg, durationFmt, "5ms", "10s", "500"))
+	}
+	return nil
+}
+
+const (
+	//nolint:lll
+	endpointFmt    = `(?:http?:\/\/)?[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(?:\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*(?::\d{1,5})?`
+	endpointErrMsg = "must be an alphanumeric hostname with an optional http scheme and an optional port"
+)
+
+var endpointFmtRegexp = regexp.MustCompile("^" + endpointFmt + "$")
+
+// ValidateEndpoint validates an endpoint, which is an alphanumeric hostname with an optional http scheme
+// and an optional port.
+func (GenericValidator) ValidateEndpoint(endpoint string) error {
+	if !endpointFmtRegexp.MatchString(endpoint) {
+		examples := []string{"my-endpoint", "my.endpoint:5678", "http://my-endpoint"}
+		return errors.New(k8svalidation.RegexError(endpointErrMsg, endpointFmt, examples...))
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package validation
+
+import (
+	"testing"
+)
+
+func TestGenericValidatorValidateEscapedStringNoVarExpansion(t *testing.T) {
+	validator := GenericValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateEscapedStringNoVarExpansion,
+		`my-span`,
+		`my \"span\"`,
+	)
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateEscapedStringNoVarExpansion,
+		`my-$span`,
+		`my "span"`,
+		`my-span\`,
+	)
+}
+
+func TestGenericValidatorValidateServiceName(t *testing.T) {
+	validator := GenericValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateServiceName,
+		`my-svc`,
+		`my_svc`,
+		`MySvc123`,
+	)
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateServiceName,
+		`my$svc`,
+		`my:svc`,
+		`my svc`,
+		``,
+	)
+}
+
+func TestGenericValidatorValidateNginxDuration(t *testing.T) {
+	validator := GenericValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateNginxDuration,
+		`5ms`,
+		`10s`,
+		`123`,
+	)
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateNginxDuration,
+		`test`,
+		`12345`,
+		`5m`,
+		``,
+	)
+}
+
+func TestGenericValidatorValidateEndpoint(t *testing.T) {
+	validator := GenericValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateEndpoint,
+		`myendpoint`,
+		`my.endpoint:5678`,
+		`my-endpoint.example.com`,
+		`http://my-endpoint:4317`,
+	)
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateEndpoint,
+		`my$endpoint`,
+		`my_endpoint`,
+		`https://my-endpoint`,
+		`my-endpoint:`,
+		``,
+	)
+}
@@ -2,6 +2,7 @@ package validation
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
@@ -18,6 +19,10 @@ type HTTPURLRewriteValidator struct{}
 // which in NGINX is done with the proxy_set_header directive.
 type HTTPRequestHeaderValidator struct{}
 
+// HTTPResponseHeaderValidator validates values for response headers,
+// which in NGINX is done with the add_header and proxy_hide_header directives.
+type HTTPResponseHeaderValidator struct{}
+
 var supportedRedirectSchemes = map[string]struct{}{
 	"http":  {},
 	"https": {},
@@ -82,3 +87,39 @@ func (HTTPRequestHeaderValidator) ValidateRequestHeaderValue(value string) error
 	// Variables in header values are supported by NGINX but not required by the Gateway API.
 	return validateEscapedStringNoVarExpansion(value, requestHeaderValueExamples)
 }
+
+// invalidResponseHeaders are the response headers that NGINX sets itself or uses internally, in addition to
+// the headers from invalidHeaders. add_header can't override them, so allowing them would result in duplicate
+// headers or an ignored configuration.
+var invalidResponseHeaders = map[string]struct{}{
+	"server":         {},
+	"date":           {},
+	"x-pad":          {},
+	"content-type":   {},
+	"content-length": {},
+}
+
+const invalidResponseHeaderPrefix = "x-accel-"
+
+func (HTTPResponseHeaderValidator) ValidateResponseHeaderName(name string) error {
+	if err := validateHeaderName(name); err != nil {
+		return err
+	}
+
+	lowerName := strings.ToLower(name)
+	if strings.HasPrefix(lowerName, invalidResponseHeaderPrefix) {
+		return fmt.Errorf("header names with the prefix %q are not supported", invalidResponseHeaderPrefix)
+	}
+	if valid, invalidValues := validateNoUnsupportedValues(lowerName, invalidResponseHeaders); !valid {
+		return errors.New(invalidHeadersErrMsg + strings.Join(invalidValues, ", "))
+	}
+
+	return nil
+}
+
+var responseHeaderValueExamples = []string{"my-header-value", "max-age=3600"}
+
+func (HTTPResponseHeaderValidator) ValidateResponseHeaderValue(value string) error {
+	// Variables in header values are supported by NGINX but not required by the Gateway API.
+	return validateEscapedStringNoVarExpansion(value, responseHeaderValueExamples)
+}
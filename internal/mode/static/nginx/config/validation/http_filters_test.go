@@ -120,3 +120,44 @@ func TestValidateRequestHeaderValue(t *testing.T) {
 		`"example"`,
 	)
 }
+
+func TestValidateResponseHeaderName(t *testing.T) {
+	validator := HTTPResponseHeaderValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateResponseHeaderName,
+		"Cache-Control",
+		"X-Frame-Options",
+		"MyBespokeHeader",
+	)
+
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateResponseHeaderName,
+		"$Content-Encoding",
+		"Host",
+		"Server",
+		"content-type",
+		"X-Accel-Redirect",
+	)
+}
+
+func TestValidateResponseHeaderValue(t *testing.T) {
+	validator := HTTPResponseHeaderValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateResponseHeaderValue,
+		"max-age=3600",
+		"DENY",
+		"1234:3456",
+	)
+
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateResponseHeaderValue,
+		"$upstream_addr",
+		`"example"`,
+	)
+}
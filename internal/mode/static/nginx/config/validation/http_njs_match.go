@@ -3,6 +3,7 @@ package validation
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
@@ -29,6 +30,21 @@ func (HTTPNJSMatchValidator) ValidatePathInMatch(path string) error {
 	return nil
 }
 
+// ValidatePathRegexInMatch validates a regular expression used in the location directive.
+// NGINX uses PCRE, which supports a superset of the RE2 syntax of Go. As a result, any regular expression
+// that compiles in Go is accepted by NGINX, while PCRE-only features, like lookarounds, are rejected.
+func (HTTPNJSMatchValidator) ValidatePathRegexInMatch(regex string) error {
+	if regex == "" {
+		return errors.New("cannot be empty")
+	}
+
+	if _, err := regexp.Compile(regex); err != nil {
+		return fmt.Errorf("must be a valid regular expression: %w", err)
+	}
+
+	return nil
+}
+
 func (HTTPNJSMatchValidator) ValidateHeaderNameInMatch(name string) error {
 	if err := k8svalidation.IsHTTPHeaderName(name); err != nil {
 		return errors.New(err[0])
@@ -41,6 +57,11 @@ func (HTTPNJSMatchValidator) ValidateHeaderValueInMatch(value string) error {
 	return validateNJSHeaderPart(value)
 }
 
+// ValidateHeaderValueRegexInMatch validates a regular expression used to match a header value in NJS.
+func (HTTPNJSMatchValidator) ValidateHeaderValueRegexInMatch(regex string) error {
+	return validateNJSRegex(regex)
+}
+
 func validateNJSHeaderPart(value string) error {
 	// if it contains the separator, it will break NJS code.
 	if strings.Contains(value, config.HeaderMatchSeparator) {
@@ -58,6 +79,28 @@ func (HTTPNJSMatchValidator) ValidateQueryParamValueInMatch(value string) error
 	return validateCommonNJSMatchPart(value)
 }
 
+// ValidateQueryParamValueRegexInMatch validates a regular expression used to match a query parameter value in NJS.
+func (HTTPNJSMatchValidator) ValidateQueryParamValueRegexInMatch(regex string) error {
+	return validateNJSRegex(regex)
+}
+
+// validateNJSRegex validates a regular expression used in NJS-based matching.
+// NJS evaluates regular expressions with PCRE, which supports a superset of the RE2 syntax of Go.
+// Unlike other match parts, the regular expression can contain "$" and ":", because the match is encoded
+// in a way that prevents NGINX from treating "$" as a variable (see config.httpMatch) and the NJS code
+// separates the header name from the value by the first ":".
+func validateNJSRegex(regex string) error {
+	if regex == "" {
+		return errors.New("cannot be empty")
+	}
+
+	if _, err := regexp.Compile(regex); err != nil {
+		return fmt.Errorf("must be a valid regular expression: %w", err)
+	}
+
+	return nil
+}
+
 // validateCommonNJSMatchPart validates a string value used in NJS-based matching.
 func validateCommonNJSMatchPart(value string) error {
 	// empty values do not make sense, so we don't allow them.
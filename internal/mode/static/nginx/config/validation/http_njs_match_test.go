@@ -27,6 +27,28 @@ func TestValidatePathInMatch(t *testing.T) {
 	)
 }
 
+func TestValidatePathRegexInMatch(t *testing.T) {
+	validator := HTTPNJSMatchValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidatePathRegexInMatch,
+		"/",
+		"/api/v[0-9]+/users",
+		"^/path/(foo|bar)$",
+		`/path\.html`,
+		`/path/{id}/"quoted"`,
+	)
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidatePathRegexInMatch,
+		"/api/v[0-9+/users",
+		"/path/(foo",
+		"/path/(?=foo)",
+		"",
+	)
+}
+
 func TestValidateHeaderNameInMatch(t *testing.T) {
 	validator := HTTPNJSMatchValidator{}
 
@@ -69,6 +91,26 @@ func TestValidateHeaderValueInMatch(t *testing.T) {
 	)
 }
 
+func TestValidateHeaderValueRegexInMatch(t *testing.T) {
+	validator := HTTPNJSMatchValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateHeaderValueRegexInMatch,
+		"value",
+		"^tenant-[a-z]+$",
+		"a:b",
+		`v\d+`,
+	)
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateHeaderValueRegexInMatch,
+		"",
+		"tenant-[a-z",
+		"(?=tenant)",
+	)
+}
+
 func TestValidateQueryParamNameInMatch(t *testing.T) {
 	validator := HTTPNJSMatchValidator{}
 
@@ -99,6 +141,25 @@ func TestValidateQueryParamValueInMatch(t *testing.T) {
 	)
 }
 
+func TestValidateQueryParamValueRegexInMatch(t *testing.T) {
+	validator := HTTPNJSMatchValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateQueryParamValueRegexInMatch,
+		"value",
+		"^(on|true)$",
+		"a=b",
+	)
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateQueryParamValueRegexInMatch,
+		"",
+		"(on|true",
+		`\`,
+	)
+}
+
 func TestValidateMethodInMatch(t *testing.T) {
 	validator := HTTPNJSMatchValidator{}
 
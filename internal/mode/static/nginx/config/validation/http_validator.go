@@ -12,6 +12,7 @@ type HTTPValidator struct {
 	HTTPRedirectValidator
 	HTTPURLRewriteValidator
 	HTTPRequestHeaderValidator
+	HTTPResponseHeaderValidator
 }
 
 var _ validation.HTTPFieldsValidator = HTTPValidator{}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"fmt"
+	"hash/fnv"
 	"strings"
 )
 
@@ -19,3 +21,40 @@ func convertStringToSafeVariableName(s string) string {
 func generateAddHeaderMapVariableName(name string) string {
 	return strings.ToLower(convertStringToSafeVariableName(name)) + "_header_var"
 }
+
+// generateTLSPassthroughVariableName generates the name of the variable that holds the upstream name of a TLS
+// passthrough connection for the port.
+func generateTLSPassthroughVariableName(port int32) string {
+	return fmt.Sprintf("tls_passthrough_upstream_%d", port)
+}
+
+// otelRatioVariableName generates the name of the split client variable that samples the ratio of the traced requests.
+func otelRatioVariableName(ratio int32) string {
+	return fmt.Sprintf("otel_ratio_%d", ratio)
+}
+
+// generateAuthResponseHeaderVariableName generates the name of the variable that holds the value of a header of the
+// response of the authorization service.
+func generateAuthResponseHeaderVariableName(name string) string {
+	return "auth_response_" + strings.ToLower(convertStringToSafeVariableName(name))
+}
+
+// generateCORSOriginVariableName generates the name of the variable that holds the allowed origin of the CORS
+// settings with the name. The name is hashed, because it can include characters that are not allowed in
+// the variable names, and replacing them could make the names of different settings equal.
+func generateCORSOriginVariableName(name string) string {
+	return fmt.Sprintf("cors_origin_%08x", hashCORSName(name))
+}
+
+// generateCORSPreflightVariableName generates the name of the variable that shows whether the request is a CORS
+// preflight request from an origin allowed by the CORS settings with the name.
+func generateCORSPreflightVariableName(name string) string {
+	return fmt.Sprintf("cors_preflight_%08x", hashCORSName(name))
+}
+
+func hashCORSName(name string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+
+	return h.Sum32()
+}
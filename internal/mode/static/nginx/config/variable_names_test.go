@@ -56,3 +56,24 @@ func TestGenerateAddHeaderMapVariableName(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateCORSOriginVariableName(t *testing.T) {
+	g := NewWithT(t)
+
+	name := generateCORSOriginVariableName("test_cors")
+	g.Expect(name).To(MatchRegexp(`^cors_origin_[0-9a-f]{8}$`))
+	g.Expect(generateCORSOriginVariableName("test_cors")).To(Equal(name))
+
+	// the names that only differ in the characters that are not allowed in variable names must not collide
+	g.Expect(generateCORSOriginVariableName("test_cors.v1")).
+		ToNot(Equal(generateCORSOriginVariableName("test_cors-v1")))
+}
+
+func TestGenerateCORSPreflightVariableName(t *testing.T) {
+	g := NewWithT(t)
+
+	name := generateCORSPreflightVariableName("test_cors")
+	g.Expect(name).To(MatchRegexp(`^cors_preflight_[0-9a-f]{8}$`))
+	g.Expect(generateCORSPreflightVariableName("test_cors")).To(Equal(name))
+	g.Expect(name[len("cors_preflight"):]).To(Equal(generateCORSOriginVariableName("test_cors")[len("cors_origin"):]))
+}
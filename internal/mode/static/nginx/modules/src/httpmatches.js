@@ -115,6 +115,18 @@ function testMatch(r, match) {
 		}
 	}
 
+	// check regex headers
+	if (match.headersRegex) {
+		try {
+			let found = headersRegexMatch(r.headersIn, match.headersRegex);
+			if (!found) {
+				return false;
+			}
+		} catch (e) {
+			throw e;
+		}
+	}
+
 	// check params
 	if (match.params) {
 		try {
@@ -127,6 +139,18 @@ function testMatch(r, match) {
 		}
 	}
 
+	// check regex params
+	if (match.paramsRegex) {
+		try {
+			let found = paramsRegexMatch(r.args, match.paramsRegex);
+			if (!found) {
+				return false;
+			}
+		} catch (e) {
+			throw e;
+		}
+	}
+
 	// all match conditions are satisfied so return true
 	return true;
 }
@@ -158,35 +182,40 @@ function headersMatch(requestHeaders, headers) {
 	return true;
 }
 
-function paramsMatch(requestParams, params) {
-	for (let i = 0; i < params.length; i++) {
-		let p = params[i];
-		// We store query parameter matches as strings with the format "key=value"; however, there may be more than one
-		// instance of "=" in the string.
-		// To recover the key and value, we need to find the first occurrence of "=" in the string.
-		const idx = params[i].indexOf('=');
-		// Check for an improperly constructed query parameter match. There are three possible error cases:
-		// (1) if the index is -1, then there are no "=" in the string (e.g. "keyvalue")
-		// (2) if the index is 0, then there is no value in the string (e.g. "key=").
-		// (3) if the index is equal to length -1, then there is no key in the string (e.g. "=value").
-		if (idx === -1 || (idx === 0) | (idx === p.length - 1)) {
-			throw Error(`invalid query parameter: ${p}`);
+function headersRegexMatch(requestHeaders, headers) {
+	for (let i = 0; i < headers.length; i++) {
+		const h = headers[i];
+		// We store regular expression header matches as strings with the format "name:regex".
+		// A header name cannot contain ":", but the regular expression can, so we split on the first ":".
+		const idx = h.indexOf(':');
+		if (idx <= 0 || idx === h.length - 1) {
+			throw Error(`invalid header match: ${h}`);
 		}
 
-		// Divide string into key value using the index.
-		let kv = [p.slice(0, idx), p.slice(idx + 1)];
+		// The NGINX request's headersIn object lookup is case-insensitive.
+		let val = requestHeaders[h.slice(0, idx)];
 
-		// val can either be a string or an array of strings.
-		// Also, the NGINX request's args object lookup is case-sensitive.
-		// For example, 'a=1&b=2&A=3&b=4' will be parsed into {a: "1", b: ["2", "4"], A: "3"}
-		let val = requestParams[kv[0]];
 		if (!val) {
 			return false;
 		}
 
-		// If val is an array, we will match against the first element in the array according to the Gateway API spec.
-		if (Array.isArray(val)) {
-			val = val[0];
+		// Unlike an exact match, the regular expression is tested against the whole header value, so
+		// that it can match multiple values delimited by commas.
+		if (!new RegExp(h.slice(idx + 1)).test(val)) {
+			return false;
+		}
+	}
+
+	return true;
+}
+
+function paramsMatch(requestParams, params) {
+	for (let i = 0; i < params.length; i++) {
+		const kv = splitParam(params[i]);
+
+		const val = getParamValue(requestParams, kv[0]);
+		if (!val) {
+			return false;
 		}
 
 		if (val !== kv[1]) {
@@ -197,12 +226,62 @@ function paramsMatch(requestParams, params) {
 	return true;
 }
 
+function paramsRegexMatch(requestParams, params) {
+	for (let i = 0; i < params.length; i++) {
+		const kv = splitParam(params[i]);
+
+		const val = getParamValue(requestParams, kv[0]);
+		if (!val) {
+			return false;
+		}
+
+		if (!new RegExp(kv[1]).test(val)) {
+			return false;
+		}
+	}
+
+	return true;
+}
+
+function splitParam(p) {
+	// We store query parameter matches as strings with the format "key=value"; however, there may be more than one
+	// instance of "=" in the string.
+	// To recover the key and value, we need to find the first occurrence of "=" in the string.
+	const idx = p.indexOf('=');
+	// Check for an improperly constructed query parameter match. There are three possible error cases:
+	// (1) if the index is -1, then there are no "=" in the string (e.g. "keyvalue")
+	// (2) if the index is 0, then there is no value in the string (e.g. "key=").
+	// (3) if the index is equal to length -1, then there is no key in the string (e.g. "=value").
+	if (idx === -1 || (idx === 0) | (idx === p.length - 1)) {
+		throw Error(`invalid query parameter: ${p}`);
+	}
+
+	// Divide string into key value using the index.
+	return [p.slice(0, idx), p.slice(idx + 1)];
+}
+
+function getParamValue(requestParams, name) {
+	// val can either be a string or an array of strings.
+	// Also, the NGINX request's args object lookup is case-sensitive.
+	// For example, 'a=1&b=2&A=3&b=4' will be parsed into {a: "1", b: ["2", "4"], A: "3"}
+	let val = requestParams[name];
+
+	// If val is an array, we will match against the first element in the array according to the Gateway API spec.
+	if (Array.isArray(val)) {
+		val = val[0];
+	}
+
+	return val;
+}
+
 export default {
 	redirect,
 	testMatch,
 	findWinningMatch,
 	headersMatch,
+	headersRegexMatch,
 	paramsMatch,
+	paramsRegexMatch,
 	extractMatchesFromRequest,
 	HTTP_CODES,
 	MATCHES_VARIABLE,
@@ -108,6 +108,18 @@ describe('testMatch', () => {
 			request: createRequest({ params: { key: 'value' } }),
 			expected: true,
 		},
+		{
+			name: 'returns true if regex headers match and no other conditions are set',
+			match: { headersRegex: ['header:^val'] },
+			request: createRequest({ headers: { header: 'value' } }),
+			expected: true,
+		},
+		{
+			name: 'returns true if regex query parameters match and no other conditions are set',
+			match: { paramsRegex: ['key=ue$'] },
+			request: createRequest({ params: { key: 'value' } }),
+			expected: true,
+		},
 		{
 			name: 'returns true if multiple conditions match',
 			match: { method: 'GET', headers: ['header:value'], params: ['key=value'] },
@@ -136,6 +148,18 @@ describe('testMatch', () => {
 			request: createRequest({ method: 'GET', headers: { header: 'value' } }), // no params set on request
 			expected: false,
 		},
+		{
+			name: 'returns false if regex headers do not match',
+			match: { headers: ['header:value'], headersRegex: ['other:^v'] },
+			request: createRequest({ headers: { header: 'value', other: 'no-match' } }),
+			expected: false,
+		},
+		{
+			name: 'returns false if regex query parameters do not match',
+			match: { params: ['key=value'], paramsRegex: ['other=^v'] },
+			request: createRequest({ params: { key: 'value', other: 'no-match' } }),
+			expected: false,
+		},
 		{
 			name: 'throws if headers are malformed',
 			match: { headers: ['malformedheader'] },
@@ -279,6 +303,85 @@ describe('headersMatch', () => {
 	});
 });
 
+describe('headersRegexMatch', () => {
+	const headers = ['header1:^VALUE[0-9]$', 'header2:a:b'];
+
+	const tests = [
+		{
+			name: 'throws an error if a header has no colon',
+			headers: ['wrong=delimiter'],
+			requestHeaders: {},
+			expectThrow: true,
+		},
+		{
+			name: 'throws an error if a header has no name',
+			headers: [':value'],
+			requestHeaders: {},
+			expectThrow: true,
+		},
+		{
+			name: 'throws an error if a header has no regex',
+			headers: ['header:'],
+			requestHeaders: {},
+			expectThrow: true,
+		},
+		{
+			name: 'returns false if one of the headers is missing from request',
+			headers: headers,
+			requestHeaders: {
+				header2: 'a:b',
+			},
+			expected: false,
+		},
+		{
+			name: 'returns false if the header is missing from request and the regex matches any value',
+			headers: ['header1:.*'],
+			requestHeaders: {},
+			expected: false,
+		},
+		{
+			name: 'returns false if one of the header values does not match',
+			headers: headers,
+			requestHeaders: {
+				header1: 'value1', // the case does not match
+				header2: 'a:b',
+			},
+			expected: false,
+		},
+		{
+			name: 'returns true if all headers match',
+			headers: headers,
+			requestHeaders: {
+				header1: 'VALUE1',
+				header2: 'xa:by',
+			},
+			expected: true,
+		},
+		{
+			name: 'tests the regex against all values if request has multiple values for a header name',
+			headers: ['multiValueHeader:val2,val3'],
+			requestHeaders: {
+				multiValueHeader: 'val1,val2,val3',
+			},
+			expected: true,
+		},
+	];
+
+	tests.forEach((test) => {
+		it(test.name, () => {
+			if (test.expectThrow) {
+				expect(() => hm.headersRegexMatch(test.requestHeaders, test.headers)).to.throw(
+					'invalid header match',
+				);
+			} else {
+				expect(hm.headersRegexMatch(test.requestHeaders, test.headers)).to.equal(
+					test.expected,
+				);
+			}
+		});
+	});
+});
+
 describe('paramsMatch', () => {
 	const params = ['Arg1=value1', 'arg2=value2=SOME=other=value', 'arg3===value3&*1(*+']; // case matters for header values
 
@@ -393,6 +496,78 @@ describe('paramsMatch', () => {
 	});
 });
 
+describe('paramsRegexMatch', () => {
+	const params = ['Arg1=^value[0-9]$', 'arg2=(on|true)'];
+
+	const tests = [
+		{
+			name: 'throws an error a param has no key',
+			params: ['=nokey'],
+			expectThrow: true,
+		},
+		{
+			name: 'throws an error if a param has no regex',
+			params: ['novalue='],
+			expectThrow: true,
+		},
+		{
+			name: 'returns false if one of the params is missing from request',
+			params: params,
+			requestParams: {
+				arg2: 'on',
+			},
+			expected: false,
+		},
+		{
+			name: 'returns false if the param is missing from request and the regex matches any value',
+			params: ['arg1=.*'],
+			requestParams: {},
+			expected: false,
+		},
+		{
+			name: 'returns false if one of the param values does not match',
+			params: params,
+			requestParams: {
+				Arg1: 'value10',
+				arg2: 'on',
+			},
+			expected: false,
+		},
+		{
+			name: 'returns true if all params match',
+			params: params,
+			requestParams: {
+				Arg1: 'value1',
+				arg2: 'true',
+			},
+			expected: true,
+		},
+		{
+			name: 'returns false if one param does not match because of multiple values',
+			params: params,
+			requestParams: {
+				Arg1: ['value', 'value1'], // 'value' wins but it does not match
+				arg2: 'on',
+			},
+			expected: false,
+		},
+	];
+
+	tests.forEach((test) => {
+		it(test.name, () => {
+			if (test.expectThrow) {
+				expect(() => hm.paramsRegexMatch(test.requestParams, test.params)).to.throw(
+					'invalid query parameter',
+				);
+			} else {
+				expect(hm.paramsRegexMatch(test.requestParams, test.params)).to.equal(
+					test.expected,
+				);
+			}
+		});
+	});
+});
+
 describe('redirect', () => {
 	const testAnyMatch = { any: true, redirectPath: '/any' };
 	const testHeaderMatches = {
@@ -0,0 +1,31 @@
+package accesscontrol
+
+import (
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+)
+
+// Merger merges AccessControlPolicies.
+// The rules and the default action of the child Policy replace the ones of the parent Policy, because
+// the rules are checked in order and can't be combined. The real IP settings are defaults: the settings of the
+// child Policy take precedence over the settings of the parent Policy.
+// Implements policies.Merger interface.
+type Merger struct{}
+
+// NewMerger returns a new instance of Merger.
+func NewMerger() *Merger {
+	return &Merger{}
+}
+
+// Merge merges the parent and the child AccessControlPolicies.
+// The merged Policy has the metadata and the targetRef of the child Policy.
+func (m *Merger) Merge(parent, child policies.Policy) policies.Policy {
+	parentACP := helpers.MustCastObject[*ngfAPI.AccessControlPolicy](parent)
+	childACP := helpers.MustCastObject[*ngfAPI.AccessControlPolicy](child)
+
+	merged := childACP.DeepCopy()
+	merged.Spec.RealIP = policies.MergeDefault(parentACP.Spec.RealIP, childACP.Spec.RealIP)
+
+	return merged
+}
@@ -0,0 +1,84 @@
+package accesscontrol
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+func TestMerger_Merge(t *testing.T) {
+	parent := &ngfAPI.AccessControlPolicy{Spec: ngfAPI.AccessControlPolicySpec{
+		Rules: []ngfAPI.AccessControlRule{
+			{Action: ngfAPI.AccessControlActionDeny, CIDR: "192.168.0.0/16"},
+		},
+		DefaultAction: helpers.GetPointer(ngfAPI.AccessControlActionAllow),
+		RealIP: &ngfAPI.RealIP{
+			TrustedAddresses: []ngfAPI.CIDR{"172.16.0.0/12"},
+		},
+	}}
+	parent.ObjectMeta = metav1.ObjectMeta{Namespace: "test", Name: "parent"}
+
+	vpnRules := []ngfAPI.AccessControlRule{
+		{Action: ngfAPI.AccessControlActionAllow, CIDR: "10.8.0.0/16"},
+	}
+
+	tests := []struct {
+		child    *ngfAPI.AccessControlPolicy
+		expected *ngfAPI.AccessControlPolicy
+		name     string
+	}{
+		{
+			name: "child rules replace parent rules and child inherits real IP",
+			child: &ngfAPI.AccessControlPolicy{Spec: ngfAPI.AccessControlPolicySpec{
+				Rules:         vpnRules,
+				DefaultAction: helpers.GetPointer(ngfAPI.AccessControlActionDeny),
+			}},
+			expected: &ngfAPI.AccessControlPolicy{Spec: ngfAPI.AccessControlPolicySpec{
+				Rules:         vpnRules,
+				DefaultAction: helpers.GetPointer(ngfAPI.AccessControlActionDeny),
+				RealIP: &ngfAPI.RealIP{
+					TrustedAddresses: []ngfAPI.CIDR{"172.16.0.0/12"},
+				},
+			}},
+		},
+		{
+			name: "child default action is not inherited",
+			child: &ngfAPI.AccessControlPolicy{Spec: ngfAPI.AccessControlPolicySpec{
+				Rules: vpnRules,
+				RealIP: &ngfAPI.RealIP{
+					Header:           helpers.GetPointer(ngfAPI.RealIPHeaderXRealIP),
+					TrustedAddresses: []ngfAPI.CIDR{"10.0.0.1"},
+				},
+			}},
+			expected: &ngfAPI.AccessControlPolicy{Spec: ngfAPI.AccessControlPolicySpec{
+				Rules: vpnRules,
+				RealIP: &ngfAPI.RealIP{
+					Header:           helpers.GetPointer(ngfAPI.RealIPHeaderXRealIP),
+					TrustedAddresses: []ngfAPI.CIDR{"10.0.0.1"},
+				},
+			}},
+		},
+	}
+
+	m := NewMerger()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			parentCopy := parent.DeepCopy()
+			childCopy := test.child.DeepCopy()
+
+			merged := m.Merge(parent, test.child)
+			g.Expect(helpers.Diff(test.expected, merged)).To(BeEmpty())
+
+			// the inputs must not be modified
+			g.Expect(helpers.Diff(parentCopy, parent)).To(BeEmpty())
+			g.Expect(helpers.Diff(childCopy, test.child)).To(BeEmpty())
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package accesscontrol
+
+import (
+	"net/netip"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+var (
+	supportedActions = map[ngfAPI.AccessControlAction]struct{}{
+		ngfAPI.AccessControlActionAllow: {},
+		ngfAPI.AccessControlActionDeny:  {},
+	}
+
+	supportedRealIPHeaders = map[ngfAPI.RealIPHeader]struct{}{
+		ngfAPI.RealIPHeaderXForwardedFor: {},
+		ngfAPI.RealIPHeaderXRealIP:       {},
+	}
+)
+
+// Validator validates an AccessControlPolicy.
+// Implements policies.Validator interface.
+type Validator struct{}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Validate validates the spec of an AccessControlPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	acp := helpers.MustCastObject[*ngfAPI.AccessControlPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []v1.Kind{policies.KindGateway, policies.KindHTTPRoute}
+
+	targetRef := acp.Spec.TargetRef.PolicyTargetReference
+	if err := policies.ValidateTargetRef(targetRef, targetRefPath, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	// only the listeners of a Gateway can be targeted with the sectionName
+	if acp.Spec.TargetRef.SectionName != nil && targetRef.Kind != policies.KindGateway {
+		err := field.Forbidden(targetRefPath.Child("sectionName"), "sectionName is only supported for a Gateway target")
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if errs := validateSpec(acp.Spec); len(errs) > 0 {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(errs.ToAggregate().Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true, because the rules of an AccessControlPolicy are checked in order: the rules of
+// two AccessControlPolicies can't be combined.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+func validateSpec(spec ngfAPI.AccessControlPolicySpec) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	rulesPath := specPath.Child("rules")
+	if len(spec.Rules) == 0 {
+		allErrs = append(allErrs, field.Required(rulesPath, "at least one rule must be set"))
+	}
+
+	for i, rule := range spec.Rules {
+		rulePath := rulesPath.Index(i)
+		allErrs = append(allErrs, validateAction(rule.Action, rulePath.Child("action"))...)
+		allErrs = append(allErrs, validateCIDR(rule.CIDR, rulePath.Child("cidr"))...)
+	}
+
+	if spec.DefaultAction != nil {
+		allErrs = append(allErrs, validateAction(*spec.DefaultAction, specPath.Child("defaultAction"))...)
+	}
+
+	if spec.RealIP != nil {
+		allErrs = append(allErrs, validateRealIP(*spec.RealIP, specPath.Child("realIP"))...)
+	}
+
+	return allErrs
+}
+
+func validateRealIP(realIP ngfAPI.RealIP, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if realIP.Header != nil {
+		if _, ok := supportedRealIPHeaders[*realIP.Header]; !ok {
+			allErrs = append(allErrs, field.NotSupported(
+				path.Child("header"),
+				*realIP.Header,
+				[]string{string(ngfAPI.RealIPHeaderXForwardedFor), string(ngfAPI.RealIPHeaderXRealIP)},
+			))
+		}
+	}
+
+	trustedPath := path.Child("trustedAddresses")
+	if len(realIP.TrustedAddresses) == 0 {
+		allErrs = append(allErrs, field.Required(trustedPath, "at least one trusted address must be set"))
+	}
+
+	for i, addr := range realIP.TrustedAddresses {
+		allErrs = append(allErrs, validateCIDR(addr, trustedPath.Index(i))...)
+	}
+
+	return allErrs
+}
+
+func validateAction(action ngfAPI.AccessControlAction, path *field.Path) field.ErrorList {
+	if _, ok := supportedActions[action]; !ok {
+		return field.ErrorList{field.NotSupported(
+			path,
+			action,
+			[]string{string(ngfAPI.AccessControlActionAllow), string(ngfAPI.AccessControlActionDeny)},
+		)}
+	}
+
+	return nil
+}
+
+func validateCIDR(cidr ngfAPI.CIDR, path *field.Path) field.ErrorList {
+	value := string(cidr)
+
+	var err error
+	if strings.Contains(value, "/") {
+		_, err = netip.ParsePrefix(value)
+	} else {
+		_, err = netip.ParseAddr(value)
+	}
+
+	if err != nil {
+		return field.ErrorList{field.Invalid(path, cidr, "must be a valid IP address or CIDR range")}
+	}
+
+	return nil
+}
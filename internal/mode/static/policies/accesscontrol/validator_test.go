@@ -0,0 +1,141 @@
+package accesscontrol
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	allowVPN := []ngfAPI.AccessControlRule{
+		{Action: ngfAPI.AccessControlActionAllow, CIDR: "10.8.0.0/16"},
+	}
+
+	tests := []struct {
+		sectionName *v1.SectionName
+		spec        ngfAPI.AccessControlPolicySpec
+		name        string
+		kind        v1.Kind
+		expConds    []conditions.Condition
+	}{
+		{
+			name: "valid policy with rules only",
+			spec: ngfAPI.AccessControlPolicySpec{Rules: allowVPN},
+		},
+		{
+			name: "valid policy with all fields",
+			spec: ngfAPI.AccessControlPolicySpec{
+				Rules: []ngfAPI.AccessControlRule{
+					{Action: ngfAPI.AccessControlActionDeny, CIDR: "10.8.1.1"},
+					{Action: ngfAPI.AccessControlActionAllow, CIDR: "10.8.0.0/16"},
+					{Action: ngfAPI.AccessControlActionAllow, CIDR: "2001:db8::/32"},
+				},
+				DefaultAction: helpers.GetPointer(ngfAPI.AccessControlActionDeny),
+				RealIP: &ngfAPI.RealIP{
+					Header:           helpers.GetPointer(ngfAPI.RealIPHeaderXRealIP),
+					TrustedAddresses: []ngfAPI.CIDR{"172.16.0.0/12", "fd00::1"},
+					Recursive:        helpers.GetPointer(true),
+				},
+			},
+		},
+		{
+			name:        "listener target",
+			spec:        ngfAPI.AccessControlPolicySpec{Rules: allowVPN},
+			kind:        policies.KindGateway,
+			sectionName: helpers.GetPointer[v1.SectionName]("http"),
+		},
+		{
+			name:        "HTTPRoute target with a sectionName",
+			spec:        ngfAPI.AccessControlPolicySpec{Rules: allowVPN},
+			sectionName: helpers.GetPointer[v1.SectionName]("rule"),
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"spec.targetRef.sectionName: Forbidden: sectionName is only supported for a Gateway target",
+				),
+			},
+		},
+		{
+			name: "no rules",
+			spec: ngfAPI.AccessControlPolicySpec{},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.rules: Required value: at least one rule must be set"),
+			},
+		},
+		{
+			name: "invalid rules and default action",
+			spec: ngfAPI.AccessControlPolicySpec{
+				Rules: []ngfAPI.AccessControlRule{
+					{Action: "Drop", CIDR: "10.0.0.0/8"},
+					{Action: ngfAPI.AccessControlActionAllow, CIDR: "10.0.0.0/33"},
+					{Action: ngfAPI.AccessControlActionAllow, CIDR: "all"},
+				},
+				DefaultAction: helpers.GetPointer[ngfAPI.AccessControlAction]("Drop"),
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.rules[0].action: Unsupported value: \"Drop\": supported values: \"Allow\", \"Deny\", " +
+						"spec.rules[1].cidr: Invalid value: \"10.0.0.0/33\": must be a valid IP address or CIDR range, " +
+						"spec.rules[2].cidr: Invalid value: \"all\": must be a valid IP address or CIDR range, " +
+						"spec.defaultAction: Unsupported value: \"Drop\": supported values: \"Allow\", \"Deny\"]",
+				),
+			},
+		},
+		{
+			name: "invalid real IP",
+			spec: ngfAPI.AccessControlPolicySpec{
+				Rules: allowVPN,
+				RealIP: &ngfAPI.RealIP{
+					Header: helpers.GetPointer[ngfAPI.RealIPHeader]("Forwarded"),
+				},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.realIP.header: Unsupported value: \"Forwarded\": " +
+						"supported values: \"X-Forwarded-For\", \"X-Real-IP\", " +
+						"spec.realIP.trustedAddresses: Required value: at least one trusted address must be set]",
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			v := NewValidator()
+
+			policy := &ngfAPI.AccessControlPolicy{Spec: test.spec}
+			policy.Spec.TargetRef.Kind = policies.KindHTTPRoute
+			if test.kind != "" {
+				policy.Spec.TargetRef.Kind = test.kind
+			}
+			policy.Spec.TargetRef.Group = v1.GroupName
+			policy.Spec.TargetRef.SectionName = test.sectionName
+
+			conds := v.Validate(policy, nil)
+			g.Expect(conds).To(Equal(test.expConds))
+		})
+	}
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	g := NewWithT(t)
+
+	v := NewValidator()
+
+	polA := &ngfAPI.AccessControlPolicy{Spec: ngfAPI.AccessControlPolicySpec{
+		Rules: []ngfAPI.AccessControlRule{{Action: ngfAPI.AccessControlActionAllow, CIDR: "10.0.0.0/8"}},
+	}}
+	polB := &ngfAPI.AccessControlPolicy{Spec: ngfAPI.AccessControlPolicySpec{
+		Rules: []ngfAPI.AccessControlRule{{Action: ngfAPI.AccessControlActionDeny, CIDR: "10.0.0.1"}},
+	}}
+
+	g.Expect(v.Conflicts(polA, polB)).To(BeTrue())
+}
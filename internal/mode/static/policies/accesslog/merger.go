@@ -0,0 +1,39 @@
+package accesslog
+
+import (
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+)
+
+// Merger merges AccessLogPolicies.
+// All settings of an AccessLogPolicy are defaults: the settings of the child Policy take precedence over
+// the settings of the parent Policy.
+// Implements policies.Merger interface.
+type Merger struct{}
+
+// NewMerger returns a new instance of Merger.
+func NewMerger() *Merger {
+	return &Merger{}
+}
+
+// Merge merges the parent and the child AccessLogPolicies.
+// The merged Policy has the metadata and the targetRef of the child Policy.
+// The format and the destination are merged as a whole, because their settings only make sense together.
+func (m *Merger) Merge(parent, child policies.Policy) policies.Policy {
+	parentALP := helpers.MustCastObject[*ngfAPI.AccessLogPolicy](parent)
+	childALP := helpers.MustCastObject[*ngfAPI.AccessLogPolicy](child)
+
+	merged := childALP.DeepCopy()
+	merged.Spec.Enabled = policies.MergeDefault(parentALP.Spec.Enabled, childALP.Spec.Enabled)
+
+	if childALP.Spec.Format == nil {
+		merged.Spec.Format = parentALP.Spec.Format.DeepCopy()
+	}
+
+	if childALP.Spec.Destination == nil {
+		merged.Spec.Destination = parentALP.Spec.Destination.DeepCopy()
+	}
+
+	return merged
+}
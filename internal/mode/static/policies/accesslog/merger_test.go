@@ -0,0 +1,77 @@
+package accesslog
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+func TestMerger_Merge(t *testing.T) {
+	parent := &ngfAPI.AccessLogPolicy{Spec: ngfAPI.AccessLogPolicySpec{
+		Format: &ngfAPI.AccessLogFormat{
+			Fields:    []ngfAPI.AccessLogField{{Name: "status", Value: "$status"}},
+			RouteInfo: helpers.GetPointer(true),
+		},
+		Destination: &ngfAPI.AccessLogDestination{
+			Type:   ngfAPI.AccessLogDestinationSyslog,
+			Syslog: &ngfAPI.SyslogDestination{Server: "10.0.0.1"},
+		},
+	}}
+	parent.ObjectMeta = metav1.ObjectMeta{Namespace: "test", Name: "parent"}
+
+	tests := []struct {
+		child    *ngfAPI.AccessLogPolicy
+		expected *ngfAPI.AccessLogPolicy
+		name     string
+	}{
+		{
+			name:     "empty child inherits all settings",
+			child:    &ngfAPI.AccessLogPolicy{Spec: ngfAPI.AccessLogPolicySpec{}},
+			expected: &ngfAPI.AccessLogPolicy{Spec: parent.Spec},
+		},
+		{
+			name: "child format replaces parent format",
+			child: &ngfAPI.AccessLogPolicy{Spec: ngfAPI.AccessLogPolicySpec{
+				Format: &ngfAPI.AccessLogFormat{TraceContext: helpers.GetPointer(true)},
+			}},
+			expected: &ngfAPI.AccessLogPolicy{Spec: ngfAPI.AccessLogPolicySpec{
+				Format:      &ngfAPI.AccessLogFormat{TraceContext: helpers.GetPointer(true)},
+				Destination: parent.Spec.Destination,
+			}},
+		},
+		{
+			name: "child turns off access logs",
+			child: &ngfAPI.AccessLogPolicy{Spec: ngfAPI.AccessLogPolicySpec{
+				Enabled:     helpers.GetPointer(false),
+				Destination: &ngfAPI.AccessLogDestination{Type: ngfAPI.AccessLogDestinationStdout},
+			}},
+			expected: &ngfAPI.AccessLogPolicy{Spec: ngfAPI.AccessLogPolicySpec{
+				Enabled:     helpers.GetPointer(false),
+				Format:      parent.Spec.Format,
+				Destination: &ngfAPI.AccessLogDestination{Type: ngfAPI.AccessLogDestinationStdout},
+			}},
+		},
+	}
+
+	m := NewMerger()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			parentCopy := parent.DeepCopy()
+			childCopy := test.child.DeepCopy()
+
+			merged := m.Merge(parent, test.child)
+			g.Expect(helpers.Diff(test.expected, merged)).To(BeEmpty())
+
+			// the inputs must not be modified
+			g.Expect(helpers.Diff(parentCopy, parent)).To(BeEmpty())
+			g.Expect(helpers.Diff(childCopy, test.child)).To(BeEmpty())
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package accesslog
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+const (
+	maxPort = 65535
+)
+
+var (
+	fieldNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_.-]*$`)
+	// fieldValueRegexp matches text without quotes, backslashes and control characters, with NGINX variables
+	// in the $name or ${name} form.
+	fieldValueRegexp    = regexp.MustCompile(`^([^"'\\$\x00-\x1f\x7f]|\$[a-zA-Z0-9_]+|\$\{[a-zA-Z0-9_]+\})+$`)
+	syslogServerRegexp  = regexp.MustCompile(`^(\[[0-9a-fA-F:.]+\]|[a-zA-Z0-9.-]+)(:([0-9]{1,5}))?$`)
+	syslogTagRegexp     = regexp.MustCompile(`^[a-zA-Z0-9_]{1,32}$`)
+	supportedFacilities = []string{
+		"kern", "user", "mail", "daemon", "auth", "intern", "lpr", "news", "uucp", "clock", "authpriv", "ftp",
+		"ntp", "audit", "alert", "cron", "local0", "local1", "local2", "local3", "local4", "local5", "local6",
+		"local7",
+	}
+)
+
+// the fields that NGF adds to the log entries
+const (
+	traceIDField        = "trace_id"
+	spanIDField         = "span_id"
+	routeNamespaceField = "route_namespace"
+	routeNameField      = "route_name"
+)
+
+// Validator validates an AccessLogPolicy.
+// Implements policies.Validator interface.
+type Validator struct{}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Validate validates the spec of an AccessLogPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	alp := helpers.MustCastObject[*ngfAPI.AccessLogPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []v1.Kind{policies.KindGateway, policies.KindHTTPRoute}
+
+	if err := policies.ValidateTargetRef(alp.Spec.TargetRef, targetRefPath, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if errs := validateSpec(alp.Spec); len(errs) > 0 {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(errs.ToAggregate().Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true, because the settings of an AccessLogPolicy configure the access logs together:
+// only one AccessLogPolicy can be applied to a target.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+func validateSpec(spec ngfAPI.AccessLogPolicySpec) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if spec.Format != nil {
+		allErrs = append(allErrs, validateFormat(*spec.Format, specPath.Child("format"))...)
+	}
+
+	if spec.Destination != nil {
+		allErrs = append(allErrs, validateDestination(*spec.Destination, specPath.Child("destination"))...)
+	}
+
+	return allErrs
+}
+
+func validateFormat(format ngfAPI.AccessLogFormat, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	// the fields added by NGF can't be redefined, because the keys of a JSON object must be unique
+	names := make(map[string]struct{})
+	if format.TraceContext != nil && *format.TraceContext {
+		names[traceIDField] = struct{}{}
+		names[spanIDField] = struct{}{}
+	}
+	if format.RouteInfo != nil && *format.RouteInfo {
+		names[routeNamespaceField] = struct{}{}
+		names[routeNameField] = struct{}{}
+	}
+
+	for i, f := range format.Fields {
+		fieldPath := path.Child("fields").Index(i)
+
+		if !fieldNameRegexp.MatchString(f.Name) {
+			allErrs = append(allErrs, field.Invalid(
+				fieldPath.Child("name"),
+				f.Name,
+				"must start with a letter or '_' and only contain alphanumeric characters, '_', '.' and '-'",
+			))
+		}
+
+		if _, exists := names[f.Name]; exists {
+			allErrs = append(allErrs, field.Duplicate(fieldPath.Child("name"), f.Name))
+		}
+		names[f.Name] = struct{}{}
+
+		if !fieldValueRegexp.MatchString(f.Value) {
+			allErrs = append(allErrs, field.Invalid(
+				fieldPath.Child("value"),
+				f.Value,
+				"must be text with NGINX variables, for example, '$request_method $uri', "+
+					"without quotes, backslashes and control characters",
+			))
+		}
+	}
+
+	return allErrs
+}
+
+func validateDestination(destination ngfAPI.AccessLogDestination, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch destination.Type {
+	case ngfAPI.AccessLogDestinationStdout:
+		if destination.Syslog != nil {
+			allErrs = append(allErrs, field.Forbidden(path.Child("syslog"), "only applicable to the Syslog type"))
+		}
+	case ngfAPI.AccessLogDestinationSyslog:
+		if destination.Syslog == nil {
+			allErrs = append(allErrs, field.Required(path.Child("syslog"), "required for the Syslog type"))
+		} else {
+			allErrs = append(allErrs, validateSyslog(*destination.Syslog, path.Child("syslog"))...)
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(
+			path.Child("type"),
+			destination.Type,
+			[]string{string(ngfAPI.AccessLogDestinationStdout), string(ngfAPI.AccessLogDestinationSyslog)},
+		))
+	}
+
+	return allErrs
+}
+
+func validateSyslog(syslog ngfAPI.SyslogDestination, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if matches := syslogServerRegexp.FindStringSubmatch(syslog.Server); matches == nil {
+		allErrs = append(allErrs, field.Invalid(
+			path.Child("server"),
+			syslog.Server,
+			"must be a hostname or an IP address with an optional port, for example, 10.0.0.1:1514",
+		))
+	} else if matches[3] != "" {
+		// the regexp guarantees that the port has at most 5 digits
+		if port, _ := strconv.Atoi(matches[3]); port < 1 || port > maxPort {
+			allErrs = append(allErrs, field.Invalid(
+				path.Child("server"),
+				syslog.Server,
+				"port must be between 1 and 65535",
+			))
+		}
+	}
+
+	if syslog.Facility != nil && !slices.Contains(supportedFacilities, string(*syslog.Facility)) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("facility"), *syslog.Facility, supportedFacilities))
+	}
+
+	if syslog.Tag != nil && !syslogTagRegexp.MatchString(*syslog.Tag) {
+		allErrs = append(allErrs, field.Invalid(
+			path.Child("tag"),
+			*syslog.Tag,
+			"must be 1 to 32 alphanumeric characters or '_'",
+		))
+	}
+
+	return allErrs
+}
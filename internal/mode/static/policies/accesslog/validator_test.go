@@ -0,0 +1,146 @@
+package accesslog
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	tests := []struct {
+		spec     ngfAPI.AccessLogPolicySpec
+		name     string
+		expConds []conditions.Condition
+	}{
+		{
+			name: "valid empty policy",
+			spec: ngfAPI.AccessLogPolicySpec{},
+		},
+		{
+			name: "valid policy with all fields",
+			spec: ngfAPI.AccessLogPolicySpec{
+				Enabled: helpers.GetPointer(true),
+				Format: &ngfAPI.AccessLogFormat{
+					Fields: []ngfAPI.AccessLogField{
+						{Name: "request", Value: "$request_method ${uri}?$args"},
+						{Name: "client.ip", Value: "$remote_addr"},
+					},
+					TraceContext: helpers.GetPointer(true),
+					RouteInfo:    helpers.GetPointer(true),
+				},
+				Destination: &ngfAPI.AccessLogDestination{
+					Type: ngfAPI.AccessLogDestinationSyslog,
+					Syslog: &ngfAPI.SyslogDestination{
+						Server:   "[::1]:1514",
+						Facility: helpers.GetPointer[ngfAPI.SyslogFacility]("local0"),
+						Tag:      helpers.GetPointer("ngf_access"),
+					},
+				},
+			},
+		},
+		{
+			name: "invalid format",
+			spec: ngfAPI.AccessLogPolicySpec{
+				Format: &ngfAPI.AccessLogFormat{
+					Fields: []ngfAPI.AccessLogField{
+						{Name: "1st", Value: "$status"},
+						{Name: "route_name", Value: "\"$uri\""},
+						{Name: "cost", Value: "100$"},
+					},
+					RouteInfo: helpers.GetPointer(true),
+				},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.format.fields[0].name: Invalid value: \"1st\": must start with a letter or '_' and only " +
+						"contain alphanumeric characters, '_', '.' and '-', " +
+						"spec.format.fields[1].name: Duplicate value: \"route_name\", " +
+						"spec.format.fields[1].value: Invalid value: \"\\\"$uri\\\"\": must be text with NGINX " +
+						"variables, for example, '$request_method $uri', without quotes, backslashes and control " +
+						"characters, " +
+						"spec.format.fields[2].value: Invalid value: \"100$\": must be text with NGINX variables, " +
+						"for example, '$request_method $uri', without quotes, backslashes and control characters]",
+				),
+			},
+		},
+		{
+			name: "syslog without server",
+			spec: ngfAPI.AccessLogPolicySpec{
+				Destination: &ngfAPI.AccessLogDestination{Type: ngfAPI.AccessLogDestinationSyslog},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.destination.syslog: Required value: required for the Syslog type"),
+			},
+		},
+		{
+			name: "syslog with stdout",
+			spec: ngfAPI.AccessLogPolicySpec{
+				Destination: &ngfAPI.AccessLogDestination{
+					Type:   ngfAPI.AccessLogDestinationStdout,
+					Syslog: &ngfAPI.SyslogDestination{Server: "10.0.0.1"},
+				},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.destination.syslog: Forbidden: only applicable to the Syslog type"),
+			},
+		},
+		{
+			name: "invalid syslog",
+			spec: ngfAPI.AccessLogPolicySpec{
+				Destination: &ngfAPI.AccessLogDestination{
+					Type: ngfAPI.AccessLogDestinationSyslog,
+					Syslog: &ngfAPI.SyslogDestination{
+						Server:   "10.0.0.1:70000",
+						Facility: helpers.GetPointer[ngfAPI.SyslogFacility]("local8"),
+						Tag:      helpers.GetPointer("ngf-access"),
+					},
+				},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.destination.syslog.server: Invalid value: \"10.0.0.1:70000\": port must be between 1 " +
+						"and 65535, " +
+						"spec.destination.syslog.facility: Unsupported value: \"local8\": supported values: " +
+						"\"kern\", \"user\", \"mail\", \"daemon\", \"auth\", \"intern\", \"lpr\", \"news\", \"uucp\", " +
+						"\"clock\", \"authpriv\", \"ftp\", \"ntp\", \"audit\", \"alert\", \"cron\", \"local0\", " +
+						"\"local1\", \"local2\", \"local3\", \"local4\", \"local5\", \"local6\", \"local7\", " +
+						"spec.destination.syslog.tag: Invalid value: \"ngf-access\": must be 1 to 32 alphanumeric " +
+						"characters or '_']",
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			v := NewValidator()
+
+			policy := &ngfAPI.AccessLogPolicy{Spec: test.spec}
+			policy.Spec.TargetRef.Kind = policies.KindHTTPRoute
+			policy.Spec.TargetRef.Group = v1.GroupName
+
+			conds := v.Validate(policy, nil)
+			g.Expect(conds).To(Equal(test.expConds))
+		})
+	}
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	g := NewWithT(t)
+
+	v := NewValidator()
+
+	polA := &ngfAPI.AccessLogPolicy{Spec: ngfAPI.AccessLogPolicySpec{Enabled: helpers.GetPointer(false)}}
+	polB := &ngfAPI.AccessLogPolicy{Spec: ngfAPI.AccessLogPolicySpec{Format: &ngfAPI.AccessLogFormat{}}}
+
+	g.Expect(v.Conflicts(polA, polB)).To(BeTrue())
+}
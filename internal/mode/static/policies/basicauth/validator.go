@@ -0,0 +1,76 @@
+package basicauth
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// Validator validates a BasicAuthPolicy.
+// It doesn't validate the referenced Secret, because the Secrets are resolved when the Graph is built.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of a BasicAuthPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	bap := helpers.MustCastObject[*ngfAPI.BasicAuthPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []v1.Kind{policies.KindHTTPRoute}
+
+	if err := policies.ValidateTargetRef(bap.Spec.TargetRef, targetRefPath, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if errs := v.validateSpec(bap.Spec); len(errs) > 0 {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(errs.ToAggregate().Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true, because a BasicAuthPolicy is a Direct Policy: only one BasicAuthPolicy can
+// be applied to a target.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+func (v *Validator) validateSpec(spec ngfAPI.BasicAuthPolicySpec) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	secretRefPath := specPath.Child("secretRef")
+
+	// Secrets belong to the core API group, which is represented by an empty group.
+	if spec.SecretRef.Group != nil && *spec.SecretRef.Group != "" {
+		allErrs = append(allErrs, field.NotSupported(secretRefPath.Child("group"), *spec.SecretRef.Group, []string{""}))
+	}
+
+	if spec.SecretRef.Kind != nil && *spec.SecretRef.Kind != policies.KindSecret {
+		allErrs = append(allErrs, field.NotSupported(
+			secretRefPath.Child("kind"),
+			*spec.SecretRef.Kind,
+			[]string{string(policies.KindSecret)},
+		))
+	}
+
+	if spec.Realm != nil {
+		if err := v.genericValidator.ValidateEscapedStringNoVarExpansion(*spec.Realm); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("realm"), *spec.Realm, err.Error()))
+		}
+	}
+
+	return allErrs
+}
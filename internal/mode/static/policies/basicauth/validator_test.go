@@ -0,0 +1,94 @@
+package basicauth
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	tests := []struct {
+		spec     ngfAPI.BasicAuthPolicySpec
+		name     string
+		expConds []conditions.Condition
+	}{
+		{
+			name: "valid policy",
+			spec: ngfAPI.BasicAuthPolicySpec{
+				SecretRef: v1.SecretObjectReference{Name: "htpasswd"},
+			},
+		},
+		{
+			name: "valid policy with explicit secret group and kind and realm",
+			spec: ngfAPI.BasicAuthPolicySpec{
+				SecretRef: v1.SecretObjectReference{
+					Group:     helpers.GetPointer[v1.Group](""),
+					Kind:      helpers.GetPointer[v1.Kind]("Secret"),
+					Name:      "htpasswd",
+					Namespace: helpers.GetPointer[v1.Namespace]("other"),
+				},
+				Realm: helpers.GetPointer("Dashboards"),
+			},
+		},
+		{
+			name: "invalid secret ref and realm",
+			spec: ngfAPI.BasicAuthPolicySpec{
+				SecretRef: v1.SecretObjectReference{
+					Group: helpers.GetPointer[v1.Group]("gateway.nginx.org"),
+					Kind:  helpers.GetPointer[v1.Kind]("ConfigMap"),
+					Name:  "htpasswd",
+				},
+				Realm: helpers.GetPointer("invalid"),
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.secretRef.group: Unsupported value: \"gateway.nginx.org\": supported values: \"\", " +
+						"spec.secretRef.kind: Unsupported value: \"ConfigMap\": supported values: \"Secret\", " +
+						"spec.realm: Invalid value: \"invalid\": invalid value]",
+				),
+			},
+		},
+	}
+
+	v := NewValidator(&validationfakes.FakeGenericValidator{
+		ValidateEscapedStringNoVarExpansionStub: func(value string) error {
+			if value == "invalid" {
+				return errors.New("invalid value")
+			}
+			return nil
+		},
+	})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			policy := &ngfAPI.BasicAuthPolicy{Spec: test.spec}
+			policy.Spec.TargetRef.Kind = policies.KindHTTPRoute
+			policy.Spec.TargetRef.Group = v1.GroupName
+
+			conds := v.Validate(policy, nil)
+			g.Expect(conds).To(Equal(test.expConds))
+		})
+	}
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	g := NewWithT(t)
+
+	v := NewValidator(&validationfakes.FakeGenericValidator{})
+
+	polA := &ngfAPI.BasicAuthPolicy{Spec: ngfAPI.BasicAuthPolicySpec{SecretRef: v1.SecretObjectReference{Name: "a"}}}
+	polB := &ngfAPI.BasicAuthPolicy{Spec: ngfAPI.BasicAuthPolicySpec{SecretRef: v1.SecretObjectReference{Name: "b"}}}
+
+	g.Expect(v.Conflicts(polA, polB)).To(BeTrue())
+}
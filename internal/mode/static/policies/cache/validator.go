@@ -0,0 +1,162 @@
+package cache
+
+import (
+	"regexp"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+const (
+	// minZoneSize is the minimum size of the keys zone in bytes. NGINX rejects the zones smaller than two
+	// memory pages.
+	minZoneSize = 8 * 1024
+
+	minStatusCode = 100
+	maxStatusCode = 599
+)
+
+var (
+	sizeRegexp       = regexp.MustCompile(`^(\d{1,4})(k|m|g)?$`)
+	durationRegexp   = regexp.MustCompile(`^\d{1,4}(ms|s)?$`)
+	keyRegexp        = regexp.MustCompile(`^(\$[a-z0-9_]+)+$`)
+	headerNameRegexp = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+)
+
+// Validator validates a CachePolicy.
+// Implements policies.Validator interface.
+type Validator struct{}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Validate validates the spec of a CachePolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	cp := helpers.MustCastObject[*ngfAPI.CachePolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []v1.Kind{policies.KindHTTPRoute}
+
+	if err := policies.ValidateTargetRef(cp.Spec.TargetRef, targetRefPath, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if errs := validateSpec(cp.Spec); len(errs) > 0 {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(errs.ToAggregate().Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true, because a CachePolicy is a Direct Policy: only one CachePolicy can be applied to a target.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+func validateSpec(spec ngfAPI.CachePolicySpec) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if spec.ZoneSize != nil {
+		zoneSizePath := specPath.Child("zoneSize")
+
+		if size, ok := parseSize(*spec.ZoneSize); !ok {
+			allErrs = append(allErrs, invalidSize(zoneSizePath, *spec.ZoneSize))
+		} else if size < minZoneSize {
+			allErrs = append(allErrs, field.Invalid(zoneSizePath, *spec.ZoneSize, "must be at least 8k"))
+		}
+	}
+
+	if spec.MaxSize != nil {
+		if _, ok := parseSize(*spec.MaxSize); !ok {
+			allErrs = append(allErrs, invalidSize(specPath.Child("maxSize"), *spec.MaxSize))
+		}
+	}
+
+	if spec.Inactive != nil {
+		allErrs = append(allErrs, validateDuration(*spec.Inactive, specPath.Child("inactive"))...)
+	}
+
+	for i, valid := range spec.Valid {
+		validPath := specPath.Child("valid").Index(i)
+
+		for j, code := range valid.Codes {
+			if code < minStatusCode || code > maxStatusCode {
+				allErrs = append(allErrs, field.Invalid(
+					validPath.Child("codes").Index(j),
+					code,
+					"must be between 100 and 599",
+				))
+			}
+		}
+
+		allErrs = append(allErrs, validateDuration(valid.Time, validPath.Child("time"))...)
+	}
+
+	if spec.Key != nil && !keyRegexp.MatchString(*spec.Key) {
+		allErrs = append(allErrs, field.Invalid(
+			specPath.Child("key"),
+			*spec.Key,
+			"must consist of one or more NGINX variables, for example, $scheme$host$request_uri",
+		))
+	}
+
+	for i, name := range spec.BypassHeaders {
+		if !headerNameRegexp.MatchString(string(name)) {
+			allErrs = append(allErrs, field.Invalid(
+				specPath.Child("bypassHeaders").Index(i),
+				name,
+				"must only contain alphanumeric characters and '-'",
+			))
+		}
+	}
+
+	return allErrs
+}
+
+// parseSize parses a Size into bytes. It returns false if the Size is not valid.
+func parseSize(size ngfAPI.Size) (int64, bool) {
+	matches := sizeRegexp.FindStringSubmatch(string(size))
+	if matches == nil {
+		return 0, false
+	}
+
+	// the regexp guarantees that the number has at most 4 digits
+	value, _ := strconv.ParseInt(matches[1], 10, 64)
+
+	switch matches[2] {
+	case "k":
+		value *= 1024
+	case "m":
+		value *= 1024 * 1024
+	case "g":
+		value *= 1024 * 1024 * 1024
+	}
+
+	return value, true
+}
+
+func invalidSize(path *field.Path, size ngfAPI.Size) *field.Error {
+	return field.Invalid(path, size, "must be a number of up to 4 digits followed by an optional 'k', 'm' or 'g' suffix")
+}
+
+func validateDuration(duration ngfAPI.Duration, path *field.Path) field.ErrorList {
+	if !durationRegexp.MatchString(string(duration)) {
+		return field.ErrorList{field.Invalid(
+			path,
+			duration,
+			"must be a number of up to 4 digits followed by an optional 'ms' or 's' suffix",
+		)}
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package cache
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	tests := []struct {
+		spec     ngfAPI.CachePolicySpec
+		name     string
+		expConds []conditions.Condition
+	}{
+		{
+			name: "valid empty policy",
+			spec: ngfAPI.CachePolicySpec{},
+		},
+		{
+			name: "valid policy with all fields",
+			spec: ngfAPI.CachePolicySpec{
+				ZoneSize: helpers.GetPointer[ngfAPI.Size]("8k"),
+				MaxSize:  helpers.GetPointer[ngfAPI.Size]("1g"),
+				Inactive: helpers.GetPointer[ngfAPI.Duration]("600s"),
+				Valid: []ngfAPI.CacheValid{
+					{Codes: []ngfAPI.CacheStatusCode{200, 301}, Time: "60s"},
+					{Time: "10s"},
+				},
+				Key:           helpers.GetPointer("$scheme$host$request_uri"),
+				BypassHeaders: []ngfAPI.HeaderName{"Cache-Bypass", "Authorization"},
+			},
+		},
+		{
+			name: "zone size too small",
+			spec: ngfAPI.CachePolicySpec{
+				ZoneSize: helpers.GetPointer[ngfAPI.Size]("4096"),
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.zoneSize: Invalid value: \"4096\": must be at least 8k"),
+			},
+		},
+		{
+			name: "invalid fields",
+			spec: ngfAPI.CachePolicySpec{
+				ZoneSize: helpers.GetPointer[ngfAPI.Size]("10M"),
+				MaxSize:  helpers.GetPointer[ngfAPI.Size]("10000m"),
+				Inactive: helpers.GetPointer[ngfAPI.Duration]("10m"),
+				Valid: []ngfAPI.CacheValid{
+					{Codes: []ngfAPI.CacheStatusCode{99, 600}, Time: "1h"},
+				},
+				Key:           helpers.GetPointer("$host/$request_uri"),
+				BypassHeaders: []ngfAPI.HeaderName{"X Bypass"},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.zoneSize: Invalid value: \"10M\": must be a number of up to 4 digits followed by an " +
+						"optional 'k', 'm' or 'g' suffix, " +
+						"spec.maxSize: Invalid value: \"10000m\": must be a number of up to 4 digits followed by an " +
+						"optional 'k', 'm' or 'g' suffix, " +
+						"spec.inactive: Invalid value: \"10m\": must be a number of up to 4 digits followed by an " +
+						"optional 'ms' or 's' suffix, " +
+						"spec.valid[0].codes[0]: Invalid value: 99: must be between 100 and 599, " +
+						"spec.valid[0].codes[1]: Invalid value: 600: must be between 100 and 599, " +
+						"spec.valid[0].time: Invalid value: \"1h\": must be a number of up to 4 digits followed by an " +
+						"optional 'ms' or 's' suffix, " +
+						"spec.key: Invalid value: \"$host/$request_uri\": must consist of one or more NGINX " +
+						"variables, for example, $scheme$host$request_uri, " +
+						"spec.bypassHeaders[0]: Invalid value: \"X Bypass\": " +
+						"must only contain alphanumeric characters and '-']",
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			v := NewValidator()
+
+			policy := &ngfAPI.CachePolicy{Spec: test.spec}
+			policy.Spec.TargetRef.Kind = policies.KindHTTPRoute
+			policy.Spec.TargetRef.Group = v1.GroupName
+
+			conds := v.Validate(policy, nil)
+			g.Expect(conds).To(Equal(test.expConds))
+		})
+	}
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	g := NewWithT(t)
+
+	v := NewValidator()
+
+	polA := &ngfAPI.CachePolicy{Spec: ngfAPI.CachePolicySpec{}}
+	polB := &ngfAPI.CachePolicy{Spec: ngfAPI.CachePolicySpec{MaxSize: helpers.GetPointer[ngfAPI.Size]("1g")}}
+
+	g.Expect(v.Conflicts(polA, polB)).To(BeTrue())
+}
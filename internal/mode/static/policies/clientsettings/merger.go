@@ -0,0 +1,91 @@
+package clientsettings
+
+import (
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+)
+
+// Merger merges ClientSettingsPolicies.
+// The body and keep-alive settings of a ClientSettingsPolicy are defaults: the settings of the child Policy take
+// precedence over the settings of the parent Policy. The settings under override are overrides: the settings of
+// the parent Policy take precedence over the settings of the child Policy.
+// Implements policies.Merger interface.
+type Merger struct{}
+
+// NewMerger returns a new instance of Merger.
+func NewMerger() *Merger {
+	return &Merger{}
+}
+
+// Merge merges the parent and the child ClientSettingsPolicies.
+// The merged Policy has the metadata and the targetRef of the child Policy.
+func (m *Merger) Merge(parent, child policies.Policy) policies.Policy {
+	parentCSP := helpers.MustCastObject[*ngfAPI.ClientSettingsPolicy](parent)
+	childCSP := helpers.MustCastObject[*ngfAPI.ClientSettingsPolicy](child)
+
+	merged := childCSP.DeepCopy()
+	merged.Spec.Body = mergeBody(parentCSP.Spec.Body, childCSP.Spec.Body)
+	merged.Spec.KeepAlive = mergeKeepAlive(parentCSP.Spec.KeepAlive, childCSP.Spec.KeepAlive)
+	merged.Spec.Override = mergeOverride(parentCSP.Spec.Override, childCSP.Spec.Override)
+
+	return merged
+}
+
+func mergeBody(parent, child *ngfAPI.ClientBody) *ngfAPI.ClientBody {
+	if parent == nil || child == nil {
+		return policies.MergeDefault(parent, child)
+	}
+
+	return &ngfAPI.ClientBody{
+		MaxSize: policies.MergeDefault(parent.MaxSize, child.MaxSize),
+		Timeout: policies.MergeDefault(parent.Timeout, child.Timeout),
+	}
+}
+
+func mergeKeepAlive(parent, child *ngfAPI.ClientKeepAlive) *ngfAPI.ClientKeepAlive {
+	if parent == nil || child == nil {
+		return policies.MergeDefault(parent, child)
+	}
+
+	// the server and header timeouts are configured together in NGINX, so they are merged as a single setting
+	return &ngfAPI.ClientKeepAlive{
+		Requests: policies.MergeDefault(parent.Requests, child.Requests),
+		Time:     policies.MergeDefault(parent.Time, child.Time),
+		Timeout:  policies.MergeDefault(parent.Timeout, child.Timeout),
+	}
+}
+
+func mergeOverride(parent, child *ngfAPI.ClientSettingsOverride) *ngfAPI.ClientSettingsOverride {
+	if parent == nil || child == nil {
+		return policies.MergeOverride(parent, child)
+	}
+
+	return &ngfAPI.ClientSettingsOverride{
+		Body:      overrideBody(parent.Body, child.Body),
+		KeepAlive: overrideKeepAlive(parent.KeepAlive, child.KeepAlive),
+	}
+}
+
+func overrideBody(parent, child *ngfAPI.ClientBody) *ngfAPI.ClientBody {
+	if parent == nil || child == nil {
+		return policies.MergeOverride(parent, child)
+	}
+
+	return &ngfAPI.ClientBody{
+		MaxSize: policies.MergeOverride(parent.MaxSize, child.MaxSize),
+		Timeout: policies.MergeOverride(parent.Timeout, child.Timeout),
+	}
+}
+
+func overrideKeepAlive(parent, child *ngfAPI.ClientKeepAlive) *ngfAPI.ClientKeepAlive {
+	if parent == nil || child == nil {
+		return policies.MergeOverride(parent, child)
+	}
+
+	return &ngfAPI.ClientKeepAlive{
+		Requests: policies.MergeOverride(parent.Requests, child.Requests),
+		Time:     policies.MergeOverride(parent.Time, child.Time),
+		Timeout:  policies.MergeOverride(parent.Timeout, child.Timeout),
+	}
+}
@@ -0,0 +1,134 @@
+package clientsettings
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+func TestMerger_Merge(t *testing.T) {
+	parent := &ngfAPI.ClientSettingsPolicy{Spec: ngfAPI.ClientSettingsPolicySpec{
+		Body: &ngfAPI.ClientBody{
+			MaxSize: helpers.GetPointer[ngfAPI.Size]("10m"),
+			Timeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+		},
+		KeepAlive: &ngfAPI.ClientKeepAlive{
+			Requests: helpers.GetPointer[int32](100),
+			Timeout: &ngfAPI.ClientKeepAliveTimeout{
+				Server: helpers.GetPointer[ngfAPI.Duration]("20s"),
+				Header: helpers.GetPointer[ngfAPI.Duration]("10s"),
+			},
+		},
+	}}
+	parent.ObjectMeta = metav1.ObjectMeta{Namespace: "test", Name: "parent"}
+
+	overrideParent := &ngfAPI.ClientSettingsPolicy{Spec: ngfAPI.ClientSettingsPolicySpec{
+		Body: parent.Spec.Body,
+		Override: &ngfAPI.ClientSettingsOverride{
+			Body: &ngfAPI.ClientBody{
+				MaxSize: helpers.GetPointer[ngfAPI.Size]("10m"),
+			},
+			KeepAlive: &ngfAPI.ClientKeepAlive{
+				Requests: helpers.GetPointer[int32](100),
+			},
+		},
+	}}
+
+	tests := []struct {
+		parent   *ngfAPI.ClientSettingsPolicy
+		child    *ngfAPI.ClientSettingsPolicy
+		expected *ngfAPI.ClientSettingsPolicy
+		name     string
+	}{
+		{
+			name:   "empty child inherits all settings",
+			parent: parent,
+			child:  &ngfAPI.ClientSettingsPolicy{Spec: ngfAPI.ClientSettingsPolicySpec{}},
+			expected: &ngfAPI.ClientSettingsPolicy{Spec: ngfAPI.ClientSettingsPolicySpec{
+				Body:      parent.Spec.Body,
+				KeepAlive: parent.Spec.KeepAlive,
+			}},
+		},
+		{
+			name:   "child settings take precedence",
+			parent: parent,
+			child: &ngfAPI.ClientSettingsPolicy{Spec: ngfAPI.ClientSettingsPolicySpec{
+				Body: &ngfAPI.ClientBody{
+					MaxSize: helpers.GetPointer[ngfAPI.Size]("1m"),
+				},
+				KeepAlive: &ngfAPI.ClientKeepAlive{
+					Time: helpers.GetPointer[ngfAPI.Duration]("1m"),
+					Timeout: &ngfAPI.ClientKeepAliveTimeout{
+						Server: helpers.GetPointer[ngfAPI.Duration]("5s"),
+					},
+				},
+			}},
+			expected: &ngfAPI.ClientSettingsPolicy{Spec: ngfAPI.ClientSettingsPolicySpec{
+				Body: &ngfAPI.ClientBody{
+					MaxSize: helpers.GetPointer[ngfAPI.Size]("1m"),
+					Timeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+				},
+				KeepAlive: &ngfAPI.ClientKeepAlive{
+					Requests: helpers.GetPointer[int32](100),
+					Time:     helpers.GetPointer[ngfAPI.Duration]("1m"),
+					// the timeouts are merged as a single setting, so the parent header timeout is not inherited
+					Timeout: &ngfAPI.ClientKeepAliveTimeout{
+						Server: helpers.GetPointer[ngfAPI.Duration]("5s"),
+					},
+				},
+			}},
+		},
+		{
+			name:   "parent overrides take precedence",
+			parent: overrideParent,
+			child: &ngfAPI.ClientSettingsPolicy{Spec: ngfAPI.ClientSettingsPolicySpec{
+				Body: &ngfAPI.ClientBody{
+					MaxSize: helpers.GetPointer[ngfAPI.Size]("100m"),
+				},
+				Override: &ngfAPI.ClientSettingsOverride{
+					Body: &ngfAPI.ClientBody{
+						MaxSize: helpers.GetPointer[ngfAPI.Size]("1g"),
+						Timeout: helpers.GetPointer[ngfAPI.Duration]("5s"),
+					},
+				},
+			}},
+			expected: &ngfAPI.ClientSettingsPolicy{Spec: ngfAPI.ClientSettingsPolicySpec{
+				Body: &ngfAPI.ClientBody{
+					MaxSize: helpers.GetPointer[ngfAPI.Size]("100m"),
+					Timeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+				},
+				Override: &ngfAPI.ClientSettingsOverride{
+					Body: &ngfAPI.ClientBody{
+						MaxSize: helpers.GetPointer[ngfAPI.Size]("10m"),
+						Timeout: helpers.GetPointer[ngfAPI.Duration]("5s"),
+					},
+					KeepAlive: &ngfAPI.ClientKeepAlive{
+						Requests: helpers.GetPointer[int32](100),
+					},
+				},
+			}},
+		},
+	}
+
+	m := NewMerger()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			parentCopy := test.parent.DeepCopy()
+			childCopy := test.child.DeepCopy()
+
+			merged := m.Merge(test.parent, test.child)
+			g.Expect(helpers.Diff(test.expected, merged)).To(BeEmpty())
+
+			// the inputs must not be modified
+			g.Expect(helpers.Diff(parentCopy, test.parent)).To(BeEmpty())
+			g.Expect(helpers.Diff(childCopy, test.child)).To(BeEmpty())
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package clientsettings
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// Validator validates a ClientSettingsPolicy.
+// Implements policies.Validator interface.
+type Validator struct{}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Validate validates the spec of a ClientSettingsPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	csp := helpers.MustCastObject[*ngfAPI.ClientSettingsPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []v1.Kind{policies.KindGateway, policies.KindHTTPRoute}
+
+	if err := policies.ValidateTargetRef(csp.Spec.TargetRef, targetRefPath, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if err := validateSpec(csp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two ClientSettingsPolicies configure the same settings.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	cspA := helpers.MustCastObject[*ngfAPI.ClientSettingsPolicy](polA)
+	cspB := helpers.MustCastObject[*ngfAPI.ClientSettingsPolicy](polB)
+
+	settingsB := make(map[string]struct{})
+	for _, s := range getConfiguredSettings(cspB.Spec) {
+		settingsB[s] = struct{}{}
+	}
+
+	for _, s := range getConfiguredSettings(cspA.Spec) {
+		if _, exists := settingsB[s]; exists {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateSpec(spec ngfAPI.ClientSettingsPolicySpec) error {
+	specPath := field.NewPath("spec")
+
+	if err := validateKeepAlive(spec.KeepAlive, specPath.Child("keepAlive")); err != nil {
+		return err
+	}
+
+	if spec.Override != nil {
+		return validateKeepAlive(spec.Override.KeepAlive, specPath.Child("override", "keepAlive"))
+	}
+
+	return nil
+}
+
+func validateKeepAlive(keepAlive *ngfAPI.ClientKeepAlive, path *field.Path) error {
+	if keepAlive == nil || keepAlive.Timeout == nil {
+		return nil
+	}
+
+	// NGINX configures both timeouts in the keepalive_timeout directive, where the header timeout is optional.
+	if keepAlive.Timeout.Header != nil && keepAlive.Timeout.Server == nil {
+		return field.Required(path.Child("timeout", "server"), "must be set if header timeout is set")
+	}
+
+	return nil
+}
+
+// getConfiguredSettings returns the paths of the settings configured in the spec.
+// The default and the override of the same setting are different settings.
+func getConfiguredSettings(spec ngfAPI.ClientSettingsPolicySpec) []string {
+	settings := getConfiguredBodyAndKeepAlive(spec.Body, spec.KeepAlive, "")
+
+	if spec.Override != nil {
+		settings = append(
+			settings,
+			getConfiguredBodyAndKeepAlive(spec.Override.Body, spec.Override.KeepAlive, "override.")...,
+		)
+	}
+
+	return settings
+}
+
+func getConfiguredBodyAndKeepAlive(body *ngfAPI.ClientBody, keepAlive *ngfAPI.ClientKeepAlive, prefix string) []string {
+	var settings []string
+
+	if body != nil {
+		if body.MaxSize != nil {
+			settings = append(settings, prefix+"body.maxSize")
+		}
+		if body.Timeout != nil {
+			settings = append(settings, prefix+"body.timeout")
+		}
+	}
+
+	if keepAlive != nil {
+		if keepAlive.Requests != nil {
+			settings = append(settings, prefix+"keepAlive.requests")
+		}
+		if keepAlive.Time != nil {
+			settings = append(settings, prefix+"keepAlive.time")
+		}
+		if keepAlive.Timeout != nil {
+			settings = append(settings, prefix+"keepAlive.timeout")
+		}
+	}
+
+	return settings
+}
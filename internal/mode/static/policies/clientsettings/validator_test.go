@@ -0,0 +1,164 @@
+package clientsettings
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+var (
+	bodySpec = ngfAPI.ClientSettingsPolicySpec{
+		Body: &ngfAPI.ClientBody{
+			MaxSize: helpers.GetPointer[ngfAPI.Size]("10m"),
+			Timeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+		},
+	}
+
+	keepAliveSpec = ngfAPI.ClientSettingsPolicySpec{
+		KeepAlive: &ngfAPI.ClientKeepAlive{
+			Requests: helpers.GetPointer[int32](100),
+			Time:     helpers.GetPointer[ngfAPI.Duration]("1m"),
+			Timeout: &ngfAPI.ClientKeepAliveTimeout{
+				Server: helpers.GetPointer[ngfAPI.Duration]("20s"),
+				Header: helpers.GetPointer[ngfAPI.Duration]("10s"),
+			},
+		},
+	}
+)
+
+func TestValidator_Validate(t *testing.T) {
+	tests := []struct {
+		spec     ngfAPI.ClientSettingsPolicySpec
+		name     string
+		expConds []conditions.Condition
+	}{
+		{
+			name: "valid keep-alive settings",
+			spec: keepAliveSpec,
+		},
+		{
+			name: "valid body settings",
+			spec: bodySpec,
+		},
+		{
+			name: "header timeout without server timeout",
+			spec: ngfAPI.ClientSettingsPolicySpec{
+				KeepAlive: &ngfAPI.ClientKeepAlive{
+					Timeout: &ngfAPI.ClientKeepAliveTimeout{
+						Header: helpers.GetPointer[ngfAPI.Duration]("10s"),
+					},
+				},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"spec.keepAlive.timeout.server: Required value: must be set if header timeout is set",
+				),
+			},
+		},
+		{
+			name: "override header timeout without server timeout",
+			spec: ngfAPI.ClientSettingsPolicySpec{
+				Override: &ngfAPI.ClientSettingsOverride{
+					KeepAlive: &ngfAPI.ClientKeepAlive{
+						Timeout: &ngfAPI.ClientKeepAliveTimeout{
+							Header: helpers.GetPointer[ngfAPI.Duration]("10s"),
+						},
+					},
+				},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"spec.override.keepAlive.timeout.server: Required value: must be set if header timeout is set",
+				),
+			},
+		},
+	}
+
+	v := NewValidator()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			policy := &ngfAPI.ClientSettingsPolicy{Spec: test.spec}
+			policy.Spec.TargetRef.Kind = policies.KindHTTPRoute
+			policy.Spec.TargetRef.Group = v1.GroupName
+
+			conds := v.Validate(policy, nil)
+			g.Expect(conds).To(Equal(test.expConds))
+		})
+	}
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	tests := []struct {
+		polA, polB  *ngfAPI.ClientSettingsPolicy
+		name        string
+		expConflict bool
+	}{
+		{
+			name:        "different settings",
+			polA:        &ngfAPI.ClientSettingsPolicy{Spec: bodySpec},
+			polB:        &ngfAPI.ClientSettingsPolicy{Spec: keepAliveSpec},
+			expConflict: false,
+		},
+		{
+			name:        "same settings",
+			polA:        &ngfAPI.ClientSettingsPolicy{Spec: bodySpec},
+			polB:        &ngfAPI.ClientSettingsPolicy{Spec: bodySpec},
+			expConflict: true,
+		},
+		{
+			name: "one overlapping setting",
+			polA: &ngfAPI.ClientSettingsPolicy{Spec: keepAliveSpec},
+			polB: &ngfAPI.ClientSettingsPolicy{Spec: ngfAPI.ClientSettingsPolicySpec{
+				KeepAlive: &ngfAPI.ClientKeepAlive{
+					Time: helpers.GetPointer[ngfAPI.Duration]("2m"),
+				},
+			}},
+			expConflict: true,
+		},
+		{
+			name: "default and override of the same setting",
+			polA: &ngfAPI.ClientSettingsPolicy{Spec: bodySpec},
+			polB: &ngfAPI.ClientSettingsPolicy{Spec: ngfAPI.ClientSettingsPolicySpec{
+				Override: &ngfAPI.ClientSettingsOverride{Body: bodySpec.Body},
+			}},
+			expConflict: false,
+		},
+		{
+			name: "same override settings",
+			polA: &ngfAPI.ClientSettingsPolicy{Spec: ngfAPI.ClientSettingsPolicySpec{
+				Override: &ngfAPI.ClientSettingsOverride{Body: bodySpec.Body},
+			}},
+			polB: &ngfAPI.ClientSettingsPolicy{Spec: ngfAPI.ClientSettingsPolicySpec{
+				Override: &ngfAPI.ClientSettingsOverride{Body: bodySpec.Body},
+			}},
+			expConflict: true,
+		},
+		{
+			name:        "empty policy",
+			polA:        &ngfAPI.ClientSettingsPolicy{Spec: ngfAPI.ClientSettingsPolicySpec{}},
+			polB:        &ngfAPI.ClientSettingsPolicy{Spec: bodySpec},
+			expConflict: false,
+		},
+	}
+
+	v := NewValidator()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(v.Conflicts(test.polA, test.polB)).To(Equal(test.expConflict))
+			g.Expect(v.Conflicts(test.polB, test.polA)).To(Equal(test.expConflict))
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package compression
+
+import (
+	"slices"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+)
+
+// Merger merges CompressionPolicies.
+// All settings of a CompressionPolicy are defaults: the settings of the child Policy take precedence over
+// the settings of the parent Policy.
+// Implements policies.Merger interface.
+type Merger struct{}
+
+// NewMerger returns a new instance of Merger.
+func NewMerger() *Merger {
+	return &Merger{}
+}
+
+// Merge merges the parent and the child CompressionPolicies.
+// The merged Policy has the metadata and the targetRef of the child Policy.
+func (m *Merger) Merge(parent, child policies.Policy) policies.Policy {
+	parentCP := helpers.MustCastObject[*ngfAPI.CompressionPolicy](parent)
+	childCP := helpers.MustCastObject[*ngfAPI.CompressionPolicy](child)
+
+	merged := childCP.DeepCopy()
+	merged.Spec.Enabled = policies.MergeDefault(parentCP.Spec.Enabled, childCP.Spec.Enabled)
+	merged.Spec.MinLength = policies.MergeDefault(parentCP.Spec.MinLength, childCP.Spec.MinLength)
+	merged.Spec.Level = policies.MergeDefault(parentCP.Spec.Level, childCP.Spec.Level)
+
+	// the types of the child Policy replace the types of the parent Policy
+	if len(childCP.Spec.Types) == 0 {
+		merged.Spec.Types = slices.Clone(parentCP.Spec.Types)
+	}
+
+	return merged
+}
@@ -0,0 +1,74 @@
+package compression
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+func TestMerger_Merge(t *testing.T) {
+	parent := &ngfAPI.CompressionPolicy{Spec: ngfAPI.CompressionPolicySpec{
+		MinLength: helpers.GetPointer[int32](1024),
+		Level:     helpers.GetPointer[int32](5),
+		Types:     []ngfAPI.MIMEType{"application/json", "text/css"},
+	}}
+	parent.ObjectMeta = metav1.ObjectMeta{Namespace: "test", Name: "parent"}
+
+	tests := []struct {
+		child    *ngfAPI.CompressionPolicy
+		expected *ngfAPI.CompressionPolicy
+		name     string
+	}{
+		{
+			name:     "empty child inherits all settings",
+			child:    &ngfAPI.CompressionPolicy{Spec: ngfAPI.CompressionPolicySpec{}},
+			expected: &ngfAPI.CompressionPolicy{Spec: parent.Spec},
+		},
+		{
+			name: "child settings take precedence",
+			child: &ngfAPI.CompressionPolicy{Spec: ngfAPI.CompressionPolicySpec{
+				Level: helpers.GetPointer[int32](9),
+				Types: []ngfAPI.MIMEType{"application/xml"},
+			}},
+			expected: &ngfAPI.CompressionPolicy{Spec: ngfAPI.CompressionPolicySpec{
+				MinLength: helpers.GetPointer[int32](1024),
+				Level:     helpers.GetPointer[int32](9),
+				Types:     []ngfAPI.MIMEType{"application/xml"},
+			}},
+		},
+		{
+			name: "child turns off compression",
+			child: &ngfAPI.CompressionPolicy{Spec: ngfAPI.CompressionPolicySpec{
+				Enabled: helpers.GetPointer(false),
+			}},
+			expected: &ngfAPI.CompressionPolicy{Spec: ngfAPI.CompressionPolicySpec{
+				Enabled:   helpers.GetPointer(false),
+				MinLength: helpers.GetPointer[int32](1024),
+				Level:     helpers.GetPointer[int32](5),
+				Types:     []ngfAPI.MIMEType{"application/json", "text/css"},
+			}},
+		},
+	}
+
+	m := NewMerger()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			parentCopy := parent.DeepCopy()
+			childCopy := test.child.DeepCopy()
+
+			merged := m.Merge(parent, test.child)
+			g.Expect(helpers.Diff(test.expected, merged)).To(BeEmpty())
+
+			// the inputs must not be modified
+			g.Expect(helpers.Diff(parentCopy, parent)).To(BeEmpty())
+			g.Expect(helpers.Diff(childCopy, test.child)).To(BeEmpty())
+		})
+	}
+}
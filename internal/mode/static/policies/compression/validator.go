@@ -0,0 +1,84 @@
+package compression
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+const (
+	minLevel     = 1
+	maxLevel     = 9
+	maxMinLength = 104857600
+)
+
+var mimeTypeRegexp = regexp.MustCompile(`^(\*|[a-z0-9][a-z0-9.+-]*/[a-z0-9][a-z0-9.+-]*)$`)
+
+// Validator validates a CompressionPolicy.
+// Implements policies.Validator interface.
+type Validator struct{}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Validate validates the spec of a CompressionPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	cp := helpers.MustCastObject[*ngfAPI.CompressionPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []v1.Kind{policies.KindGateway, policies.KindHTTPRoute}
+
+	if err := policies.ValidateTargetRef(cp.Spec.TargetRef, targetRefPath, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if errs := validateSpec(cp.Spec); len(errs) > 0 {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(errs.ToAggregate().Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true, because the settings of a CompressionPolicy configure the compression together:
+// only one CompressionPolicy can be applied to a target.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+func validateSpec(spec ngfAPI.CompressionPolicySpec) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if spec.MinLength != nil && (*spec.MinLength < 0 || *spec.MinLength > maxMinLength) {
+		allErrs = append(allErrs, field.Invalid(
+			specPath.Child("minLength"),
+			*spec.MinLength,
+			"must be between 0 and 104857600",
+		))
+	}
+
+	if spec.Level != nil && (*spec.Level < minLevel || *spec.Level > maxLevel) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("level"), *spec.Level, "must be between 1 and 9"))
+	}
+
+	for i, t := range spec.Types {
+		if !mimeTypeRegexp.MatchString(string(t)) {
+			allErrs = append(allErrs, field.Invalid(
+				specPath.Child("types").Index(i),
+				t,
+				"must be '*' or a lowercase MIME type without parameters, for example, application/json",
+			))
+		}
+	}
+
+	return allErrs
+}
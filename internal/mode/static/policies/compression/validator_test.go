@@ -0,0 +1,80 @@
+package compression
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	tests := []struct {
+		spec     ngfAPI.CompressionPolicySpec
+		name     string
+		expConds []conditions.Condition
+	}{
+		{
+			name: "valid empty policy",
+			spec: ngfAPI.CompressionPolicySpec{},
+		},
+		{
+			name: "valid policy with all fields",
+			spec: ngfAPI.CompressionPolicySpec{
+				Enabled:   helpers.GetPointer(true),
+				MinLength: helpers.GetPointer[int32](1024),
+				Level:     helpers.GetPointer[int32](5),
+				Types:     []ngfAPI.MIMEType{"application/json", "image/svg+xml", "*"},
+			},
+		},
+		{
+			name: "invalid fields",
+			spec: ngfAPI.CompressionPolicySpec{
+				MinLength: helpers.GetPointer[int32](-1),
+				Level:     helpers.GetPointer[int32](10),
+				Types:     []ngfAPI.MIMEType{"application/json; charset=utf-8", "text"},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.minLength: Invalid value: -1: must be between 0 and 104857600, " +
+						"spec.level: Invalid value: 10: must be between 1 and 9, " +
+						"spec.types[0]: Invalid value: \"application/json; charset=utf-8\": must be '*' or " +
+						"a lowercase MIME type without parameters, for example, application/json, " +
+						"spec.types[1]: Invalid value: \"text\": must be '*' or " +
+						"a lowercase MIME type without parameters, for example, application/json]",
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			v := NewValidator()
+
+			policy := &ngfAPI.CompressionPolicy{Spec: test.spec}
+			policy.Spec.TargetRef.Kind = policies.KindHTTPRoute
+			policy.Spec.TargetRef.Group = v1.GroupName
+
+			conds := v.Validate(policy, nil)
+			g.Expect(conds).To(Equal(test.expConds))
+		})
+	}
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	g := NewWithT(t)
+
+	v := NewValidator()
+
+	polA := &ngfAPI.CompressionPolicy{Spec: ngfAPI.CompressionPolicySpec{Level: helpers.GetPointer[int32](1)}}
+	polB := &ngfAPI.CompressionPolicy{Spec: ngfAPI.CompressionPolicySpec{Types: []ngfAPI.MIMEType{"application/json"}}}
+
+	g.Expect(v.Conflicts(polA, polB)).To(BeTrue())
+}
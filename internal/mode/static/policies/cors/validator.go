@@ -0,0 +1,129 @@
+package cors
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+const (
+	maxMaxAge = 86400
+)
+
+var (
+	// originRegexp matches "*" and the origins with an optional "*." wildcard at the start of the host.
+	originRegexp = regexp.MustCompile(`^(\*|https?://(\*\.)?[a-z0-9]([a-z0-9.-]*[a-z0-9])?(:[0-9]{1,5})?)$`)
+
+	headerNameRegexp = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+
+	supportedMethods = map[v1.HTTPMethod]struct{}{
+		v1.HTTPMethodGet:     {},
+		v1.HTTPMethodHead:    {},
+		v1.HTTPMethodPost:    {},
+		v1.HTTPMethodPut:     {},
+		v1.HTTPMethodDelete:  {},
+		v1.HTTPMethodConnect: {},
+		v1.HTTPMethodOptions: {},
+		v1.HTTPMethodTrace:   {},
+		v1.HTTPMethodPatch:   {},
+	}
+)
+
+// Validator validates a CORSPolicy.
+// Implements policies.Validator interface.
+type Validator struct{}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Validate validates the spec of a CORSPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	cp := helpers.MustCastObject[*ngfAPI.CORSPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []v1.Kind{policies.KindHTTPRoute}
+
+	if err := policies.ValidateTargetRef(cp.Spec.TargetRef, targetRefPath, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if errs := validateSpec(cp.Spec); len(errs) > 0 {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(errs.ToAggregate().Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true, because a CORSPolicy is a Direct Policy: only one CORSPolicy can be applied to a target.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+func validateSpec(spec ngfAPI.CORSPolicySpec) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	originsPath := specPath.Child("allowOrigins")
+	if len(spec.AllowOrigins) == 0 {
+		allErrs = append(allErrs, field.Required(originsPath, "at least one origin must be set"))
+	}
+
+	for i, origin := range spec.AllowOrigins {
+		if !originRegexp.MatchString(string(origin)) {
+			allErrs = append(allErrs, field.Invalid(
+				originsPath.Index(i),
+				origin,
+				"must be '*' or a lowercase scheme and host with an optional port, "+
+					"where the host can start with a '*.' wildcard",
+			))
+		}
+	}
+
+	for i, method := range spec.AllowMethods {
+		if _, ok := supportedMethods[method]; !ok {
+			allErrs = append(allErrs, field.NotSupported(
+				specPath.Child("allowMethods").Index(i),
+				method,
+				[]string{"GET", "HEAD", "POST", "PUT", "DELETE", "CONNECT", "OPTIONS", "TRACE", "PATCH"},
+			))
+		}
+	}
+
+	allErrs = append(allErrs, validateHeaderNames(spec.AllowHeaders, specPath.Child("allowHeaders"))...)
+	allErrs = append(allErrs, validateHeaderNames(spec.ExposeHeaders, specPath.Child("exposeHeaders"))...)
+
+	if spec.MaxAge != nil && (*spec.MaxAge < 0 || *spec.MaxAge > maxMaxAge) {
+		allErrs = append(allErrs, field.Invalid(
+			specPath.Child("maxAge"),
+			*spec.MaxAge,
+			"must be between 0 and 86400",
+		))
+	}
+
+	return allErrs
+}
+
+func validateHeaderNames(names []ngfAPI.HeaderName, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, name := range names {
+		if !headerNameRegexp.MatchString(string(name)) {
+			allErrs = append(allErrs, field.Invalid(
+				path.Index(i),
+				name,
+				"must only contain alphanumeric characters and '-'",
+			))
+		}
+	}
+
+	return allErrs
+}
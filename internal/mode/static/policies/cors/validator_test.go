@@ -0,0 +1,104 @@
+package cors
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	tests := []struct {
+		spec     ngfAPI.CORSPolicySpec
+		name     string
+		expConds []conditions.Condition
+	}{
+		{
+			name: "valid policy",
+			spec: ngfAPI.CORSPolicySpec{
+				AllowOrigins: []ngfAPI.CORSOrigin{"*"},
+			},
+		},
+		{
+			name: "valid policy with all fields",
+			spec: ngfAPI.CORSPolicySpec{
+				AllowOrigins: []ngfAPI.CORSOrigin{
+					"https://example.com",
+					"http://localhost:8080",
+					"https://*.example.com",
+				},
+				AllowMethods:     []v1.HTTPMethod{v1.HTTPMethodGet, v1.HTTPMethodPut},
+				AllowHeaders:     []ngfAPI.HeaderName{"Authorization", "Content-Type"},
+				ExposeHeaders:    []ngfAPI.HeaderName{"X-Request-Id"},
+				AllowCredentials: helpers.GetPointer(true),
+				MaxAge:           helpers.GetPointer[int32](600),
+			},
+		},
+		{
+			name: "no origins",
+			spec: ngfAPI.CORSPolicySpec{},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.allowOrigins: Required value: at least one origin must be set"),
+			},
+		},
+		{
+			name: "invalid fields",
+			spec: ngfAPI.CORSPolicySpec{
+				AllowOrigins:  []ngfAPI.CORSOrigin{"https://example.com/path", "https://a.*.com"},
+				AllowMethods:  []v1.HTTPMethod{"FETCH"},
+				AllowHeaders:  []ngfAPI.HeaderName{"X Header"},
+				ExposeHeaders: []ngfAPI.HeaderName{"X-$header"},
+				MaxAge:        helpers.GetPointer[int32](-1),
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.allowOrigins[0]: Invalid value: \"https://example.com/path\": must be '*' or a lowercase " +
+						"scheme and host with an optional port, where the host can start with a '*.' wildcard, " +
+						"spec.allowOrigins[1]: Invalid value: \"https://a.*.com\": must be '*' or a lowercase " +
+						"scheme and host with an optional port, where the host can start with a '*.' wildcard, " +
+						"spec.allowMethods[0]: Unsupported value: \"FETCH\": supported values: \"GET\", \"HEAD\", " +
+						"\"POST\", \"PUT\", \"DELETE\", \"CONNECT\", \"OPTIONS\", \"TRACE\", \"PATCH\", " +
+						"spec.allowHeaders[0]: Invalid value: \"X Header\": " +
+						"must only contain alphanumeric characters and '-', " +
+						"spec.exposeHeaders[0]: Invalid value: \"X-$header\": " +
+						"must only contain alphanumeric characters and '-', " +
+						"spec.maxAge: Invalid value: -1: must be between 0 and 86400]",
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			v := NewValidator()
+
+			policy := &ngfAPI.CORSPolicy{Spec: test.spec}
+			policy.Spec.TargetRef.Kind = policies.KindHTTPRoute
+			policy.Spec.TargetRef.Group = v1.GroupName
+
+			conds := v.Validate(policy, nil)
+			g.Expect(conds).To(Equal(test.expConds))
+		})
+	}
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	g := NewWithT(t)
+
+	v := NewValidator()
+
+	polA := &ngfAPI.CORSPolicy{Spec: ngfAPI.CORSPolicySpec{AllowOrigins: []ngfAPI.CORSOrigin{"*"}}}
+	polB := &ngfAPI.CORSPolicy{Spec: ngfAPI.CORSPolicySpec{
+		AllowOrigins: []ngfAPI.CORSOrigin{"https://example.com"},
+	}}
+
+	g.Expect(v.Conflicts(polA, polB)).To(BeTrue())
+}
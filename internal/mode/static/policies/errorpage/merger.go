@@ -0,0 +1,22 @@
+package errorpage
+
+import (
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+)
+
+// Merger merges ErrorPagePolicies.
+// The pages of the child Policy replace the pages of the parent Policy, because the pages reference resources in
+// the namespace of their Policy. The pages of a Gateway Policy are configured for the whole Gateway, so that
+// they still apply to the status codes that the pages of an HTTPRoute Policy don't handle.
+// Implements policies.Merger interface.
+type Merger struct{}
+
+// NewMerger returns a new instance of Merger.
+func NewMerger() *Merger {
+	return &Merger{}
+}
+
+// Merge returns the child ErrorPagePolicy.
+func (m *Merger) Merge(_, child policies.Policy) policies.Policy {
+	return child
+}
@@ -0,0 +1,162 @@
+package errorpage
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+const (
+	minStatusCode         = 400
+	maxStatusCode         = 599
+	minResponseStatusCode = 200
+)
+
+var (
+	keyRegexp         = regexp.MustCompile(`^[-._a-zA-Z0-9]+$`)
+	contentTypeRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9.+-]*/[a-z0-9][a-z0-9.+-]*$`)
+)
+
+// Validator validates an ErrorPagePolicy.
+// It doesn't validate the referenced Services and ConfigMaps, because they are resolved when the Graph is built.
+// Implements policies.Validator interface.
+type Validator struct{}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Validate validates the spec of an ErrorPagePolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	epp := helpers.MustCastObject[*ngfAPI.ErrorPagePolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []v1.Kind{policies.KindGateway, policies.KindHTTPRoute}
+
+	if err := policies.ValidateTargetRef(epp.Spec.TargetRef, targetRefPath, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if errs := validateSpec(epp.Spec); len(errs) > 0 {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(errs.ToAggregate().Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true, because the pages of an ErrorPagePolicy reference resources in the namespace of
+// the Policy: only one ErrorPagePolicy can be applied to a target.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+func validateSpec(spec ngfAPI.ErrorPagePolicySpec) field.ErrorList {
+	var allErrs field.ErrorList
+	pagesPath := field.NewPath("spec").Child("pages")
+
+	if len(spec.Pages) == 0 {
+		allErrs = append(allErrs, field.Required(pagesPath, "at least one page must be specified"))
+	}
+
+	// a status code can only be handled by one page
+	codes := make(map[ngfAPI.ErrorPageStatusCode]struct{})
+
+	for i, page := range spec.Pages {
+		pagePath := pagesPath.Index(i)
+
+		if len(page.Codes) == 0 {
+			allErrs = append(allErrs, field.Required(pagePath.Child("codes"), "at least one code must be specified"))
+		}
+
+		for j, code := range page.Codes {
+			codePath := pagePath.Child("codes").Index(j)
+
+			if code < minStatusCode || code > maxStatusCode {
+				allErrs = append(allErrs, field.Invalid(codePath, code, "must be between 400 and 599"))
+				continue
+			}
+
+			if _, exists := codes[code]; exists {
+				allErrs = append(allErrs, field.Duplicate(codePath, code))
+				continue
+			}
+
+			codes[code] = struct{}{}
+		}
+
+		if page.ResponseCode != nil &&
+			(*page.ResponseCode < minResponseStatusCode || *page.ResponseCode > maxStatusCode) {
+			allErrs = append(allErrs, field.Invalid(
+				pagePath.Child("responseCode"),
+				*page.ResponseCode,
+				"must be between 200 and 599",
+			))
+		}
+
+		switch {
+		case page.BackendRef != nil && page.Content != nil:
+			allErrs = append(allErrs, field.Invalid(
+				pagePath,
+				"backendRef and content",
+				"exactly one of backendRef or content must be specified",
+			))
+		case page.BackendRef != nil:
+			allErrs = append(allErrs, validateBackendRef(*page.BackendRef, pagePath.Child("backendRef"))...)
+		case page.Content != nil:
+			allErrs = append(allErrs, validateContent(*page.Content, pagePath.Child("content"))...)
+		default:
+			allErrs = append(allErrs, field.Required(pagePath, "exactly one of backendRef or content must be specified"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateBackendRef(ref v1.BackendObjectReference, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	// Services belong to the core API group, which is represented by an empty group.
+	if ref.Group != nil && !(*ref.Group == "core" || *ref.Group == "") {
+		allErrs = append(allErrs, field.NotSupported(path.Child("group"), *ref.Group, []string{"core", ""}))
+	}
+
+	if ref.Kind != nil && *ref.Kind != policies.KindService {
+		allErrs = append(allErrs, field.NotSupported(path.Child("kind"), *ref.Kind, []string{string(policies.KindService)}))
+	}
+
+	if ref.Port == nil {
+		allErrs = append(allErrs, field.Required(path.Child("port"), "port cannot be nil"))
+	}
+
+	return allErrs
+}
+
+func validateContent(content ngfAPI.ErrorPageContent, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !keyRegexp.MatchString(content.Key) {
+		allErrs = append(allErrs, field.Invalid(
+			path.Child("key"),
+			content.Key,
+			"must only contain alphanumeric characters, '-', '_' or '.'",
+		))
+	}
+
+	if content.ContentType != nil && !contentTypeRegexp.MatchString(string(*content.ContentType)) {
+		allErrs = append(allErrs, field.Invalid(
+			path.Child("contentType"),
+			*content.ContentType,
+			"must be a lowercase MIME type without parameters, for example, text/html",
+		))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,133 @@
+package errorpage
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func createBackendRef(port int32) *v1.BackendObjectReference {
+	ref := &v1.BackendObjectReference{Name: "errors"}
+	if port != 0 {
+		ref.Port = helpers.GetPointer[v1.PortNumber](v1.PortNumber(port))
+	}
+
+	return ref
+}
+
+func TestValidator_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		pages    []ngfAPI.ErrorPage
+		expConds []conditions.Condition
+	}{
+		{
+			name: "valid policy",
+			pages: []ngfAPI.ErrorPage{
+				{
+					Codes:      []ngfAPI.ErrorPageStatusCode{502, 503},
+					BackendRef: createBackendRef(80),
+				},
+				{
+					Codes:        []ngfAPI.ErrorPageStatusCode{404},
+					ResponseCode: helpers.GetPointer[int32](200),
+					Content: &ngfAPI.ErrorPageContent{
+						ConfigMapName: "pages",
+						Key:           "404.json",
+						ContentType:   helpers.GetPointer[ngfAPI.MIMEType]("application/json"),
+					},
+				},
+			},
+		},
+		{
+			name: "no pages",
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.pages: Required value: at least one page must be specified"),
+			},
+		},
+		{
+			name: "invalid pages",
+			pages: []ngfAPI.ErrorPage{
+				{
+					Codes:        []ngfAPI.ErrorPageStatusCode{304, 404},
+					ResponseCode: helpers.GetPointer[int32](101),
+					BackendRef: &v1.BackendObjectReference{
+						Group: helpers.GetPointer[v1.Group]("apps"),
+						Kind:  helpers.GetPointer[v1.Kind]("Deployment"),
+						Name:  "errors",
+					},
+				},
+				{
+					Codes: []ngfAPI.ErrorPageStatusCode{404},
+					Content: &ngfAPI.ErrorPageContent{
+						ConfigMapName: "pages",
+						Key:           "404/html",
+						ContentType:   helpers.GetPointer[ngfAPI.MIMEType]("*"),
+					},
+				},
+				{
+					BackendRef: createBackendRef(80),
+					Content:    &ngfAPI.ErrorPageContent{ConfigMapName: "pages", Key: "500.html"},
+				},
+				{
+					Codes: []ngfAPI.ErrorPageStatusCode{503},
+				},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.pages[0].codes[0]: Invalid value: 304: must be between 400 and 599, " +
+						"spec.pages[0].responseCode: Invalid value: 101: must be between 200 and 599, " +
+						"spec.pages[0].backendRef.group: Unsupported value: \"apps\": supported values: \"core\", \"\", " +
+						"spec.pages[0].backendRef.kind: Unsupported value: \"Deployment\": supported values: \"Service\", " +
+						"spec.pages[0].backendRef.port: Required value: port cannot be nil, " +
+						"spec.pages[1].codes[0]: Duplicate value: 404, " +
+						"spec.pages[1].content.key: Invalid value: \"404/html\": must only contain alphanumeric " +
+						"characters, '-', '_' or '.', " +
+						"spec.pages[1].content.contentType: Invalid value: \"*\": must be a lowercase MIME type " +
+						"without parameters, for example, text/html, " +
+						"spec.pages[2].codes: Required value: at least one code must be specified, " +
+						"spec.pages[2]: Invalid value: \"backendRef and content\": exactly one of backendRef or " +
+						"content must be specified, " +
+						"spec.pages[3]: Required value: exactly one of backendRef or content must be specified]",
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			v := NewValidator()
+
+			policy := &ngfAPI.ErrorPagePolicy{Spec: ngfAPI.ErrorPagePolicySpec{Pages: test.pages}}
+			policy.Spec.TargetRef.Kind = policies.KindHTTPRoute
+			policy.Spec.TargetRef.Group = v1.GroupName
+
+			conds := v.Validate(policy, nil)
+			g.Expect(conds).To(Equal(test.expConds))
+		})
+	}
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	g := NewWithT(t)
+
+	v := NewValidator()
+
+	polA := &ngfAPI.ErrorPagePolicy{Spec: ngfAPI.ErrorPagePolicySpec{
+		Pages: []ngfAPI.ErrorPage{{Codes: []ngfAPI.ErrorPageStatusCode{404}}},
+	}}
+	polB := &ngfAPI.ErrorPagePolicy{Spec: ngfAPI.ErrorPagePolicySpec{
+		Pages: []ngfAPI.ErrorPage{{Codes: []ngfAPI.ErrorPageStatusCode{500}}},
+	}}
+
+	g.Expect(v.Conflicts(polA, polB)).To(BeTrue())
+}
@@ -0,0 +1,112 @@
+package externalauth
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+var (
+	// pathRegexp matches the paths that can be used in the proxy_pass directive: they must not contain
+	// whitespace, braces, semicolons, variables, quotes or backslashes.
+	pathRegexp = regexp.MustCompile(`^/[^\s{};$"'\\]*$`)
+
+	headerNameRegexp = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+)
+
+// Validator validates an ExternalAuthPolicy.
+// It doesn't validate the referenced Service, because the Services are resolved when the Graph is built.
+// Implements policies.Validator interface.
+type Validator struct{}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Validate validates the spec of an ExternalAuthPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	eap := helpers.MustCastObject[*ngfAPI.ExternalAuthPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []v1.Kind{policies.KindHTTPRoute}
+
+	if err := policies.ValidateTargetRef(eap.Spec.TargetRef, targetRefPath, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if errs := validateSpec(eap.Spec); len(errs) > 0 {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(errs.ToAggregate().Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true, because an ExternalAuthPolicy is a Direct Policy: only one ExternalAuthPolicy can
+// be applied to a target.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+func validateSpec(spec ngfAPI.ExternalAuthPolicySpec) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	backendRefPath := specPath.Child("backendRef")
+
+	// Services belong to the core API group, which is represented by an empty group.
+	if spec.BackendRef.Group != nil && !(*spec.BackendRef.Group == "core" || *spec.BackendRef.Group == "") {
+		allErrs = append(allErrs, field.NotSupported(
+			backendRefPath.Child("group"),
+			*spec.BackendRef.Group,
+			[]string{"core", ""},
+		))
+	}
+
+	if spec.BackendRef.Kind != nil && *spec.BackendRef.Kind != policies.KindService {
+		allErrs = append(allErrs, field.NotSupported(
+			backendRefPath.Child("kind"),
+			*spec.BackendRef.Kind,
+			[]string{string(policies.KindService)},
+		))
+	}
+
+	if spec.BackendRef.Port == nil {
+		allErrs = append(allErrs, field.Required(backendRefPath.Child("port"), "port cannot be nil"))
+	}
+
+	if spec.Path != nil && !pathRegexp.MatchString(*spec.Path) {
+		allErrs = append(allErrs, field.Invalid(
+			specPath.Child("path"),
+			*spec.Path,
+			"must start with / and must not contain whitespace, '{', '}', ';', '$', quotes or '\\'",
+		))
+	}
+
+	allErrs = append(allErrs, validateHeaderNames(spec.ForwardRequestHeaders, specPath.Child("forwardRequestHeaders"))...)
+	allErrs = append(allErrs, validateHeaderNames(spec.CopyResponseHeaders, specPath.Child("copyResponseHeaders"))...)
+
+	return allErrs
+}
+
+func validateHeaderNames(names []ngfAPI.HeaderName, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, name := range names {
+		if !headerNameRegexp.MatchString(string(name)) {
+			allErrs = append(allErrs, field.Invalid(
+				path.Index(i),
+				name,
+				"must only contain alphanumeric characters and '-'",
+			))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,113 @@
+package externalauth
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func createBackendRef() v1.BackendObjectReference {
+	return v1.BackendObjectReference{
+		Name: "authz",
+		Port: helpers.GetPointer[v1.PortNumber](80),
+	}
+}
+
+func TestValidator_Validate(t *testing.T) {
+	tests := []struct {
+		spec     ngfAPI.ExternalAuthPolicySpec
+		name     string
+		expConds []conditions.Condition
+	}{
+		{
+			name: "valid policy",
+			spec: ngfAPI.ExternalAuthPolicySpec{
+				BackendRef: createBackendRef(),
+			},
+		},
+		{
+			name: "valid policy with all fields",
+			spec: ngfAPI.ExternalAuthPolicySpec{
+				BackendRef: v1.BackendObjectReference{
+					Group:     helpers.GetPointer[v1.Group](""),
+					Kind:      helpers.GetPointer[v1.Kind]("Service"),
+					Name:      "authz",
+					Namespace: helpers.GetPointer[v1.Namespace]("other"),
+					Port:      helpers.GetPointer[v1.PortNumber](4180),
+				},
+				Path:                  helpers.GetPointer("/oauth2/auth"),
+				ForwardRequestHeaders: []ngfAPI.HeaderName{"Authorization", "Cookie"},
+				CopyResponseHeaders:   []ngfAPI.HeaderName{"X-User", "X-Email"},
+			},
+		},
+		{
+			name: "invalid backend ref",
+			spec: ngfAPI.ExternalAuthPolicySpec{
+				BackendRef: v1.BackendObjectReference{
+					Group: helpers.GetPointer[v1.Group]("gateway.nginx.org"),
+					Kind:  helpers.GetPointer[v1.Kind]("ConfigMap"),
+					Name:  "authz",
+				},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.backendRef.group: Unsupported value: \"gateway.nginx.org\": supported values: \"core\", \"\", " +
+						"spec.backendRef.kind: Unsupported value: \"ConfigMap\": supported values: \"Service\", " +
+						"spec.backendRef.port: Required value: port cannot be nil]",
+				),
+			},
+		},
+		{
+			name: "invalid path and headers",
+			spec: ngfAPI.ExternalAuthPolicySpec{
+				BackendRef:            createBackendRef(),
+				Path:                  helpers.GetPointer("/auth;return 200"),
+				ForwardRequestHeaders: []ngfAPI.HeaderName{"Authorization", "X_Invalid"},
+				CopyResponseHeaders:   []ngfAPI.HeaderName{"X-User $x"},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.path: Invalid value: \"/auth;return 200\": must start with / and must not contain " +
+						"whitespace, '{', '}', ';', '$', quotes or '\\', " +
+						"spec.forwardRequestHeaders[1]: Invalid value: \"X_Invalid\": " +
+						"must only contain alphanumeric characters and '-', " +
+						"spec.copyResponseHeaders[0]: Invalid value: \"X-User $x\": " +
+						"must only contain alphanumeric characters and '-']",
+				),
+			},
+		},
+	}
+
+	v := NewValidator()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			policy := &ngfAPI.ExternalAuthPolicy{Spec: test.spec}
+			policy.Spec.TargetRef.Kind = policies.KindHTTPRoute
+			policy.Spec.TargetRef.Group = v1.GroupName
+
+			conds := v.Validate(policy, nil)
+			g.Expect(conds).To(Equal(test.expConds))
+		})
+	}
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	g := NewWithT(t)
+
+	v := NewValidator()
+
+	polA := &ngfAPI.ExternalAuthPolicy{Spec: ngfAPI.ExternalAuthPolicySpec{BackendRef: createBackendRef()}}
+	polB := &ngfAPI.ExternalAuthPolicy{Spec: ngfAPI.ExternalAuthPolicySpec{BackendRef: createBackendRef()}}
+
+	g.Expect(v.Conflicts(polA, polB)).To(BeTrue())
+}
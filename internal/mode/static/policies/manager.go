@@ -0,0 +1,93 @@
+package policies
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+)
+
+// GVKExtractor returns the GroupVersionKind of an object. It panics if the GroupVersionKind can't be determined.
+type GVKExtractor func(obj client.Object) schema.GroupVersionKind
+
+// ManagerConfig contains the config to register a Policy kind with the Manager.
+type ManagerConfig struct {
+	// Validator is the Validator for the Policy kind.
+	Validator Validator
+	// Merger is the Merger for the Policy kind.
+	Merger Merger
+	// GVK is the GroupVersionKind of the Policy kind.
+	GVK schema.GroupVersionKind
+}
+
+// Manager manages the Validators and the Mergers of the NGF Policy kinds.
+// It calls the Validator or the Merger registered for the kind of the passed Policy.
+type Manager struct {
+	validators     map[schema.GroupVersionKind]Validator
+	mergers        map[schema.GroupVersionKind]Merger
+	mustExtractGVK GVKExtractor
+}
+
+// NewManager returns a new Manager for the registered Policy kinds.
+func NewManager(mustExtractGVK GVKExtractor, configs ...ManagerConfig) *Manager {
+	m := &Manager{
+		validators:     make(map[schema.GroupVersionKind]Validator, len(configs)),
+		mergers:        make(map[schema.GroupVersionKind]Merger, len(configs)),
+		mustExtractGVK: mustExtractGVK,
+	}
+
+	for _, cfg := range configs {
+		m.validators[cfg.GVK] = cfg.Validator
+		m.mergers[cfg.GVK] = cfg.Merger
+	}
+
+	return m
+}
+
+// Validate validates the Policy with the Validator of its kind.
+// It panics if the kind of the Policy is not registered.
+func (m *Manager) Validate(policy Policy, globalSettings *GlobalSettings) []conditions.Condition {
+	gvk := m.mustExtractGVK(policy)
+
+	validator, ok := m.validators[gvk]
+	if !ok {
+		panic(fmt.Sprintf("no validator registered for policy %T", policy))
+	}
+
+	return validator.Validate(policy, globalSettings)
+}
+
+// Conflicts returns true if the two Policies conflict according to the Validator of their kind.
+// Policies of different kinds never conflict.
+// It panics if the kind of the Policies is not registered.
+func (m *Manager) Conflicts(a, b Policy) bool {
+	gvk := m.mustExtractGVK(a)
+	if gvk != m.mustExtractGVK(b) {
+		return false
+	}
+
+	validator, ok := m.validators[gvk]
+	if !ok {
+		panic(fmt.Sprintf("no validator registered for policy %T", a))
+	}
+
+	return validator.Conflicts(a, b)
+}
+
+// Merge merges the Policies with the Merger of their kind.
+// It panics if the Policies are of different kinds or if their kind is not registered.
+func (m *Manager) Merge(parent, child Policy) Policy {
+	gvk := m.mustExtractGVK(parent)
+	if childGVK := m.mustExtractGVK(child); gvk != childGVK {
+		panic(fmt.Sprintf("cannot merge policies of different kinds %s and %s", gvk.Kind, childGVK.Kind))
+	}
+
+	merger, ok := m.mergers[gvk]
+	if !ok {
+		panic(fmt.Sprintf("no merger registered for policy %T", parent))
+	}
+
+	return merger.Merge(parent, child)
+}
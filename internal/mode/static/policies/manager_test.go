@@ -0,0 +1,151 @@
+package policies_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/policiesfakes"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+var (
+	cspGVK = schema.GroupVersionKind{Group: ngfAPI.GroupName, Version: "v1alpha1", Kind: "ClientSettingsPolicy"}
+	opGVK  = schema.GroupVersionKind{Group: ngfAPI.GroupName, Version: "v1alpha1", Kind: "ObservabilityPolicy"}
+)
+
+func mustExtractGVK(obj client.Object) schema.GroupVersionKind {
+	switch obj.(type) {
+	case *ngfAPI.ClientSettingsPolicy:
+		return cspGVK
+	case *ngfAPI.ObservabilityPolicy:
+		return opGVK
+	default:
+		return schema.GroupVersionKind{}
+	}
+}
+
+func TestManager_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	invalidConds := []conditions.Condition{staticConds.NewPolicyInvalid("invalid")}
+	globalSettings := &policies.GlobalSettings{TelemetryEnabled: true}
+
+	cspValidator := &policiesfakes.FakeValidator{}
+	cspValidator.ValidateReturns(invalidConds)
+	opValidator := &policiesfakes.FakeValidator{}
+
+	mgr := policies.NewManager(
+		mustExtractGVK,
+		policies.ManagerConfig{GVK: cspGVK, Validator: cspValidator, Merger: &policiesfakes.FakeMerger{}},
+		policies.ManagerConfig{GVK: opGVK, Validator: opValidator, Merger: &policiesfakes.FakeMerger{}},
+	)
+
+	csp := &ngfAPI.ClientSettingsPolicy{}
+
+	g.Expect(mgr.Validate(csp, globalSettings)).To(Equal(invalidConds))
+	g.Expect(cspValidator.ValidateCallCount()).To(Equal(1))
+	g.Expect(opValidator.ValidateCallCount()).To(BeZero())
+
+	passedPolicy, passedSettings := cspValidator.ValidateArgsForCall(0)
+	g.Expect(passedPolicy).To(BeIdenticalTo(csp))
+	g.Expect(passedSettings).To(BeIdenticalTo(globalSettings))
+
+	g.Expect(mgr.Validate(&ngfAPI.ObservabilityPolicy{}, globalSettings)).To(BeNil())
+	g.Expect(opValidator.ValidateCallCount()).To(Equal(1))
+}
+
+func TestManager_Conflicts(t *testing.T) {
+	g := NewWithT(t)
+
+	cspValidator := &policiesfakes.FakeValidator{}
+	cspValidator.ConflictsReturns(true)
+
+	mgr := policies.NewManager(
+		mustExtractGVK,
+		policies.ManagerConfig{GVK: cspGVK, Validator: cspValidator, Merger: &policiesfakes.FakeMerger{}},
+		policies.ManagerConfig{GVK: opGVK, Validator: &policiesfakes.FakeValidator{}, Merger: &policiesfakes.FakeMerger{}},
+	)
+
+	g.Expect(mgr.Conflicts(&ngfAPI.ClientSettingsPolicy{}, &ngfAPI.ClientSettingsPolicy{})).To(BeTrue())
+	g.Expect(cspValidator.ConflictsCallCount()).To(Equal(1))
+
+	g.Expect(mgr.Conflicts(&ngfAPI.ClientSettingsPolicy{}, &ngfAPI.ObservabilityPolicy{})).To(BeFalse())
+	g.Expect(cspValidator.ConflictsCallCount()).To(Equal(1))
+}
+
+func TestManager_Merge(t *testing.T) {
+	g := NewWithT(t)
+
+	merged := &ngfAPI.ClientSettingsPolicy{}
+	cspMerger := &policiesfakes.FakeMerger{}
+	cspMerger.MergeReturns(merged)
+
+	mgr := policies.NewManager(
+		mustExtractGVK,
+		policies.ManagerConfig{GVK: cspGVK, Validator: &policiesfakes.FakeValidator{}, Merger: cspMerger},
+	)
+
+	parent := &ngfAPI.ClientSettingsPolicy{}
+	child := &ngfAPI.ClientSettingsPolicy{}
+
+	g.Expect(mgr.Merge(parent, child)).To(BeIdenticalTo(merged))
+	g.Expect(cspMerger.MergeCallCount()).To(Equal(1))
+
+	passedParent, passedChild := cspMerger.MergeArgsForCall(0)
+	g.Expect(passedParent).To(BeIdenticalTo(parent))
+	g.Expect(passedChild).To(BeIdenticalTo(child))
+}
+
+func TestManager_Panics(t *testing.T) {
+	mgr := policies.NewManager(
+		mustExtractGVK,
+		policies.ManagerConfig{
+			GVK:       cspGVK,
+			Validator: &policiesfakes.FakeValidator{},
+			Merger:    &policiesfakes.FakeMerger{},
+		},
+	)
+
+	tests := []struct {
+		run  func()
+		name string
+	}{
+		{
+			name: "validate unregistered kind",
+			run: func() {
+				mgr.Validate(&ngfAPI.ObservabilityPolicy{}, nil)
+			},
+		},
+		{
+			name: "conflicts of unregistered kind",
+			run: func() {
+				mgr.Conflicts(&ngfAPI.ObservabilityPolicy{}, &ngfAPI.ObservabilityPolicy{})
+			},
+		},
+		{
+			name: "merge unregistered kind",
+			run: func() {
+				mgr.Merge(&ngfAPI.ObservabilityPolicy{}, &ngfAPI.ObservabilityPolicy{})
+			},
+		},
+		{
+			name: "merge different kinds",
+			run: func() {
+				mgr.Merge(&ngfAPI.ClientSettingsPolicy{}, &ngfAPI.ObservabilityPolicy{})
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(test.run).To(Panic())
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package observability
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// Validator validates an ObservabilityPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of an ObservabilityPolicy.
+// Tracing requires the telemetry configuration of the NginxProxy, so a Policy that configures tracing is not
+// accepted if the NginxProxy doesn't enable telemetry.
+func (v *Validator) Validate(
+	policy policies.Policy,
+	globalSettings *policies.GlobalSettings,
+) []conditions.Condition {
+	op := helpers.MustCastObject[*ngfAPI.ObservabilityPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []v1.Kind{policies.KindHTTPRoute}
+
+	if err := policies.ValidateTargetRef(op.Spec.TargetRef, targetRefPath, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if errs := v.validateSpec(op.Spec); len(errs) > 0 {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(errs.ToAggregate().Error())}
+	}
+
+	if op.Spec.Tracing != nil && (globalSettings == nil || !globalSettings.TelemetryEnabled) {
+		return []conditions.Condition{
+			staticConds.NewPolicyNotAcceptedNginxProxyNotSet(
+				"Tracing requires the telemetry exporter to be configured in the NginxProxy " +
+					"referenced by the GatewayClass",
+			),
+		}
+	}
+
+	return nil
+}
+
+// Conflicts returns true, because an ObservabilityPolicy is a Direct Policy: only one ObservabilityPolicy can
+// be applied to a target.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+func (v *Validator) validateSpec(spec ngfAPI.ObservabilityPolicySpec) field.ErrorList {
+	tracing := spec.Tracing
+	if tracing == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	tracingPath := field.NewPath("spec").Child("tracing")
+
+	switch tracing.Strategy {
+	case ngfAPI.TraceStrategyRatio:
+	case ngfAPI.TraceStrategyParent:
+		if tracing.Ratio != nil {
+			allErrs = append(
+				allErrs,
+				field.Forbidden(tracingPath.Child("ratio"), "ratio can only be specified if strategy is of type ratio"),
+			)
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(
+			tracingPath.Child("strategy"),
+			tracing.Strategy,
+			[]string{string(ngfAPI.TraceStrategyRatio), string(ngfAPI.TraceStrategyParent)},
+		))
+	}
+
+	if tracing.SpanName != nil {
+		if err := v.genericValidator.ValidateEscapedStringNoVarExpansion(*tracing.SpanName); err != nil {
+			allErrs = append(allErrs, field.Invalid(tracingPath.Child("spanName"), *tracing.SpanName, err.Error()))
+		}
+	}
+
+	for i, attr := range tracing.SpanAttributes {
+		attrPath := tracingPath.Child("spanAttributes").Index(i)
+
+		if err := v.genericValidator.ValidateEscapedStringNoVarExpansion(attr.Key); err != nil {
+			allErrs = append(allErrs, field.Invalid(attrPath.Child("key"), attr.Key, err.Error()))
+		}
+
+		if err := v.genericValidator.ValidateEscapedStringNoVarExpansion(attr.Value); err != nil {
+			allErrs = append(allErrs, field.Invalid(attrPath.Child("value"), attr.Value, err.Error()))
+		}
+	}
+
+	return allErrs
+}
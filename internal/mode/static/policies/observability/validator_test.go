@@ -0,0 +1,115 @@
+package observability
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	validTracing := &ngfAPI.Tracing{
+		Strategy: ngfAPI.TraceStrategyRatio,
+		Ratio:    helpers.GetPointer[int32](10),
+		SpanName: helpers.GetPointer("my-span"),
+		SpanAttributes: []ngfAPI.SpanAttribute{
+			{Key: "key", Value: "value"},
+		},
+	}
+
+	telemetryEnabled := &policies.GlobalSettings{TelemetryEnabled: true}
+
+	tests := []struct {
+		tracing        *ngfAPI.Tracing
+		globalSettings *policies.GlobalSettings
+		name           string
+		expConds       []conditions.Condition
+	}{
+		{
+			name:           "valid policy",
+			tracing:        validTracing,
+			globalSettings: telemetryEnabled,
+		},
+		{
+			name: "policy without tracing doesn't require telemetry",
+		},
+		{
+			name: "invalid tracing",
+			tracing: &ngfAPI.Tracing{
+				Strategy: ngfAPI.TraceStrategyParent,
+				Ratio:    helpers.GetPointer[int32](10),
+				SpanName: helpers.GetPointer("invalid"),
+				SpanAttributes: []ngfAPI.SpanAttribute{
+					{Key: "invalid", Value: "invalid"},
+				},
+			},
+			globalSettings: telemetryEnabled,
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.tracing.ratio: Forbidden: ratio can only be specified if strategy is of type ratio, " +
+						"spec.tracing.spanName: Invalid value: \"invalid\": invalid value, " +
+						"spec.tracing.spanAttributes[0].key: Invalid value: \"invalid\": invalid value, " +
+						"spec.tracing.spanAttributes[0].value: Invalid value: \"invalid\": invalid value]",
+				),
+			},
+		},
+		{
+			name:    "unsupported strategy",
+			tracing: &ngfAPI.Tracing{Strategy: "unknown"},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"spec.tracing.strategy: Unsupported value: \"unknown\": supported values: \"ratio\", \"parent\"",
+				),
+			},
+		},
+		{
+			name:           "tracing without telemetry",
+			tracing:        validTracing,
+			globalSettings: &policies.GlobalSettings{},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedNginxProxyNotSet(
+					"Tracing requires the telemetry exporter to be configured in the NginxProxy " +
+						"referenced by the GatewayClass",
+				),
+			},
+		},
+	}
+
+	v := NewValidator(&validationfakes.FakeGenericValidator{
+		ValidateEscapedStringNoVarExpansionStub: func(value string) error {
+			if value == "invalid" {
+				return errors.New("invalid value")
+			}
+			return nil
+		},
+	})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			policy := &ngfAPI.ObservabilityPolicy{Spec: ngfAPI.ObservabilityPolicySpec{Tracing: test.tracing}}
+			policy.Spec.TargetRef.Kind = policies.KindHTTPRoute
+			policy.Spec.TargetRef.Group = v1.GroupName
+
+			conds := v.Validate(policy, test.globalSettings)
+			g.Expect(conds).To(Equal(test.expConds))
+		})
+	}
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	g := NewWithT(t)
+
+	v := NewValidator(&validationfakes.FakeGenericValidator{})
+
+	g.Expect(v.Conflicts(&ngfAPI.ObservabilityPolicy{}, &ngfAPI.ObservabilityPolicy{})).To(BeTrue())
+}
@@ -0,0 +1,119 @@
+/*
+Package policies contains the generic support for the NGF Policies, which follow the Gateway API policy attachment
+model (GEP-713).
+
+A Policy kind is added by registering a Validator and a Merger for its GroupVersionKind with the Manager.
+The rest is handled generically:
+- The graph finds the target of a Policy, validates the Policy, resolves the conflicts between the Policies that
+target the same resource and computes the effective Policies of the Gateway and its Routes.
+- The status of a Policy is reported per ancestor (the target of the Policy).
+- The configuration generation asks for the effective Policy of a kind with FindPolicy.
+
+The effective Policy of a Route combines the Policies attached to the Gateway with the Policies attached to the Route.
+The Merger of a kind decides which settings of the Gateway Policy are defaults, which the Route Policy can override,
+and which are overrides, which the Route Policy can't change. The MergeDefault and MergeOverride helpers implement
+these semantics for a single setting. The Direct Policy kinds, which only target one level of the hierarchy,
+register the DirectMerger.
+
+A Policy kind that implements SectionPolicy can also target a listener of the Gateway. The effective Policy of
+a listener combines the Policy attached to the Gateway with the Policy attached to the listener.
+*/
+package policies
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+)
+
+// Policy is an extension of client.Object. It adds methods that are common among all NGF Policies.
+type Policy interface {
+	// GetTargetRef returns the reference to the resource the Policy is attached to.
+	GetTargetRef() v1alpha2.PolicyTargetReference
+	// GetPolicyStatus returns the status of the Policy.
+	GetPolicyStatus() v1alpha2.PolicyStatus
+	// SetPolicyStatus sets the status of the Policy.
+	SetPolicyStatus(status v1alpha2.PolicyStatus)
+	client.Object
+}
+
+// SectionPolicy is a Policy that can target a section of a resource, like a listener of a Gateway.
+type SectionPolicy interface {
+	// GetSectionName returns the name of the targeted section. It is nil if the Policy targets the whole resource.
+	GetSectionName() *v1.SectionName
+	Policy
+}
+
+// GlobalSettings contains the settings from the current state of the graph that apply to the whole data plane.
+// Policies that rely on these settings use them for validation.
+type GlobalSettings struct {
+	// TelemetryEnabled is whether the NginxProxy enables telemetry.
+	TelemetryEnabled bool
+}
+
+// Validator validates the Policies of a kind.
+//
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . Validator
+type Validator interface {
+	// Validate validates the Policy. It returns the conditions that explain why the Policy is not accepted.
+	// If the Policy is valid, it returns nil.
+	Validate(policy Policy, globalSettings *GlobalSettings) []conditions.Condition
+	// Conflicts returns true if the two Policies can't be applied to the same target together.
+	Conflicts(a, b Policy) bool
+}
+
+// Merger merges the Policies of a kind.
+//
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . Merger
+type Merger interface {
+	// Merge merges the parent and the child Policy into a new Policy. The parent Policy is attached to a higher level
+	// of the hierarchy (for example, a Gateway) than the child Policy (for example, an HTTPRoute), or it is an older
+	// Policy attached to the same level. Merge must not modify the passed Policies.
+	Merge(parent, child Policy) Policy
+}
+
+// DirectMerger merges the Policies of a Direct Policy kind, which only targets one level of the hierarchy, for example,
+// HTTPRoutes. Such a Policy is never merged with a Policy attached to a higher level, and the conflicts prevent
+// merging the Policies attached to the same target, so Merge returns the child Policy.
+// Implements Merger interface.
+type DirectMerger struct{}
+
+// Merge returns the child Policy.
+func (DirectMerger) Merge(_, child Policy) Policy {
+	return child
+}
+
+// MergeDefault merges a setting which the parent Policy configures as a default: the child setting takes
+// precedence if it is set.
+func MergeDefault[T any](parent, child *T) *T {
+	if child != nil {
+		return child
+	}
+
+	return parent
+}
+
+// MergeOverride merges a setting which the parent Policy configures as an override: the parent setting takes
+// precedence if it is set.
+func MergeOverride[T any](parent, child *T) *T {
+	if parent != nil {
+		return parent
+	}
+
+	return child
+}
+
+// FindPolicy returns the Policy of the type T from the effective Policies.
+// It returns false if the effective Policies don't include a Policy of the type.
+func FindPolicy[T Policy](effectivePolicies []Policy) (T, bool) {
+	for _, p := range effectivePolicies {
+		if policy, ok := p.(T); ok {
+			return policy, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
@@ -0,0 +1,120 @@
+package policies
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+func TestDirectMerger_Merge(t *testing.T) {
+	g := NewWithT(t)
+
+	parent := &ngfAPI.CORSPolicy{}
+	child := &ngfAPI.CORSPolicy{}
+
+	g.Expect(DirectMerger{}.Merge(parent, child)).To(BeIdenticalTo(child))
+}
+
+func TestMergeDefault(t *testing.T) {
+	g := NewWithT(t)
+
+	parent := helpers.GetPointer("parent")
+	child := helpers.GetPointer("child")
+
+	g.Expect(MergeDefault(parent, child)).To(BeIdenticalTo(child))
+	g.Expect(MergeDefault(parent, nil)).To(BeIdenticalTo(parent))
+	g.Expect(MergeDefault(nil, child)).To(BeIdenticalTo(child))
+	g.Expect(MergeDefault[string](nil, nil)).To(BeNil())
+}
+
+func TestMergeOverride(t *testing.T) {
+	g := NewWithT(t)
+
+	parent := helpers.GetPointer("parent")
+	child := helpers.GetPointer("child")
+
+	g.Expect(MergeOverride(parent, child)).To(BeIdenticalTo(parent))
+	g.Expect(MergeOverride(parent, nil)).To(BeIdenticalTo(parent))
+	g.Expect(MergeOverride(nil, child)).To(BeIdenticalTo(child))
+	g.Expect(MergeOverride[string](nil, nil)).To(BeNil())
+}
+
+func TestFindPolicy(t *testing.T) {
+	g := NewWithT(t)
+
+	csp := &ngfAPI.ClientSettingsPolicy{}
+	op := &ngfAPI.ObservabilityPolicy{}
+
+	found, ok := FindPolicy[*ngfAPI.ObservabilityPolicy]([]Policy{csp, op})
+	g.Expect(ok).To(BeTrue())
+	g.Expect(found).To(BeIdenticalTo(op))
+
+	found, ok = FindPolicy[*ngfAPI.ObservabilityPolicy]([]Policy{csp})
+	g.Expect(ok).To(BeFalse())
+	g.Expect(found).To(BeNil())
+
+	_, ok = FindPolicy[*ngfAPI.ClientSettingsPolicy](nil)
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestValidateTargetRef(t *testing.T) {
+	path := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []v1.Kind{"Gateway", "HTTPRoute", "Service"}
+
+	tests := []struct {
+		name   string
+		group  v1.Group
+		kind   v1.Kind
+		expErr string
+	}{
+		{
+			name:  "supported kind",
+			group: v1.GroupName,
+			kind:  "HTTPRoute",
+		},
+		{
+			name: "supported core kind",
+			kind: "Service",
+		},
+		{
+			name:  "unsupported kind",
+			group: v1.GroupName,
+			kind:  "GRPCRoute",
+			expErr: "spec.targetRef.kind: Unsupported value: \"GRPCRoute\": " +
+				"supported values: \"Gateway\", \"HTTPRoute\", \"Service\"",
+		},
+		{
+			name:  "unsupported group",
+			group: "foo.example.com",
+			kind:  "Gateway",
+			expErr: "spec.targetRef.group: Unsupported value: \"foo.example.com\": " +
+				"supported values: \"gateway.networking.k8s.io\"",
+		},
+		{
+			name:   "core group for Gateway API kind",
+			kind:   "HTTPRoute",
+			expErr: "spec.targetRef.group: Unsupported value: \"\": supported values: \"gateway.networking.k8s.io\"",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ref := v1alpha2.PolicyTargetReference{Group: test.group, Kind: test.kind, Name: "target"}
+
+			err := ValidateTargetRef(ref, path, supportedKinds)
+			if test.expErr == "" {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(test.expErr))
+			}
+		})
+	}
+}
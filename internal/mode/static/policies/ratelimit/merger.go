@@ -0,0 +1,34 @@
+package ratelimit
+
+import (
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+)
+
+// Merger merges RateLimitPolicies.
+// All settings of a RateLimitPolicy are defaults: the settings of the child Policy take precedence over
+// the settings of the parent Policy. The rate is required, so it always comes from the child Policy.
+// Implements policies.Merger interface.
+type Merger struct{}
+
+// NewMerger returns a new instance of Merger.
+func NewMerger() *Merger {
+	return &Merger{}
+}
+
+// Merge merges the parent and the child RateLimitPolicies.
+// The merged Policy has the metadata and the targetRef of the child Policy.
+func (m *Merger) Merge(parent, child policies.Policy) policies.Policy {
+	parentRLP := helpers.MustCastObject[*ngfAPI.RateLimitPolicy](parent)
+	childRLP := helpers.MustCastObject[*ngfAPI.RateLimitPolicy](child)
+
+	merged := childRLP.DeepCopy()
+	// the fields of the key only make sense together, so the key is merged as a single setting
+	merged.Spec.Key = policies.MergeDefault(parentRLP.Spec.Key, childRLP.Spec.Key)
+	merged.Spec.Burst = policies.MergeDefault(parentRLP.Spec.Burst, childRLP.Spec.Burst)
+	merged.Spec.NoDelay = policies.MergeDefault(parentRLP.Spec.NoDelay, childRLP.Spec.NoDelay)
+	merged.Spec.RejectCode = policies.MergeDefault(parentRLP.Spec.RejectCode, childRLP.Spec.RejectCode)
+
+	return merged
+}
@@ -0,0 +1,77 @@
+package ratelimit
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+func TestMerger_Merge(t *testing.T) {
+	parent := &ngfAPI.RateLimitPolicy{Spec: ngfAPI.RateLimitPolicySpec{
+		Key: &ngfAPI.RateLimitKey{
+			Type:   ngfAPI.RateLimitKeyHeader,
+			Header: helpers.GetPointer("X-Api-Key"),
+		},
+		Rate:       "10r/s",
+		Burst:      helpers.GetPointer[int32](20),
+		NoDelay:    helpers.GetPointer(true),
+		RejectCode: helpers.GetPointer[int32](429),
+	}}
+	parent.ObjectMeta = metav1.ObjectMeta{Namespace: "test", Name: "parent"}
+
+	tests := []struct {
+		child    *ngfAPI.RateLimitPolicy
+		expected *ngfAPI.RateLimitPolicy
+		name     string
+	}{
+		{
+			name:  "child with only the rate inherits all settings",
+			child: &ngfAPI.RateLimitPolicy{Spec: ngfAPI.RateLimitPolicySpec{Rate: "1r/s"}},
+			expected: &ngfAPI.RateLimitPolicy{Spec: ngfAPI.RateLimitPolicySpec{
+				Key:        parent.Spec.Key,
+				Rate:       "1r/s",
+				Burst:      parent.Spec.Burst,
+				NoDelay:    parent.Spec.NoDelay,
+				RejectCode: parent.Spec.RejectCode,
+			}},
+		},
+		{
+			name: "child settings take precedence",
+			child: &ngfAPI.RateLimitPolicy{Spec: ngfAPI.RateLimitPolicySpec{
+				Key:     &ngfAPI.RateLimitKey{Type: ngfAPI.RateLimitKeyClientIP},
+				Rate:    "60r/m",
+				Burst:   helpers.GetPointer[int32](0),
+				NoDelay: helpers.GetPointer(false),
+			}},
+			expected: &ngfAPI.RateLimitPolicy{Spec: ngfAPI.RateLimitPolicySpec{
+				Key:        &ngfAPI.RateLimitKey{Type: ngfAPI.RateLimitKeyClientIP},
+				Rate:       "60r/m",
+				Burst:      helpers.GetPointer[int32](0),
+				NoDelay:    helpers.GetPointer(false),
+				RejectCode: helpers.GetPointer[int32](429),
+			}},
+		},
+	}
+
+	m := NewMerger()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			parentCopy := parent.DeepCopy()
+			childCopy := test.child.DeepCopy()
+
+			merged := m.Merge(parent, test.child)
+			g.Expect(helpers.Diff(test.expected, merged)).To(BeEmpty())
+
+			// the inputs must not be modified
+			g.Expect(helpers.Diff(parentCopy, parent)).To(BeEmpty())
+			g.Expect(helpers.Diff(childCopy, test.child)).To(BeEmpty())
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package ratelimit
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// The rate and the key names are rendered verbatim into the NGINX configuration, so they are validated here too,
+// not only by the CRD.
+var (
+	rateRegexp       = regexp.MustCompile(`^\d{1,6}r/(s|m)$`)
+	headerNameRegexp = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+	jwtClaimRegexp   = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+)
+
+// Validator validates a RateLimitPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	plus bool
+}
+
+// NewValidator returns a new instance of Validator.
+// The JWT claim key is only supported if NGINX Plus is used.
+func NewValidator(plus bool) *Validator {
+	return &Validator{plus: plus}
+}
+
+// Validate validates the spec of a RateLimitPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	rlp := helpers.MustCastObject[*ngfAPI.RateLimitPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []v1.Kind{policies.KindGateway, policies.KindHTTPRoute}
+
+	if err := policies.ValidateTargetRef(rlp.Spec.TargetRef, targetRefPath, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if errs := v.validateSpec(rlp.Spec); len(errs) > 0 {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(errs.ToAggregate().Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true, because a RateLimitPolicy configures a single rate limit: only one RateLimitPolicy can
+// be applied to a target.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+func (v *Validator) validateSpec(spec ngfAPI.RateLimitPolicySpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !rateRegexp.MatchString(string(spec.Rate)) {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec").Child("rate"),
+			spec.Rate,
+			"must be a number of requests per second or per minute, for example, 10r/s or 300r/m",
+		))
+	}
+
+	return append(allErrs, v.validateKey(spec.Key)...)
+}
+
+func (v *Validator) validateKey(key *ngfAPI.RateLimitKey) field.ErrorList {
+	if key == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	keyPath := field.NewPath("spec").Child("key")
+
+	switch key.Type {
+	case ngfAPI.RateLimitKeyClientIP:
+	case ngfAPI.RateLimitKeyHeader:
+		if key.Header == nil {
+			allErrs = append(allErrs, field.Required(keyPath.Child("header"), "must be set if type is Header"))
+		} else if !headerNameRegexp.MatchString(*key.Header) {
+			allErrs = append(allErrs, field.Invalid(
+				keyPath.Child("header"),
+				*key.Header,
+				"must only contain alphanumeric characters and '-'",
+			))
+		}
+	case ngfAPI.RateLimitKeyJWTClaim:
+		if !v.plus {
+			allErrs = append(allErrs, field.Forbidden(keyPath.Child("type"), "JWTClaim is only supported by NGINX Plus"))
+		}
+		if key.JWTClaim == nil {
+			allErrs = append(allErrs, field.Required(keyPath.Child("jwtClaim"), "must be set if type is JWTClaim"))
+		} else if !jwtClaimRegexp.MatchString(*key.JWTClaim) {
+			allErrs = append(allErrs, field.Invalid(
+				keyPath.Child("jwtClaim"),
+				*key.JWTClaim,
+				"must only contain alphanumeric characters and '_'",
+			))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(
+			keyPath.Child("type"),
+			key.Type,
+			[]string{
+				string(ngfAPI.RateLimitKeyClientIP),
+				string(ngfAPI.RateLimitKeyHeader),
+				string(ngfAPI.RateLimitKeyJWTClaim),
+			},
+		))
+	}
+
+	if key.Header != nil && key.Type != ngfAPI.RateLimitKeyHeader {
+		allErrs = append(allErrs, field.Forbidden(keyPath.Child("header"), "can only be set if type is Header"))
+	}
+
+	if key.JWTClaim != nil && key.Type != ngfAPI.RateLimitKeyJWTClaim {
+		allErrs = append(allErrs, field.Forbidden(keyPath.Child("jwtClaim"), "can only be set if type is JWTClaim"))
+	}
+
+	return allErrs
+}
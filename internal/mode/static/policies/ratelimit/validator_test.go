@@ -0,0 +1,154 @@
+package ratelimit
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	tests := []struct {
+		spec     ngfAPI.RateLimitPolicySpec
+		name     string
+		expConds []conditions.Condition
+		plus     bool
+	}{
+		{
+			name: "valid policy with default key",
+			spec: ngfAPI.RateLimitPolicySpec{
+				Rate:  "10r/s",
+				Burst: helpers.GetPointer[int32](5),
+			},
+		},
+		{
+			name: "valid policy with header key",
+			spec: ngfAPI.RateLimitPolicySpec{
+				Key: &ngfAPI.RateLimitKey{
+					Type:   ngfAPI.RateLimitKeyHeader,
+					Header: helpers.GetPointer("X-Api-Key"),
+				},
+				Rate: "100r/m",
+			},
+		},
+		{
+			name: "valid JWT claim key with NGINX Plus",
+			spec: ngfAPI.RateLimitPolicySpec{
+				Key: &ngfAPI.RateLimitKey{
+					Type:     ngfAPI.RateLimitKeyJWTClaim,
+					JWTClaim: helpers.GetPointer("sub"),
+				},
+				Rate: "10r/s",
+			},
+			plus: true,
+		},
+		{
+			name: "JWT claim key without NGINX Plus",
+			spec: ngfAPI.RateLimitPolicySpec{
+				Key: &ngfAPI.RateLimitKey{
+					Type:     ngfAPI.RateLimitKeyJWTClaim,
+					JWTClaim: helpers.GetPointer("sub"),
+				},
+				Rate: "10r/s",
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.key.type: Forbidden: JWTClaim is only supported by NGINX Plus"),
+			},
+		},
+		{
+			name: "invalid key",
+			spec: ngfAPI.RateLimitPolicySpec{
+				Key: &ngfAPI.RateLimitKey{
+					Type:     ngfAPI.RateLimitKeyHeader,
+					JWTClaim: helpers.GetPointer("sub"),
+				},
+				Rate: "10r/s",
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.key.header: Required value: must be set if type is Header, " +
+						"spec.key.jwtClaim: Forbidden: can only be set if type is JWTClaim]",
+				),
+			},
+		},
+		{
+			name: "invalid rate and key names",
+			spec: ngfAPI.RateLimitPolicySpec{
+				Key: &ngfAPI.RateLimitKey{
+					Type:   ngfAPI.RateLimitKeyHeader,
+					Header: helpers.GetPointer("X-Api-Key;"),
+				},
+				Rate: "10r/s burst=100",
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.rate: Invalid value: \"10r/s burst=100\": must be a number of requests per second or " +
+						"per minute, for example, 10r/s or 300r/m, " +
+						"spec.key.header: Invalid value: \"X-Api-Key;\": " +
+						"must only contain alphanumeric characters and '-']",
+				),
+			},
+		},
+		{
+			name: "invalid JWT claim name",
+			spec: ngfAPI.RateLimitPolicySpec{
+				Key: &ngfAPI.RateLimitKey{
+					Type:     ngfAPI.RateLimitKeyJWTClaim,
+					JWTClaim: helpers.GetPointer("sub-claim"),
+				},
+				Rate: "10r/s",
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"spec.key.jwtClaim: Invalid value: \"sub-claim\": must only contain alphanumeric characters and '_'",
+				),
+			},
+			plus: true,
+		},
+		{
+			name: "unsupported key type",
+			spec: ngfAPI.RateLimitPolicySpec{
+				Key:  &ngfAPI.RateLimitKey{Type: "Cookie"},
+				Rate: "10r/s",
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"spec.key.type: Unsupported value: \"Cookie\": " +
+						"supported values: \"ClientIP\", \"Header\", \"JWTClaim\"",
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			v := NewValidator(test.plus)
+
+			policy := &ngfAPI.RateLimitPolicy{Spec: test.spec}
+			policy.Spec.TargetRef.Kind = policies.KindHTTPRoute
+			policy.Spec.TargetRef.Group = v1.GroupName
+
+			conds := v.Validate(policy, nil)
+			g.Expect(conds).To(Equal(test.expConds))
+		})
+	}
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	g := NewWithT(t)
+
+	v := NewValidator(false)
+
+	polA := &ngfAPI.RateLimitPolicy{Spec: ngfAPI.RateLimitPolicySpec{Rate: "10r/s"}}
+	polB := &ngfAPI.RateLimitPolicy{Spec: ngfAPI.RateLimitPolicySpec{Rate: "20r/s"}}
+
+	g.Expect(v.Conflicts(polA, polB)).To(BeTrue())
+}
@@ -0,0 +1,34 @@
+package retry
+
+import (
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+)
+
+// Merger merges RetryPolicies.
+// All settings of a RetryPolicy are defaults: the settings of the child Policy take precedence over
+// the settings of the parent Policy.
+// Implements policies.Merger interface.
+type Merger struct{}
+
+// NewMerger returns a new instance of Merger.
+func NewMerger() *Merger {
+	return &Merger{}
+}
+
+// Merge merges the parent and the child RetryPolicies.
+// The merged Policy has the metadata and the targetRef of the child Policy.
+func (m *Merger) Merge(parent, child policies.Policy) policies.Policy {
+	parentRP := helpers.MustCastObject[*ngfAPI.RetryPolicy](parent)
+	childRP := helpers.MustCastObject[*ngfAPI.RetryPolicy](child)
+
+	merged := childRP.DeepCopy()
+	merged.Spec.Attempts = policies.MergeDefault(parentRP.Spec.Attempts, childRP.Spec.Attempts)
+	// the conditions and the status codes together define when a request is retried,
+	// so they are merged as a single setting
+	merged.Spec.RetryOn = policies.MergeDefault(parentRP.Spec.RetryOn, childRP.Spec.RetryOn)
+	merged.Spec.TotalTimeout = policies.MergeDefault(parentRP.Spec.TotalTimeout, childRP.Spec.TotalTimeout)
+
+	return merged
+}
@@ -0,0 +1,69 @@
+package retry
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+func TestMerger_Merge(t *testing.T) {
+	parent := &ngfAPI.RetryPolicy{Spec: ngfAPI.RetryPolicySpec{
+		Attempts: helpers.GetPointer[int32](3),
+		RetryOn: &ngfAPI.RetryOn{
+			Conditions:  []ngfAPI.RetryCondition{ngfAPI.RetryConditionError},
+			StatusCodes: []ngfAPI.RetryStatusCode{502},
+		},
+		TotalTimeout: helpers.GetPointer[ngfAPI.Duration]("10s"),
+	}}
+	parent.ObjectMeta = metav1.ObjectMeta{Namespace: "test", Name: "parent"}
+
+	tests := []struct {
+		child    *ngfAPI.RetryPolicy
+		expected *ngfAPI.RetryPolicy
+		name     string
+	}{
+		{
+			name:     "empty child inherits all settings",
+			child:    &ngfAPI.RetryPolicy{Spec: ngfAPI.RetryPolicySpec{}},
+			expected: &ngfAPI.RetryPolicy{Spec: parent.Spec},
+		},
+		{
+			name: "child settings take precedence",
+			child: &ngfAPI.RetryPolicy{Spec: ngfAPI.RetryPolicySpec{
+				Attempts: helpers.GetPointer[int32](1),
+				RetryOn: &ngfAPI.RetryOn{
+					StatusCodes: []ngfAPI.RetryStatusCode{503},
+				},
+			}},
+			expected: &ngfAPI.RetryPolicy{Spec: ngfAPI.RetryPolicySpec{
+				Attempts: helpers.GetPointer[int32](1),
+				RetryOn: &ngfAPI.RetryOn{
+					StatusCodes: []ngfAPI.RetryStatusCode{503},
+				},
+				TotalTimeout: helpers.GetPointer[ngfAPI.Duration]("10s"),
+			}},
+		},
+	}
+
+	m := NewMerger()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			parentCopy := parent.DeepCopy()
+			childCopy := test.child.DeepCopy()
+
+			merged := m.Merge(parent, test.child)
+			g.Expect(helpers.Diff(test.expected, merged)).To(BeEmpty())
+
+			// the inputs must not be modified
+			g.Expect(helpers.Diff(parentCopy, parent)).To(BeEmpty())
+			g.Expect(helpers.Diff(childCopy, test.child)).To(BeEmpty())
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package retry
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+var (
+	supportedConditions = map[ngfAPI.RetryCondition]struct{}{
+		ngfAPI.RetryConditionError:         {},
+		ngfAPI.RetryConditionTimeout:       {},
+		ngfAPI.RetryConditionInvalidHeader: {},
+		ngfAPI.RetryConditionNonIdempotent: {},
+	}
+
+	supportedStatusCodes = map[ngfAPI.RetryStatusCode]struct{}{
+		403: {},
+		404: {},
+		429: {},
+		500: {},
+		502: {},
+		503: {},
+		504: {},
+	}
+)
+
+// Validator validates a RetryPolicy.
+// Implements policies.Validator interface.
+type Validator struct{}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Validate validates the spec of a RetryPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	rp := helpers.MustCastObject[*ngfAPI.RetryPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []v1.Kind{policies.KindGateway, policies.KindHTTPRoute}
+
+	if err := policies.ValidateTargetRef(rp.Spec.TargetRef, targetRefPath, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if errs := validateRetryOn(rp.Spec.RetryOn); len(errs) > 0 {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(errs.ToAggregate().Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true, because the settings of a RetryPolicy configure retries together: only one RetryPolicy
+// can be applied to a target.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+func validateRetryOn(retryOn *ngfAPI.RetryOn) field.ErrorList {
+	if retryOn == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	retryOnPath := field.NewPath("spec").Child("retryOn")
+
+	if len(retryOn.Conditions) == 0 && len(retryOn.StatusCodes) == 0 {
+		return append(allErrs, field.Required(retryOnPath, "at least one of conditions or statusCodes must be set"))
+	}
+
+	for i, c := range retryOn.Conditions {
+		if _, ok := supportedConditions[c]; !ok {
+			allErrs = append(allErrs, field.NotSupported(
+				retryOnPath.Child("conditions").Index(i),
+				c,
+				[]string{
+					string(ngfAPI.RetryConditionError),
+					string(ngfAPI.RetryConditionTimeout),
+					string(ngfAPI.RetryConditionInvalidHeader),
+					string(ngfAPI.RetryConditionNonIdempotent),
+				},
+			))
+		}
+	}
+
+	for i, code := range retryOn.StatusCodes {
+		if _, ok := supportedStatusCodes[code]; !ok {
+			allErrs = append(allErrs, field.NotSupported(
+				retryOnPath.Child("statusCodes").Index(i),
+				code,
+				[]string{"403", "404", "429", "500", "502", "503", "504"},
+			))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,92 @@
+package retry
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	tests := []struct {
+		spec     ngfAPI.RetryPolicySpec
+		name     string
+		expConds []conditions.Condition
+	}{
+		{
+			name: "valid empty policy",
+			spec: ngfAPI.RetryPolicySpec{},
+		},
+		{
+			name: "valid policy with all fields",
+			spec: ngfAPI.RetryPolicySpec{
+				Attempts: helpers.GetPointer[int32](3),
+				RetryOn: &ngfAPI.RetryOn{
+					Conditions:  []ngfAPI.RetryCondition{ngfAPI.RetryConditionError, ngfAPI.RetryConditionTimeout},
+					StatusCodes: []ngfAPI.RetryStatusCode{502, 503},
+				},
+				TotalTimeout: helpers.GetPointer[ngfAPI.Duration]("10s"),
+			},
+		},
+		{
+			name: "empty retryOn",
+			spec: ngfAPI.RetryPolicySpec{
+				RetryOn: &ngfAPI.RetryOn{},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"spec.retryOn: Required value: at least one of conditions or statusCodes must be set",
+				),
+			},
+		},
+		{
+			name: "unsupported condition and status code",
+			spec: ngfAPI.RetryPolicySpec{
+				RetryOn: &ngfAPI.RetryOn{
+					Conditions:  []ngfAPI.RetryCondition{ngfAPI.RetryConditionError, "Off"},
+					StatusCodes: []ngfAPI.RetryStatusCode{418},
+				},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.retryOn.conditions[1]: Unsupported value: \"Off\": " +
+						"supported values: \"Error\", \"Timeout\", \"InvalidHeader\", \"NonIdempotent\", " +
+						"spec.retryOn.statusCodes[0]: Unsupported value: 418: " +
+						"supported values: \"403\", \"404\", \"429\", \"500\", \"502\", \"503\", \"504\"]",
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			v := NewValidator()
+
+			policy := &ngfAPI.RetryPolicy{Spec: test.spec}
+			policy.Spec.TargetRef.Kind = policies.KindHTTPRoute
+			policy.Spec.TargetRef.Group = v1.GroupName
+
+			conds := v.Validate(policy, nil)
+			g.Expect(conds).To(Equal(test.expConds))
+		})
+	}
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	g := NewWithT(t)
+
+	v := NewValidator()
+
+	polA := &ngfAPI.RetryPolicy{Spec: ngfAPI.RetryPolicySpec{Attempts: helpers.GetPointer[int32](2)}}
+	polB := &ngfAPI.RetryPolicy{Spec: ngfAPI.RetryPolicySpec{TotalTimeout: helpers.GetPointer[ngfAPI.Duration]("5s")}}
+
+	g.Expect(v.Conflicts(polA, polB)).To(BeTrue())
+}
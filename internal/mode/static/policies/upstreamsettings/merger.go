@@ -0,0 +1,46 @@
+package upstreamsettings
+
+import (
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+)
+
+// Merger merges UpstreamSettingsPolicies.
+// An UpstreamSettingsPolicy is a Direct Policy that only targets Services, so only the non-conflicting Policies
+// attached to the same Service are merged: the merged Policy combines the settings of both Policies.
+// Implements policies.Merger interface.
+type Merger struct{}
+
+// NewMerger returns a new instance of Merger.
+func NewMerger() *Merger {
+	return &Merger{}
+}
+
+// Merge merges the parent and the child UpstreamSettingsPolicies.
+// The merged Policy has the metadata and the targetRef of the child Policy.
+func (m *Merger) Merge(parent, child policies.Policy) policies.Policy {
+	parentUSP := helpers.MustCastObject[*ngfAPI.UpstreamSettingsPolicy](parent)
+	childUSP := helpers.MustCastObject[*ngfAPI.UpstreamSettingsPolicy](child)
+
+	merged := childUSP.DeepCopy()
+	// the fields of the load balancing method only make sense together, so they are merged as a single setting
+	merged.Spec.LoadBalancing = policies.MergeDefault(parentUSP.Spec.LoadBalancing, childUSP.Spec.LoadBalancing)
+	merged.Spec.ZoneSize = policies.MergeDefault(parentUSP.Spec.ZoneSize, childUSP.Spec.ZoneSize)
+	merged.Spec.KeepAlive = mergeKeepAlive(parentUSP.Spec.KeepAlive, childUSP.Spec.KeepAlive)
+
+	return merged
+}
+
+func mergeKeepAlive(parent, child *ngfAPI.UpstreamKeepAlive) *ngfAPI.UpstreamKeepAlive {
+	if parent == nil || child == nil {
+		return policies.MergeDefault(parent, child)
+	}
+
+	return &ngfAPI.UpstreamKeepAlive{
+		Connections: policies.MergeDefault(parent.Connections, child.Connections),
+		Requests:    policies.MergeDefault(parent.Requests, child.Requests),
+		Time:        policies.MergeDefault(parent.Time, child.Time),
+		Timeout:     policies.MergeDefault(parent.Timeout, child.Timeout),
+	}
+}
@@ -0,0 +1,71 @@
+package upstreamsettings
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+func TestMerger_Merge(t *testing.T) {
+	parent := &ngfAPI.UpstreamSettingsPolicy{Spec: ngfAPI.UpstreamSettingsPolicySpec{
+		LoadBalancing: &ngfAPI.LoadBalancing{Method: ngfAPI.LoadBalancingLeastConn},
+		KeepAlive: &ngfAPI.UpstreamKeepAlive{
+			Connections: helpers.GetPointer[int32](16),
+		},
+	}}
+	parent.ObjectMeta = metav1.ObjectMeta{Namespace: "test", Name: "parent"}
+
+	tests := []struct {
+		child    *ngfAPI.UpstreamSettingsPolicy
+		expected *ngfAPI.UpstreamSettingsPolicy
+		name     string
+	}{
+		{
+			name:  "empty child inherits all settings",
+			child: &ngfAPI.UpstreamSettingsPolicy{Spec: ngfAPI.UpstreamSettingsPolicySpec{}},
+			expected: &ngfAPI.UpstreamSettingsPolicy{Spec: ngfAPI.UpstreamSettingsPolicySpec{
+				LoadBalancing: parent.Spec.LoadBalancing,
+				KeepAlive:     parent.Spec.KeepAlive,
+			}},
+		},
+		{
+			name: "settings of both policies are combined",
+			child: &ngfAPI.UpstreamSettingsPolicy{Spec: ngfAPI.UpstreamSettingsPolicySpec{
+				ZoneSize: helpers.GetPointer[ngfAPI.Size]("2m"),
+				KeepAlive: &ngfAPI.UpstreamKeepAlive{
+					Timeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+				},
+			}},
+			expected: &ngfAPI.UpstreamSettingsPolicy{Spec: ngfAPI.UpstreamSettingsPolicySpec{
+				LoadBalancing: &ngfAPI.LoadBalancing{Method: ngfAPI.LoadBalancingLeastConn},
+				ZoneSize:      helpers.GetPointer[ngfAPI.Size]("2m"),
+				KeepAlive: &ngfAPI.UpstreamKeepAlive{
+					Connections: helpers.GetPointer[int32](16),
+					Timeout:     helpers.GetPointer[ngfAPI.Duration]("30s"),
+				},
+			}},
+		},
+	}
+
+	m := NewMerger()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			parentCopy := parent.DeepCopy()
+			childCopy := test.child.DeepCopy()
+
+			merged := m.Merge(parent, test.child)
+			g.Expect(helpers.Diff(test.expected, merged)).To(BeEmpty())
+
+			// the inputs must not be modified
+			g.Expect(helpers.Diff(parentCopy, parent)).To(BeEmpty())
+			g.Expect(helpers.Diff(childCopy, test.child)).To(BeEmpty())
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package upstreamsettings
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// keyRegexp matches the hash key, which is rendered verbatim into the hash directive.
+var keyRegexp = regexp.MustCompile(`^(\$[a-z0-9_]+)+$`)
+
+// Validator validates an UpstreamSettingsPolicy.
+// Implements policies.Validator interface.
+type Validator struct{}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Validate validates the spec of an UpstreamSettingsPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	usp := helpers.MustCastObject[*ngfAPI.UpstreamSettingsPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []v1.Kind{policies.KindService}
+
+	if err := policies.ValidateTargetRef(usp.Spec.TargetRef, targetRefPath, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if errs := validateLoadBalancing(usp.Spec.LoadBalancing); len(errs) > 0 {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(errs.ToAggregate().Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two UpstreamSettingsPolicies configure the same settings.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	uspA := helpers.MustCastObject[*ngfAPI.UpstreamSettingsPolicy](polA)
+	uspB := helpers.MustCastObject[*ngfAPI.UpstreamSettingsPolicy](polB)
+
+	settingsB := make(map[string]struct{})
+	for _, s := range getConfiguredSettings(uspB.Spec) {
+		settingsB[s] = struct{}{}
+	}
+
+	for _, s := range getConfiguredSettings(uspA.Spec) {
+		if _, exists := settingsB[s]; exists {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateLoadBalancing(lb *ngfAPI.LoadBalancing) field.ErrorList {
+	if lb == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	lbPath := field.NewPath("spec").Child("loadBalancing")
+
+	switch lb.Method {
+	case ngfAPI.LoadBalancingHash:
+		if lb.Key == nil {
+			allErrs = append(allErrs, field.Required(lbPath.Child("key"), "must be set if method is Hash"))
+		} else if !keyRegexp.MatchString(*lb.Key) {
+			allErrs = append(allErrs, field.Invalid(
+				lbPath.Child("key"),
+				*lb.Key,
+				"must consist of one or more NGINX variables, for example, $remote_addr$request_uri",
+			))
+		}
+	case ngfAPI.LoadBalancingRoundRobin,
+		ngfAPI.LoadBalancingLeastConn,
+		ngfAPI.LoadBalancingIPHash,
+		ngfAPI.LoadBalancingRandom:
+		if lb.Key != nil {
+			allErrs = append(allErrs, field.Forbidden(lbPath.Child("key"), "can only be set if method is Hash"))
+		}
+		if lb.Consistent != nil {
+			allErrs = append(allErrs, field.Forbidden(lbPath.Child("consistent"), "can only be set if method is Hash"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(
+			lbPath.Child("method"),
+			lb.Method,
+			[]string{
+				string(ngfAPI.LoadBalancingRoundRobin),
+				string(ngfAPI.LoadBalancingLeastConn),
+				string(ngfAPI.LoadBalancingIPHash),
+				string(ngfAPI.LoadBalancingHash),
+				string(ngfAPI.LoadBalancingRandom),
+			},
+		))
+	}
+
+	return allErrs
+}
+
+// getConfiguredSettings returns the paths of the settings configured in the spec.
+func getConfiguredSettings(spec ngfAPI.UpstreamSettingsPolicySpec) []string {
+	var settings []string
+
+	if spec.LoadBalancing != nil {
+		settings = append(settings, "loadBalancing")
+	}
+
+	if spec.ZoneSize != nil {
+		settings = append(settings, "zoneSize")
+	}
+
+	if spec.KeepAlive != nil {
+		if spec.KeepAlive.Connections != nil {
+			settings = append(settings, "keepAlive.connections")
+		}
+		if spec.KeepAlive.Requests != nil {
+			settings = append(settings, "keepAlive.requests")
+		}
+		if spec.KeepAlive.Time != nil {
+			settings = append(settings, "keepAlive.time")
+		}
+		if spec.KeepAlive.Timeout != nil {
+			settings = append(settings, "keepAlive.timeout")
+		}
+	}
+
+	return settings
+}
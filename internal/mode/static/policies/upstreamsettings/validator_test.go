@@ -0,0 +1,166 @@
+package upstreamsettings
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+var (
+	loadBalancingSpec = ngfAPI.UpstreamSettingsPolicySpec{
+		LoadBalancing: &ngfAPI.LoadBalancing{
+			Method:     ngfAPI.LoadBalancingHash,
+			Key:        helpers.GetPointer("$request_uri"),
+			Consistent: helpers.GetPointer(true),
+		},
+		ZoneSize: helpers.GetPointer[ngfAPI.Size]("1m"),
+	}
+
+	keepAliveSpec = ngfAPI.UpstreamSettingsPolicySpec{
+		KeepAlive: &ngfAPI.UpstreamKeepAlive{
+			Connections: helpers.GetPointer[int32](16),
+			Requests:    helpers.GetPointer[int32](1000),
+			Time:        helpers.GetPointer[ngfAPI.Duration]("1h"),
+			Timeout:     helpers.GetPointer[ngfAPI.Duration]("60s"),
+		},
+	}
+)
+
+func TestValidator_Validate(t *testing.T) {
+	tests := []struct {
+		spec     ngfAPI.UpstreamSettingsPolicySpec
+		name     string
+		expConds []conditions.Condition
+	}{
+		{
+			name: "valid load balancing policy",
+			spec: loadBalancingSpec,
+		},
+		{
+			name: "valid keep-alive policy",
+			spec: keepAliveSpec,
+		},
+		{
+			name: "hash method without key",
+			spec: ngfAPI.UpstreamSettingsPolicySpec{
+				LoadBalancing: &ngfAPI.LoadBalancing{Method: ngfAPI.LoadBalancingHash},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.loadBalancing.key: Required value: must be set if method is Hash"),
+			},
+		},
+		{
+			name: "invalid hash key",
+			spec: ngfAPI.UpstreamSettingsPolicySpec{
+				LoadBalancing: &ngfAPI.LoadBalancing{
+					Method: ngfAPI.LoadBalancingHash,
+					Key:    helpers.GetPointer("$request_uri; return 200"),
+				},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"spec.loadBalancing.key: Invalid value: \"$request_uri; return 200\": " +
+						"must consist of one or more NGINX variables, for example, $remote_addr$request_uri",
+				),
+			},
+		},
+		{
+			name: "hash settings with another method",
+			spec: ngfAPI.UpstreamSettingsPolicySpec{
+				LoadBalancing: &ngfAPI.LoadBalancing{
+					Method:     ngfAPI.LoadBalancingLeastConn,
+					Key:        helpers.GetPointer("$request_uri"),
+					Consistent: helpers.GetPointer(true),
+				},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.loadBalancing.key: Forbidden: can only be set if method is Hash, " +
+						"spec.loadBalancing.consistent: Forbidden: can only be set if method is Hash]",
+				),
+			},
+		},
+		{
+			name: "unsupported method",
+			spec: ngfAPI.UpstreamSettingsPolicySpec{
+				LoadBalancing: &ngfAPI.LoadBalancing{Method: "LeastTime"},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"spec.loadBalancing.method: Unsupported value: \"LeastTime\": " +
+						"supported values: \"RoundRobin\", \"LeastConn\", \"IPHash\", \"Hash\", \"Random\"",
+				),
+			},
+		},
+	}
+
+	v := NewValidator()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			policy := &ngfAPI.UpstreamSettingsPolicy{Spec: test.spec}
+			policy.Spec.TargetRef.Kind = policies.KindService
+
+			conds := v.Validate(policy, nil)
+			g.Expect(conds).To(Equal(test.expConds))
+		})
+	}
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	tests := []struct {
+		polA, polB  *ngfAPI.UpstreamSettingsPolicy
+		name        string
+		expConflict bool
+	}{
+		{
+			name:        "different settings",
+			polA:        &ngfAPI.UpstreamSettingsPolicy{Spec: loadBalancingSpec},
+			polB:        &ngfAPI.UpstreamSettingsPolicy{Spec: keepAliveSpec},
+			expConflict: false,
+		},
+		{
+			name: "same load balancing setting",
+			polA: &ngfAPI.UpstreamSettingsPolicy{Spec: loadBalancingSpec},
+			polB: &ngfAPI.UpstreamSettingsPolicy{Spec: ngfAPI.UpstreamSettingsPolicySpec{
+				LoadBalancing: &ngfAPI.LoadBalancing{Method: ngfAPI.LoadBalancingRandom},
+			}},
+			expConflict: true,
+		},
+		{
+			name: "one overlapping keep-alive setting",
+			polA: &ngfAPI.UpstreamSettingsPolicy{Spec: keepAliveSpec},
+			polB: &ngfAPI.UpstreamSettingsPolicy{Spec: ngfAPI.UpstreamSettingsPolicySpec{
+				KeepAlive: &ngfAPI.UpstreamKeepAlive{
+					Timeout: helpers.GetPointer[ngfAPI.Duration]("10s"),
+				},
+			}},
+			expConflict: true,
+		},
+		{
+			name:        "empty policy",
+			polA:        &ngfAPI.UpstreamSettingsPolicy{Spec: ngfAPI.UpstreamSettingsPolicySpec{}},
+			polB:        &ngfAPI.UpstreamSettingsPolicy{Spec: keepAliveSpec},
+			expConflict: false,
+		},
+	}
+
+	v := NewValidator()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(v.Conflicts(test.polA, test.polB)).To(Equal(test.expConflict))
+			g.Expect(v.Conflicts(test.polB, test.polA)).To(Equal(test.expConflict))
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package policies
+
+import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+const (
+	// KindGateway is the kind of the Gateway resource.
+	KindGateway v1.Kind = "Gateway"
+	// KindHTTPRoute is the kind of the HTTPRoute resource.
+	KindHTTPRoute v1.Kind = "HTTPRoute"
+	// KindService is the kind of the Service resource.
+	KindService v1.Kind = "Service"
+	// KindSecret is the kind of the Secret resource.
+	KindSecret v1.Kind = "Secret"
+)
+
+// ValidateTargetRef validates that the Policy targets one of the supported kinds of resources in the API group of
+// the kind.
+func ValidateTargetRef(ref v1alpha2.PolicyTargetReference, basePath *field.Path, supportedKinds []v1.Kind) error {
+	if !slices.Contains(supportedKinds, ref.Kind) {
+		supportedValues := make([]string, 0, len(supportedKinds))
+		for _, kind := range supportedKinds {
+			supportedValues = append(supportedValues, string(kind))
+		}
+
+		return field.NotSupported(basePath.Child("kind"), ref.Kind, supportedValues)
+	}
+
+	if group := getKindGroup(ref.Kind); ref.Group != group {
+		return field.NotSupported(basePath.Child("group"), ref.Group, []string{string(group)})
+	}
+
+	return nil
+}
+
+// getKindGroup returns the API group of a kind. Services and Secrets belong to the core API group, which is
+// represented by an empty group.
+func getKindGroup(kind v1.Kind) v1.Group {
+	switch kind {
+	case KindService, KindSecret:
+		return ""
+	default:
+		return v1.GroupName
+	}
+}
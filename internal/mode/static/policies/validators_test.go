@@ -0,0 +1,223 @@
+package policies_test
+
+import (
+	"slices"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/accesscontrol"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/accesslog"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/basicauth"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/cache"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/clientsettings"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/compression"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/cors"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/errorpage"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/externalauth"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/observability"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/ratelimit"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/retry"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies/upstreamsettings"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
+)
+
+func TestValidators_TargetRef(t *testing.T) {
+	genericValidator := &validationfakes.FakeGenericValidator{}
+	port := helpers.GetPointer[v1.PortNumber](80)
+
+	// the policies are valid, except for their target kind or group
+	tests := []struct {
+		validator      policies.Validator
+		createPolicy   func(ref v1alpha2.PolicyTargetReference) policies.Policy
+		name           string
+		supportedKinds []v1.Kind
+	}{
+		{
+			name:      "AccessControlPolicy",
+			validator: accesscontrol.NewValidator(),
+			createPolicy: func(ref v1alpha2.PolicyTargetReference) policies.Policy {
+				return &ngfAPI.AccessControlPolicy{Spec: ngfAPI.AccessControlPolicySpec{
+					TargetRef: v1alpha2.PolicyTargetReferenceWithSectionName{PolicyTargetReference: ref},
+					Rules:     []ngfAPI.AccessControlRule{{Action: ngfAPI.AccessControlActionAllow, CIDR: "10.0.0.0/8"}},
+				}}
+			},
+			supportedKinds: []v1.Kind{policies.KindGateway, policies.KindHTTPRoute},
+		},
+		{
+			name:      "AccessLogPolicy",
+			validator: accesslog.NewValidator(),
+			createPolicy: func(ref v1alpha2.PolicyTargetReference) policies.Policy {
+				return &ngfAPI.AccessLogPolicy{Spec: ngfAPI.AccessLogPolicySpec{TargetRef: ref}}
+			},
+			supportedKinds: []v1.Kind{policies.KindGateway, policies.KindHTTPRoute},
+		},
+		{
+			name:      "BasicAuthPolicy",
+			validator: basicauth.NewValidator(genericValidator),
+			createPolicy: func(ref v1alpha2.PolicyTargetReference) policies.Policy {
+				return &ngfAPI.BasicAuthPolicy{Spec: ngfAPI.BasicAuthPolicySpec{
+					TargetRef: ref,
+					SecretRef: v1.SecretObjectReference{Name: "users"},
+				}}
+			},
+			supportedKinds: []v1.Kind{policies.KindHTTPRoute},
+		},
+		{
+			name:      "CachePolicy",
+			validator: cache.NewValidator(),
+			createPolicy: func(ref v1alpha2.PolicyTargetReference) policies.Policy {
+				return &ngfAPI.CachePolicy{Spec: ngfAPI.CachePolicySpec{TargetRef: ref}}
+			},
+			supportedKinds: []v1.Kind{policies.KindHTTPRoute},
+		},
+		{
+			name:      "ClientSettingsPolicy",
+			validator: clientsettings.NewValidator(),
+			createPolicy: func(ref v1alpha2.PolicyTargetReference) policies.Policy {
+				return &ngfAPI.ClientSettingsPolicy{Spec: ngfAPI.ClientSettingsPolicySpec{TargetRef: ref}}
+			},
+			supportedKinds: []v1.Kind{policies.KindGateway, policies.KindHTTPRoute},
+		},
+		{
+			name:      "CompressionPolicy",
+			validator: compression.NewValidator(),
+			createPolicy: func(ref v1alpha2.PolicyTargetReference) policies.Policy {
+				return &ngfAPI.CompressionPolicy{Spec: ngfAPI.CompressionPolicySpec{TargetRef: ref}}
+			},
+			supportedKinds: []v1.Kind{policies.KindGateway, policies.KindHTTPRoute},
+		},
+		{
+			name:      "CORSPolicy",
+			validator: cors.NewValidator(),
+			createPolicy: func(ref v1alpha2.PolicyTargetReference) policies.Policy {
+				return &ngfAPI.CORSPolicy{Spec: ngfAPI.CORSPolicySpec{
+					TargetRef:    ref,
+					AllowOrigins: []ngfAPI.CORSOrigin{"*"},
+				}}
+			},
+			supportedKinds: []v1.Kind{policies.KindHTTPRoute},
+		},
+		{
+			name:      "ErrorPagePolicy",
+			validator: errorpage.NewValidator(),
+			createPolicy: func(ref v1alpha2.PolicyTargetReference) policies.Policy {
+				return &ngfAPI.ErrorPagePolicy{Spec: ngfAPI.ErrorPagePolicySpec{
+					TargetRef: ref,
+					Pages: []ngfAPI.ErrorPage{
+						{
+							Codes:      []ngfAPI.ErrorPageStatusCode{502},
+							BackendRef: &v1.BackendObjectReference{Name: "errors", Port: port},
+						},
+					},
+				}}
+			},
+			supportedKinds: []v1.Kind{policies.KindGateway, policies.KindHTTPRoute},
+		},
+		{
+			name:      "ExternalAuthPolicy",
+			validator: externalauth.NewValidator(),
+			createPolicy: func(ref v1alpha2.PolicyTargetReference) policies.Policy {
+				return &ngfAPI.ExternalAuthPolicy{Spec: ngfAPI.ExternalAuthPolicySpec{
+					TargetRef:  ref,
+					BackendRef: v1.BackendObjectReference{Name: "auth", Port: port},
+				}}
+			},
+			supportedKinds: []v1.Kind{policies.KindHTTPRoute},
+		},
+		{
+			name:      "ObservabilityPolicy",
+			validator: observability.NewValidator(genericValidator),
+			createPolicy: func(ref v1alpha2.PolicyTargetReference) policies.Policy {
+				return &ngfAPI.ObservabilityPolicy{Spec: ngfAPI.ObservabilityPolicySpec{TargetRef: ref}}
+			},
+			supportedKinds: []v1.Kind{policies.KindHTTPRoute},
+		},
+		{
+			name:      "RateLimitPolicy",
+			validator: ratelimit.NewValidator(false),
+			createPolicy: func(ref v1alpha2.PolicyTargetReference) policies.Policy {
+				return &ngfAPI.RateLimitPolicy{Spec: ngfAPI.RateLimitPolicySpec{TargetRef: ref, Rate: "10r/s"}}
+			},
+			supportedKinds: []v1.Kind{policies.KindGateway, policies.KindHTTPRoute},
+		},
+		{
+			name:      "RetryPolicy",
+			validator: retry.NewValidator(),
+			createPolicy: func(ref v1alpha2.PolicyTargetReference) policies.Policy {
+				return &ngfAPI.RetryPolicy{Spec: ngfAPI.RetryPolicySpec{TargetRef: ref}}
+			},
+			supportedKinds: []v1.Kind{policies.KindGateway, policies.KindHTTPRoute},
+		},
+		{
+			name:      "UpstreamSettingsPolicy",
+			validator: upstreamsettings.NewValidator(),
+			createPolicy: func(ref v1alpha2.PolicyTargetReference) policies.Policy {
+				return &ngfAPI.UpstreamSettingsPolicy{Spec: ngfAPI.UpstreamSettingsPolicySpec{TargetRef: ref}}
+			},
+			supportedKinds: []v1.Kind{policies.KindService},
+		},
+	}
+
+	allKinds := []v1.Kind{policies.KindGateway, policies.KindHTTPRoute, policies.KindService, "GRPCRoute"}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			supportedValues := make([]string, 0, len(test.supportedKinds))
+			for _, kind := range test.supportedKinds {
+				supportedValues = append(supportedValues, string(kind))
+			}
+
+			for _, kind := range allKinds {
+				group := v1.Group(v1.GroupName)
+				if kind == policies.KindService {
+					group = ""
+				}
+
+				policy := test.createPolicy(v1alpha2.PolicyTargetReference{Group: group, Kind: kind, Name: "target"})
+
+				var expConds []conditions.Condition
+				if !slices.Contains(test.supportedKinds, kind) {
+					path := field.NewPath("spec", "targetRef", "kind")
+					expConds = []conditions.Condition{
+						staticConds.NewPolicyInvalid(field.NotSupported(path, kind, supportedValues).Error()),
+					}
+				}
+
+				g.Expect(test.validator.Validate(policy, nil)).To(Equal(expConds), string(kind))
+			}
+
+			for _, kind := range test.supportedKinds {
+				policy := test.createPolicy(v1alpha2.PolicyTargetReference{
+					Group: "foo.example.com",
+					Kind:  kind,
+					Name:  "target",
+				})
+
+				group := v1.GroupName
+				if kind == policies.KindService {
+					group = ""
+				}
+
+				path := field.NewPath("spec", "targetRef", "group")
+				expConds := []conditions.Condition{
+					staticConds.NewPolicyInvalid(
+						field.NotSupported(path, v1.Group("foo.example.com"), []string{group}).Error(),
+					),
+				}
+
+				g.Expect(test.validator.Validate(policy, nil)).To(Equal(expConds), "foo.example.com/"+string(kind))
+			}
+		})
+	}
+}
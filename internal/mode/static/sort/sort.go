@@ -1,6 +1,9 @@
 package sort
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
 
 // LessObjectMeta compares two ObjectMetas according to the Gateway API conflict resolution guidelines.
 // See https://gateway-api.sigs.k8s.io/concepts/guidelines/?h=conflict#conflicts
@@ -14,3 +17,17 @@ func LessObjectMeta(meta1 *metav1.ObjectMeta, meta2 *metav1.ObjectMeta) bool {
 
 	return meta1.CreationTimestamp.Before(&meta2.CreationTimestamp)
 }
+
+// LessClientObject compares two client.Objects according to the Gateway API conflict resolution guidelines.
+// See LessObjectMeta.
+func LessClientObject(obj1 client.Object, obj2 client.Object) bool {
+	return LessObjectMeta(toObjectMeta(obj1), toObjectMeta(obj2))
+}
+
+func toObjectMeta(obj client.Object) *metav1.ObjectMeta {
+	return &metav1.ObjectMeta{
+		Namespace:         obj.GetNamespace(),
+		Name:              obj.GetName(),
+		CreationTimestamp: obj.GetCreationTimestamp(),
+	}
+}
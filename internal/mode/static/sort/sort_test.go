@@ -6,6 +6,8 @@ import (
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 func TestLessObjectMeta(t *testing.T) {
@@ -89,3 +91,82 @@ func TestLessObjectMeta(t *testing.T) {
 		})
 	}
 }
+
+func TestLessClientObject(t *testing.T) {
+	before := metav1.Now()
+	later := metav1.NewTime(before.Add(1 * time.Second))
+
+	tests := []struct {
+		obj1     client.Object
+		obj2     client.Object
+		name     string
+		expected bool
+	}{
+		{
+			obj1: &v1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         "ns1",
+					Name:              "obj2",
+					CreationTimestamp: before,
+				},
+			},
+			obj2: &v1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         "ns1",
+					Name:              "obj1",
+					CreationTimestamp: later,
+				},
+			},
+			name:     "first is less by timestamp",
+			expected: true,
+		},
+		{
+			obj1: &v1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         "ns1",
+					Name:              "obj1",
+					CreationTimestamp: before,
+				},
+			},
+			obj2: &v1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         "ns1",
+					Name:              "obj2",
+					CreationTimestamp: before,
+				},
+			},
+			name:     "first is less by name",
+			expected: true,
+		},
+		{
+			obj1: &v1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         "ns1",
+					Name:              "obj1",
+					CreationTimestamp: before,
+				},
+			},
+			obj2: &v1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         "ns1",
+					Name:              "obj1",
+					CreationTimestamp: before,
+				},
+			},
+			name:     "equal",
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := LessClientObject(test.obj1, test.obj2)
+			invertedResult := LessClientObject(test.obj2, test.obj1)
+
+			g.Expect(result).To(Equal(test.expected))
+			g.Expect(invertedResult).To(BeFalse())
+		})
+	}
+}
@@ -19,7 +19,9 @@ import (
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/gatewayclass"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
 )
@@ -98,6 +100,10 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 		GatewayClasses:     make(map[types.NamespacedName]*v1.GatewayClass),
 		Gateways:           make(map[types.NamespacedName]*v1.Gateway),
 		HTTPRoutes:         make(map[types.NamespacedName]*v1.HTTPRoute),
+		GRPCRoutes:         make(map[types.NamespacedName]*v1alpha2.GRPCRoute),
+		TLSRoutes:          make(map[types.NamespacedName]*v1alpha2.TLSRoute),
+		TCPRoutes:          make(map[types.NamespacedName]*v1alpha2.TCPRoute),
+		UDPRoutes:          make(map[types.NamespacedName]*v1alpha2.UDPRoute),
 		Services:           make(map[types.NamespacedName]*apiv1.Service),
 		Namespaces:         make(map[types.NamespacedName]*apiv1.Namespace),
 		ReferenceGrants:    make(map[types.NamespacedName]*v1beta1.ReferenceGrant),
@@ -105,6 +111,8 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 		CRDMetadata:        make(map[types.NamespacedName]*metav1.PartialObjectMetadata),
 		BackendTLSPolicies: make(map[types.NamespacedName]*v1alpha2.BackendTLSPolicy),
 		ConfigMaps:         make(map[types.NamespacedName]*apiv1.ConfigMap),
+		NGFPolicies:        make(map[graph.PolicyKey]policies.Policy),
+		NginxProxies:       make(map[types.NamespacedName]*ngfAPI.NginxProxy),
 	}
 
 	extractGVK := func(obj client.Object) schema.GroupVersionKind {
@@ -142,6 +150,26 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 				store:     newObjectStoreMapAdapter(clusterStore.HTTPRoutes),
 				predicate: nil,
 			},
+			{
+				gvk:       extractGVK(&v1alpha2.GRPCRoute{}),
+				store:     newObjectStoreMapAdapter(clusterStore.GRPCRoutes),
+				predicate: nil,
+			},
+			{
+				gvk:       extractGVK(&v1alpha2.TLSRoute{}),
+				store:     newObjectStoreMapAdapter(clusterStore.TLSRoutes),
+				predicate: nil,
+			},
+			{
+				gvk:       extractGVK(&v1alpha2.TCPRoute{}),
+				store:     newObjectStoreMapAdapter(clusterStore.TCPRoutes),
+				predicate: nil,
+			},
+			{
+				gvk:       extractGVK(&v1alpha2.UDPRoute{}),
+				store:     newObjectStoreMapAdapter(clusterStore.UDPRoutes),
+				predicate: nil,
+			},
 			{
 				gvk:       extractGVK(&v1beta1.ReferenceGrant{}),
 				store:     newObjectStoreMapAdapter(clusterStore.ReferenceGrants),
@@ -152,6 +180,115 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 				store:     newObjectStoreMapAdapter(clusterStore.BackendTLSPolicies),
 				predicate: nil,
 			},
+			{
+				gvk: extractGVK(&ngfAPI.ClientSettingsPolicy{}),
+				store: newNGFPolicyObjectStoreMapAdapter[*ngfAPI.ClientSettingsPolicy](
+					clusterStore.NGFPolicies,
+					extractGVK(&ngfAPI.ClientSettingsPolicy{}),
+				),
+				predicate: nil,
+			},
+			{
+				gvk: extractGVK(&ngfAPI.ObservabilityPolicy{}),
+				store: newNGFPolicyObjectStoreMapAdapter[*ngfAPI.ObservabilityPolicy](
+					clusterStore.NGFPolicies,
+					extractGVK(&ngfAPI.ObservabilityPolicy{}),
+				),
+				predicate: nil,
+			},
+			{
+				gvk: extractGVK(&ngfAPI.RateLimitPolicy{}),
+				store: newNGFPolicyObjectStoreMapAdapter[*ngfAPI.RateLimitPolicy](
+					clusterStore.NGFPolicies,
+					extractGVK(&ngfAPI.RateLimitPolicy{}),
+				),
+				predicate: nil,
+			},
+			{
+				gvk: extractGVK(&ngfAPI.UpstreamSettingsPolicy{}),
+				store: newNGFPolicyObjectStoreMapAdapter[*ngfAPI.UpstreamSettingsPolicy](
+					clusterStore.NGFPolicies,
+					extractGVK(&ngfAPI.UpstreamSettingsPolicy{}),
+				),
+				predicate: nil,
+			},
+			{
+				gvk: extractGVK(&ngfAPI.RetryPolicy{}),
+				store: newNGFPolicyObjectStoreMapAdapter[*ngfAPI.RetryPolicy](
+					clusterStore.NGFPolicies,
+					extractGVK(&ngfAPI.RetryPolicy{}),
+				),
+				predicate: nil,
+			},
+			{
+				gvk: extractGVK(&ngfAPI.BasicAuthPolicy{}),
+				store: newNGFPolicyObjectStoreMapAdapter[*ngfAPI.BasicAuthPolicy](
+					clusterStore.NGFPolicies,
+					extractGVK(&ngfAPI.BasicAuthPolicy{}),
+				),
+				predicate: nil,
+			},
+			{
+				gvk: extractGVK(&ngfAPI.ExternalAuthPolicy{}),
+				store: newNGFPolicyObjectStoreMapAdapter[*ngfAPI.ExternalAuthPolicy](
+					clusterStore.NGFPolicies,
+					extractGVK(&ngfAPI.ExternalAuthPolicy{}),
+				),
+				predicate: nil,
+			},
+			{
+				gvk: extractGVK(&ngfAPI.AccessControlPolicy{}),
+				store: newNGFPolicyObjectStoreMapAdapter[*ngfAPI.AccessControlPolicy](
+					clusterStore.NGFPolicies,
+					extractGVK(&ngfAPI.AccessControlPolicy{}),
+				),
+				predicate: nil,
+			},
+			{
+				gvk: extractGVK(&ngfAPI.CORSPolicy{}),
+				store: newNGFPolicyObjectStoreMapAdapter[*ngfAPI.CORSPolicy](
+					clusterStore.NGFPolicies,
+					extractGVK(&ngfAPI.CORSPolicy{}),
+				),
+				predicate: nil,
+			},
+			{
+				gvk: extractGVK(&ngfAPI.CachePolicy{}),
+				store: newNGFPolicyObjectStoreMapAdapter[*ngfAPI.CachePolicy](
+					clusterStore.NGFPolicies,
+					extractGVK(&ngfAPI.CachePolicy{}),
+				),
+				predicate: nil,
+			},
+			{
+				gvk: extractGVK(&ngfAPI.CompressionPolicy{}),
+				store: newNGFPolicyObjectStoreMapAdapter[*ngfAPI.CompressionPolicy](
+					clusterStore.NGFPolicies,
+					extractGVK(&ngfAPI.CompressionPolicy{}),
+				),
+				predicate: nil,
+			},
+			{
+				gvk: extractGVK(&ngfAPI.ErrorPagePolicy{}),
+				store: newNGFPolicyObjectStoreMapAdapter[*ngfAPI.ErrorPagePolicy](
+					clusterStore.NGFPolicies,
+					extractGVK(&ngfAPI.ErrorPagePolicy{}),
+				),
+				predicate: nil,
+			},
+			{
+				gvk: extractGVK(&ngfAPI.AccessLogPolicy{}),
+				store: newNGFPolicyObjectStoreMapAdapter[*ngfAPI.AccessLogPolicy](
+					clusterStore.NGFPolicies,
+					extractGVK(&ngfAPI.AccessLogPolicy{}),
+				),
+				predicate: nil,
+			},
+			{
+				gvk:       extractGVK(&ngfAPI.NginxProxy{}),
+				store:     newObjectStoreMapAdapter(clusterStore.NginxProxies),
+				predicate: funcPredicate{stateChanged: isReferenced},
+			},
 			{
 				gvk:       extractGVK(&apiv1.Namespace{}),
 				store:     newObjectStoreMapAdapter(clusterStore.Namespaces),
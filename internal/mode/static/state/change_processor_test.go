@@ -17,6 +17,7 @@ import (
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/controller/index"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/gatewayclass"
@@ -175,6 +176,8 @@ func createAlwaysValidValidators() validation.Validators {
 
 	return validation.Validators{
 		HTTPFieldsValidator: http,
+		GenericValidator:    &validationfakes.FakeGenericValidator{},
+		PolicyValidator:     &validationfakes.FakePolicyValidator{},
 	}
 }
 
@@ -187,6 +190,7 @@ func createScheme() *runtime.Scheme {
 	utilruntime.Must(apiv1.AddToScheme(scheme))
 	utilruntime.Must(discoveryV1.AddToScheme(scheme))
 	utilruntime.Must(apiext.AddToScheme(scheme))
+	utilruntime.Must(ngfAPI.AddToScheme(scheme))
 
 	return scheme
 }
@@ -286,8 +290,8 @@ var _ = Describe("ChangeProcessor", func() {
 				gw1, gw1Updated, gw2                *v1.Gateway
 				refGrant1, refGrant2                *v1beta1.ReferenceGrant
 				expGraph                            *graph.Graph
-				expRouteHR1, expRouteHR2            *graph.Route
-				hr1Name, hr2Name                    types.NamespacedName
+				expRouteHR1, expRouteHR2            *graph.L7Route
+				hr1Name, hr2Name                    graph.RouteKey
 				gatewayAPICRD, gatewayAPICRDUpdated *metav1.PartialObjectMetadata
 			)
 			BeforeAll(func() {
@@ -306,13 +310,13 @@ var _ = Describe("ChangeProcessor", func() {
 				}
 
 				hr1 = createRoute("hr-1", "gateway-1", "foo.example.com", crossNsBackendRef)
-				hr1Name = types.NamespacedName{Namespace: hr1.Namespace, Name: hr1.Name}
+				hr1Name = graph.CreateRouteKey(hr1)
 
 				hr1Updated = hr1.DeepCopy()
 				hr1Updated.Generation++
 
 				hr2 = createRoute("hr-2", "gateway-2", "bar.example.com")
-				hr2Name = types.NamespacedName{Namespace: "test", Name: "hr-2"}
+				hr2Name = graph.CreateRouteKey(hr2)
 
 				refGrant1 = &v1beta1.ReferenceGrant{
 					ObjectMeta: metav1.ObjectMeta{
@@ -404,35 +408,47 @@ var _ = Describe("ChangeProcessor", func() {
 				gatewayAPICRDUpdated.Annotations[gatewayclass.BundleVersionAnnotation] = "v1.99.0"
 			})
 			BeforeEach(func() {
-				expRouteHR1 = &graph.Route{
-					Source: hr1,
+				expRouteHR1 = &graph.L7Route{
+					RouteType: graph.RouteTypeHTTP,
+					Source:    hr1,
 					ParentRefs: []graph.ParentRef{
 						{
 							Attachment: &graph.ParentRefAttachmentStatus{
 								AcceptedHostnames: map[string][]string{"listener-80-1": {"foo.example.com"}},
 								Attached:          true,
 							},
-							Gateway: types.NamespacedName{Namespace: "test", Name: "gateway-1"},
+							Gateway:     types.NamespacedName{Namespace: "test", Name: "gateway-1"},
+							SectionName: hr1.Spec.ParentRefs[0].SectionName,
 						},
 						{
 							Attachment: &graph.ParentRefAttachmentStatus{
 								AcceptedHostnames: map[string][]string{"listener-443-1": {"foo.example.com"}},
 								Attached:          true,
 							},
-							Gateway: types.NamespacedName{Namespace: "test", Name: "gateway-1"},
-							Idx:     1,
+							Gateway:     types.NamespacedName{Namespace: "test", Name: "gateway-1"},
+							Idx:         1,
+							SectionName: hr1.Spec.ParentRefs[1].SectionName,
 						},
 					},
-					Rules: []graph.Rule{
-						{
-							BackendRefs: []graph.BackendRef{
-								{
-									SvcNsName: types.NamespacedName{Namespace: "service-ns", Name: "service"},
-									Weight:    1,
+					Spec: graph.L7RouteSpec{
+						Hostnames: hr1.Spec.Hostnames,
+						Rules: []graph.RouteRule{
+							{
+								BackendRefs: []graph.BackendRef{
+									{
+										SvcNsName: types.NamespacedName{Namespace: "service-ns", Name: "service"},
+										Weight:    1,
+									},
+								},
+								ValidMatches: true,
+								ValidFilters: true,
+								Matches:      hr1.Spec.Rules[0].Matches,
+								RouteBackendRefs: []graph.RouteBackendRef{
+									{
+										BackendRef: hr1.Spec.Rules[0].BackendRefs[0].BackendRef,
+									},
 								},
 							},
-							ValidMatches: true,
-							ValidFilters: true,
 						},
 					},
 					Valid:      true,
@@ -444,26 +460,39 @@ var _ = Describe("ChangeProcessor", func() {
 					},
 				}
 
-				expRouteHR2 = &graph.Route{
-					Source: hr2,
+				expRouteHR2 = &graph.L7Route{
+					RouteType: graph.RouteTypeHTTP,
+					Source:    hr2,
 					ParentRefs: []graph.ParentRef{
 						{
 							Attachment: &graph.ParentRefAttachmentStatus{
 								AcceptedHostnames: map[string][]string{"listener-80-1": {"bar.example.com"}},
 								Attached:          true,
 							},
-							Gateway: types.NamespacedName{Namespace: "test", Name: "gateway-2"},
+							Gateway:     types.NamespacedName{Namespace: "test", Name: "gateway-2"},
+							SectionName: hr2.Spec.ParentRefs[0].SectionName,
 						},
 						{
 							Attachment: &graph.ParentRefAttachmentStatus{
 								AcceptedHostnames: map[string][]string{"listener-443-1": {"bar.example.com"}},
 								Attached:          true,
 							},
-							Gateway: types.NamespacedName{Namespace: "test", Name: "gateway-2"},
-							Idx:     1,
+							Gateway:     types.NamespacedName{Namespace: "test", Name: "gateway-2"},
+							Idx:         1,
+							SectionName: hr2.Spec.ParentRefs[1].SectionName,
+						},
+					},
+					Spec: graph.L7RouteSpec{
+						Hostnames: hr2.Spec.Hostnames,
+						Rules: []graph.RouteRule{
+							{
+								ValidMatches:     true,
+								ValidFilters:     true,
+								Matches:          hr2.Spec.Rules[0].Matches,
+								RouteBackendRefs: []graph.RouteBackendRef{},
+							},
 						},
 					},
-					Rules:      []graph.Rule{{ValidMatches: true, ValidFilters: true}},
 					Valid:      true,
 					Attachable: true,
 				}
@@ -483,31 +512,34 @@ var _ = Describe("ChangeProcessor", func() {
 								Source:     gw1.Spec.Listeners[0],
 								Valid:      true,
 								Attachable: true,
-								Routes: map[types.NamespacedName]*graph.Route{
-									{Namespace: "test", Name: "hr-1"}: expRouteHR1,
+								Routes: map[graph.RouteKey]*graph.L7Route{
+									graph.CreateRouteKey(hr1): expRouteHR1,
 								},
-								SupportedKinds: []v1.RouteGroupKind{{Kind: "HTTPRoute"}},
+								L4Routes:       map[graph.RouteKey]*graph.L4Route{},
+								SupportedKinds: []v1.RouteGroupKind{{Kind: "HTTPRoute"}, {Kind: "GRPCRoute"}},
 							},
 							{
 								Name:       "listener-443-1",
 								Source:     gw1.Spec.Listeners[1],
 								Valid:      true,
 								Attachable: true,
-								Routes: map[types.NamespacedName]*graph.Route{
-									{Namespace: "test", Name: "hr-1"}: expRouteHR1,
+								Routes: map[graph.RouteKey]*graph.L7Route{
+									graph.CreateRouteKey(hr1): expRouteHR1,
 								},
+								L4Routes:       map[graph.RouteKey]*graph.L4Route{},
 								ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(diffNsTLSSecret)),
-								SupportedKinds: []v1.RouteGroupKind{{Kind: "HTTPRoute"}},
+								SupportedKinds: []v1.RouteGroupKind{{Kind: "HTTPRoute"}, {Kind: "GRPCRoute"}},
 							},
 						},
 						Valid: true,
 					},
 					IgnoredGateways: map[types.NamespacedName]*v1.Gateway{},
-					Routes: map[types.NamespacedName]*graph.Route{
-						{Namespace: "test", Name: "hr-1"}: expRouteHR1,
+					Routes: map[graph.RouteKey]*graph.L7Route{
+						graph.CreateRouteKey(hr1): expRouteHR1,
 					},
+					L4Routes:          map[graph.RouteKey]*graph.L4Route{},
 					ReferencedSecrets: map[types.NamespacedName]*graph.Secret{},
-					ReferencedServices: map[types.NamespacedName]struct{}{
+					ReferencedServices: map[types.NamespacedName]*graph.ReferencedService{
 						{
 							Namespace: "service-ns",
 							Name:      "service",
@@ -583,7 +615,7 @@ var _ = Describe("ChangeProcessor", func() {
 							expGraph.ReferencedSecrets = nil
 							expGraph.ReferencedServices = nil
 
-							expRouteHR1.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
+							expRouteHR1.Spec.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
 
 							changed, graphCfg := processor.Process()
 							Expect(changed).To(Equal(state.ClusterStateChange))
@@ -637,7 +669,7 @@ var _ = Describe("ChangeProcessor", func() {
 					expGraph.ReferencedSecrets = nil
 					expGraph.ReferencedServices = nil
 
-					expRouteHR1.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
+					expRouteHR1.Spec.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
 
 					changed, graphCfg := processor.Process()
 					Expect(changed).To(Equal(state.ClusterStateChange))
@@ -660,7 +692,7 @@ var _ = Describe("ChangeProcessor", func() {
 					}
 
 					expGraph.ReferencedServices = nil
-					expRouteHR1.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
+					expRouteHR1.Spec.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
 
 					changed, graphCfg := processor.Process()
 					Expect(changed).To(Equal(state.ClusterStateChange))
@@ -740,10 +772,10 @@ var _ = Describe("ChangeProcessor", func() {
 					processor.CaptureUpsertChange(hr1Updated)
 
 					listener443 := getListenerByName(expGraph.Gateway, "listener-443-1")
-					listener443.Routes[hr1Name].Source.Generation = hr1Updated.Generation
+					listener443.Routes[hr1Name].Source.SetGeneration(hr1Updated.Generation)
 
 					listener80 := getListenerByName(expGraph.Gateway, "listener-80-1")
-					listener80.Routes[hr1Name].Source.Generation = hr1Updated.Generation
+					listener80.Routes[hr1Name].Source.SetGeneration(hr1Updated.Generation)
 					expGraph.ReferencedSecrets[client.ObjectKeyFromObject(diffNsTLSSecret)] = &graph.Secret{
 						Source: diffNsTLSSecret,
 					}
@@ -895,7 +927,7 @@ var _ = Describe("ChangeProcessor", func() {
 						Source: sameNsTLSSecret,
 					}
 
-					expRouteHR1.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
+					expRouteHR1.Spec.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
 					expGraph.ReferencedServices = nil
 
 					changed, graphCfg := processor.Process()
@@ -921,14 +953,14 @@ var _ = Describe("ChangeProcessor", func() {
 					listener443.Source = gw2.Spec.Listeners[1]
 					delete(listener80.Routes, hr1Name)
 					delete(listener443.Routes, hr1Name)
-					expGraph.Routes = map[types.NamespacedName]*graph.Route{}
+					expGraph.Routes = map[graph.RouteKey]*graph.L7Route{}
 					sameNsTLSSecretRef := helpers.GetPointer(client.ObjectKeyFromObject(sameNsTLSSecret))
 					listener443.ResolvedSecret = sameNsTLSSecretRef
 					expGraph.ReferencedSecrets[client.ObjectKeyFromObject(sameNsTLSSecret)] = &graph.Secret{
 						Source: sameNsTLSSecret,
 					}
 
-					expRouteHR1.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
+					expRouteHR1.Spec.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
 					expGraph.ReferencedServices = nil
 
 					changed, graphCfg := processor.Process()
@@ -949,10 +981,10 @@ var _ = Describe("ChangeProcessor", func() {
 						Source:     gw2,
 						Conditions: staticConds.NewGatewayInvalid("GatewayClass doesn't exist"),
 					}
-					expGraph.Routes = map[types.NamespacedName]*graph.Route{}
+					expGraph.Routes = map[graph.RouteKey]*graph.L7Route{}
 					expGraph.ReferencedSecrets = nil
 
-					expRouteHR1.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
+					expRouteHR1.Spec.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
 					expGraph.ReferencedServices = nil
 
 					changed, graphCfg := processor.Process()
@@ -968,7 +1000,7 @@ var _ = Describe("ChangeProcessor", func() {
 						types.NamespacedName{Namespace: "test", Name: "gateway-2"},
 					)
 
-					expRouteHR1.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
+					expRouteHR1.Spec.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
 					expGraph.ReferencedServices = nil
 
 					changed, graphCfg := processor.Process()
@@ -984,7 +1016,7 @@ var _ = Describe("ChangeProcessor", func() {
 						types.NamespacedName{Namespace: "test", Name: "hr-1"},
 					)
 
-					expRouteHR1.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
+					expRouteHR1.Spec.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
 					expGraph.ReferencedServices = nil
 
 					changed, graphCfg := processor.Process()
@@ -1523,6 +1555,89 @@ var _ = Describe("ChangeProcessor", func() {
 				})
 			})
 		})
+
+		Describe("NginxProxy changes", Ordered, func() {
+			var (
+				paramGC         *v1.GatewayClass
+				np, unrelatedNP *ngfAPI.NginxProxy
+				npNsName        types.NamespacedName
+				unrelatedNPName types.NamespacedName
+			)
+
+			BeforeAll(func() {
+				paramGC = gc.DeepCopy()
+				paramGC.Spec.ParametersRef = &v1.ParametersReference{
+					Group: ngfAPI.GroupName,
+					Kind:  "NginxProxy",
+					Name:  "np",
+				}
+
+				np = &ngfAPI.NginxProxy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "np",
+					},
+					Spec: ngfAPI.NginxProxySpec{
+						Telemetry: &ngfAPI.Telemetry{
+							ServiceName: helpers.GetPointer("my-svc"),
+						},
+					},
+				}
+				npNsName = types.NamespacedName{Name: "np"}
+
+				unrelatedNP = &ngfAPI.NginxProxy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "unrelated-np",
+					},
+				}
+				unrelatedNPName = types.NamespacedName{Name: "unrelated-np"}
+
+				processor.CaptureUpsertChange(paramGC)
+				processor.Process()
+			})
+
+			When("an NginxProxy that is not referenced by the GatewayClass is upserted", func() {
+				It("does not trigger an update", func() {
+					processor.CaptureUpsertChange(unrelatedNP)
+					changed, _ := processor.Process()
+					Expect(changed).To(Equal(state.NoChange))
+				})
+			})
+			When("the NginxProxy referenced by the GatewayClass is upserted", func() {
+				It("triggers an update", func() {
+					processor.CaptureUpsertChange(np)
+					changed, graph := processor.Process()
+					Expect(changed).To(Equal(state.ClusterStateChange))
+					Expect(graph.NginxProxy).ToNot(BeNil())
+					Expect(graph.NginxProxy.Source).To(Equal(np))
+				})
+			})
+			When("the NginxProxy referenced by the GatewayClass is updated", func() {
+				It("triggers an update", func() {
+					npUpdated := np.DeepCopy()
+					npUpdated.Spec.Telemetry.ServiceName = helpers.GetPointer("my-svc-updated")
+					npUpdated.Generation++
+
+					processor.CaptureUpsertChange(npUpdated)
+					changed, _ := processor.Process()
+					Expect(changed).To(Equal(state.ClusterStateChange))
+				})
+			})
+			When("an NginxProxy that is not referenced by the GatewayClass is deleted", func() {
+				It("does not trigger an update", func() {
+					processor.CaptureDeleteChange(unrelatedNP, unrelatedNPName)
+					changed, _ := processor.Process()
+					Expect(changed).To(Equal(state.NoChange))
+				})
+			})
+			When("the NginxProxy referenced by the GatewayClass is deleted", func() {
+				It("triggers an update", func() {
+					processor.CaptureDeleteChange(np, npNsName)
+					changed, graph := processor.Process()
+					Expect(changed).To(Equal(state.ClusterStateChange))
+					Expect(graph.NginxProxy).To(BeNil())
+				})
+			})
+		})
 	})
 
 	Describe("Ensuring non-changing changes don't override previously changing changes", func() {
@@ -1531,18 +1646,25 @@ var _ = Describe("ChangeProcessor", func() {
 
 		//nolint:lll
 		var (
-			processor                                                                                                     *state.ChangeProcessorImpl
-			gcNsName, gwNsName, hrNsName, hr2NsName, rgNsName, svcNsName, sliceNsName, secretNsName, cmNsName, btlsNsName types.NamespacedName
-			gc, gcUpdated                                                                                                 *v1.GatewayClass
-			gw1, gw1Updated, gw2                                                                                          *v1.Gateway
-			hr1, hr1Updated, hr2                                                                                          *v1.HTTPRoute
-			rg1, rg1Updated, rg2                                                                                          *v1beta1.ReferenceGrant
-			svc, barSvc, unrelatedSvc                                                                                     *apiv1.Service
-			slice, barSlice, unrelatedSlice                                                                               *discoveryV1.EndpointSlice
-			ns, unrelatedNS, testNs, barNs                                                                                *apiv1.Namespace
-			secret, secretUpdated, unrelatedSecret, barSecret, barSecretUpdated                                           *apiv1.Secret
-			cm, cmUpdated, unrelatedCM                                                                                    *apiv1.ConfigMap
-			btls, btlsUpdated                                                                                             *v1alpha2.BackendTLSPolicy
+			processor                                                                                                                                              *state.ChangeProcessorImpl
+			gcNsName, gwNsName, hrNsName, hr2NsName, grNsName, trNsName, tcrNsName, urNsName, rgNsName, svcNsName, sliceNsName, secretNsName, cmNsName, btlsNsName types.NamespacedName
+			gc, gcUpdated                                                                                                                                          *v1.GatewayClass
+			gw1, gw1Updated, gw2                                                                                                                                   *v1.Gateway
+			hr1, hr1Updated, hr2                                                                                                                                   *v1.HTTPRoute
+			gr1, gr1Updated                                                                                                                                        *v1alpha2.GRPCRoute
+			tr1, tr1Updated                                                                                                                                        *v1alpha2.TLSRoute
+			tcr1, tcr1Updated                                                                                                                                      *v1alpha2.TCPRoute
+			ur1, ur1Updated                                                                                                                                        *v1alpha2.UDPRoute
+			rg1, rg1Updated, rg2                                                                                                                                   *v1beta1.ReferenceGrant
+			svc, barSvc, unrelatedSvc                                                                                                                              *apiv1.Service
+			slice, barSlice, unrelatedSlice                                                                                                                        *discoveryV1.EndpointSlice
+			ns, unrelatedNS, testNs, barNs                                                                                                                         *apiv1.Namespace
+			secret, secretUpdated, unrelatedSecret, barSecret, barSecretUpdated                                                                                    *apiv1.Secret
+			cm, cmUpdated, unrelatedCM                                                                                                                             *apiv1.ConfigMap
+			btls, btlsUpdated                                                                                                                                      *v1alpha2.BackendTLSPolicy
+			cspNsName, obsNsName                                                                                                                                   types.NamespacedName
+			csp, cspUpdated                                                                                                                                        *ngfAPI.ClientSettingsPolicy
+			obs, obsUpdated                                                                                                                                        *ngfAPI.ObservabilityPolicy
 		)
 
 		BeforeEach(OncePerOrdered, func() {
@@ -1695,6 +1817,128 @@ var _ = Describe("ChangeProcessor", func() {
 			hr2 = hr1.DeepCopy()
 			hr2.Name = hr2NsName.Name
 
+			grNsName = types.NamespacedName{Namespace: "test", Name: "gr-1"}
+
+			gr1 = &v1alpha2.GRPCRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  grNsName.Namespace,
+					Name:       grNsName.Name,
+					Generation: 1,
+				},
+				Spec: v1alpha2.GRPCRouteSpec{
+					CommonRouteSpec: v1.CommonRouteSpec{
+						ParentRefs: []v1.ParentReference{
+							{
+								Namespace:   (*v1.Namespace)(helpers.GetPointer("test")),
+								Name:        "gw-1",
+								SectionName: (*v1.SectionName)(helpers.GetPointer("listener-80-1")),
+							},
+						},
+					},
+					Hostnames: []v1.Hostname{"grpc.example.com"},
+					Rules: []v1alpha2.GRPCRouteRule{
+						{
+							BackendRefs: []v1alpha2.GRPCBackendRef{
+								{
+									BackendRef: fooRef.BackendRef,
+								},
+							},
+						},
+					},
+				},
+			}
+
+			gr1Updated = gr1.DeepCopy()
+			gr1Updated.Generation++
+
+			trNsName = types.NamespacedName{Namespace: "test", Name: "tr-1"}
+
+			tr1 = &v1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  trNsName.Namespace,
+					Name:       trNsName.Name,
+					Generation: 1,
+				},
+				Spec: v1alpha2.TLSRouteSpec{
+					CommonRouteSpec: v1.CommonRouteSpec{
+						ParentRefs: []v1.ParentReference{
+							{
+								Namespace:   (*v1.Namespace)(helpers.GetPointer("test")),
+								Name:        "gw-1",
+								SectionName: (*v1.SectionName)(helpers.GetPointer("listener-443-1")),
+							},
+						},
+					},
+					Hostnames: []v1.Hostname{"tls.example.com"},
+					Rules: []v1alpha2.TLSRouteRule{
+						{
+							BackendRefs: []v1.BackendRef{fooRef.BackendRef},
+						},
+					},
+				},
+			}
+
+			tr1Updated = tr1.DeepCopy()
+			tr1Updated.Generation++
+
+			tcrNsName = types.NamespacedName{Namespace: "test", Name: "tcr-1"}
+
+			tcr1 = &v1alpha2.TCPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  tcrNsName.Namespace,
+					Name:       tcrNsName.Name,
+					Generation: 1,
+				},
+				Spec: v1alpha2.TCPRouteSpec{
+					CommonRouteSpec: v1.CommonRouteSpec{
+						ParentRefs: []v1.ParentReference{
+							{
+								Namespace:   (*v1.Namespace)(helpers.GetPointer("test")),
+								Name:        "gw-1",
+								SectionName: (*v1.SectionName)(helpers.GetPointer("listener-5432-1")),
+							},
+						},
+					},
+					Rules: []v1alpha2.TCPRouteRule{
+						{
+							BackendRefs: []v1.BackendRef{fooRef.BackendRef},
+						},
+					},
+				},
+			}
+
+			tcr1Updated = tcr1.DeepCopy()
+			tcr1Updated.Generation++
+
+			urNsName = types.NamespacedName{Namespace: "test", Name: "ur-1"}
+
+			ur1 = &v1alpha2.UDPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  urNsName.Namespace,
+					Name:       urNsName.Name,
+					Generation: 1,
+				},
+				Spec: v1alpha2.UDPRouteSpec{
+					CommonRouteSpec: v1.CommonRouteSpec{
+						ParentRefs: []v1.ParentReference{
+							{
+								Namespace:   (*v1.Namespace)(helpers.GetPointer("test")),
+								Name:        "gw-1",
+								SectionName: (*v1.SectionName)(helpers.GetPointer("listener-53-1")),
+							},
+						},
+					},
+					Rules: []v1alpha2.UDPRouteRule{
+						{
+							BackendRefs: []v1.BackendRef{fooRef.BackendRef},
+						},
+					},
+				},
+			}
+
+			ur1Updated = ur1.DeepCopy()
+			ur1Updated.Generation++
+
 			svcNsName = types.NamespacedName{Namespace: "test", Name: "foo-svc"}
 			svc = &apiv1.Service{
 				ObjectMeta: metav1.ObjectMeta{
@@ -1834,6 +2078,40 @@ var _ = Describe("ChangeProcessor", func() {
 				},
 			}
 			btlsUpdated = btls.DeepCopy()
+
+			cspNsName = types.NamespacedName{Namespace: "test", Name: "csp-1"}
+			csp = &ngfAPI.ClientSettingsPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       cspNsName.Name,
+					Namespace:  cspNsName.Namespace,
+					Generation: 1,
+				},
+				Spec: ngfAPI.ClientSettingsPolicySpec{
+					TargetRef: v1alpha2.PolicyTargetReference{
+						Group: v1.GroupName,
+						Kind:  "Gateway",
+						Name:  v1.ObjectName(gwNsName.Name),
+					},
+				},
+			}
+			cspUpdated = csp.DeepCopy()
+
+			obsNsName = types.NamespacedName{Namespace: "test", Name: "obs-1"}
+			obs = &ngfAPI.ObservabilityPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       obsNsName.Name,
+					Namespace:  obsNsName.Namespace,
+					Generation: 1,
+				},
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					TargetRef: v1alpha2.PolicyTargetReference{
+						Group: v1.GroupName,
+						Kind:  "HTTPRoute",
+						Name:  v1.ObjectName(hrNsName.Name),
+					},
+				},
+			}
+			obsUpdated = obs.DeepCopy()
 		})
 		// Changing change - a change that makes processor.Process() report changed
 		// Non-changing change - a change that doesn't do that
@@ -1848,9 +2126,15 @@ var _ = Describe("ChangeProcessor", func() {
 				processor.CaptureUpsertChange(gw1)
 				processor.CaptureUpsertChange(testNs)
 				processor.CaptureUpsertChange(hr1)
+				processor.CaptureUpsertChange(gr1)
+				processor.CaptureUpsertChange(tr1)
+				processor.CaptureUpsertChange(tcr1)
+				processor.CaptureUpsertChange(ur1)
 				processor.CaptureUpsertChange(rg1)
 				processor.CaptureUpsertChange(btls)
 				processor.CaptureUpsertChange(cm)
+				processor.CaptureUpsertChange(csp)
+				processor.CaptureUpsertChange(obs)
 
 				changed, _ := processor.Process()
 				Expect(changed).To(Equal(state.ClusterStateChange))
@@ -1861,17 +2145,29 @@ var _ = Describe("ChangeProcessor", func() {
 					processor.CaptureUpsertChange(gcUpdated)
 					processor.CaptureUpsertChange(gw1Updated)
 					processor.CaptureUpsertChange(hr1Updated)
+					processor.CaptureUpsertChange(gr1Updated)
+					processor.CaptureUpsertChange(tr1Updated)
+					processor.CaptureUpsertChange(tcr1Updated)
+					processor.CaptureUpsertChange(ur1Updated)
 					processor.CaptureUpsertChange(rg1Updated)
 					processor.CaptureUpsertChange(btlsUpdated)
 					processor.CaptureUpsertChange(cmUpdated)
+					processor.CaptureUpsertChange(cspUpdated)
+					processor.CaptureUpsertChange(obsUpdated)
 
 					// there are non-changing changes
 					processor.CaptureUpsertChange(gcUpdated)
 					processor.CaptureUpsertChange(gw1Updated)
 					processor.CaptureUpsertChange(hr1Updated)
+					processor.CaptureUpsertChange(gr1Updated)
+					processor.CaptureUpsertChange(tr1Updated)
+					processor.CaptureUpsertChange(tcr1Updated)
+					processor.CaptureUpsertChange(ur1Updated)
 					processor.CaptureUpsertChange(rg1Updated)
 					processor.CaptureUpsertChange(btlsUpdated)
 					processor.CaptureUpsertChange(cmUpdated)
+					processor.CaptureUpsertChange(cspUpdated)
+					processor.CaptureUpsertChange(obsUpdated)
 
 					changed, _ := processor.Process()
 					Expect(changed).To(Equal(state.ClusterStateChange))
@@ -1892,9 +2188,15 @@ var _ = Describe("ChangeProcessor", func() {
 					processor.CaptureDeleteChange(&v1.GatewayClass{}, gcNsName)
 					processor.CaptureDeleteChange(&v1.Gateway{}, gwNsName)
 					processor.CaptureDeleteChange(&v1.HTTPRoute{}, hrNsName)
+					processor.CaptureDeleteChange(&v1alpha2.GRPCRoute{}, grNsName)
+					processor.CaptureDeleteChange(&v1alpha2.TLSRoute{}, trNsName)
+					processor.CaptureDeleteChange(&v1alpha2.TCPRoute{}, tcrNsName)
+					processor.CaptureDeleteChange(&v1alpha2.UDPRoute{}, urNsName)
 					processor.CaptureDeleteChange(&v1beta1.ReferenceGrant{}, rgNsName)
 					processor.CaptureDeleteChange(&v1alpha2.BackendTLSPolicy{}, btlsNsName)
 					processor.CaptureDeleteChange(&apiv1.ConfigMap{}, cmNsName)
+					processor.CaptureDeleteChange(&ngfAPI.ClientSettingsPolicy{}, cspNsName)
+					processor.CaptureDeleteChange(&ngfAPI.ObservabilityPolicy{}, obsNsName)
 
 					// these are non-changing changes
 					processor.CaptureUpsertChange(gw2)
@@ -1918,6 +2220,10 @@ var _ = Describe("ChangeProcessor", func() {
 				processor.CaptureDeleteChange(&v1.Gateway{}, gwNsName)
 				processor.CaptureDeleteChange(&v1.HTTPRoute{}, hrNsName)
 				processor.CaptureDeleteChange(&v1.HTTPRoute{}, hr2NsName)
+				processor.CaptureDeleteChange(&v1alpha2.GRPCRoute{}, grNsName)
+				processor.CaptureDeleteChange(&v1alpha2.TLSRoute{}, trNsName)
+				processor.CaptureDeleteChange(&v1alpha2.TCPRoute{}, tcrNsName)
+				processor.CaptureDeleteChange(&v1alpha2.UDPRoute{}, urNsName)
 				processor.CaptureDeleteChange(&v1beta1.ReferenceGrant{}, rgNsName)
 
 				changed, _ := processor.Process()
@@ -2072,7 +2378,7 @@ var _ = Describe("ChangeProcessor", func() {
 			},
 			Entry(
 				"an unsupported resource",
-				&v1alpha2.TCPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "tcp"}},
+				&apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "pod"}},
 			),
 			Entry(
 				"nil resource",
@@ -2090,8 +2396,8 @@ var _ = Describe("ChangeProcessor", func() {
 			},
 			Entry(
 				"an unsupported resource",
-				&v1alpha2.TCPRoute{},
-				types.NamespacedName{Namespace: "test", Name: "tcp"},
+				&apiv1.Pod{},
+				types.NamespacedName{Namespace: "test", Name: "pod"},
 			),
 			Entry(
 				"nil resource type",
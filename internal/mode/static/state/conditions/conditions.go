@@ -61,6 +61,49 @@ const (
 	GatewayMessageFailedNginxReload = "The Gateway is not programmed due to a failure to " +
 		"reload nginx with the configuration. Please see the nginx container logs for any possible configuration issues"
 
+	// GatewayClassResolvedRefs condition indicates whether the controller was able to resolve the
+	// parametersRef on the GatewayClass.
+	GatewayClassResolvedRefs v1.GatewayClassConditionType = "ResolvedRefs"
+
+	// GatewayClassReasonResolvedRefs is used with the "GatewayClassResolvedRefs" condition when the condition
+	// is true.
+	GatewayClassReasonResolvedRefs v1.GatewayClassConditionReason = "ResolvedRefs"
+
+	// GatewayClassReasonParamsRefNotFound is used with the "GatewayClassResolvedRefs" condition when the
+	// parametersRef resource does not exist.
+	GatewayClassReasonParamsRefNotFound v1.GatewayClassConditionReason = "ParametersRefNotFound"
+
+	// GatewayClassReasonParamsRefInvalidKind is used with the "GatewayClassResolvedRefs" condition when the
+	// parametersRef references a resource of an unsupported kind.
+	GatewayClassReasonParamsRefInvalidKind v1.GatewayClassConditionReason = "InvalidKind"
+
+	// PolicyReasonNginxProxyConfigNotSet is used with the "PolicyAccepted" condition when the
+	// NginxProxy resource is missing or invalid, or doesn't configure the settings required by the Policy.
+	PolicyReasonNginxProxyConfigNotSet v1alpha2.PolicyConditionReason = "NginxProxyConfigNotSet"
+
+	// PolicyConditionResolvedRefs indicates whether the controller was able to resolve the references of the
+	// Policy to other resources, like the backendRef of an ExternalAuthPolicy.
+	PolicyConditionResolvedRefs v1alpha2.PolicyConditionType = "ResolvedRefs"
+
+	// PolicyReasonResolvedRefs is used with the "ResolvedRefs" condition when the condition is true.
+	PolicyReasonResolvedRefs v1alpha2.PolicyConditionReason = "ResolvedRefs"
+
+	// PolicyReasonBackendNotFound is used with the "ResolvedRefs" condition when the backendRef of the Policy
+	// points to a Service or a port that doesn't exist.
+	PolicyReasonBackendNotFound v1alpha2.PolicyConditionReason = "BackendNotFound"
+
+	// PolicyReasonRefNotPermitted is used with the "ResolvedRefs" condition when the backendRef of the Policy
+	// points to a Service in another namespace that no ReferenceGrant allows.
+	PolicyReasonRefNotPermitted v1alpha2.PolicyConditionReason = "RefNotPermitted"
+
+	// PolicyConditionOverridden indicates whether some settings of the Policy are overridden by other configuration
+	// of its targets.
+	PolicyConditionOverridden v1alpha2.PolicyConditionType = "Overridden"
+
+	// PolicyReasonRequestTimeout is used with the "Overridden" condition when the request timeout of an HTTPRoute
+	// rule takes precedence over a timeout of the Policy.
+	PolicyReasonRequestTimeout v1alpha2.PolicyConditionReason = "RequestTimeout"
+
 	// RouteMessageFailedNginxReload is a message used with RouteReasonGatewayNotProgrammed
 	// when nginx fails to reload.
 	RouteMessageFailedNginxReload = GatewayMessageFailedNginxReload + ". NGINX may still be configured " +
@@ -403,12 +446,46 @@ func NewListenerRefNotPermitted(msg string) []conditions.Condition {
 }
 
 // NewGatewayClassInvalidParameters returns a Condition that indicates that the GatewayClass has invalid parameters.
+// The GatewayClass stays accepted, so that an invalid parametersRef doesn't invalidate the whole configuration.
 func NewGatewayClassInvalidParameters(msg string) conditions.Condition {
 	return conditions.Condition{
 		Type:    string(v1.GatewayClassConditionStatusAccepted),
-		Status:  metav1.ConditionFalse,
+		Status:  metav1.ConditionTrue,
 		Reason:  string(v1.GatewayClassReasonInvalidParameters),
-		Message: msg,
+		Message: fmt.Sprintf("GatewayClass is accepted, but parametersRef is ignored due to an error: %s", msg),
+	}
+}
+
+// NewGatewayClassResolvedRefs returns a Condition that indicates that the parametersRef of the GatewayClass
+// is resolved.
+func NewGatewayClassResolvedRefs() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(GatewayClassResolvedRefs),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(GatewayClassReasonResolvedRefs),
+		Message: "parametersRef resource is resolved",
+	}
+}
+
+// NewGatewayClassRefNotFound returns a Condition that indicates that the resource referenced by the parametersRef
+// of the GatewayClass could not be found.
+func NewGatewayClassRefNotFound() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(GatewayClassResolvedRefs),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(GatewayClassReasonParamsRefNotFound),
+		Message: "parametersRef resource could not be found",
+	}
+}
+
+// NewGatewayClassRefInvalidKind returns a Condition that indicates that the parametersRef of the GatewayClass
+// references a resource of an unsupported kind.
+func NewGatewayClassRefInvalidKind() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(GatewayClassResolvedRefs),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(GatewayClassReasonParamsRefInvalidKind),
+		Message: "parametersRef kind must be NginxProxy",
 	}
 }
 
@@ -575,3 +652,89 @@ func NewBackendTLSPolicyInvalid(msg string) conditions.Condition {
 		Message: msg,
 	}
 }
+
+// NewPolicyAccepted returns a Condition that indicates that the Policy is accepted.
+func NewPolicyAccepted() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(v1alpha2.PolicyReasonAccepted),
+		Message: "Policy is accepted",
+	}
+}
+
+// NewPolicyInvalid returns a Condition that indicates that the Policy is not accepted because it is semantically or
+// syntactically invalid.
+func NewPolicyInvalid(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(v1alpha2.PolicyReasonInvalid),
+		Message: msg,
+	}
+}
+
+// NewPolicyConflicted returns a Condition that indicates that the Policy is not accepted because it conflicts with
+// another Policy and a merge is not possible.
+func NewPolicyConflicted(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(v1alpha2.PolicyReasonConflicted),
+		Message: msg,
+	}
+}
+
+// NewPolicyResolvedRefs returns a Condition that indicates that all the references of the Policy are resolved.
+func NewPolicyResolvedRefs() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(PolicyConditionResolvedRefs),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(PolicyReasonResolvedRefs),
+		Message: "All references are resolved",
+	}
+}
+
+// NewPolicyBackendRefNotFound returns a Condition that indicates that the Policy has a backendRef that points to
+// a non-existing backend.
+func NewPolicyBackendRefNotFound(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(PolicyConditionResolvedRefs),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(PolicyReasonBackendNotFound),
+		Message: msg,
+	}
+}
+
+// NewPolicyBackendRefNotPermitted returns a Condition that indicates that the Policy has a backendRef to a
+// Service in another namespace that is not permitted by any ReferenceGrant.
+func NewPolicyBackendRefNotPermitted(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(PolicyConditionResolvedRefs),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(PolicyReasonRefNotPermitted),
+		Message: msg,
+	}
+}
+
+// NewPolicyOverriddenByRequestTimeout returns a Condition that indicates that the request timeout of an HTTPRoute
+// rule takes precedence over a timeout of the Policy.
+func NewPolicyOverriddenByRequestTimeout(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(PolicyConditionOverridden),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(PolicyReasonRequestTimeout),
+		Message: msg,
+	}
+}
+
+// NewPolicyNotAcceptedNginxProxyNotSet returns a Condition that indicates that the Policy is not accepted
+// because it relies on the NginxProxy configuration, which is missing or invalid.
+func NewPolicyNotAcceptedNginxProxyNotSet(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(PolicyReasonNginxProxyConfigNotSet),
+		Message: msg,
+	}
+}
@@ -2,15 +2,23 @@ package dataplane
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"slices"
 	"sort"
+	"strings"
 
 	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	ngfsort "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/sort"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
 )
@@ -35,20 +43,50 @@ func BuildConfiguration(
 		return Configuration{Version: configVersion}
 	}
 
-	upstreams := buildUpstreams(ctx, g.Gateway.Listeners, resolver)
+	upstreams := buildUpstreams(ctx, g.Gateway.Listeners, g.Gateway.ErrorPages, g.ReferencedServices, resolver)
+	streamUpstreams := buildStreamUpstreams(ctx, g.Gateway.Listeners, resolver)
 	httpServers, sslServers := buildServers(g.Gateway.Listeners)
+	addGatewayPoliciesToServers(httpServers, g.Gateway.EffectivePolicies)
+	addGatewayPoliciesToServers(sslServers, g.Gateway.EffectivePolicies)
+	addGatewayErrorPagesToServers(append(httpServers, sslServers...), g.Gateway.ErrorPages)
+	addUpstreamKeepAliveToBackends(append(httpServers, sslServers...), upstreams)
+	tlsPassthroughServers := buildLayer4Servers(g.Gateway.Listeners, v1.TLSProtocolType)
+	tcpServers := buildLayer4Servers(g.Gateway.Listeners, v1.TCPProtocolType)
+	udpServers := buildLayer4Servers(g.Gateway.Listeners, v1.UDPProtocolType)
+	telemetry := buildTelemetry(g)
+	addTelemetrySpanAttributesToServers(httpServers, telemetry.SpanAttributes)
+	addTelemetrySpanAttributesToServers(sslServers, telemetry.SpanAttributes)
 	backendGroups := buildBackendGroups(append(httpServers, sslServers...))
+	rateLimitZones := buildRateLimitZones(append(httpServers, sslServers...))
+	cacheZones := buildCacheZones(append(httpServers, sslServers...))
+	logFormats := buildLogFormats(append(httpServers, sslServers...))
 	keyPairs := buildSSLKeyPairs(g.ReferencedSecrets, g.Gateway.Listeners)
-	certBundles := buildCertBundles(g.ReferencedCaCertConfigMaps, backendGroups)
+	authUserFiles := buildAuthUserFiles(g.ReferencedSecrets, append(httpServers, sslServers...))
+	errorPageFiles := buildErrorPageFiles(g, append(httpServers, sslServers...))
+	certBundles := buildCertBundles(
+		g.ReferencedCaCertConfigMaps,
+		backendGroups,
+		buildMirrorBackends(append(httpServers, sslServers...)),
+	)
 
 	config := Configuration{
-		HTTPServers:   httpServers,
-		SSLServers:    sslServers,
-		Upstreams:     upstreams,
-		BackendGroups: backendGroups,
-		SSLKeyPairs:   keyPairs,
-		Version:       configVersion,
-		CertBundles:   certBundles,
+		HTTPServers:           httpServers,
+		SSLServers:            sslServers,
+		TLSPassthroughServers: tlsPassthroughServers,
+		TCPServers:            tcpServers,
+		UDPServers:            udpServers,
+		Upstreams:             upstreams,
+		StreamUpstreams:       streamUpstreams,
+		BackendGroups:         backendGroups,
+		SSLKeyPairs:           keyPairs,
+		AuthUserFiles:         authUserFiles,
+		ErrorPageFiles:        errorPageFiles,
+		Version:               configVersion,
+		CertBundles:           certBundles,
+		Telemetry:             telemetry,
+		RateLimitZones:        rateLimitZones,
+		CacheZones:            cacheZones,
+		LogFormats:            logFormats,
 	}
 
 	return config
@@ -78,28 +116,124 @@ func buildSSLKeyPairs(
 	return keyPairs
 }
 
+// buildAuthUserFiles builds the AuthUserFiles from the htpasswd Secrets. It will only include Secrets that are
+// referenced by the basic authentication of the rules of the servers, so that we don't include unused Secrets
+// in the configuration of the data plane.
+func buildAuthUserFiles(
+	secrets map[types.NamespacedName]*graph.Secret,
+	servers []VirtualServer,
+) map[AuthUserFileID]AuthUserFile {
+	refByRules := make(map[AuthUserFileID]struct{})
+
+	for _, s := range servers {
+		for _, pr := range s.PathRules {
+			for _, mr := range pr.MatchRules {
+				if mr.Policies.BasicAuth != nil {
+					refByRules[mr.Policies.BasicAuth.UserFileID] = struct{}{}
+				}
+			}
+		}
+	}
+
+	if len(refByRules) == 0 {
+		return nil
+	}
+
+	files := make(map[AuthUserFileID]AuthUserFile)
+
+	for nsname, secret := range secrets {
+		id := generateAuthUserFileID(nsname)
+		if _, exists := refByRules[id]; !exists || secret.Source == nil {
+			continue
+		}
+
+		files[id] = secret.Source.Data[graph.HtpasswdSecretKey]
+	}
+
+	return files
+}
+
+// buildErrorPageFiles builds the ErrorPageFiles from the content of the error pages of the Gateway and the Routes.
+// It will only include the content referenced by the servers and their rules, so that we don't include the content
+// of the unused error pages in the configuration of the data plane.
+func buildErrorPageFiles(g *graph.Graph, servers []VirtualServer) map[ErrorPageFileID]ErrorPageFile {
+	refByServers := make(map[ErrorPageFileID]struct{})
+
+	addRefs := func(errorPages []ErrorPage) {
+		for _, page := range errorPages {
+			if page.FileID != "" {
+				refByServers[page.FileID] = struct{}{}
+			}
+		}
+	}
+
+	for _, s := range servers {
+		addRefs(s.ErrorPages)
+
+		for _, pr := range s.PathRules {
+			for _, mr := range pr.MatchRules {
+				addRefs(mr.Policies.ErrorPages)
+			}
+		}
+	}
+
+	if len(refByServers) == 0 {
+		return nil
+	}
+
+	files := make(map[ErrorPageFileID]ErrorPageFile)
+
+	addFiles := func(errorPages []graph.ErrorPage) {
+		for _, page := range errorPages {
+			if page.BackendRef != nil {
+				continue
+			}
+
+			id := generateErrorPageFileID(page.Content)
+			if _, exists := refByServers[id]; exists {
+				files[id] = page.Content
+			}
+		}
+	}
+
+	addFiles(g.Gateway.ErrorPages)
+
+	for _, route := range g.Routes {
+		addFiles(route.ErrorPages)
+	}
+
+	return files
+}
+
 func buildCertBundles(
 	caCertConfigMaps map[types.NamespacedName]*graph.CaCertConfigMap,
 	backendGroups []BackendGroup,
+	mirrorBackends []Backend,
 ) map[CertBundleID]CertBundle {
 	bundles := make(map[CertBundleID]CertBundle)
 	refByBG := make(map[CertBundleID]struct{})
 
-	// We only need to build the cert bundles if there are valid backend groups that reference them.
-	if len(backendGroups) == 0 {
+	// We only need to build the cert bundles if there are valid backend groups or mirror backends
+	// that reference them.
+	if len(backendGroups) == 0 && len(mirrorBackends) == 0 {
 		return bundles
 	}
-	for _, bg := range backendGroups {
-		if bg.Backends == nil {
-			continue
+
+	addRef := func(b Backend) {
+		if !b.Valid || b.VerifyTLS == nil {
+			return
 		}
+		refByBG[b.VerifyTLS.CertBundleID] = struct{}{}
+	}
+
+	for _, bg := range backendGroups {
 		for _, b := range bg.Backends {
-			if !b.Valid || b.VerifyTLS == nil {
-				continue
-			}
-			refByBG[b.VerifyTLS.CertBundleID] = struct{}{}
+			addRef(b)
 		}
 	}
+	for _, b := range mirrorBackends {
+		addRef(b)
+	}
 
 	for cmName, cm := range caCertConfigMaps {
 		id := generateCertBundleID(cmName)
@@ -119,6 +253,23 @@ func buildCertBundles(
 	return bundles
 }
 
+// buildMirrorBackends returns the backends of the RequestMirror filters of the servers.
+func buildMirrorBackends(servers []VirtualServer) []Backend {
+	var backends []Backend
+
+	for _, s := range servers {
+		for _, pr := range s.PathRules {
+			for _, mr := range pr.MatchRules {
+				if mr.Filters.RequestMirror != nil {
+					backends = append(backends, mr.Filters.RequestMirror.Backend)
+				}
+			}
+		}
+	}
+
+	return backends
+}
+
 func buildBackendGroups(servers []VirtualServer) []BackendGroup {
 	type key struct {
 		nsname  types.NamespacedName
@@ -165,12 +316,7 @@ func newBackendGroup(refs []graph.BackendRef, sourceNsName types.NamespacedName,
 	}
 
 	for _, ref := range refs {
-		backends = append(backends, Backend{
-			UpstreamName: ref.ServicePortReference(),
-			Weight:       ref.Weight,
-			Valid:        ref.Valid,
-			VerifyTLS:    convertBackendTLS(ref.BackendTLSPolicy),
-		})
+		backends = append(backends, convertBackendRef(ref))
 	}
 
 	return BackendGroup{
@@ -180,6 +326,21 @@ func newBackendGroup(refs []graph.BackendRef, sourceNsName types.NamespacedName,
 	}
 }
 
+func convertBackendRef(ref graph.BackendRef) Backend {
+	var filters *HTTPFilters
+	if len(ref.Filters) > 0 {
+		filters = helpers.GetPointer(createHTTPFilters(ref.Filters))
+	}
+
+	return Backend{
+		UpstreamName: ref.ServicePortReference(),
+		Weight:       ref.Weight,
+		Valid:        ref.Valid,
+		VerifyTLS:    convertBackendTLS(ref.BackendTLSPolicy),
+		Filters:      filters,
+	}
+}
+
 func convertBackendTLS(btp *graph.BackendTLSPolicy) *VerifyTLS {
 	if btp == nil || !btp.Valid {
 		return nil
@@ -201,6 +362,10 @@ func buildServers(listeners []*graph.Listener) (http, ssl []VirtualServer) {
 	}
 
 	for _, l := range listeners {
+		if _, ok := rulesForProtocol[l.Source.Protocol]; !ok {
+			continue
+		}
+
 		if l.Valid {
 			rules := rulesForProtocol[l.Source.Protocol][l.Source.Port]
 			if rules == nil {
@@ -218,6 +383,366 @@ func buildServers(listeners []*graph.Listener) (http, ssl []VirtualServer) {
 	return httpRules.buildServers(), sslRules.buildServers()
 }
 
+// addGatewayPoliciesToServers adds the settings of the Gateway's effective policies to the servers.
+// The access control settings of a server are only added if the server doesn't have the access control settings of
+// its listener.
+// The default servers are skipped, because they only reject requests. Only the access log settings apply to them,
+// so that the rejected requests are logged too.
+func addGatewayPoliciesToServers(servers []VirtualServer, effectivePolicies []policies.Policy) {
+	settings := convertClientSettings(effectivePolicies)
+	rateLimit := convertRateLimit(effectivePolicies)
+	retry := convertRetry(effectivePolicies)
+	accessControl := convertAccessControl(effectivePolicies)
+	compression := convertCompression(effectivePolicies)
+	accessLog := convertAccessLog(effectivePolicies)
+
+	for i := range servers {
+		servers[i].AccessLog = accessLog
+
+		if servers[i].IsDefault {
+			continue
+		}
+
+		servers[i].ClientSettings = settings
+		servers[i].RateLimit = rateLimit
+		servers[i].Retry = retry
+		if servers[i].AccessControl == nil {
+			servers[i].AccessControl = accessControl
+		}
+		servers[i].Compression = compression
+	}
+}
+
+// addGatewayErrorPagesToServers adds the error pages of the Gateway to the servers. Unlike the Gateway's effective
+// policies, the error pages also apply to the default servers, so that they replace the responses to the requests
+// that don't match any server.
+func addGatewayErrorPagesToServers(servers []VirtualServer, errorPages []graph.ErrorPage) {
+	converted := convertErrorPages(errorPages)
+
+	for i := range servers {
+		servers[i].ErrorPages = converted
+	}
+}
+
+// addUpstreamKeepAliveToBackends marks the Backends of the servers whose Upstreams keep the connections to their
+// servers alive, so that the requests are proxied to them in a way that allows reusing the connections.
+func addUpstreamKeepAliveToBackends(servers []VirtualServer, upstreams []Upstream) {
+	keepAliveUpstreams := make(map[string]struct{})
+	for _, u := range upstreams {
+		if u.Settings != nil && u.Settings.KeepAliveConnections != nil {
+			keepAliveUpstreams[u.Name] = struct{}{}
+		}
+	}
+
+	if len(keepAliveUpstreams) == 0 {
+		return
+	}
+
+	addToErrorPages := func(errorPages []ErrorPage) {
+		for _, page := range errorPages {
+			if page.Backend != nil {
+				_, page.Backend.KeepAlive = keepAliveUpstreams[page.Backend.UpstreamName]
+			}
+		}
+	}
+
+	for _, s := range servers {
+		addToErrorPages(s.ErrorPages)
+
+		for _, pr := range s.PathRules {
+			for _, mr := range pr.MatchRules {
+				addToErrorPages(mr.Policies.ErrorPages)
+
+				backends := mr.BackendGroup.Backends
+				for i := range backends {
+					_, backends[i].KeepAlive = keepAliveUpstreams[backends[i].UpstreamName]
+				}
+
+				if mirror := mr.Filters.RequestMirror; mirror != nil {
+					_, mirror.Backend.KeepAlive = keepAliveUpstreams[mirror.Backend.UpstreamName]
+				}
+
+				if auth := mr.Policies.ExternalAuth; auth != nil {
+					_, auth.Backend.KeepAlive = keepAliveUpstreams[auth.Backend.UpstreamName]
+				}
+			}
+		}
+	}
+}
+
+// buildRateLimitZones builds the unique RateLimitZones of the rate limits of the servers and their rules,
+// sorted by name.
+func buildRateLimitZones(servers []VirtualServer) []RateLimitZone {
+	uniqueZones := make(map[string]RateLimitZone)
+
+	addZone := func(rateLimit *RateLimit) {
+		if rateLimit != nil {
+			uniqueZones[rateLimit.Zone.Name] = rateLimit.Zone
+		}
+	}
+
+	for _, s := range servers {
+		addZone(s.RateLimit)
+
+		for _, pr := range s.PathRules {
+			for _, mr := range pr.MatchRules {
+				addZone(mr.Policies.RateLimit)
+			}
+		}
+	}
+
+	if len(uniqueZones) == 0 {
+		return nil
+	}
+
+	zones := make([]RateLimitZone, 0, len(uniqueZones))
+	for _, zone := range uniqueZones {
+		zones = append(zones, zone)
+	}
+
+	slices.SortFunc(zones, func(z1, z2 RateLimitZone) int {
+		return strings.Compare(z1.Name, z2.Name)
+	})
+
+	return zones
+}
+
+// buildCacheZones builds the unique CacheZones of the caches of the rules of the servers, sorted by name.
+func buildCacheZones(servers []VirtualServer) []CacheZone {
+	uniqueZones := make(map[string]CacheZone)
+
+	for _, s := range servers {
+		for _, pr := range s.PathRules {
+			for _, mr := range pr.MatchRules {
+				if mr.Policies.Cache != nil {
+					uniqueZones[mr.Policies.Cache.Zone.Name] = mr.Policies.Cache.Zone
+				}
+			}
+		}
+	}
+
+	if len(uniqueZones) == 0 {
+		return nil
+	}
+
+	zones := make([]CacheZone, 0, len(uniqueZones))
+	for _, zone := range uniqueZones {
+		zones = append(zones, zone)
+	}
+
+	slices.SortFunc(zones, func(z1, z2 CacheZone) int {
+		return strings.Compare(z1.Name, z2.Name)
+	})
+
+	return zones
+}
+
+// buildLogFormats builds the unique LogFormats of the access logs of the servers and their rules, sorted by name.
+func buildLogFormats(servers []VirtualServer) []LogFormat {
+	uniqueFormats := make(map[string]LogFormat)
+
+	addFormat := func(accessLog *AccessLog) {
+		if accessLog != nil && accessLog.Format != nil {
+			uniqueFormats[accessLog.Format.Name] = *accessLog.Format
+		}
+	}
+
+	for _, s := range servers {
+		addFormat(s.AccessLog)
+
+		for _, pr := range s.PathRules {
+			for _, mr := range pr.MatchRules {
+				addFormat(mr.Policies.AccessLog)
+			}
+		}
+	}
+
+	if len(uniqueFormats) == 0 {
+		return nil
+	}
+
+	formats := make([]LogFormat, 0, len(uniqueFormats))
+	for _, format := range uniqueFormats {
+		formats = append(formats, format)
+	}
+
+	slices.SortFunc(formats, func(f1, f2 LogFormat) int {
+		return strings.Compare(f1.Name, f2.Name)
+	})
+
+	return formats
+}
+
+// buildTelemetry builds the Telemetry from the NginxProxy referenced by the GatewayClass.
+// If the NginxProxy doesn't enable telemetry, an empty Telemetry is returned.
+func buildTelemetry(g *graph.Graph) Telemetry {
+	if !g.NginxProxy.TelemetryEnabled() {
+		return Telemetry{}
+	}
+
+	spec := g.NginxProxy.Source.Spec.Telemetry
+
+	// the service name is prefixed with the Gateway, so that the traces of different Gateways can be told apart
+	serviceName := fmt.Sprintf("ngf:%s:%s", g.Gateway.Source.Namespace, g.Gateway.Source.Name)
+	if spec.ServiceName != nil {
+		serviceName = fmt.Sprintf("%s:%s", serviceName, *spec.ServiceName)
+	}
+
+	telemetry := Telemetry{
+		Endpoint:    spec.Exporter.Endpoint,
+		ServiceName: serviceName,
+	}
+
+	if spec.Exporter.Interval != nil {
+		telemetry.Interval = string(*spec.Exporter.Interval)
+	}
+
+	if spec.Exporter.BatchSize != nil {
+		telemetry.BatchSize = *spec.Exporter.BatchSize
+	}
+
+	if spec.Exporter.BatchCount != nil {
+		telemetry.BatchCount = *spec.Exporter.BatchCount
+	}
+
+	if len(spec.SpanAttributes) > 0 {
+		telemetry.SpanAttributes = make([]SpanAttribute, 0, len(spec.SpanAttributes))
+		for _, attr := range spec.SpanAttributes {
+			telemetry.SpanAttributes = append(telemetry.SpanAttributes, SpanAttribute{Key: attr.Key, Value: attr.Value})
+		}
+	}
+
+	return telemetry
+}
+
+// addTelemetrySpanAttributesToServers adds the span attributes of the Telemetry to the tracing settings of the rules.
+// NGINX doesn't inherit the span attributes of the http context in a location that defines its own span attributes,
+// so they are merged here. The span attributes of a rule take precedence over the Telemetry ones with the same key.
+func addTelemetrySpanAttributesToServers(servers []VirtualServer, attrs []SpanAttribute) {
+	if len(attrs) == 0 {
+		return
+	}
+
+	for _, server := range servers {
+		for _, pathRule := range server.PathRules {
+			for i := range pathRule.MatchRules {
+				tracing := pathRule.MatchRules[i].Policies.Tracing
+				if tracing == nil || len(tracing.SpanAttributes) == 0 {
+					continue
+				}
+
+				// the Tracing is shared between the rules of a route, so it is copied instead of updated in place
+				merged := *tracing
+				merged.SpanAttributes = mergeSpanAttributes(attrs, tracing.SpanAttributes)
+				pathRule.MatchRules[i].Policies.Tracing = &merged
+			}
+		}
+	}
+}
+
+// mergeSpanAttributes merges the base and override span attributes. The override attributes replace
+// the base attributes with the same key.
+func mergeSpanAttributes(base, override []SpanAttribute) []SpanAttribute {
+	merged := make([]SpanAttribute, 0, len(base)+len(override))
+
+	for _, attr := range base {
+		overridden := slices.ContainsFunc(override, func(o SpanAttribute) bool {
+			return o.Key == attr.Key
+		})
+		if !overridden {
+			merged = append(merged, attr)
+		}
+	}
+
+	return append(merged, override...)
+}
+
+// buildLayer4Servers builds the layer 4 servers from the L4Routes attached to the listeners of the protocol.
+// For TLS listeners, a server is built for every hostname of a Route, because the connections are routed by SNI.
+// For TCP and UDP listeners, a single server is built for the listener, because the traffic can't be distinguished.
+// If multiple Routes claim the same hostname (TLS) or the same listener (TCP, UDP) on the same port, the Route that was
+// created first wins, according to the Gateway API conflict resolution guidelines.
+func buildLayer4Servers(listeners []*graph.Listener, protocol v1.ProtocolType) []Layer4VirtualServer {
+	type key struct {
+		hostname string
+		port     int32
+	}
+
+	type candidate struct {
+		source client.Object
+		server Layer4VirtualServer
+	}
+
+	candidates := make(map[key]candidate)
+
+	for _, l := range listeners {
+		if !l.Valid || l.Source.Protocol != protocol {
+			continue
+		}
+
+		port := int32(l.Source.Port)
+
+		for _, r := range l.L4Routes {
+			if !r.Valid {
+				continue
+			}
+
+			hostnames := []string{""}
+			if protocol == v1.TLSProtocolType {
+				hostnames = getAcceptedHostnames(r.ParentRefs, l)
+			}
+
+			for _, h := range hostnames {
+				k := key{hostname: h, port: port}
+
+				if prev, exists := candidates[k]; exists && !ngfsort.LessClientObject(r.Source, prev.source) {
+					continue
+				}
+
+				candidates[k] = candidate{
+					source: r.Source,
+					server: Layer4VirtualServer{
+						Hostname:     h,
+						BackendGroup: newBackendGroup(r.Spec.BackendRefs, client.ObjectKeyFromObject(r.Source), 0),
+						Port:         port,
+					},
+				}
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	servers := make([]Layer4VirtualServer, 0, len(candidates))
+	for _, c := range candidates {
+		servers = append(servers, c.server)
+	}
+
+	// We sort the servers so the order is preserved after reconfiguration.
+	sort.Slice(servers, func(i, j int) bool {
+		if servers[i].Port != servers[j].Port {
+			return servers[i].Port < servers[j].Port
+		}
+		return servers[i].Hostname < servers[j].Hostname
+	})
+
+	return servers
+}
+
+// getAcceptedHostnames returns the hostnames of the Route accepted by the listener.
+func getAcceptedHostnames(parentRefs []graph.ParentRef, listener *graph.Listener) []string {
+	var hostnames []string
+	for _, p := range parentRefs {
+		if val, exist := p.Attachment.AcceptedHostnames[string(listener.Source.Name)]; exist {
+			hostnames = val
+		}
+	}
+
+	return hostnames
+}
+
 // portPathRules keeps track of hostPathRules per port
 type portPathRules map[v1.PortNumber]*hostPathRules
 
@@ -274,13 +799,8 @@ func (hpr *hostPathRules) upsertListener(l *graph.Listener) {
 	}
 }
 
-func (hpr *hostPathRules) upsertRoute(route *graph.Route, listener *graph.Listener) {
-	var hostnames []string
-	for _, p := range route.ParentRefs {
-		if val, exist := p.Attachment.AcceptedHostnames[string(listener.Source.Name)]; exist {
-			hostnames = val
-		}
-	}
+func (hpr *hostPathRules) upsertRoute(route *graph.L7Route, listener *graph.Listener) {
+	hostnames := getAcceptedHostnames(route.ParentRefs, listener)
 
 	for _, h := range hostnames {
 		if prevListener, exists := hpr.listenersForHost[h]; exists {
@@ -297,14 +817,35 @@ func (hpr *hostPathRules) upsertRoute(route *graph.Route, listener *graph.Listen
 		}
 	}
 
-	for i, rule := range route.Source.Spec.Rules {
-		if !route.Rules[i].ValidMatches {
+	var objectSrc *metav1.ObjectMeta
+
+	switch route.RouteType {
+	case graph.RouteTypeHTTP:
+		objectSrc = &helpers.MustCastObject[*v1.HTTPRoute](route.Source).ObjectMeta
+	case graph.RouteTypeGRPC:
+		objectSrc = &helpers.MustCastObject[*v1alpha2.GRPCRoute](route.Source).ObjectMeta
+	default:
+		panic(fmt.Sprintf("unknown route type %s", route.RouteType))
+	}
+
+	routeNsName := client.ObjectKeyFromObject(route.Source)
+	rulePolicies := convertRulePolicies(route)
+
+	for i, rule := range route.Spec.Rules {
+		if !rule.ValidMatches {
 			continue
 		}
 
 		var filters HTTPFilters
-		if route.Rules[i].ValidFilters {
+		var timeouts *HTTPTimeouts
+		if rule.ValidFilters {
+			timeouts = convertHTTPTimeouts(rule.Timeouts)
 			filters = createHTTPFilters(rule.Filters)
+			if rule.MirrorBackendRef != nil {
+				filters.RequestMirror = &HTTPRequestMirrorFilter{
+					Backend: convertBackendRef(*rule.MirrorBackendRef),
+				}
+			}
 		} else {
 			filters = HTTPFilters{
 				InvalidFilter: &InvalidHTTPFilter{},
@@ -320,30 +861,49 @@ func (hpr *hostPathRules) upsertRoute(route *graph.Route, listener *graph.Listen
 					pathType: *m.Path.Type,
 				}
 
-				rule, exist := hpr.rulesPerHost[h][key]
+				hostRule, exist := hpr.rulesPerHost[h][key]
 				if !exist {
-					rule.Path = path
-					rule.PathType = convertPathType(*m.Path.Type)
+					hostRule.Path = path
+					hostRule.PathType = convertPathType(*m.Path.Type)
 				}
 
-				// create iteration variable inside the loop to fix implicit memory aliasing
-				om := route.Source.ObjectMeta
-
-				routeNsName := client.ObjectKeyFromObject(route.Source)
+				if route.RouteType == graph.RouteTypeGRPC {
+					hostRule.GRPC = true
+				}
 
-				rule.MatchRules = append(rule.MatchRules, MatchRule{
-					Source:       &om,
-					BackendGroup: newBackendGroup(route.Rules[i].BackendRefs, routeNsName, i),
+				hostRule.MatchRules = append(hostRule.MatchRules, MatchRule{
+					Source:       objectSrc,
+					BackendGroup: newBackendGroup(rule.BackendRefs, routeNsName, i),
 					Filters:      filters,
 					Match:        convertMatch(m),
+					Timeouts:     timeouts,
+					Policies:     rulePolicies,
 				})
 
-				hpr.rulesPerHost[h][key] = rule
+				hpr.rulesPerHost[h][key] = hostRule
 			}
 		}
 	}
 }
 
+// convertRulePolicies converts the effective Policies of a route into the Policy settings of its rules.
+func convertRulePolicies(route *graph.L7Route) RulePolicies {
+	return RulePolicies{
+		ClientSettings: convertClientSettings(route.EffectivePolicies),
+		Tracing:        convertTracing(route.EffectivePolicies),
+		RateLimit:      convertRateLimit(route.EffectivePolicies),
+		Retry:          convertRetry(route.EffectivePolicies),
+		BasicAuth:      convertBasicAuth(route.EffectivePolicies),
+		ExternalAuth:   convertExternalAuth(route.EffectivePolicies, route.ExternalAuthBackendRef),
+		AccessControl:  convertAccessControl(route.EffectivePolicies),
+		CORS:           convertCORS(route.EffectivePolicies),
+		Cache:          convertCache(route.EffectivePolicies),
+		Compression:    convertCompression(route.EffectivePolicies),
+		AccessLog:      convertAccessLog(route.EffectivePolicies),
+		ErrorPages:     convertErrorPages(route.ErrorPages),
+	}
+}
+
 func (hpr *hostPathRules) buildServers() []VirtualServer {
 	servers := make([]VirtualServer, 0, len(hpr.rulesPerHost)+len(hpr.httpsListeners))
 
@@ -359,6 +919,8 @@ func (hpr *hostPathRules) buildServers() []VirtualServer {
 			panic(fmt.Sprintf("no listener found for hostname: %s", h))
 		}
 
+		s.AccessControl = convertAccessControl(l.EffectivePolicies)
+
 		if l.ResolvedSecret != nil {
 			s.SSL = &SSL{
 				KeyPairID: generateSSLKeyPairID(*l.ResolvedSecret),
@@ -389,8 +951,9 @@ func (hpr *hostPathRules) buildServers() []VirtualServer {
 		// This server overrides the default ssl server.
 		if len(l.Routes) == 0 || hostname == wildcardHostname {
 			s := VirtualServer{
-				Hostname: hostname,
-				Port:     hpr.port,
+				Hostname:      hostname,
+				AccessControl: convertAccessControl(l.EffectivePolicies),
+				Port:          hpr.port,
 			}
 
 			if l.ResolvedSecret != nil {
@@ -428,15 +991,47 @@ func (hpr *hostPathRules) maxServerCount() int {
 	return len(hpr.rulesPerHost) + len(hpr.httpsListeners) + 1
 }
 
+// buildUpstreams builds the Upstreams of the valid BackendRefs of the HTTP and GRPC Routes.
+// The settings of an Upstream come from the effective UpstreamSettingsPolicy of its Service.
 func buildUpstreams(
 	ctx context.Context,
 	listeners []*graph.Listener,
+	gatewayErrorPages []graph.ErrorPage,
+	referencedServices map[types.NamespacedName]*graph.ReferencedService,
 	resolver resolver.ServiceResolver,
 ) []Upstream {
 	// There can be duplicate upstreams if multiple routes reference the same upstream.
 	// We use a map to deduplicate them.
 	uniqueUpstreams := make(map[string]Upstream)
 
+	addUpstream := func(br graph.BackendRef) {
+		if !br.Valid {
+			return
+		}
+
+		upstreamName := br.ServicePortReference()
+		if _, exist := uniqueUpstreams[upstreamName]; exist {
+			return
+		}
+
+		upstream := buildUpstream(ctx, br, resolver)
+		if svc, exists := referencedServices[br.SvcNsName]; exists {
+			upstream.Settings = convertUpstreamSettings(svc.EffectivePolicies)
+		}
+
+		uniqueUpstreams[upstreamName] = upstream
+	}
+
+	addErrorPageUpstreams := func(errorPages []graph.ErrorPage) {
+		for _, page := range errorPages {
+			if page.BackendRef != nil {
+				addUpstream(*page.BackendRef)
+			}
+		}
+	}
+
+	addErrorPageUpstreams(gatewayErrorPages)
+
 	for _, l := range listeners {
 
 		if !l.Valid {
@@ -448,34 +1043,71 @@ func buildUpstreams(
 				continue
 			}
 
-			for _, rule := range route.Rules {
+			for _, rule := range route.Spec.Rules {
 				if !rule.ValidMatches || !rule.ValidFilters {
 					// don't generate upstreams for rules that have invalid matches or filters
 					continue
 				}
+
 				for _, br := range rule.BackendRefs {
-					if br.Valid {
-						upstreamName := br.ServicePortReference()
-						_, exist := uniqueUpstreams[upstreamName]
-
-						if exist {
-							continue
-						}
-
-						var errMsg string
-
-						eps, err := resolver.Resolve(ctx, br.SvcNsName, br.ServicePort)
-						if err != nil {
-							errMsg = err.Error()
-						}
-
-						uniqueUpstreams[upstreamName] = Upstream{
-							Name:      upstreamName,
-							Endpoints: eps,
-							ErrorMsg:  errMsg,
-						}
-					}
+					addUpstream(br)
+				}
+
+				if rule.MirrorBackendRef != nil {
+					addUpstream(*rule.MirrorBackendRef)
+				}
+			}
+
+			if route.ExternalAuthBackendRef != nil {
+				addUpstream(*route.ExternalAuthBackendRef)
+			}
+
+			addErrorPageUpstreams(route.ErrorPages)
+		}
+	}
+
+	if len(uniqueUpstreams) == 0 {
+		return nil
+	}
+
+	upstreams := make([]Upstream, 0, len(uniqueUpstreams))
+
+	for _, up := range uniqueUpstreams {
+		upstreams = append(upstreams, up)
+	}
+	return upstreams
+}
+
+func buildStreamUpstreams(
+	ctx context.Context,
+	listeners []*graph.Listener,
+	resolver resolver.ServiceResolver,
+) []Upstream {
+	// There can be duplicate upstreams if multiple routes reference the same upstream.
+	// We use a map to deduplicate them.
+	uniqueUpstreams := make(map[string]Upstream)
+
+	for _, l := range listeners {
+		if !l.Valid {
+			continue
+		}
+
+		for _, route := range l.L4Routes {
+			if !route.Valid {
+				continue
+			}
+
+			for _, br := range route.Spec.BackendRefs {
+				if !br.Valid {
+					continue
+				}
+
+				upstreamName := br.ServicePortReference()
+				if _, exist := uniqueUpstreams[upstreamName]; exist {
+					continue
 				}
+
+				uniqueUpstreams[upstreamName] = buildUpstream(ctx, br, resolver)
 			}
 		}
 	}
@@ -492,6 +1124,21 @@ func buildUpstreams(
 	return upstreams
 }
 
+func buildUpstream(ctx context.Context, br graph.BackendRef, resolver resolver.ServiceResolver) Upstream {
+	var errMsg string
+
+	eps, err := resolver.Resolve(ctx, br.SvcNsName, br.ServicePort)
+	if err != nil {
+		errMsg = err.Error()
+	}
+
+	return Upstream{
+		Name:      br.ServicePortReference(),
+		Endpoints: eps,
+		ErrorMsg:  errMsg,
+	}
+}
+
 func getListenerHostname(h *v1.Hostname) string {
 	if h == nil || *h == "" {
 		return wildcardHostname
@@ -527,6 +1174,11 @@ func createHTTPFilters(filters []v1.HTTPRouteFilter) HTTPFilters {
 				// using the first filter
 				result.RequestHeaderModifiers = convertHTTPHeaderFilter(f.RequestHeaderModifier)
 			}
+		case v1.HTTPRouteFilterResponseHeaderModifier:
+			if result.ResponseHeaderModifiers == nil {
+				// using the first filter
+				result.ResponseHeaderModifiers = convertHTTPHeaderFilter(f.ResponseHeaderModifier)
+			}
 		}
 	}
 	return result
@@ -553,6 +1205,20 @@ func generateSSLKeyPairID(secret types.NamespacedName) SSLKeyPairID {
 	return SSLKeyPairID(fmt.Sprintf("ssl_keypair_%s_%s", secret.Namespace, secret.Name))
 }
 
+// generateErrorPageFileID generates an ID for the file with the content of an error page. The ID is derived from
+// the content, so that the error pages with the same content share the file.
+func generateErrorPageFileID(content []byte) ErrorPageFileID {
+	return ErrorPageFileID(fmt.Sprintf("error_page_%x", sha256.Sum256(content)))
+}
+
+// generateAuthUserFileID generates an ID for the file with the users and passwords of the basic authentication
+// based on the Secret namespaced name.
+// It is guaranteed to be unique per unique namespaced name.
+// The ID is safe to use as a file name.
+func generateAuthUserFileID(secret types.NamespacedName) AuthUserFileID {
+	return AuthUserFileID(fmt.Sprintf("auth_user_file_%s_%s", secret.Namespace, secret.Name))
+}
+
 // generateCertBundleID generates an ID for the certificate bundle based on the ConfigMap namespaced name.
 // It is guaranteed to be unique per unique namespaced name.
 // The ID is safe to use as a file name.
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	apiv1 "k8s.io/api/core/v1"
@@ -14,7 +15,9 @@ import (
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 	v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver/resolverfakes"
@@ -63,9 +66,9 @@ func TestBuildConfiguration(t *testing.T) {
 		}
 	}
 
-	addFilters := func(hr *v1.HTTPRoute, filters []v1.HTTPRouteFilter) {
-		for i := range hr.Spec.Rules {
-			hr.Spec.Rules[i].Filters = filters
+	addFilters := func(r *graph.L7Route, filters []v1.HTTPRouteFilter) {
+		for i := range r.Spec.Rules {
+			r.Spec.Rules[i].Filters = filters
 		}
 	}
 
@@ -107,15 +110,17 @@ func TestBuildConfiguration(t *testing.T) {
 		return []graph.BackendRef{validBackendRef}
 	}
 
-	createRules := func(hr *v1.HTTPRoute, paths []pathAndType) []graph.Rule {
-		rules := make([]graph.Rule, len(hr.Spec.Rules))
+	createRules := func(hr *v1.HTTPRoute, paths []pathAndType) []graph.RouteRule {
+		rules := make([]graph.RouteRule, len(hr.Spec.Rules))
 
 		for i := range paths {
 			validMatches := paths[i].path != invalidMatchesPath
 			validFilters := paths[i].path != invalidFiltersPath
 			validRule := validMatches && validFilters
 
-			rules[i] = graph.Rule{
+			rules[i] = graph.RouteRule{
+				Matches:      hr.Spec.Rules[i].Matches,
+				Filters:      hr.Spec.Rules[i].Filters,
 				ValidMatches: validMatches,
 				ValidFilters: validFilters,
 				BackendRefs:  createBackendRefs(validRule),
@@ -129,15 +134,18 @@ func TestBuildConfiguration(t *testing.T) {
 		source *v1.HTTPRoute,
 		listenerName string,
 		paths []pathAndType,
-	) *graph.Route {
+	) *graph.L7Route {
 		hostnames := make([]string, 0, len(source.Spec.Hostnames))
 		for _, h := range source.Spec.Hostnames {
 			hostnames = append(hostnames, string(h))
 		}
-		r := &graph.Route{
-			Source: source,
-			Rules:  createRules(source, paths),
-			Valid:  true,
+		r := &graph.L7Route{
+			RouteType: graph.RouteTypeHTTP,
+			Source:    source,
+			Spec: graph.L7RouteSpec{
+				Rules: createRules(source, paths),
+			},
+			Valid: true,
 			ParentRefs: []graph.ParentRef{
 				{
 					Attachment: &graph.ParentRefAttachmentStatus{
@@ -151,10 +159,10 @@ func TestBuildConfiguration(t *testing.T) {
 		return r
 	}
 
-	createExpBackendGroupsForRoute := func(route *graph.Route) []BackendGroup {
+	createExpBackendGroupsForRoute := func(route *graph.L7Route) []BackendGroup {
 		groups := make([]BackendGroup, 0)
 
-		for idx, r := range route.Rules {
+		for idx, r := range route.Spec.Rules {
 			var backends []Backend
 			if r.ValidFilters && r.ValidMatches {
 				backends = []Backend{expValidBackend}
@@ -171,7 +179,7 @@ func TestBuildConfiguration(t *testing.T) {
 	}
 
 	createTestResources := func(name, hostname, listenerName string, paths ...pathAndType) (
-		*v1.HTTPRoute, []BackendGroup, *graph.Route,
+		*v1.HTTPRoute, []BackendGroup, *graph.L7Route,
 	) {
 		hr := createRoute(name, hostname, listenerName, paths...)
 		route := createInternalRoute(hr, listenerName, paths)
@@ -229,7 +237,7 @@ func TestBuildConfiguration(t *testing.T) {
 			Hostname: (*v1.PreciseHostname)(helpers.GetPointer("foo.example.com")),
 		},
 	}
-	addFilters(hr5, []v1.HTTPRouteFilter{redirect})
+	addFilters(routeHR5, []v1.HTTPRouteFilter{redirect})
 	expRedirect := HTTPRequestRedirectFilter{
 		Hostname: helpers.GetPointer("foo.example.com"),
 	}
@@ -321,7 +329,7 @@ func TestBuildConfiguration(t *testing.T) {
 		pathAndType{path: "/", pathType: prefix}, pathAndType{path: "/", pathType: prefix},
 	)
 
-	httpsRouteHR8.Rules[0].BackendRefs[0].BackendTLSPolicy = &graph.BackendTLSPolicy{
+	httpsRouteHR8.Spec.Rules[0].BackendRefs[0].BackendTLSPolicy = &graph.BackendTLSPolicy{
 		Source: &v1alpha2.BackendTLSPolicy{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "btp",
@@ -357,6 +365,247 @@ func TestBuildConfiguration(t *testing.T) {
 		Hostname:     "foo.example.com",
 	}
 
+	hrMirror, expHRMirrorGroups, routeHRMirror := createTestResources(
+		"hr-mirror",
+		"foo.example.com",
+		"listener-80-1",
+		pathAndType{path: "/", pathType: prefix},
+	)
+
+	mirror := v1.HTTPRouteFilter{
+		Type: v1.HTTPRouteFilterRequestMirror,
+		RequestMirror: &v1.HTTPRequestMirrorFilter{
+			BackendRef: v1.BackendObjectReference{
+				Name: "mirror",
+				Port: helpers.GetPointer[v1.PortNumber](80),
+			},
+		},
+	}
+	addFilters(routeHRMirror, []v1.HTTPRouteFilter{mirror})
+	routeHRMirror.Spec.Rules[0].MirrorBackendRef = &graph.BackendRef{
+		SvcNsName:        types.NamespacedName{Name: "mirror", Namespace: "test"},
+		ServicePort:      apiv1.ServicePort{Port: 80},
+		BackendTLSPolicy: httpsRouteHR8.Spec.Rules[0].BackendRefs[0].BackendTLSPolicy,
+		Valid:            true,
+		Weight:           1,
+	}
+	hrTimeouts, expHRTimeoutsGroups, routeHRTimeouts := createTestResources(
+		"hr-timeouts",
+		"foo.example.com",
+		"listener-80-1",
+		pathAndType{path: "/", pathType: prefix},
+	)
+	routeHRTimeouts.Spec.Rules[0].Timeouts = &v1.HTTPRouteTimeouts{
+		Request:        helpers.GetPointer[v1.Duration]("10s"),
+		BackendRequest: helpers.GetPointer[v1.Duration]("500ms"),
+	}
+
+	hrErrorPages, expHRErrorPagesGroups, routeHRErrorPages := createTestResources(
+		"hr-error-pages",
+		"foo.example.com",
+		"listener-80-1",
+		pathAndType{path: "/", pathType: prefix},
+	)
+	routeHRErrorPages.ErrorPages = []graph.ErrorPage{
+		{
+			BackendRef: &graph.BackendRef{
+				SvcNsName:   types.NamespacedName{Namespace: "test", Name: "foo"},
+				ServicePort: apiv1.ServicePort{Port: 80},
+				Weight:      1,
+				Valid:       true,
+			},
+			Codes: []int32{500, 502},
+		},
+	}
+
+	gwErrorPages := []graph.ErrorPage{
+		{
+			ResponseCode: helpers.GetPointer[int32](200),
+			ContentType:  "application/json",
+			Content:      []byte(`{"error":"not found"}`),
+			Codes:        []int32{404},
+		},
+		{
+			BackendRef: &graph.BackendRef{SvcNsName: types.NamespacedName{Namespace: "test", Name: "unresolved"}},
+			Codes:      []int32{503},
+		},
+	}
+	errorPageFileID := generateErrorPageFileID([]byte(`{"error":"not found"}`))
+	expGwErrorPages := []ErrorPage{
+		{
+			ResponseCode: helpers.GetPointer[int32](200),
+			FileID:       errorPageFileID,
+			ContentType:  "application/json",
+			Codes:        []int32{404},
+		},
+	}
+
+	hrClientSettings, expHRClientSettingsGroups, routeHRClientSettings := createTestResources(
+		"hr-client-settings",
+		"foo.example.com",
+		"listener-80-1",
+		pathAndType{path: "/", pathType: prefix},
+	)
+	routeHRClientSettings.EffectivePolicies = []policies.Policy{
+		&ngfAPI.ClientSettingsPolicy{
+			Spec: ngfAPI.ClientSettingsPolicySpec{
+				Body: &ngfAPI.ClientBody{
+					MaxSize: helpers.GetPointer[ngfAPI.Size]("10m"),
+				},
+			},
+		},
+		&ngfAPI.ObservabilityPolicy{
+			Spec: ngfAPI.ObservabilityPolicySpec{
+				Tracing: &ngfAPI.Tracing{
+					Strategy: ngfAPI.TraceStrategyParent,
+				},
+			},
+		},
+		&ngfAPI.RateLimitPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "route-limit"},
+			Spec: ngfAPI.RateLimitPolicySpec{
+				Rate:  "1r/s",
+				Burst: helpers.GetPointer[int32](5),
+			},
+		},
+		&ngfAPI.RetryPolicy{
+			Spec: ngfAPI.RetryPolicySpec{
+				Attempts: helpers.GetPointer[int32](3),
+				RetryOn: &ngfAPI.RetryOn{
+					StatusCodes: []ngfAPI.RetryStatusCode{502},
+				},
+			},
+		},
+		&ngfAPI.BasicAuthPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "route-auth"},
+			Spec: ngfAPI.BasicAuthPolicySpec{
+				SecretRef: v1.SecretObjectReference{
+					Name: "htpasswd",
+				},
+			},
+		},
+		&ngfAPI.AccessControlPolicy{
+			Spec: ngfAPI.AccessControlPolicySpec{
+				Rules: []ngfAPI.AccessControlRule{
+					{Action: ngfAPI.AccessControlActionAllow, CIDR: "10.8.0.0/16"},
+				},
+				DefaultAction: helpers.GetPointer(ngfAPI.AccessControlActionDeny),
+			},
+		},
+		&ngfAPI.CORSPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "route-cors"},
+			Spec: ngfAPI.CORSPolicySpec{
+				AllowOrigins: []ngfAPI.CORSOrigin{"https://example.com"},
+			},
+		},
+		&ngfAPI.CachePolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "route-cache"},
+			Spec: ngfAPI.CachePolicySpec{
+				Valid: []ngfAPI.CacheValid{{Time: "60s"}},
+			},
+		},
+		&ngfAPI.CompressionPolicy{
+			Spec: ngfAPI.CompressionPolicySpec{
+				MinLength: helpers.GetPointer[int32](1024),
+				Level:     helpers.GetPointer[int32](9),
+				Types:     []ngfAPI.MIMEType{"application/json"},
+			},
+		},
+		&ngfAPI.AccessLogPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "route-logs"},
+			Spec: ngfAPI.AccessLogPolicySpec{
+				Format: &ngfAPI.AccessLogFormat{
+					RouteInfo: helpers.GetPointer(true),
+				},
+			},
+		},
+	}
+
+	htpasswdSecret := &graph.Secret{
+		Source: &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "htpasswd"},
+			Data:       map[string][]byte{graph.HtpasswdSecretKey: []byte("user:password")},
+			Type:       graph.SecretTypeHtpasswd,
+		},
+	}
+
+	authUserFileID := generateAuthUserFileID(types.NamespacedName{Namespace: "test", Name: "htpasswd"})
+
+	gwEffectivePolicies := []policies.Policy{
+		&ngfAPI.ClientSettingsPolicy{
+			Spec: ngfAPI.ClientSettingsPolicySpec{
+				KeepAlive: &ngfAPI.ClientKeepAlive{
+					Requests: helpers.GetPointer[int32](100),
+				},
+			},
+		},
+		&ngfAPI.RateLimitPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway-limit"},
+			Spec: ngfAPI.RateLimitPolicySpec{
+				Rate: "10r/s",
+			},
+		},
+		&ngfAPI.RetryPolicy{
+			Spec: ngfAPI.RetryPolicySpec{
+				TotalTimeout: helpers.GetPointer[ngfAPI.Duration]("10s"),
+			},
+		},
+		&ngfAPI.AccessControlPolicy{
+			Spec: ngfAPI.AccessControlPolicySpec{
+				Rules: []ngfAPI.AccessControlRule{
+					{Action: ngfAPI.AccessControlActionDeny, CIDR: "192.168.0.0/16"},
+				},
+			},
+		},
+		&ngfAPI.CompressionPolicy{
+			Spec: ngfAPI.CompressionPolicySpec{
+				Types: []ngfAPI.MIMEType{"application/json"},
+			},
+		},
+		&ngfAPI.AccessLogPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway-logs"},
+			Spec: ngfAPI.AccessLogPolicySpec{
+				Destination: &ngfAPI.AccessLogDestination{
+					Type:   ngfAPI.AccessLogDestinationSyslog,
+					Syslog: &ngfAPI.SyslogDestination{Server: "10.0.0.1:1514"},
+				},
+			},
+		},
+	}
+
+	routeAccessLog := &AccessLog{
+		Format: &LogFormat{Name: "test_route-logs", RouteInfo: true},
+	}
+
+	gwAccessLog := &AccessLog{
+		Format: &LogFormat{Name: "test_gateway-logs"},
+		Syslog: &SyslogServer{Address: "10.0.0.1:1514"},
+	}
+
+	routeRateLimitZone := RateLimitZone{
+		Name:    "test_route-limit",
+		KeyType: RateLimitKeyClientIP,
+		Rate:    "1r/s",
+	}
+
+	gwRateLimitZone := RateLimitZone{
+		Name:    "test_gateway-limit",
+		KeyType: RateLimitKeyClientIP,
+		Rate:    "10r/s",
+	}
+
+	expMirror := HTTPRequestMirrorFilter{
+		Backend: Backend{
+			UpstreamName: "test_mirror_80",
+			Weight:       1,
+			Valid:        true,
+			VerifyTLS: &VerifyTLS{
+				CertBundleID: generateCertBundleID(types.NamespacedName{Namespace: "test", Name: "configmap-1"}),
+				Hostname:     "foo.example.com",
+			},
+		},
+	}
+
 	httpsHR9, expHTTPSHR9Groups, httpsRouteHR9 := createTestResources(
 		"https-hr-9",
 		"foo.example.com",
@@ -364,7 +613,7 @@ func TestBuildConfiguration(t *testing.T) {
 		pathAndType{path: "/", pathType: prefix}, pathAndType{path: "/", pathType: prefix},
 	)
 
-	httpsRouteHR9.Rules[0].BackendRefs[0].BackendTLSPolicy = &graph.BackendTLSPolicy{
+	httpsRouteHR9.Spec.Rules[0].BackendRefs[0].BackendTLSPolicy = &graph.BackendTLSPolicy{
 		Source: &v1alpha2.BackendTLSPolicy{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "btp2",
@@ -400,6 +649,54 @@ func TestBuildConfiguration(t *testing.T) {
 		Hostname:     "foo.example.com",
 	}
 
+	gr := &v1alpha2.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "gr",
+		},
+	}
+
+	routeGR := &graph.L7Route{
+		RouteType: graph.RouteTypeGRPC,
+		Source:    gr,
+		Valid:     true,
+		Spec: graph.L7RouteSpec{
+			Hostnames: []v1.Hostname{"foo.example.com"},
+			Rules: []graph.RouteRule{
+				{
+					Matches: []v1.HTTPRouteMatch{
+						{
+							Path: &v1.HTTPPathMatch{
+								Type:  helpers.GetPointer(v1.PathMatchExact),
+								Value: helpers.GetPointer("/helloworld.Greeter/SayHello"),
+							},
+						},
+					},
+					ValidMatches: true,
+					ValidFilters: true,
+					BackendRefs:  []graph.BackendRef{validBackendRef},
+				},
+			},
+		},
+		ParentRefs: []graph.ParentRef{
+			{
+				Attachment: &graph.ParentRefAttachmentStatus{
+					AcceptedHostnames: map[string][]string{
+						"listener-80-1": {"foo.example.com"},
+					},
+				},
+			},
+		},
+	}
+
+	expGRGroups := []BackendGroup{
+		{
+			Backends: []Backend{expValidBackend},
+			Source:   client.ObjectKeyFromObject(gr),
+			RuleIdx:  0,
+		},
+	}
+
 	secret1NsName := types.NamespacedName{Namespace: "test", Name: "secret-1"}
 	secret1 := &graph.Secret{
 		Source: &apiv1.Secret{
@@ -554,7 +851,7 @@ func TestBuildConfiguration(t *testing.T) {
 					Source:    &v1.Gateway{},
 					Listeners: []*graph.Listener{},
 				},
-				Routes: map[types.NamespacedName]*graph.Route{},
+				Routes: map[graph.RouteKey]*graph.L7Route{},
 			},
 			expConf: Configuration{
 				HTTPServers: []VirtualServer{},
@@ -577,11 +874,11 @@ func TestBuildConfiguration(t *testing.T) {
 							Name:   "listener-80-1",
 							Source: listener80,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{},
+							Routes: map[graph.RouteKey]*graph.L7Route{},
 						},
 					},
 				},
-				Routes: map[types.NamespacedName]*graph.Route{},
+				Routes: map[graph.RouteKey]*graph.L7Route{},
 			},
 			expConf: Configuration{
 				HTTPServers: []VirtualServer{
@@ -609,23 +906,23 @@ func TestBuildConfiguration(t *testing.T) {
 							Name:   "listener-80-1",
 							Source: listener80,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								client.ObjectKeyFromObject(hr1Invalid): routeHR1Invalid,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(hr1Invalid): routeHR1Invalid,
 							},
 						},
 						{
 							Name:   "listener-443-1",
 							Source: listener443, // nil hostname
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								client.ObjectKeyFromObject(httpsHR1Invalid): httpsRouteHR1Invalid,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(httpsHR1Invalid): httpsRouteHR1Invalid,
 							},
 							ResolvedSecret: &secret1NsName,
 						},
 					},
 				},
-				Routes: map[types.NamespacedName]*graph.Route{
-					client.ObjectKeyFromObject(hr1Invalid): routeHR1Invalid,
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(hr1Invalid): routeHR1Invalid,
 				},
 				ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
 					secret1NsName: secret1,
@@ -672,19 +969,19 @@ func TestBuildConfiguration(t *testing.T) {
 							Name:           "listener-443-1",
 							Source:         listener443, // nil hostname
 							Valid:          true,
-							Routes:         map[types.NamespacedName]*graph.Route{},
+							Routes:         map[graph.RouteKey]*graph.L7Route{},
 							ResolvedSecret: &secret1NsName,
 						},
 						{
 							Name:           "listener-443-with-hostname",
 							Source:         listener443WithHostname, // non-nil hostname
 							Valid:          true,
-							Routes:         map[types.NamespacedName]*graph.Route{},
+							Routes:         map[graph.RouteKey]*graph.L7Route{},
 							ResolvedSecret: &secret2NsName,
 						},
 					},
 				},
-				Routes: map[types.NamespacedName]*graph.Route{},
+				Routes: map[graph.RouteKey]*graph.L7Route{},
 				ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
 					secret1NsName: secret1,
 					secret2NsName: secret2,
@@ -739,9 +1036,9 @@ func TestBuildConfiguration(t *testing.T) {
 						},
 					},
 				},
-				Routes: map[types.NamespacedName]*graph.Route{
-					{Namespace: "test", Name: "https-hr-1"}: httpsRouteHR1,
-					{Namespace: "test", Name: "https-hr-2"}: httpsRouteHR2,
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(httpsRouteHR1.Source): httpsRouteHR1,
+					graph.CreateRouteKey(httpsRouteHR2.Source): httpsRouteHR2,
 				},
 				ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
 					secret1NsName: secret1,
@@ -768,16 +1065,16 @@ func TestBuildConfiguration(t *testing.T) {
 							Name:   "listener-80-1",
 							Source: listener80,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								{Namespace: "test", Name: "hr-1"}: routeHR1,
-								{Namespace: "test", Name: "hr-2"}: routeHR2,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(routeHR1.Source): routeHR1,
+								graph.CreateRouteKey(routeHR2.Source): routeHR2,
 							},
 						},
 					},
 				},
-				Routes: map[types.NamespacedName]*graph.Route{
-					{Namespace: "test", Name: "hr-1"}: routeHR1,
-					{Namespace: "test", Name: "hr-2"}: routeHR2,
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(routeHR1.Source): routeHR1,
+					graph.CreateRouteKey(routeHR2.Source): routeHR2,
 				},
 			},
 			expConf: Configuration{
@@ -827,6 +1124,61 @@ func TestBuildConfiguration(t *testing.T) {
 			},
 			msg: "one http listener with two routes for different hostnames",
 		},
+		{
+			graph: &graph.Graph{
+				GatewayClass: &graph.GatewayClass{
+					Source: &v1.GatewayClass{},
+					Valid:  true,
+				},
+				Gateway: &graph.Gateway{
+					Source: &v1.Gateway{},
+					Listeners: []*graph.Listener{
+						{
+							Name:   "listener-80-1",
+							Source: listener80,
+							Valid:  true,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(gr): routeGR,
+							},
+						},
+					},
+				},
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(gr): routeGR,
+				},
+			},
+			expConf: Configuration{
+				HTTPServers: []VirtualServer{
+					{
+						IsDefault: true,
+						Port:      80,
+					},
+					{
+						Hostname: "foo.example.com",
+						PathRules: []PathRule{
+							{
+								Path:     "/helloworld.Greeter/SayHello",
+								PathType: PathTypeExact,
+								MatchRules: []MatchRule{
+									{
+										BackendGroup: expGRGroups[0],
+										Source:       &gr.ObjectMeta,
+									},
+								},
+								GRPC: true,
+							},
+						},
+						Port: 80,
+					},
+				},
+				SSLServers:    []VirtualServer{},
+				Upstreams:     []Upstream{fooUpstream},
+				BackendGroups: []BackendGroup{expGRGroups[0]},
+				SSLKeyPairs:   map[SSLKeyPairID]SSLKeyPair{},
+				CertBundles:   map[CertBundleID]CertBundle{},
+			},
+			msg: "one http listener with a grpc route",
+		},
 		{
 			graph: &graph.Graph{
 				GatewayClass: &graph.GatewayClass{
@@ -840,9 +1192,9 @@ func TestBuildConfiguration(t *testing.T) {
 							Name:   "listener-443-1",
 							Source: listener443,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								{Namespace: "test", Name: "https-hr-1"}: httpsRouteHR1,
-								{Namespace: "test", Name: "https-hr-2"}: httpsRouteHR2,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(httpsRouteHR1.Source): httpsRouteHR1,
+								graph.CreateRouteKey(httpsRouteHR2.Source): httpsRouteHR2,
 							},
 							ResolvedSecret: &secret1NsName,
 						},
@@ -850,17 +1202,17 @@ func TestBuildConfiguration(t *testing.T) {
 							Name:   "listener-443-with-hostname",
 							Source: listener443WithHostname,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								{Namespace: "test", Name: "https-hr-5"}: httpsRouteHR5,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(httpsRouteHR5.Source): httpsRouteHR5,
 							},
 							ResolvedSecret: &secret2NsName,
 						},
 					},
 				},
-				Routes: map[types.NamespacedName]*graph.Route{
-					{Namespace: "test", Name: "https-hr-1"}: httpsRouteHR1,
-					{Namespace: "test", Name: "https-hr-2"}: httpsRouteHR2,
-					{Namespace: "test", Name: "https-hr-5"}: httpsRouteHR5,
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(httpsRouteHR1.Source): httpsRouteHR1,
+					graph.CreateRouteKey(httpsRouteHR2.Source): httpsRouteHR2,
+					graph.CreateRouteKey(httpsRouteHR5.Source): httpsRouteHR5,
 				},
 				ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
 					secret1NsName: secret1,
@@ -960,28 +1312,28 @@ func TestBuildConfiguration(t *testing.T) {
 							Name:   "listener-80-1",
 							Source: listener80,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								{Namespace: "test", Name: "hr-3"}: routeHR3,
-								{Namespace: "test", Name: "hr-4"}: routeHR4,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(routeHR3.Source): routeHR3,
+								graph.CreateRouteKey(routeHR4.Source): routeHR4,
 							},
 						},
 						{
 							Name:   "listener-443-1",
 							Source: listener443,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								{Namespace: "test", Name: "https-hr-3"}: httpsRouteHR3,
-								{Namespace: "test", Name: "https-hr-4"}: httpsRouteHR4,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(httpsRouteHR3.Source): httpsRouteHR3,
+								graph.CreateRouteKey(httpsRouteHR4.Source): httpsRouteHR4,
 							},
 							ResolvedSecret: &secret1NsName,
 						},
 					},
 				},
-				Routes: map[types.NamespacedName]*graph.Route{
-					{Namespace: "test", Name: "hr-3"}:       routeHR3,
-					{Namespace: "test", Name: "hr-4"}:       routeHR4,
-					{Namespace: "test", Name: "https-hr-3"}: httpsRouteHR3,
-					{Namespace: "test", Name: "https-hr-4"}: httpsRouteHR4,
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(routeHR3.Source):      routeHR3,
+					graph.CreateRouteKey(routeHR4.Source):      routeHR4,
+					graph.CreateRouteKey(httpsRouteHR3.Source): httpsRouteHR3,
+					graph.CreateRouteKey(httpsRouteHR4.Source): httpsRouteHR4,
 				},
 				ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
 					secret1NsName: secret1,
@@ -1120,24 +1472,24 @@ func TestBuildConfiguration(t *testing.T) {
 							Name:   "listener-80-1",
 							Source: listener80,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								{Namespace: "test", Name: "hr-3"}: routeHR3,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(routeHR3.Source): routeHR3,
 							},
 						},
 						{
 							Name:   "listener-8080",
 							Source: listener8080,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								{Namespace: "test", Name: "hr-8"}: routeHR8,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(routeHR8.Source): routeHR8,
 							},
 						},
 						{
 							Name:   "listener-443-1",
 							Source: listener443,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								{Namespace: "test", Name: "https-hr-3"}: httpsRouteHR3,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(httpsRouteHR3.Source): httpsRouteHR3,
 							},
 							ResolvedSecret: &secret1NsName,
 						},
@@ -1145,18 +1497,18 @@ func TestBuildConfiguration(t *testing.T) {
 							Name:   "listener-8443",
 							Source: listener8443,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								{Namespace: "test", Name: "https-hr-7"}: httpsRouteHR7,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(httpsRouteHR7.Source): httpsRouteHR7,
 							},
 							ResolvedSecret: &secret1NsName,
 						},
 					},
 				},
-				Routes: map[types.NamespacedName]*graph.Route{
-					{Namespace: "test", Name: "hr-3"}:       routeHR3,
-					{Namespace: "test", Name: "hr-8"}:       routeHR8,
-					{Namespace: "test", Name: "https-hr-3"}: httpsRouteHR3,
-					{Namespace: "test", Name: "https-hr-7"}: httpsRouteHR7,
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(routeHR3.Source):      routeHR3,
+					graph.CreateRouteKey(routeHR8.Source):      routeHR8,
+					graph.CreateRouteKey(httpsRouteHR3.Source): httpsRouteHR3,
+					graph.CreateRouteKey(httpsRouteHR7.Source): httpsRouteHR7,
 				},
 				ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
 					secret1NsName: secret1,
@@ -1334,14 +1686,14 @@ func TestBuildConfiguration(t *testing.T) {
 							Name:   "listener-80-1",
 							Source: listener80,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								{Namespace: "test", Name: "hr-1"}: routeHR1,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(routeHR1.Source): routeHR1,
 							},
 						},
 					},
 				},
-				Routes: map[types.NamespacedName]*graph.Route{
-					{Namespace: "test", Name: "hr-1"}: routeHR1,
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(routeHR1.Source): routeHR1,
 				},
 			},
 			expConf: Configuration{},
@@ -1357,14 +1709,14 @@ func TestBuildConfiguration(t *testing.T) {
 							Name:   "listener-80-1",
 							Source: listener80,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								{Namespace: "test", Name: "hr-1"}: routeHR1,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(routeHR1.Source): routeHR1,
 							},
 						},
 					},
 				},
-				Routes: map[types.NamespacedName]*graph.Route{
-					{Namespace: "test", Name: "hr-1"}: routeHR1,
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(routeHR1.Source): routeHR1,
 				},
 			},
 			expConf: Configuration{},
@@ -1377,7 +1729,7 @@ func TestBuildConfiguration(t *testing.T) {
 					Valid:  true,
 				},
 				Gateway: nil,
-				Routes:  map[types.NamespacedName]*graph.Route{},
+				Routes:  map[graph.RouteKey]*graph.L7Route{},
 			},
 			expConf: Configuration{},
 			msg:     "missing gateway",
@@ -1395,14 +1747,14 @@ func TestBuildConfiguration(t *testing.T) {
 							Name:   "listener-80-1",
 							Source: listener80,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								{Namespace: "test", Name: "hr-5"}: routeHR5,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(routeHR5.Source): routeHR5,
 							},
 						},
 					},
 				},
-				Routes: map[types.NamespacedName]*graph.Route{
-					{Namespace: "test", Name: "hr-5"}: routeHR5,
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(routeHR5.Source): routeHR5,
 				},
 			},
 			expConf: Configuration{
@@ -1465,24 +1817,24 @@ func TestBuildConfiguration(t *testing.T) {
 							Name:   "listener-80-1",
 							Source: listener80,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								{Namespace: "test", Name: "hr-6"}: routeHR6,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(routeHR6.Source): routeHR6,
 							},
 						},
 						{
 							Name:   "listener-443-1",
 							Source: listener443,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								{Namespace: "test", Name: "https-hr-6"}: httpsRouteHR6,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(httpsRouteHR6.Source): httpsRouteHR6,
 							},
 							ResolvedSecret: &secret1NsName,
 						},
 					},
 				},
-				Routes: map[types.NamespacedName]*graph.Route{
-					{Namespace: "test", Name: "hr-6"}:       routeHR6,
-					{Namespace: "test", Name: "https-hr-6"}: httpsRouteHR6,
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(routeHR6.Source):      routeHR6,
+					graph.CreateRouteKey(httpsRouteHR6.Source): httpsRouteHR6,
 				},
 				ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
 					secret1NsName: secret1,
@@ -1567,14 +1919,14 @@ func TestBuildConfiguration(t *testing.T) {
 							Name:   "listener-80-1",
 							Source: listener80,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								{Namespace: "test", Name: "hr-7"}: routeHR7,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(routeHR7.Source): routeHR7,
 							},
 						},
 					},
 				},
-				Routes: map[types.NamespacedName]*graph.Route{
-					{Namespace: "test", Name: "hr-7"}: routeHR7,
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(routeHR7.Source): routeHR7,
 				},
 			},
 			expConf: Configuration{
@@ -1631,8 +1983,8 @@ func TestBuildConfiguration(t *testing.T) {
 							Name:   "listener-443-with-hostname",
 							Source: listener443WithHostname,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								{Namespace: "test", Name: "https-hr-5"}: httpsRouteHR5,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(httpsRouteHR5.Source): httpsRouteHR5,
 							},
 							ResolvedSecret: &secret2NsName,
 						},
@@ -1640,15 +1992,15 @@ func TestBuildConfiguration(t *testing.T) {
 							Name:   "listener-443-1",
 							Source: listener443,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								{Namespace: "test", Name: "https-hr-5"}: httpsRouteHR5,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(httpsRouteHR5.Source): httpsRouteHR5,
 							},
 							ResolvedSecret: &secret1NsName,
 						},
 					},
 				},
-				Routes: map[types.NamespacedName]*graph.Route{
-					{Namespace: "test", Name: "https-hr-5"}: httpsRouteHR5,
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(httpsRouteHR5.Source): httpsRouteHR5,
 				},
 				ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
 					secret1NsName: secret1,
@@ -1719,15 +2071,15 @@ func TestBuildConfiguration(t *testing.T) {
 							Name:   "listener-443",
 							Source: listener443,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								{Namespace: "test", Name: "https-hr-8"}: httpsRouteHR8,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(httpsRouteHR8.Source): httpsRouteHR8,
 							},
 							ResolvedSecret: &secret1NsName,
 						},
 					},
 				},
-				Routes: map[types.NamespacedName]*graph.Route{
-					{Namespace: "test", Name: "https-hr-8"}: httpsRouteHR8,
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(httpsRouteHR8.Source): httpsRouteHR8,
 				},
 				ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
 					secret1NsName: secret1,
@@ -1795,15 +2147,15 @@ func TestBuildConfiguration(t *testing.T) {
 							Name:   "listener-443",
 							Source: listener443,
 							Valid:  true,
-							Routes: map[types.NamespacedName]*graph.Route{
-								{Namespace: "test", Name: "https-hr-9"}: httpsRouteHR9,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(httpsRouteHR9.Source): httpsRouteHR9,
 							},
 							ResolvedSecret: &secret1NsName,
 						},
 					},
 				},
-				Routes: map[types.NamespacedName]*graph.Route{
-					{Namespace: "test", Name: "https-hr-9"}: httpsRouteHR9,
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(httpsRouteHR9.Source): httpsRouteHR9,
 				},
 				ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
 					secret1NsName: secret1,
@@ -1858,44 +2210,787 @@ func TestBuildConfiguration(t *testing.T) {
 			},
 			msg: "https listener with httproute with backend that has a backend TLS policy with binaryData attached",
 		},
-	}
-
-	for _, test := range tests {
-		t.Run(test.msg, func(t *testing.T) {
-			g := NewWithT(t)
-
-			result := BuildConfiguration(context.TODO(), test.graph, fakeResolver, 1)
-
-			g.Expect(result.BackendGroups).To(ConsistOf(test.expConf.BackendGroups))
-			g.Expect(result.Upstreams).To(ConsistOf(test.expConf.Upstreams))
-			g.Expect(result.HTTPServers).To(ConsistOf(test.expConf.HTTPServers))
-			g.Expect(result.SSLServers).To(ConsistOf(test.expConf.SSLServers))
-			g.Expect(result.SSLKeyPairs).To(Equal(test.expConf.SSLKeyPairs))
-			g.Expect(result.Version).To(Equal(1))
-			g.Expect(result.CertBundles).To(Equal(test.expConf.CertBundles))
-		})
-	}
-}
-
-func TestGetPath(t *testing.T) {
-	tests := []struct {
-		path     *v1.HTTPPathMatch
-		expected string
-		msg      string
-	}{
-		{
-			path:     &v1.HTTPPathMatch{Value: helpers.GetPointer("/abc")},
-			expected: "/abc",
-			msg:      "normal case",
-		},
 		{
-			path:     nil,
-			expected: "/",
-			msg:      "nil path",
+			graph: &graph.Graph{
+				GatewayClass: &graph.GatewayClass{
+					Source: &v1.GatewayClass{},
+					Valid:  true,
+				},
+				Gateway: &graph.Gateway{
+					Source: &v1.Gateway{},
+					Listeners: []*graph.Listener{
+						{
+							Name:   "listener-80-1",
+							Source: listener80,
+							Valid:  true,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(routeHRMirror.Source): routeHRMirror,
+							},
+						},
+					},
+				},
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(routeHRMirror.Source): routeHRMirror,
+				},
+				ReferencedCaCertConfigMaps: referencedConfigMaps,
+			},
+			expConf: Configuration{
+				HTTPServers: []VirtualServer{
+					{
+						IsDefault: true,
+						Port:      80,
+					},
+					{
+						Hostname: "foo.example.com",
+						PathRules: []PathRule{
+							{
+								Path:     "/",
+								PathType: PathTypePrefix,
+								MatchRules: []MatchRule{
+									{
+										Source:       &hrMirror.ObjectMeta,
+										BackendGroup: expHRMirrorGroups[0],
+										Filters: HTTPFilters{
+											RequestMirror: &expMirror,
+										},
+									},
+								},
+							},
+						},
+						Port: 80,
+					},
+				},
+				SSLServers: []VirtualServer{},
+				Upstreams: []Upstream{
+					fooUpstream,
+					{
+						Name:      "test_mirror_80",
+						Endpoints: fooEndpoints,
+					},
+				},
+				BackendGroups: []BackendGroup{expHRMirrorGroups[0]},
+				SSLKeyPairs:   map[SSLKeyPairID]SSLKeyPair{},
+				CertBundles: map[CertBundleID]CertBundle{
+					"cert_bundle_test_configmap-1": []byte("cert-1"),
+				},
+			},
+			msg: "http listener with httproute with request mirror filter",
 		},
 		{
-			path:     &v1.HTTPPathMatch{Value: nil},
-			expected: "/",
+			graph: &graph.Graph{
+				GatewayClass: &graph.GatewayClass{
+					Source: &v1.GatewayClass{},
+					Valid:  true,
+				},
+				Gateway: &graph.Gateway{
+					Source: &v1.Gateway{},
+					Listeners: []*graph.Listener{
+						{
+							Name:   "listener-80-1",
+							Source: listener80,
+							Valid:  true,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(routeHRTimeouts.Source): routeHRTimeouts,
+							},
+						},
+					},
+				},
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(routeHRTimeouts.Source): routeHRTimeouts,
+				},
+			},
+			expConf: Configuration{
+				HTTPServers: []VirtualServer{
+					{
+						IsDefault: true,
+						Port:      80,
+					},
+					{
+						Hostname: "foo.example.com",
+						PathRules: []PathRule{
+							{
+								Path:     "/",
+								PathType: PathTypePrefix,
+								MatchRules: []MatchRule{
+									{
+										Source:       &hrTimeouts.ObjectMeta,
+										BackendGroup: expHRTimeoutsGroups[0],
+										Timeouts: &HTTPTimeouts{
+											Request:        helpers.GetPointer(10 * time.Second),
+											BackendRequest: helpers.GetPointer(500 * time.Millisecond),
+										},
+									},
+								},
+							},
+						},
+						Port: 80,
+					},
+				},
+				SSLServers:    []VirtualServer{},
+				Upstreams:     []Upstream{fooUpstream},
+				BackendGroups: []BackendGroup{expHRTimeoutsGroups[0]},
+				SSLKeyPairs:   map[SSLKeyPairID]SSLKeyPair{},
+				CertBundles:   map[CertBundleID]CertBundle{},
+			},
+			msg: "http listener with httproute with timeouts",
+		},
+		{
+			graph: &graph.Graph{
+				GatewayClass: &graph.GatewayClass{
+					Source: &v1.GatewayClass{},
+					Valid:  true,
+				},
+				Gateway: &graph.Gateway{
+					Source: &v1.Gateway{},
+					Listeners: []*graph.Listener{
+						{
+							Name:   "listener-80-1",
+							Source: listener80,
+							Valid:  true,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(routeHRErrorPages.Source): routeHRErrorPages,
+							},
+						},
+					},
+					ErrorPages: gwErrorPages,
+				},
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(routeHRErrorPages.Source): routeHRErrorPages,
+				},
+			},
+			expConf: Configuration{
+				HTTPServers: []VirtualServer{
+					{
+						IsDefault:  true,
+						Port:       80,
+						ErrorPages: expGwErrorPages,
+					},
+					{
+						Hostname: "foo.example.com",
+						PathRules: []PathRule{
+							{
+								Path:     "/",
+								PathType: PathTypePrefix,
+								MatchRules: []MatchRule{
+									{
+										Source:       &hrErrorPages.ObjectMeta,
+										BackendGroup: expHRErrorPagesGroups[0],
+										Policies: RulePolicies{
+											ErrorPages: []ErrorPage{
+												{
+													Backend: &Backend{UpstreamName: "test_foo_80", Weight: 1, Valid: true},
+													Codes:   []int32{500, 502},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						ErrorPages: expGwErrorPages,
+						Port:       80,
+					},
+				},
+				SSLServers:    []VirtualServer{},
+				Upstreams:     []Upstream{fooUpstream},
+				BackendGroups: []BackendGroup{expHRErrorPagesGroups[0]},
+				SSLKeyPairs:   map[SSLKeyPairID]SSLKeyPair{},
+				CertBundles:   map[CertBundleID]CertBundle{},
+				ErrorPageFiles: map[ErrorPageFileID]ErrorPageFile{
+					errorPageFileID: []byte(`{"error":"not found"}`),
+				},
+			},
+			msg: "http listener with gateway and httproute with error pages",
+		},
+		{
+			graph: &graph.Graph{
+				GatewayClass: &graph.GatewayClass{
+					Source: &v1.GatewayClass{},
+					Valid:  true,
+				},
+				Gateway: &graph.Gateway{
+					Source: &v1.Gateway{},
+					Listeners: []*graph.Listener{
+						{
+							Name:   "listener-80-1",
+							Source: listener80,
+							Valid:  true,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(routeHRClientSettings.Source): routeHRClientSettings,
+							},
+						},
+					},
+					EffectivePolicies: gwEffectivePolicies,
+				},
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(routeHRClientSettings.Source): routeHRClientSettings,
+				},
+				ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
+					{Namespace: "test", Name: "htpasswd"}: htpasswdSecret,
+					{Namespace: "test", Name: "unused"}:   secret1,
+				},
+			},
+			expConf: Configuration{
+				HTTPServers: []VirtualServer{
+					{
+						IsDefault: true,
+						AccessLog: gwAccessLog,
+						Port:      80,
+					},
+					{
+						Hostname: "foo.example.com",
+						PathRules: []PathRule{
+							{
+								Path:     "/",
+								PathType: PathTypePrefix,
+								MatchRules: []MatchRule{
+									{
+										Source:       &hrClientSettings.ObjectMeta,
+										BackendGroup: expHRClientSettingsGroups[0],
+										Policies: RulePolicies{
+											ClientSettings: &ClientSettings{
+												BodyMaxSize: "10m",
+											},
+											Tracing: &Tracing{
+												Strategy: TraceStrategyParent,
+											},
+											RateLimit: &RateLimit{
+												Zone:  routeRateLimitZone,
+												Burst: helpers.GetPointer[int32](5),
+											},
+											Retry: &Retry{
+												Attempts:    helpers.GetPointer[int32](3),
+												StatusCodes: []int32{502},
+											},
+											BasicAuth: &BasicAuth{
+												Realm:      "Restricted",
+												UserFileID: authUserFileID,
+											},
+											AccessControl: &AccessControl{
+												Rules: []AccessControlRule{
+													{Action: AccessControlActionAllow, CIDR: "10.8.0.0/16"},
+												},
+												DefaultAction: AccessControlActionDeny,
+											},
+											CORS: &CORS{
+												Name:         "test_route-cors",
+												AllowOrigins: []string{"https://example.com"},
+												AllowMethods: []string{"GET", "HEAD", "POST"},
+											},
+											Cache: &Cache{
+												Zone:  CacheZone{Name: "test_route-cache"},
+												Valid: []CacheValid{{Time: "60s"}},
+											},
+											Compression: &Compression{
+												Enabled:   true,
+												MinLength: helpers.GetPointer[int32](1024),
+												Level:     helpers.GetPointer[int32](9),
+												Types:     []string{"application/json"},
+											},
+											AccessLog: routeAccessLog,
+										},
+									},
+								},
+							},
+						},
+						ClientSettings: &ClientSettings{
+							KeepAliveRequests: helpers.GetPointer[int32](100),
+						},
+						RateLimit: &RateLimit{
+							Zone: gwRateLimitZone,
+						},
+						Retry: &Retry{
+							TotalTimeout: "10s",
+						},
+						AccessControl: &AccessControl{
+							Rules: []AccessControlRule{
+								{Action: AccessControlActionDeny, CIDR: "192.168.0.0/16"},
+							},
+						},
+						Compression: &Compression{
+							Enabled: true,
+							Types:   []string{"application/json"},
+						},
+						AccessLog: gwAccessLog,
+						Port:      80,
+					},
+				},
+				SSLServers:     []VirtualServer{},
+				Upstreams:      []Upstream{fooUpstream},
+				BackendGroups:  []BackendGroup{expHRClientSettingsGroups[0]},
+				SSLKeyPairs:    map[SSLKeyPairID]SSLKeyPair{},
+				CertBundles:    map[CertBundleID]CertBundle{},
+				RateLimitZones: []RateLimitZone{gwRateLimitZone, routeRateLimitZone},
+				CacheZones:     []CacheZone{{Name: "test_route-cache"}},
+				LogFormats:     []LogFormat{*gwAccessLog.Format, *routeAccessLog.Format},
+				AuthUserFiles: map[AuthUserFileID]AuthUserFile{
+					authUserFileID: []byte("user:password"),
+				},
+			},
+			msg: "http listener with gateway and httproute with client settings, observability, rate limit, " +
+				"retry, basic auth, access control, CORS, cache, compression and access log policies",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := BuildConfiguration(context.TODO(), test.graph, fakeResolver, 1)
+
+			g.Expect(result.BackendGroups).To(ConsistOf(test.expConf.BackendGroups))
+			g.Expect(result.Upstreams).To(ConsistOf(test.expConf.Upstreams))
+			g.Expect(result.HTTPServers).To(ConsistOf(test.expConf.HTTPServers))
+			g.Expect(result.SSLServers).To(ConsistOf(test.expConf.SSLServers))
+			g.Expect(result.SSLKeyPairs).To(Equal(test.expConf.SSLKeyPairs))
+			g.Expect(result.Version).To(Equal(1))
+			g.Expect(result.CertBundles).To(Equal(test.expConf.CertBundles))
+			g.Expect(result.Telemetry).To(Equal(test.expConf.Telemetry))
+			g.Expect(result.RateLimitZones).To(Equal(test.expConf.RateLimitZones))
+			g.Expect(result.CacheZones).To(Equal(test.expConf.CacheZones))
+			g.Expect(result.LogFormats).To(Equal(test.expConf.LogFormats))
+			g.Expect(result.AuthUserFiles).To(Equal(test.expConf.AuthUserFiles))
+		})
+	}
+}
+
+func TestBuildServersListenerAccessControl(t *testing.T) {
+	g := NewWithT(t)
+
+	createListener := func(
+		name string,
+		protocol v1.ProtocolType,
+		port v1.PortNumber,
+		hostname string,
+		effectivePolicies []policies.Policy,
+	) *graph.Listener {
+		l := &graph.Listener{
+			Name: name,
+			Source: v1.Listener{
+				Name:     v1.SectionName(name),
+				Protocol: protocol,
+				Port:     port,
+			},
+			Routes:            make(map[graph.RouteKey]*graph.L7Route),
+			EffectivePolicies: effectivePolicies,
+			Valid:             true,
+		}
+
+		if hostname == "" {
+			return l
+		}
+
+		hr := &v1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name}}
+		key := graph.CreateRouteKey(hr)
+		l.Routes[key] = &graph.L7Route{
+			RouteType: graph.RouteTypeHTTP,
+			Source:    hr,
+			ParentRefs: []graph.ParentRef{
+				{
+					Attachment: &graph.ParentRefAttachmentStatus{
+						AcceptedHostnames: map[string][]string{name: {hostname}},
+						Attached:          true,
+					},
+				},
+			},
+			Valid: true,
+		}
+
+		return l
+	}
+
+	listenerACP := &ngfAPI.AccessControlPolicy{
+		Spec: ngfAPI.AccessControlPolicySpec{
+			Rules: []ngfAPI.AccessControlRule{
+				{Action: ngfAPI.AccessControlActionAllow, CIDR: "10.8.0.0/16"},
+			},
+			DefaultAction: helpers.GetPointer(ngfAPI.AccessControlActionDeny),
+		},
+	}
+
+	gatewayACP := &ngfAPI.AccessControlPolicy{
+		Spec: ngfAPI.AccessControlPolicySpec{
+			Rules: []ngfAPI.AccessControlRule{
+				{Action: ngfAPI.AccessControlActionDeny, CIDR: "192.168.0.0/16"},
+			},
+		},
+	}
+
+	listeners := []*graph.Listener{
+		createListener("admin", v1.HTTPProtocolType, 80, "admin.example.com", []policies.Policy{listenerACP}),
+		createListener("app", v1.HTTPProtocolType, 8080, "app.example.com", nil),
+		createListener("https", v1.HTTPSProtocolType, 443, "", []policies.Policy{listenerACP}),
+	}
+
+	httpServers, sslServers := buildServers(listeners)
+	addGatewayPoliciesToServers(httpServers, []policies.Policy{gatewayACP})
+	addGatewayPoliciesToServers(sslServers, []policies.Policy{gatewayACP})
+
+	listenerAccessControl := &AccessControl{
+		Rules:         []AccessControlRule{{Action: AccessControlActionAllow, CIDR: "10.8.0.0/16"}},
+		DefaultAction: AccessControlActionDeny,
+	}
+
+	gatewayAccessControl := &AccessControl{
+		Rules: []AccessControlRule{{Action: AccessControlActionDeny, CIDR: "192.168.0.0/16"}},
+	}
+
+	getAccessControls := func(servers []VirtualServer) map[string]*AccessControl {
+		accessControls := make(map[string]*AccessControl, len(servers))
+		for _, s := range servers {
+			accessControls[fmt.Sprintf("%s:%d", s.Hostname, s.Port)] = s.AccessControl
+		}
+
+		return accessControls
+	}
+
+	g.Expect(getAccessControls(httpServers)).To(Equal(map[string]*AccessControl{
+		":80":                  nil,
+		"admin.example.com:80": listenerAccessControl,
+		":8080":                nil,
+		"app.example.com:8080": gatewayAccessControl,
+	}))
+
+	g.Expect(getAccessControls(sslServers)).To(Equal(map[string]*AccessControl{
+		":443":                    nil,
+		wildcardHostname + ":443": listenerAccessControl,
+	}))
+}
+
+func TestAddUpstreamKeepAliveToBackends(t *testing.T) {
+	createServers := func() []VirtualServer {
+		return []VirtualServer{
+			{
+				ErrorPages: []ErrorPage{
+					{Backend: &Backend{UpstreamName: "keepalive"}},
+					{FileID: "error_page"},
+				},
+				PathRules: []PathRule{
+					{
+						MatchRules: []MatchRule{
+							{
+								BackendGroup: BackendGroup{
+									Backends: []Backend{
+										{UpstreamName: "keepalive"},
+										{UpstreamName: "no-keepalive"},
+									},
+								},
+								Policies: RulePolicies{
+									ErrorPages: []ErrorPage{
+										{Backend: &Backend{UpstreamName: "no-keepalive"}},
+									},
+									ExternalAuth: &ExternalAuth{
+										Backend: Backend{UpstreamName: "keepalive"},
+									},
+								},
+								Filters: HTTPFilters{
+									RequestMirror: &HTTPRequestMirrorFilter{
+										Backend: Backend{UpstreamName: "keepalive"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	upstreams := []Upstream{
+		{
+			Name:     "keepalive",
+			Settings: &UpstreamSettings{KeepAliveConnections: helpers.GetPointer[int32](16)},
+		},
+		{
+			Name:     "no-keepalive",
+			Settings: &UpstreamSettings{LoadBalancingMethod: LoadBalancingRandom},
+		},
+	}
+
+	g := NewWithT(t)
+
+	servers := createServers()
+	addUpstreamKeepAliveToBackends(servers, upstreams)
+
+	matchRule := servers[0].PathRules[0].MatchRules[0]
+	g.Expect(matchRule.BackendGroup.Backends[0].KeepAlive).To(BeTrue())
+	g.Expect(matchRule.BackendGroup.Backends[1].KeepAlive).To(BeFalse())
+	g.Expect(matchRule.Filters.RequestMirror.Backend.KeepAlive).To(BeTrue())
+	g.Expect(matchRule.Policies.ExternalAuth.Backend.KeepAlive).To(BeTrue())
+	g.Expect(matchRule.Policies.ErrorPages[0].Backend.KeepAlive).To(BeFalse())
+	g.Expect(servers[0].ErrorPages[0].Backend.KeepAlive).To(BeTrue())
+
+	servers = createServers()
+	addUpstreamKeepAliveToBackends(servers, nil)
+	g.Expect(servers).To(Equal(createServers()))
+}
+
+func TestBuildRateLimitZones(t *testing.T) {
+	g := NewWithT(t)
+
+	zoneA := RateLimitZone{Name: "test_a", KeyType: RateLimitKeyClientIP, Rate: "10r/s"}
+	zoneB := RateLimitZone{Name: "test_b", KeyType: RateLimitKeyHeader, KeyName: "X-Api-Key", Rate: "1r/m"}
+
+	rule := func(rateLimit *RateLimit) MatchRule {
+		return MatchRule{Policies: RulePolicies{RateLimit: rateLimit}}
+	}
+
+	servers := []VirtualServer{
+		{
+			IsDefault: true,
+		},
+		{
+			RateLimit: &RateLimit{Zone: zoneB},
+			PathRules: []PathRule{
+				{
+					MatchRules: []MatchRule{rule(&RateLimit{Zone: zoneA}), rule(nil)},
+				},
+			},
+		},
+		{
+			// the same route is attached to multiple listeners, so the zone is deduplicated
+			PathRules: []PathRule{
+				{
+					MatchRules: []MatchRule{rule(&RateLimit{Zone: zoneA})},
+				},
+			},
+		},
+	}
+
+	g.Expect(buildRateLimitZones(servers)).To(Equal([]RateLimitZone{zoneA, zoneB}))
+	g.Expect(buildRateLimitZones(servers[:1])).To(BeNil())
+}
+
+func TestBuildCacheZones(t *testing.T) {
+	g := NewWithT(t)
+
+	zoneA := CacheZone{Name: "test_a"}
+	zoneB := CacheZone{Name: "test_b", Size: "1m", MaxSize: "1g", Inactive: "600s"}
+
+	rule := func(cache *Cache) MatchRule {
+		return MatchRule{Policies: RulePolicies{Cache: cache}}
+	}
+
+	servers := []VirtualServer{
+		{
+			IsDefault: true,
+		},
+		{
+			PathRules: []PathRule{
+				{
+					MatchRules: []MatchRule{rule(&Cache{Zone: zoneB}), rule(nil)},
+				},
+				{
+					MatchRules: []MatchRule{rule(&Cache{Zone: zoneA})},
+				},
+			},
+		},
+		{
+			// the same route is attached to multiple listeners, so the zone is deduplicated
+			PathRules: []PathRule{
+				{
+					MatchRules: []MatchRule{rule(&Cache{Zone: zoneB})},
+				},
+			},
+		},
+	}
+
+	g.Expect(buildCacheZones(servers)).To(Equal([]CacheZone{zoneA, zoneB}))
+	g.Expect(buildCacheZones(servers[:1])).To(BeNil())
+}
+
+func TestBuildLogFormats(t *testing.T) {
+	g := NewWithT(t)
+
+	formatA := LogFormat{Name: "test_a", TraceContext: true}
+	formatB := LogFormat{Name: "test_b", Fields: []LogField{{Name: "status", Value: "$status"}}}
+
+	rule := func(accessLog *AccessLog) MatchRule {
+		return MatchRule{Policies: RulePolicies{AccessLog: accessLog}}
+	}
+
+	servers := []VirtualServer{
+		{
+			IsDefault: true,
+			AccessLog: &AccessLog{Format: &formatB},
+		},
+		{
+			AccessLog: &AccessLog{Format: &formatB},
+			PathRules: []PathRule{
+				{
+					// the access logs of the first rule are turned off, so it has no format
+					MatchRules: []MatchRule{rule(&AccessLog{}), rule(nil), rule(&AccessLog{Format: &formatA})},
+				},
+			},
+		},
+	}
+
+	g.Expect(buildLogFormats(servers)).To(Equal([]LogFormat{formatA, formatB}))
+	g.Expect(buildLogFormats(servers[1:1])).To(BeNil())
+}
+
+func TestBuildTelemetry(t *testing.T) {
+	gateway := &graph.Gateway{
+		Source: &v1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "gateway",
+			},
+		},
+	}
+
+	createNginxProxy := func(telemetry *ngfAPI.Telemetry) *graph.NginxProxy {
+		return &graph.NginxProxy{
+			Source: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					Telemetry: telemetry,
+				},
+			},
+			Valid: true,
+		}
+	}
+
+	tests := []struct {
+		np       *graph.NginxProxy
+		msg      string
+		expected Telemetry
+	}{
+		{
+			np:       nil,
+			expected: Telemetry{},
+			msg:      "no NginxProxy",
+		},
+		{
+			np: createNginxProxy(&ngfAPI.Telemetry{
+				ServiceName: helpers.GetPointer("my-svc"),
+			}),
+			expected: Telemetry{},
+			msg:      "telemetry exporter is not configured",
+		},
+		{
+			np: createNginxProxy(&ngfAPI.Telemetry{
+				Exporter: &ngfAPI.TelemetryExporter{
+					Endpoint: "my-otel.svc:4317",
+				},
+			}),
+			expected: Telemetry{
+				Endpoint:    "my-otel.svc:4317",
+				ServiceName: "ngf:test:gateway",
+			},
+			msg: "only endpoint is configured",
+		},
+		{
+			np: createNginxProxy(&ngfAPI.Telemetry{
+				Exporter: &ngfAPI.TelemetryExporter{
+					Endpoint:   "my-otel.svc:4317",
+					Interval:   helpers.GetPointer[ngfAPI.Duration]("5s"),
+					BatchSize:  helpers.GetPointer[int32](512),
+					BatchCount: helpers.GetPointer[int32](4),
+				},
+				ServiceName: helpers.GetPointer("my-svc"),
+				SpanAttributes: []ngfAPI.SpanAttribute{
+					{Key: "key", Value: "value"},
+				},
+			}),
+			expected: Telemetry{
+				Endpoint:    "my-otel.svc:4317",
+				ServiceName: "ngf:test:gateway:my-svc",
+				Interval:    "5s",
+				BatchSize:   512,
+				BatchCount:  4,
+				SpanAttributes: []SpanAttribute{
+					{Key: "key", Value: "value"},
+				},
+			},
+			msg: "all fields are configured",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := buildTelemetry(&graph.Graph{Gateway: gateway, NginxProxy: test.np})
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestAddTelemetrySpanAttributesToServers(t *testing.T) {
+	g := NewWithT(t)
+
+	routeTracing := &Tracing{
+		Strategy: TraceStrategyRatio,
+		SpanAttributes: []SpanAttribute{
+			{Key: "route-key", Value: "route-value"},
+			{Key: "shared-key", Value: "route-value"},
+		},
+	}
+	noAttrsTracing := &Tracing{Strategy: TraceStrategyParent}
+
+	servers := []VirtualServer{
+		{
+			IsDefault: true,
+		},
+		{
+			PathRules: []PathRule{
+				{
+					MatchRules: []MatchRule{
+						{Policies: RulePolicies{Tracing: routeTracing}},
+						{Policies: RulePolicies{Tracing: routeTracing}},
+						{Policies: RulePolicies{Tracing: noAttrsTracing}},
+						{},
+					},
+				},
+			},
+		},
+	}
+
+	attrs := []SpanAttribute{
+		{Key: "global-key", Value: "global-value"},
+		{Key: "shared-key", Value: "global-value"},
+	}
+
+	addTelemetrySpanAttributesToServers(servers, attrs)
+
+	expTracing := &Tracing{
+		Strategy: TraceStrategyRatio,
+		SpanAttributes: []SpanAttribute{
+			{Key: "global-key", Value: "global-value"},
+			{Key: "route-key", Value: "route-value"},
+			{Key: "shared-key", Value: "route-value"},
+		},
+	}
+
+	matchRules := servers[1].PathRules[0].MatchRules
+	g.Expect(matchRules[0].Policies.Tracing).To(Equal(expTracing))
+	g.Expect(matchRules[1].Policies.Tracing).To(Equal(expTracing))
+	g.Expect(matchRules[2].Policies.Tracing).To(BeIdenticalTo(noAttrsTracing))
+	g.Expect(matchRules[3].Policies.Tracing).To(BeNil())
+
+	// the shared Tracing of the route must not be modified
+	g.Expect(routeTracing.SpanAttributes).To(HaveLen(2))
+}
+
+func TestGetPath(t *testing.T) {
+	tests := []struct {
+		path     *v1.HTTPPathMatch
+		expected string
+		msg      string
+	}{
+		{
+			path:     &v1.HTTPPathMatch{Value: helpers.GetPointer("/abc")},
+			expected: "/abc",
+			msg:      "normal case",
+		},
+		{
+			path:     nil,
+			expected: "/",
+			msg:      "nil path",
+		},
+		{
+			path:     &v1.HTTPPathMatch{Value: nil},
+			expected: "/",
 			msg:      "nil value",
 		},
 		{
@@ -1961,6 +3056,29 @@ func TestCreateFilters(t *testing.T) {
 			},
 		},
 	}
+	responseHeaderModifiers1 := v1.HTTPRouteFilter{
+		Type: v1.HTTPRouteFilterResponseHeaderModifier,
+		ResponseHeaderModifier: &v1.HTTPHeaderFilter{
+			Set: []v1.HTTPHeader{
+				{
+					Name:  "Cache-Control",
+					Value: "no-store",
+				},
+			},
+			Remove: []string{"X-Powered-By"},
+		},
+	}
+	responseHeaderModifiers2 := v1.HTTPRouteFilter{
+		Type: v1.HTTPRouteFilterResponseHeaderModifier,
+		ResponseHeaderModifier: &v1.HTTPHeaderFilter{
+			Add: []v1.HTTPHeader{
+				{
+					Name:  "X-Frame-Options",
+					Value: "DENY",
+				},
+			},
+		},
+	}
 
 	expectedRedirect1 := HTTPRequestRedirectFilter{
 		Hostname: helpers.GetPointer("foo.example.com"),
@@ -1976,6 +3094,15 @@ func TestCreateFilters(t *testing.T) {
 			},
 		},
 	}
+	expectedResponseHeaderModifier1 := HTTPHeaderFilter{
+		Set: []HTTPHeader{
+			{
+				Name:  "Cache-Control",
+				Value: "no-store",
+			},
+		},
+		Remove: []string{"X-Powered-By"},
+	}
 
 	tests := []struct {
 		expected HTTPFilters
@@ -2026,11 +3153,14 @@ func TestCreateFilters(t *testing.T) {
 				rewrite2,
 				requestHeaderModifiers1,
 				requestHeaderModifiers2,
+				responseHeaderModifiers1,
+				responseHeaderModifiers2,
 			},
 			expected: HTTPFilters{
-				RequestRedirect:        &expectedRedirect1,
-				RequestURLRewrite:      &expectedRewrite1,
-				RequestHeaderModifiers: &expectedHeaderModifier1,
+				RequestRedirect:         &expectedRedirect1,
+				RequestURLRewrite:       &expectedRewrite1,
+				RequestHeaderModifiers:  &expectedHeaderModifier1,
+				ResponseHeaderModifiers: &expectedResponseHeaderModifier1,
 			},
 			msg: "two of each filter, first value for each wins",
 		},
@@ -2081,11 +3211,11 @@ func TestGetListenerHostname(t *testing.T) {
 	}
 }
 
-func refsToValidRules(refs ...[]graph.BackendRef) []graph.Rule {
-	rules := make([]graph.Rule, 0, len(refs))
+func refsToValidRules(refs ...[]graph.BackendRef) []graph.RouteRule {
+	rules := make([]graph.RouteRule, 0, len(refs))
 
 	for _, ref := range refs {
-		rules = append(rules, graph.Rule{
+		rules = append(rules, graph.RouteRule{
 			ValidMatches: true,
 			ValidFilters: true,
 			BackendRefs:  ref,
@@ -2151,6 +3281,27 @@ func TestBuildUpstreams(t *testing.T) {
 		},
 	}
 
+	mirrorEndpoints := []resolver.Endpoint{
+		{
+			Address: "15.0.0.0",
+			Port:    80,
+		},
+	}
+
+	authzEndpoints := []resolver.Endpoint{
+		{
+			Address: "16.0.0.0",
+			Port:    80,
+		},
+	}
+
+	errorsEndpoints := []resolver.Endpoint{
+		{
+			Address: "17.0.0.0",
+			Port:    80,
+		},
+	}
+
 	createBackendRefs := func(serviceNames ...string) []graph.BackendRef {
 		var backends []graph.BackendRef
 		for _, name := range serviceNames {
@@ -2181,39 +3332,59 @@ func TestBuildUpstreams(t *testing.T) {
 
 	invalidHRRefs := createBackendRefs("abc")
 
-	routes := map[types.NamespacedName]*graph.Route{
-		{Name: "hr1", Namespace: "test"}: {
+	hr3Rules := refsToValidRules(hr3Refs0)
+	hr3Rules[0].MirrorBackendRef = &createBackendRefs("mirror")[0]
+
+	routes := map[graph.RouteKey]*graph.L7Route{
+		{NamespacedName: types.NamespacedName{Name: "hr1", Namespace: "test"}}: {
 			Valid: true,
-			Rules: refsToValidRules(hr1Refs0, hr1Refs1),
+			Spec: graph.L7RouteSpec{
+				Rules: refsToValidRules(hr1Refs0, hr1Refs1),
+			},
 		},
-		{Name: "hr2", Namespace: "test"}: {
+		{NamespacedName: types.NamespacedName{Name: "hr2", Namespace: "test"}}: {
 			Valid: true,
-			Rules: refsToValidRules(hr2Refs0, hr2Refs1),
+			Spec: graph.L7RouteSpec{
+				Rules: refsToValidRules(hr2Refs0, hr2Refs1),
+			},
 		},
-		{Name: "hr3", Namespace: "test"}: {
+		{NamespacedName: types.NamespacedName{Name: "hr3", Namespace: "test"}}: {
 			Valid: true,
-			Rules: refsToValidRules(hr3Refs0),
+			Spec: graph.L7RouteSpec{
+				Rules: hr3Rules,
+			},
+			ExternalAuthBackendRef: &createBackendRefs("authz")[0],
 		},
 	}
 
-	routes2 := map[types.NamespacedName]*graph.Route{
-		{Name: "hr4", Namespace: "test"}: {
+	routes2 := map[graph.RouteKey]*graph.L7Route{
+		{NamespacedName: types.NamespacedName{Name: "hr4", Namespace: "test"}}: {
 			Valid: true,
-			Rules: refsToValidRules(hr4Refs0, hr4Refs1),
+			Spec: graph.L7RouteSpec{
+				Rules: refsToValidRules(hr4Refs0, hr4Refs1),
+			},
+			ErrorPages: []graph.ErrorPage{
+				{Codes: []int32{404}, Content: []byte("not found")},
+				{Codes: []int32{500}, BackendRef: &createBackendRefs("errors")[0]},
+			},
 		},
 	}
 
-	routesWithNonExistingRefs := map[types.NamespacedName]*graph.Route{
-		{Name: "non-existing", Namespace: "test"}: {
+	routesWithNonExistingRefs := map[graph.RouteKey]*graph.L7Route{
+		{NamespacedName: types.NamespacedName{Name: "non-existing", Namespace: "test"}}: {
 			Valid: true,
-			Rules: refsToValidRules(nonExistingRefs),
+			Spec: graph.L7RouteSpec{
+				Rules: refsToValidRules(nonExistingRefs),
+			},
 		},
 	}
 
-	invalidRoutes := map[types.NamespacedName]*graph.Route{
-		{Name: "invalid", Namespace: "test"}: {
+	invalidRoutes := map[graph.RouteKey]*graph.L7Route{
+		{NamespacedName: types.NamespacedName{Name: "invalid", Namespace: "test"}}: {
 			Valid: false,
-			Rules: refsToValidRules(invalidHRRefs),
+			Spec: graph.L7RouteSpec{
+				Rules: refsToValidRules(invalidHRRefs),
+			},
 		},
 	}
 
@@ -2264,12 +3435,28 @@ func TestBuildUpstreams(t *testing.T) {
 		{
 			Name:      "test_foo_80",
 			Endpoints: fooEndpoints,
+			Settings: &UpstreamSettings{
+				LoadBalancingMethod:  LoadBalancingLeastConn,
+				KeepAliveConnections: helpers.GetPointer[int32](16),
+			},
 		},
 		{
 			Name:      "test_nil-endpoints_80",
 			Endpoints: nil,
 			ErrorMsg:  nilEndpointsErrMsg,
 		},
+		{
+			Name:      "test_mirror_80",
+			Endpoints: mirrorEndpoints,
+		},
+		{
+			Name:      "test_authz_80",
+			Endpoints: authzEndpoints,
+		},
+		{
+			Name:      "test_errors_80",
+			Endpoints: errorsEndpoints,
+		},
 	}
 
 	fakeResolver := &resolverfakes.FakeServiceResolver{}
@@ -2293,6 +3480,126 @@ func TestBuildUpstreams(t *testing.T) {
 			return nil, errors.New(nilEndpointsErrMsg)
 		case "abc":
 			return abcEndpoints, nil
+		case "mirror":
+			return mirrorEndpoints, nil
+		case "authz":
+			return authzEndpoints, nil
+		case "errors":
+			return errorsEndpoints, nil
+		default:
+			return nil, fmt.Errorf("unexpected service %s", svcNsName.Name)
+		}
+	})
+
+	g := NewWithT(t)
+
+	referencedServices := map[types.NamespacedName]*graph.ReferencedService{
+		{Namespace: "test", Name: "foo"}: {
+			EffectivePolicies: []policies.Policy{
+				&ngfAPI.UpstreamSettingsPolicy{
+					Spec: ngfAPI.UpstreamSettingsPolicySpec{
+						LoadBalancing: &ngfAPI.LoadBalancing{Method: ngfAPI.LoadBalancingLeastConn},
+						KeepAlive:     &ngfAPI.UpstreamKeepAlive{Connections: helpers.GetPointer[int32](16)},
+					},
+				},
+			},
+		},
+		{Namespace: "test", Name: "bar"}: {},
+	}
+
+	gatewayErrorPages := []graph.ErrorPage{
+		{Codes: []int32{502}, BackendRef: &createBackendRefs("errors")[0]},
+		{
+			Codes: []int32{503},
+			// unresolved backendRefs don't have upstreams
+			BackendRef: &graph.BackendRef{SvcNsName: types.NamespacedName{Namespace: "test", Name: "unresolved"}},
+		},
+	}
+
+	upstreams := buildUpstreams(context.TODO(), listeners, gatewayErrorPages, referencedServices, fakeResolver)
+	g.Expect(upstreams).To(ConsistOf(expUpstreams))
+}
+
+func TestBuildStreamUpstreams(t *testing.T) {
+	fooEndpoints := []resolver.Endpoint{
+		{
+			Address: "10.0.0.0",
+			Port:    8443,
+		},
+	}
+
+	createL4Route := func(valid bool, svcName string) *graph.L4Route {
+		return &graph.L4Route{
+			Valid: valid,
+			Spec: graph.L4RouteSpec{
+				BackendRefs: []graph.BackendRef{
+					{
+						SvcNsName:   types.NamespacedName{Namespace: "test", Name: svcName},
+						ServicePort: apiv1.ServicePort{Port: 443},
+						Valid:       svcName != "",
+					},
+				},
+			},
+		}
+	}
+
+	createRouteKey := func(name string) graph.RouteKey {
+		return graph.RouteKey{
+			NamespacedName: types.NamespacedName{Namespace: "test", Name: name},
+			RouteType:      graph.RouteTypeTLS,
+		}
+	}
+
+	listeners := []*graph.Listener{
+		{
+			Name:  "invalid-listener",
+			Valid: false,
+			L4Routes: map[graph.RouteKey]*graph.L4Route{
+				// shouldn't be included since listener is invalid
+				createRouteKey("tr-invalid-listener"): createL4Route(true, "non-existing"),
+			},
+		},
+		{
+			Name:  "listener-1",
+			Valid: true,
+			L4Routes: map[graph.RouteKey]*graph.L4Route{
+				createRouteKey("tr-1"): createL4Route(true, "foo"),
+				// shouldn't duplicate foo upstream
+				createRouteKey("tr-2"): createL4Route(true, "foo"),
+				// shouldn't be included since backend ref is invalid
+				createRouteKey("tr-3"): createL4Route(true, ""),
+				// shouldn't be included since route is invalid
+				createRouteKey("tr-4"): createL4Route(false, "abc"),
+				createRouteKey("tr-5"): createL4Route(true, "nil-endpoints"),
+			},
+		},
+	}
+
+	nilEndpointsErrMsg := "nil endpoints error"
+
+	expUpstreams := []Upstream{
+		{
+			Name:      "test_foo_443",
+			Endpoints: fooEndpoints,
+		},
+		{
+			Name:      "test_nil-endpoints_443",
+			Endpoints: nil,
+			ErrorMsg:  nilEndpointsErrMsg,
+		},
+	}
+
+	fakeResolver := &resolverfakes.FakeServiceResolver{}
+	fakeResolver.ResolveCalls(func(
+		_ context.Context,
+		svcNsName types.NamespacedName,
+		_ apiv1.ServicePort,
+	) ([]resolver.Endpoint, error) {
+		switch svcNsName.Name {
+		case "foo":
+			return fooEndpoints, nil
+		case "nil-endpoints":
+			return nil, errors.New(nilEndpointsErrMsg)
 		default:
 			return nil, fmt.Errorf("unexpected service %s", svcNsName.Name)
 		}
@@ -2300,8 +3607,280 @@ func TestBuildUpstreams(t *testing.T) {
 
 	g := NewWithT(t)
 
-	upstreams := buildUpstreams(context.TODO(), listeners, fakeResolver)
+	upstreams := buildStreamUpstreams(context.TODO(), listeners, fakeResolver)
 	g.Expect(upstreams).To(ConsistOf(expUpstreams))
+
+	g.Expect(buildStreamUpstreams(context.TODO(), nil, fakeResolver)).To(BeNil())
+}
+
+func TestBuildLayer4Servers(t *testing.T) {
+	now := metav1.Now()
+	later := metav1.NewTime(now.Add(time.Minute))
+
+	createSource := func(name string, creationTime metav1.Time) *v1alpha2.TLSRoute {
+		return &v1alpha2.TLSRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "test",
+				Name:              name,
+				CreationTimestamp: creationTime,
+			},
+		}
+	}
+
+	createTCPSource := func(name string, creationTime metav1.Time) *v1alpha2.TCPRoute {
+		return &v1alpha2.TCPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "test",
+				Name:              name,
+				CreationTimestamp: creationTime,
+			},
+		}
+	}
+
+	createUDPSource := func(name string, creationTime metav1.Time) *v1alpha2.UDPRoute {
+		return &v1alpha2.UDPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "test",
+				Name:              name,
+				CreationTimestamp: creationTime,
+			},
+		}
+	}
+
+	createBackendRef := func(svcName string, weight int32) graph.BackendRef {
+		return graph.BackendRef{
+			SvcNsName:   types.NamespacedName{Namespace: "test", Name: svcName},
+			ServicePort: apiv1.ServicePort{Port: 443},
+			Valid:       svcName != "",
+			Weight:      weight,
+		}
+	}
+
+	createL4Route := func(
+		source client.Object,
+		routeType graph.RouteType,
+		listenerName string,
+		hostnames []string,
+		refs ...graph.BackendRef,
+	) *graph.L4Route {
+		return &graph.L4Route{
+			Source:    source,
+			RouteType: routeType,
+			Valid:     true,
+			ParentRefs: []graph.ParentRef{
+				{
+					Attachment: &graph.ParentRefAttachmentStatus{
+						AcceptedHostnames: map[string][]string{
+							listenerName: hostnames,
+						},
+						Attached: true,
+					},
+				},
+			},
+			Spec: graph.L4RouteSpec{
+				BackendRefs: refs,
+			},
+		}
+	}
+
+	createBackendGroup := func(source client.Object, backends ...Backend) BackendGroup {
+		return BackendGroup{
+			Source:   client.ObjectKeyFromObject(source),
+			Backends: backends,
+		}
+	}
+
+	tr1 := createSource("tr-1", now)
+	tr2 := createSource("tr-2", later) // created later, so it loses the hostname conflict with tr1
+	tr3 := createSource("tr-3", now)
+	tr4 := createSource("tr-4", now)
+
+	tcr1 := createTCPSource("tcr-1", now)
+	tcr2 := createTCPSource("tcr-2", later) // created later, so it loses the port conflict with tcr1
+
+	ur1 := createUDPSource("ur-1", now)
+
+	invalidRoute := createL4Route(
+		createSource("tr-invalid", now),
+		graph.RouteTypeTLS,
+		"listener-8443",
+		[]string{"invalid.example.com"},
+		createBackendRef("foo", 1),
+	)
+	invalidRoute.Valid = false
+
+	listeners := []*graph.Listener{
+		{
+			Name: "listener-8443",
+			Source: v1.Listener{
+				Name:     "listener-8443",
+				Protocol: v1.TLSProtocolType,
+				Port:     8443,
+			},
+			Valid: true,
+			L4Routes: map[graph.RouteKey]*graph.L4Route{
+				graph.CreateRouteKey(tr1): createL4Route(
+					tr1,
+					graph.RouteTypeTLS,
+					"listener-8443",
+					[]string{"app.example.com"},
+					createBackendRef("foo", 1),
+				),
+				graph.CreateRouteKey(tr2): createL4Route(
+					tr2,
+					graph.RouteTypeTLS,
+					"listener-8443",
+					[]string{"app.example.com", "cafe.example.com"},
+					createBackendRef("bar", 1),
+				),
+				graph.CreateRouteKey(tr3): createL4Route(
+					tr3,
+					graph.RouteTypeTLS,
+					"listener-8443",
+					[]string{"invalid-ref.example.com"},
+					createBackendRef("", 1),
+				),
+				{NamespacedName: types.NamespacedName{Namespace: "test", Name: "tr-invalid"}}: invalidRoute,
+			},
+		},
+		{
+			Name: "listener-9443",
+			Source: v1.Listener{
+				Name:     "listener-9443",
+				Protocol: v1.TLSProtocolType,
+				Port:     9443,
+			},
+			Valid: true,
+			L4Routes: map[graph.RouteKey]*graph.L4Route{
+				graph.CreateRouteKey(tr4): createL4Route(
+					tr4,
+					graph.RouteTypeTLS,
+					"listener-9443",
+					[]string{"app.example.com"},
+					createBackendRef("baz", 1),
+				),
+			},
+		},
+		{
+			Name: "invalid-listener",
+			Source: v1.Listener{
+				Name:     "invalid-listener",
+				Protocol: v1.TLSProtocolType,
+				Port:     10443,
+			},
+			Valid: false,
+			L4Routes: map[graph.RouteKey]*graph.L4Route{
+				graph.CreateRouteKey(tr4): createL4Route(
+					tr4,
+					graph.RouteTypeTLS,
+					"invalid-listener",
+					[]string{"app.example.com"},
+					createBackendRef("baz", 1),
+				),
+			},
+		},
+		{
+			Name: "listener-5432",
+			Source: v1.Listener{
+				Name:     "listener-5432",
+				Protocol: v1.TCPProtocolType,
+				Port:     5432,
+			},
+			Valid: true,
+			L4Routes: map[graph.RouteKey]*graph.L4Route{
+				graph.CreateRouteKey(tcr1): createL4Route(
+					tcr1,
+					graph.RouteTypeTCP,
+					"listener-5432",
+					[]string{"*"},
+					createBackendRef("postgres", 80),
+					createBackendRef("postgres-canary", 20),
+				),
+				graph.CreateRouteKey(tcr2): createL4Route(
+					tcr2,
+					graph.RouteTypeTCP,
+					"listener-5432",
+					[]string{"*"},
+					createBackendRef("bar", 1),
+				),
+			},
+		},
+		{
+			Name: "listener-53",
+			Source: v1.Listener{
+				Name:     "listener-53",
+				Protocol: v1.UDPProtocolType,
+				Port:     53,
+			},
+			Valid: true,
+			L4Routes: map[graph.RouteKey]*graph.L4Route{
+				graph.CreateRouteKey(ur1): createL4Route(
+					ur1,
+					graph.RouteTypeUDP,
+					"listener-53",
+					[]string{"*"},
+					createBackendRef("coredns", 1),
+				),
+			},
+		},
+		{
+			Name: "listener-80",
+			Source: v1.Listener{
+				Name:     "listener-80",
+				Protocol: v1.HTTPProtocolType,
+				Port:     80,
+			},
+			Valid: true,
+		},
+	}
+
+	expectedTLS := []Layer4VirtualServer{
+		{
+			Hostname:     "app.example.com",
+			BackendGroup: createBackendGroup(tr1, Backend{UpstreamName: "test_foo_443", Weight: 1, Valid: true}),
+			Port:         8443,
+		},
+		{
+			Hostname:     "cafe.example.com",
+			BackendGroup: createBackendGroup(tr2, Backend{UpstreamName: "test_bar_443", Weight: 1, Valid: true}),
+			Port:         8443,
+		},
+		{
+			Hostname:     "invalid-ref.example.com",
+			BackendGroup: createBackendGroup(tr3, Backend{Weight: 1}),
+			Port:         8443,
+		},
+		{
+			Hostname:     "app.example.com",
+			BackendGroup: createBackendGroup(tr4, Backend{UpstreamName: "test_baz_443", Weight: 1, Valid: true}),
+			Port:         9443,
+		},
+	}
+
+	expectedTCP := []Layer4VirtualServer{
+		{
+			BackendGroup: createBackendGroup(
+				tcr1,
+				Backend{UpstreamName: "test_postgres_443", Weight: 80, Valid: true},
+				Backend{UpstreamName: "test_postgres-canary_443", Weight: 20, Valid: true},
+			),
+			Port: 5432,
+		},
+	}
+
+	expectedUDP := []Layer4VirtualServer{
+		{
+			BackendGroup: createBackendGroup(ur1, Backend{UpstreamName: "test_coredns_443", Weight: 1, Valid: true}),
+			Port:         53,
+		},
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(buildLayer4Servers(listeners, v1.TLSProtocolType)).To(Equal(expectedTLS))
+	g.Expect(buildLayer4Servers(listeners, v1.TCPProtocolType)).To(Equal(expectedTCP))
+	g.Expect(buildLayer4Servers(listeners, v1.UDPProtocolType)).To(Equal(expectedUDP))
+	g.Expect(buildLayer4Servers(nil, v1.TLSProtocolType)).To(BeNil())
 }
 
 func TestBuildBackendGroups(t *testing.T) {
@@ -2511,3 +4090,61 @@ func TestConvertBackendTLS(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertBackendRef(t *testing.T) {
+	tests := []struct {
+		expected Backend
+		msg      string
+		ref      graph.BackendRef
+	}{
+		{
+			ref: graph.BackendRef{
+				SvcNsName:   types.NamespacedName{Namespace: "test", Name: "foo"},
+				ServicePort: apiv1.ServicePort{Port: 80},
+				Valid:       true,
+				Weight:      1,
+			},
+			expected: Backend{
+				UpstreamName: "test_foo_80",
+				Valid:        true,
+				Weight:       1,
+			},
+			msg: "no filters",
+		},
+		{
+			ref: graph.BackendRef{
+				SvcNsName:   types.NamespacedName{Namespace: "test", Name: "foo"},
+				ServicePort: apiv1.ServicePort{Port: 80},
+				Filters: []v1.HTTPRouteFilter{
+					{
+						Type: v1.HTTPRouteFilterRequestHeaderModifier,
+						RequestHeaderModifier: &v1.HTTPHeaderFilter{
+							Set: []v1.HTTPHeader{{Name: "X-Canary", Value: "true"}},
+						},
+					},
+				},
+				Valid:  true,
+				Weight: 1,
+			},
+			expected: Backend{
+				UpstreamName: "test_foo_80",
+				Valid:        true,
+				Weight:       1,
+				Filters: &HTTPFilters{
+					RequestHeaderModifiers: &HTTPHeaderFilter{
+						Set: []HTTPHeader{{Name: "X-Canary", Value: "true"}},
+					},
+				},
+			},
+			msg: "with filters",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(convertBackendRef(tc.ref)).To(Equal(tc.expected))
+		})
+	}
+}
@@ -2,10 +2,17 @@ package dataplane
 
 import (
 	"fmt"
+	"time"
 
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
 )
 
+const defaultBasicAuthRealm = "Restricted"
+
 func convertMatch(m v1.HTTPRouteMatch) Match {
 	match := Match{}
 
@@ -20,6 +27,7 @@ func convertMatch(m v1.HTTPRouteMatch) Match {
 			match.Headers = append(match.Headers, HTTPHeaderMatch{
 				Name:  string(h.Name),
 				Value: h.Value,
+				Type:  convertHeaderMatchType(h.Type),
 			})
 		}
 	}
@@ -30,6 +38,7 @@ func convertMatch(m v1.HTTPRouteMatch) Match {
 			match.QueryParams = append(match.QueryParams, HTTPQueryParamMatch{
 				Name:  string(q.Name),
 				Value: q.Value,
+				Type:  convertQueryParamMatchType(q.Type),
 			})
 		}
 	}
@@ -37,6 +46,24 @@ func convertMatch(m v1.HTTPRouteMatch) Match {
 	return match
 }
 
+// convertHeaderMatchType converts the type of a header match. The type must be validated beforehand.
+func convertHeaderMatchType(t *v1.HeaderMatchType) MatchType {
+	if t != nil && *t == v1.HeaderMatchRegularExpression {
+		return MatchTypeRegularExpression
+	}
+
+	return MatchTypeExact
+}
+
+// convertQueryParamMatchType converts the type of a query parameter match. The type must be validated beforehand.
+func convertQueryParamMatchType(t *v1.QueryParamMatchType) MatchType {
+	if t != nil && *t == v1.QueryParamMatchRegularExpression {
+		return MatchTypeRegularExpression
+	}
+
+	return MatchTypeExact
+}
+
 func convertHTTPRequestRedirectFilter(filter *v1.HTTPRequestRedirectFilter) *HTTPRequestRedirectFilter {
 	return &HTTPRequestRedirectFilter{
 		Scheme:     filter.Scheme,
@@ -75,12 +102,536 @@ func convertHTTPHeaderFilter(filter *v1.HTTPHeaderFilter) *HTTPHeaderFilter {
 	return result
 }
 
+// convertHTTPTimeouts converts the timeouts of a rule. The timeouts must be validated beforehand.
+func convertHTTPTimeouts(timeouts *v1.HTTPRouteTimeouts) *HTTPTimeouts {
+	if timeouts == nil {
+		return nil
+	}
+
+	return &HTTPTimeouts{
+		Request:        convertDuration(timeouts.Request),
+		BackendRequest: convertDuration(timeouts.BackendRequest),
+	}
+}
+
+func convertDuration(d *v1.Duration) *time.Duration {
+	if d == nil {
+		return nil
+	}
+
+	duration, err := time.ParseDuration(string(*d))
+	if err != nil {
+		panic(fmt.Errorf("invalid duration %q: %w", *d, err))
+	}
+
+	return &duration
+}
+
+// convertClientSettings converts the effective ClientSettingsPolicy among the effective policies into
+// ClientSettings. If there is no effective ClientSettingsPolicy, it returns nil.
+func convertClientSettings(effectivePolicies []policies.Policy) *ClientSettings {
+	csp, ok := policies.FindPolicy[*ngfAPI.ClientSettingsPolicy](effectivePolicies)
+	if !ok {
+		return nil
+	}
+
+	spec := csp.Spec
+	settings := &ClientSettings{}
+
+	setClientBodySettings(settings, spec.Body)
+	setClientKeepAliveSettings(settings, spec.KeepAlive)
+
+	// the override settings take precedence over the default settings
+	if spec.Override != nil {
+		setClientBodySettings(settings, spec.Override.Body)
+		setClientKeepAliveSettings(settings, spec.Override.KeepAlive)
+	}
+
+	return settings
+}
+
+func setClientBodySettings(settings *ClientSettings, body *ngfAPI.ClientBody) {
+	if body == nil {
+		return
+	}
+
+	if body.MaxSize != nil {
+		settings.BodyMaxSize = string(*body.MaxSize)
+	}
+	if body.Timeout != nil {
+		settings.BodyTimeout = string(*body.Timeout)
+	}
+}
+
+func setClientKeepAliveSettings(settings *ClientSettings, keepAlive *ngfAPI.ClientKeepAlive) {
+	if keepAlive == nil {
+		return
+	}
+
+	if keepAlive.Requests != nil {
+		settings.KeepAliveRequests = keepAlive.Requests
+	}
+	if keepAlive.Time != nil {
+		settings.KeepAliveTime = string(*keepAlive.Time)
+	}
+	// the server and header timeouts are configured together, so a header timeout is never kept without
+	// its server timeout
+	if keepAlive.Timeout != nil {
+		settings.KeepAliveServerTimeout = ""
+		settings.KeepAliveHeaderTimeout = ""
+		if keepAlive.Timeout.Server != nil {
+			settings.KeepAliveServerTimeout = string(*keepAlive.Timeout.Server)
+		}
+		if keepAlive.Timeout.Header != nil {
+			settings.KeepAliveHeaderTimeout = string(*keepAlive.Timeout.Header)
+		}
+	}
+}
+
+// convertRateLimit converts the effective RateLimitPolicy among the effective policies into RateLimit.
+// The zone of the rate limit is named after the effective RateLimitPolicy, so that every Policy has its own zone.
+// If there is no effective RateLimitPolicy, it returns nil.
+func convertRateLimit(effectivePolicies []policies.Policy) *RateLimit {
+	rlp, ok := policies.FindPolicy[*ngfAPI.RateLimitPolicy](effectivePolicies)
+	if !ok {
+		return nil
+	}
+
+	spec := rlp.Spec
+
+	rateLimit := &RateLimit{
+		Zone: RateLimitZone{
+			Name:    fmt.Sprintf("%s_%s", rlp.GetNamespace(), rlp.GetName()),
+			KeyType: RateLimitKeyClientIP,
+			Rate:    string(spec.Rate),
+		},
+		Burst:      spec.Burst,
+		RejectCode: spec.RejectCode,
+		NoDelay:    spec.NoDelay != nil && *spec.NoDelay,
+	}
+
+	if spec.Key != nil {
+		switch spec.Key.Type {
+		case ngfAPI.RateLimitKeyHeader:
+			rateLimit.Zone.KeyType = RateLimitKeyHeader
+			rateLimit.Zone.KeyName = *spec.Key.Header
+		case ngfAPI.RateLimitKeyJWTClaim:
+			rateLimit.Zone.KeyType = RateLimitKeyJWTClaim
+			rateLimit.Zone.KeyName = *spec.Key.JWTClaim
+		}
+	}
+
+	return rateLimit
+}
+
+// convertRetry converts the effective RetryPolicy among the effective policies into Retry.
+// If there is no effective RetryPolicy, it returns nil.
+func convertRetry(effectivePolicies []policies.Policy) *Retry {
+	rp, ok := policies.FindPolicy[*ngfAPI.RetryPolicy](effectivePolicies)
+	if !ok {
+		return nil
+	}
+
+	spec := rp.Spec
+
+	retry := &Retry{
+		Attempts: spec.Attempts,
+	}
+
+	if spec.TotalTimeout != nil {
+		retry.TotalTimeout = string(*spec.TotalTimeout)
+	}
+
+	if spec.RetryOn != nil {
+		for _, c := range spec.RetryOn.Conditions {
+			retry.Conditions = append(retry.Conditions, convertRetryCondition(c))
+		}
+
+		for _, code := range spec.RetryOn.StatusCodes {
+			retry.StatusCodes = append(retry.StatusCodes, int32(code))
+		}
+	}
+
+	return retry
+}
+
+func convertRetryCondition(condition ngfAPI.RetryCondition) RetryCondition {
+	switch condition {
+	case ngfAPI.RetryConditionError:
+		return RetryConditionError
+	case ngfAPI.RetryConditionTimeout:
+		return RetryConditionTimeout
+	case ngfAPI.RetryConditionInvalidHeader:
+		return RetryConditionInvalidHeader
+	case ngfAPI.RetryConditionNonIdempotent:
+		return RetryConditionNonIdempotent
+	default:
+		panic(fmt.Sprintf("unsupported retry condition: %s", condition))
+	}
+}
+
+// convertBasicAuth converts the effective BasicAuthPolicy among the effective policies into BasicAuth.
+// If there is no effective BasicAuthPolicy, it returns nil.
+func convertBasicAuth(effectivePolicies []policies.Policy) *BasicAuth {
+	bap, ok := policies.FindPolicy[*ngfAPI.BasicAuthPolicy](effectivePolicies)
+	if !ok {
+		return nil
+	}
+
+	realm := defaultBasicAuthRealm
+	if bap.Spec.Realm != nil {
+		realm = *bap.Spec.Realm
+	}
+
+	return &BasicAuth{
+		Realm:      realm,
+		UserFileID: generateAuthUserFileID(graph.GetBasicAuthSecretNsName(bap)),
+	}
+}
+
+// convertExternalAuth converts the effective ExternalAuthPolicy among the effective policies into ExternalAuth,
+// using the resolved backendRef of the authorization service. If there is no effective ExternalAuthPolicy,
+// it returns nil.
+func convertExternalAuth(effectivePolicies []policies.Policy, backendRef *graph.BackendRef) *ExternalAuth {
+	eap, ok := policies.FindPolicy[*ngfAPI.ExternalAuthPolicy](effectivePolicies)
+	if !ok {
+		return nil
+	}
+
+	externalAuth := &ExternalAuth{}
+
+	// the graph package sets the BackendRef of a Route with an ExternalAuthPolicy
+	if backendRef != nil {
+		externalAuth.Backend = convertBackendRef(*backendRef)
+	}
+
+	if eap.Spec.Path != nil {
+		externalAuth.Path = *eap.Spec.Path
+	}
+
+	for _, h := range eap.Spec.ForwardRequestHeaders {
+		externalAuth.ForwardRequestHeaders = append(externalAuth.ForwardRequestHeaders, string(h))
+	}
+
+	for _, h := range eap.Spec.CopyResponseHeaders {
+		externalAuth.CopyResponseHeaders = append(externalAuth.CopyResponseHeaders, string(h))
+	}
+
+	return externalAuth
+}
+
+// convertAccessControl converts the effective AccessControlPolicy among the effective policies into AccessControl.
+// If there is no effective AccessControlPolicy, it returns nil.
+func convertAccessControl(effectivePolicies []policies.Policy) *AccessControl {
+	acp, ok := policies.FindPolicy[*ngfAPI.AccessControlPolicy](effectivePolicies)
+	if !ok {
+		return nil
+	}
+
+	spec := acp.Spec
+
+	accessControl := &AccessControl{
+		Rules: make([]AccessControlRule, 0, len(spec.Rules)),
+	}
+
+	for _, rule := range spec.Rules {
+		accessControl.Rules = append(accessControl.Rules, AccessControlRule{
+			Action: convertAccessControlAction(rule.Action),
+			CIDR:   string(rule.CIDR),
+		})
+	}
+
+	if spec.DefaultAction != nil {
+		accessControl.DefaultAction = convertAccessControlAction(*spec.DefaultAction)
+	}
+
+	if spec.RealIP != nil {
+		realIP := &RealIP{
+			Header:    string(ngfAPI.RealIPHeaderXForwardedFor),
+			Recursive: spec.RealIP.Recursive != nil && *spec.RealIP.Recursive,
+		}
+
+		if spec.RealIP.Header != nil {
+			realIP.Header = string(*spec.RealIP.Header)
+		}
+
+		for _, addr := range spec.RealIP.TrustedAddresses {
+			realIP.TrustedAddresses = append(realIP.TrustedAddresses, string(addr))
+		}
+
+		accessControl.RealIP = realIP
+	}
+
+	return accessControl
+}
+
+func convertAccessControlAction(action ngfAPI.AccessControlAction) AccessControlAction {
+	switch action {
+	case ngfAPI.AccessControlActionAllow:
+		return AccessControlActionAllow
+	case ngfAPI.AccessControlActionDeny:
+		return AccessControlActionDeny
+	default:
+		panic(fmt.Sprintf("unsupported access control action: %s", action))
+	}
+}
+
+// convertCORS converts the effective CORSPolicy among the effective policies into CORS.
+// If there is no effective CORSPolicy, it returns nil.
+func convertCORS(effectivePolicies []policies.Policy) *CORS {
+	cp, ok := policies.FindPolicy[*ngfAPI.CORSPolicy](effectivePolicies)
+	if !ok {
+		return nil
+	}
+
+	spec := cp.Spec
+
+	cors := &CORS{
+		Name:             fmt.Sprintf("%s_%s", cp.GetNamespace(), cp.GetName()),
+		MaxAge:           spec.MaxAge,
+		AllowCredentials: spec.AllowCredentials != nil && *spec.AllowCredentials,
+	}
+
+	for _, o := range spec.AllowOrigins {
+		cors.AllowOrigins = append(cors.AllowOrigins, string(o))
+	}
+
+	// the CORS-safelisted methods are allowed by default
+	if len(spec.AllowMethods) == 0 {
+		cors.AllowMethods = []string{"GET", "HEAD", "POST"}
+	}
+
+	for _, m := range spec.AllowMethods {
+		cors.AllowMethods = append(cors.AllowMethods, string(m))
+	}
+
+	for _, h := range spec.AllowHeaders {
+		cors.AllowHeaders = append(cors.AllowHeaders, string(h))
+	}
+
+	for _, h := range spec.ExposeHeaders {
+		cors.ExposeHeaders = append(cors.ExposeHeaders, string(h))
+	}
+
+	return cors
+}
+
+// convertCache converts the effective CachePolicy among the effective policies into Cache.
+// The zone of the cache is named after the effective CachePolicy, so that every Policy has its own zone.
+// If there is no effective CachePolicy, it returns nil.
+func convertCache(effectivePolicies []policies.Policy) *Cache {
+	cp, ok := policies.FindPolicy[*ngfAPI.CachePolicy](effectivePolicies)
+	if !ok {
+		return nil
+	}
+
+	spec := cp.Spec
+
+	cache := &Cache{
+		Zone: CacheZone{
+			Name: fmt.Sprintf("%s_%s", cp.GetNamespace(), cp.GetName()),
+		},
+	}
+
+	if spec.ZoneSize != nil {
+		cache.Zone.Size = string(*spec.ZoneSize)
+	}
+
+	if spec.MaxSize != nil {
+		cache.Zone.MaxSize = string(*spec.MaxSize)
+	}
+
+	if spec.Inactive != nil {
+		cache.Zone.Inactive = string(*spec.Inactive)
+	}
+
+	if spec.Key != nil {
+		cache.Key = *spec.Key
+	}
+
+	for _, v := range spec.Valid {
+		valid := CacheValid{Time: string(v.Time)}
+		for _, code := range v.Codes {
+			valid.Codes = append(valid.Codes, int32(code))
+		}
+
+		cache.Valid = append(cache.Valid, valid)
+	}
+
+	for _, h := range spec.BypassHeaders {
+		cache.BypassHeaders = append(cache.BypassHeaders, string(h))
+	}
+
+	return cache
+}
+
+// convertCompression converts the effective CompressionPolicy among the effective policies into Compression.
+// If there is no effective CompressionPolicy, it returns nil.
+func convertCompression(effectivePolicies []policies.Policy) *Compression {
+	cp, ok := policies.FindPolicy[*ngfAPI.CompressionPolicy](effectivePolicies)
+	if !ok {
+		return nil
+	}
+
+	spec := cp.Spec
+
+	compression := &Compression{
+		// the compression is enabled unless it is turned off explicitly
+		Enabled:   spec.Enabled == nil || *spec.Enabled,
+		MinLength: spec.MinLength,
+		Level:     spec.Level,
+	}
+
+	for _, t := range spec.Types {
+		compression.Types = append(compression.Types, string(t))
+	}
+
+	return compression
+}
+
+// convertAccessLog converts the effective AccessLogPolicy among the effective policies into AccessLog.
+// The format of the access log is named after the effective AccessLogPolicy, so that every Policy has its own format.
+// If there is no effective AccessLogPolicy, it returns nil.
+func convertAccessLog(effectivePolicies []policies.Policy) *AccessLog {
+	alp, ok := policies.FindPolicy[*ngfAPI.AccessLogPolicy](effectivePolicies)
+	if !ok {
+		return nil
+	}
+
+	spec := alp.Spec
+
+	// the access logs are enabled unless they are turned off explicitly
+	if spec.Enabled != nil && !*spec.Enabled {
+		return &AccessLog{}
+	}
+
+	accessLog := &AccessLog{
+		Format: &LogFormat{
+			Name: fmt.Sprintf("%s_%s", alp.GetNamespace(), alp.GetName()),
+		},
+	}
+
+	if spec.Format != nil {
+		for _, f := range spec.Format.Fields {
+			accessLog.Format.Fields = append(accessLog.Format.Fields, LogField{Name: f.Name, Value: f.Value})
+		}
+
+		accessLog.Format.TraceContext = spec.Format.TraceContext != nil && *spec.Format.TraceContext
+		accessLog.Format.RouteInfo = spec.Format.RouteInfo != nil && *spec.Format.RouteInfo
+	}
+
+	if spec.Destination != nil && spec.Destination.Syslog != nil {
+		syslog := spec.Destination.Syslog
+
+		accessLog.Syslog = &SyslogServer{Address: syslog.Server}
+
+		if syslog.Facility != nil {
+			accessLog.Syslog.Facility = string(*syslog.Facility)
+		}
+
+		if syslog.Tag != nil {
+			accessLog.Syslog.Tag = *syslog.Tag
+		}
+	}
+
+	return accessLog
+}
+
+// convertErrorPages converts the resolved error pages of an ErrorPagePolicy into ErrorPages. The pages with
+// an unresolved backendRef are skipped, so that the original error responses are returned.
+func convertErrorPages(errorPages []graph.ErrorPage) []ErrorPage {
+	if len(errorPages) == 0 {
+		return nil
+	}
+
+	converted := make([]ErrorPage, 0, len(errorPages))
+
+	for _, page := range errorPages {
+		errorPage := ErrorPage{
+			ResponseCode: page.ResponseCode,
+			Codes:        page.Codes,
+		}
+
+		if page.BackendRef != nil {
+			if !page.BackendRef.Valid {
+				continue
+			}
+
+			backend := convertBackendRef(*page.BackendRef)
+			errorPage.Backend = &backend
+		} else {
+			errorPage.FileID = generateErrorPageFileID(page.Content)
+			errorPage.ContentType = page.ContentType
+		}
+
+		converted = append(converted, errorPage)
+	}
+
+	return converted
+}
+
+// convertUpstreamSettings converts the effective UpstreamSettingsPolicy among the effective policies into
+// UpstreamSettings. If there is no effective UpstreamSettingsPolicy, it returns nil.
+func convertUpstreamSettings(effectivePolicies []policies.Policy) *UpstreamSettings {
+	usp, ok := policies.FindPolicy[*ngfAPI.UpstreamSettingsPolicy](effectivePolicies)
+	if !ok {
+		return nil
+	}
+
+	spec := usp.Spec
+	settings := &UpstreamSettings{}
+
+	if spec.LoadBalancing != nil {
+		settings.LoadBalancingMethod = convertLoadBalancingMethod(spec.LoadBalancing.Method)
+		if spec.LoadBalancing.Key != nil {
+			settings.HashKey = *spec.LoadBalancing.Key
+		}
+		settings.HashConsistent = spec.LoadBalancing.Consistent != nil && *spec.LoadBalancing.Consistent
+	}
+
+	if spec.ZoneSize != nil {
+		settings.ZoneSize = string(*spec.ZoneSize)
+	}
+
+	if spec.KeepAlive != nil {
+		settings.KeepAliveConnections = spec.KeepAlive.Connections
+		settings.KeepAliveRequests = spec.KeepAlive.Requests
+		if spec.KeepAlive.Time != nil {
+			settings.KeepAliveTime = string(*spec.KeepAlive.Time)
+		}
+		if spec.KeepAlive.Timeout != nil {
+			settings.KeepAliveTimeout = string(*spec.KeepAlive.Timeout)
+		}
+	}
+
+	return settings
+}
+
+func convertLoadBalancingMethod(method ngfAPI.LoadBalancingMethod) LoadBalancingMethod {
+	switch method {
+	case ngfAPI.LoadBalancingRoundRobin:
+		return LoadBalancingRoundRobin
+	case ngfAPI.LoadBalancingLeastConn:
+		return LoadBalancingLeastConn
+	case ngfAPI.LoadBalancingIPHash:
+		return LoadBalancingIPHash
+	case ngfAPI.LoadBalancingHash:
+		return LoadBalancingHash
+	case ngfAPI.LoadBalancingRandom:
+		return LoadBalancingRandom
+	default:
+		panic(fmt.Sprintf("unsupported load balancing method: %s", method))
+	}
+}
+
 func convertPathType(pathType v1.PathMatchType) PathType {
 	switch pathType {
 	case v1.PathMatchPathPrefix:
 		return PathTypePrefix
 	case v1.PathMatchExact:
 		return PathTypeExact
+	case v1.PathMatchRegularExpression:
+		return PathTypeRegularExpression
 	default:
 		panic(fmt.Sprintf("unsupported path type: %s", pathType))
 	}
@@ -104,3 +655,36 @@ func convertPathModifier(path *v1.HTTPPathModifier) *HTTPPathModifier {
 
 	return nil
 }
+
+// convertTracing converts the tracing settings of the effective ObservabilityPolicy among the effective policies
+// into Tracing. If there is no effective ObservabilityPolicy or it doesn't configure tracing, it returns nil.
+func convertTracing(effectivePolicies []policies.Policy) *Tracing {
+	op, ok := policies.FindPolicy[*ngfAPI.ObservabilityPolicy](effectivePolicies)
+	if !ok || op.Spec.Tracing == nil {
+		return nil
+	}
+
+	spec := op.Spec.Tracing
+
+	tracing := &Tracing{
+		Strategy: TraceStrategy(spec.Strategy),
+		Ratio:    spec.Ratio,
+	}
+
+	if spec.Context != nil {
+		tracing.Context = string(*spec.Context)
+	}
+
+	if spec.SpanName != nil {
+		tracing.SpanName = *spec.SpanName
+	}
+
+	if len(spec.SpanAttributes) > 0 {
+		tracing.SpanAttributes = make([]SpanAttribute, 0, len(spec.SpanAttributes))
+		for _, attr := range spec.SpanAttributes {
+			tracing.SpanAttributes = append(tracing.SpanAttributes, SpanAttribute{Key: attr.Key, Value: attr.Value})
+		}
+	}
+
+	return tracing
+}
@@ -2,11 +2,18 @@ package dataplane
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
 )
 
 func TestConvertMatch(t *testing.T) {
@@ -52,6 +59,7 @@ func TestConvertMatch(t *testing.T) {
 					{
 						Name:  "Test-Header",
 						Value: "test-header-value",
+						Type:  MatchTypeExact,
 					},
 				},
 			},
@@ -72,6 +80,7 @@ func TestConvertMatch(t *testing.T) {
 					{
 						Name:  "Test-Param",
 						Value: "test-param-value",
+						Type:  MatchTypeExact,
 					},
 				},
 			},
@@ -100,17 +109,55 @@ func TestConvertMatch(t *testing.T) {
 					{
 						Name:  "Test-Header",
 						Value: "test-header-value",
+						Type:  MatchTypeExact,
 					},
 				},
 				QueryParams: []HTTPQueryParamMatch{
 					{
 						Name:  "Test-Param",
 						Value: "test-param-value",
+						Type:  MatchTypeExact,
 					},
 				},
 			},
 			name: "path, method, header, and query param",
 		},
+		{
+			match: v1.HTTPRouteMatch{
+				Path: &path,
+				Headers: []v1.HTTPHeaderMatch{
+					{
+						Type:  helpers.GetPointer(v1.HeaderMatchRegularExpression),
+						Name:  "Test-Header",
+						Value: "^test-.*$",
+					},
+				},
+				QueryParams: []v1.HTTPQueryParamMatch{
+					{
+						Type:  helpers.GetPointer(v1.QueryParamMatchRegularExpression),
+						Name:  "Test-Param",
+						Value: "^test-.*$",
+					},
+				},
+			},
+			expected: Match{
+				Headers: []HTTPHeaderMatch{
+					{
+						Name:  "Test-Header",
+						Value: "^test-.*$",
+						Type:  MatchTypeRegularExpression,
+					},
+				},
+				QueryParams: []HTTPQueryParamMatch{
+					{
+						Name:  "Test-Param",
+						Value: "^test-.*$",
+						Type:  MatchTypeRegularExpression,
+					},
+				},
+			},
+			name: "path, regex header, and regex query param",
+		},
 	}
 
 	for _, test := range tests {
@@ -266,6 +313,155 @@ func TestConvertHTTPHeaderFilter(t *testing.T) {
 	}
 }
 
+func TestConvertHTTPTimeouts(t *testing.T) {
+	tests := []struct {
+		timeouts *v1.HTTPRouteTimeouts
+		expected *HTTPTimeouts
+		name     string
+	}{
+		{
+			timeouts: nil,
+			expected: nil,
+			name:     "nil",
+		},
+		{
+			timeouts: &v1.HTTPRouteTimeouts{},
+			expected: &HTTPTimeouts{},
+			name:     "empty",
+		},
+		{
+			timeouts: &v1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[v1.Duration]("1m30s"),
+				BackendRequest: helpers.GetPointer[v1.Duration]("0s"),
+			},
+			expected: &HTTPTimeouts{
+				Request:        helpers.GetPointer(90 * time.Second),
+				BackendRequest: helpers.GetPointer(time.Duration(0)),
+			},
+			name: "full",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := convertHTTPTimeouts(test.timeouts)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertClientSettings(t *testing.T) {
+	bodyPolicy := &ngfAPI.ClientSettingsPolicy{
+		Spec: ngfAPI.ClientSettingsPolicySpec{
+			Body: &ngfAPI.ClientBody{
+				MaxSize: helpers.GetPointer[ngfAPI.Size]("10m"),
+				Timeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+			},
+		},
+	}
+
+	fullPolicy := &ngfAPI.ClientSettingsPolicy{
+		Spec: ngfAPI.ClientSettingsPolicySpec{
+			Body: &ngfAPI.ClientBody{
+				MaxSize: helpers.GetPointer[ngfAPI.Size]("10m"),
+				Timeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+			},
+			KeepAlive: &ngfAPI.ClientKeepAlive{
+				Requests: helpers.GetPointer[int32](100),
+				Time:     helpers.GetPointer[ngfAPI.Duration]("1m"),
+				Timeout: &ngfAPI.ClientKeepAliveTimeout{
+					Server: helpers.GetPointer[ngfAPI.Duration]("20s"),
+					Header: helpers.GetPointer[ngfAPI.Duration]("10s"),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		expected *ClientSettings
+		name     string
+		policies []policies.Policy
+	}{
+		{
+			policies: nil,
+			expected: nil,
+			name:     "no policies",
+		},
+		{
+			policies: []policies.Policy{&ngfAPI.ObservabilityPolicy{}},
+			expected: nil,
+			name:     "no ClientSettingsPolicy",
+		},
+		{
+			policies: []policies.Policy{&ngfAPI.ClientSettingsPolicy{}},
+			expected: &ClientSettings{},
+			name:     "empty policy",
+		},
+		{
+			policies: []policies.Policy{bodyPolicy},
+			expected: &ClientSettings{
+				BodyMaxSize: "10m",
+				BodyTimeout: "30s",
+			},
+			name: "body settings",
+		},
+		{
+			policies: []policies.Policy{fullPolicy, &ngfAPI.ObservabilityPolicy{}},
+			expected: &ClientSettings{
+				BodyMaxSize:            "10m",
+				BodyTimeout:            "30s",
+				KeepAliveRequests:      helpers.GetPointer[int32](100),
+				KeepAliveTime:          "1m",
+				KeepAliveServerTimeout: "20s",
+				KeepAliveHeaderTimeout: "10s",
+			},
+			name: "all settings",
+		},
+		{
+			policies: []policies.Policy{
+				&ngfAPI.ClientSettingsPolicy{
+					Spec: ngfAPI.ClientSettingsPolicySpec{
+						Body: bodyPolicy.Spec.Body,
+						KeepAlive: &ngfAPI.ClientKeepAlive{
+							Timeout: &ngfAPI.ClientKeepAliveTimeout{
+								Server: helpers.GetPointer[ngfAPI.Duration]("20s"),
+								Header: helpers.GetPointer[ngfAPI.Duration]("10s"),
+							},
+						},
+						Override: &ngfAPI.ClientSettingsOverride{
+							Body: &ngfAPI.ClientBody{
+								MaxSize: helpers.GetPointer[ngfAPI.Size]("1m"),
+							},
+							KeepAlive: &ngfAPI.ClientKeepAlive{
+								Timeout: &ngfAPI.ClientKeepAliveTimeout{
+									Server: helpers.GetPointer[ngfAPI.Duration]("5s"),
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &ClientSettings{
+				BodyMaxSize:            "1m",
+				BodyTimeout:            "30s",
+				KeepAliveServerTimeout: "5s",
+			},
+			name: "override settings take precedence",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := convertClientSettings(test.policies)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
 func TestConvertPathType(t *testing.T) {
 	g := NewWithT(t)
 
@@ -283,7 +479,11 @@ func TestConvertPathType(t *testing.T) {
 			pathType: v1.PathMatchExact,
 		},
 		{
+			expected: PathTypeRegularExpression,
 			pathType: v1.PathMatchRegularExpression,
+		},
+		{
+			pathType: v1.PathMatchType("Unknown"),
 			panic:    true,
 		},
 	}
@@ -297,3 +497,836 @@ func TestConvertPathType(t *testing.T) {
 		}
 	}
 }
+
+func TestConvertTracing(t *testing.T) {
+	createPolicy := func(tracing *ngfAPI.Tracing) []policies.Policy {
+		return []policies.Policy{
+			&ngfAPI.ClientSettingsPolicy{},
+			&ngfAPI.ObservabilityPolicy{
+				Spec: ngfAPI.ObservabilityPolicySpec{Tracing: tracing},
+			},
+		}
+	}
+
+	tests := []struct {
+		expected *Tracing
+		name     string
+		policies []policies.Policy
+	}{
+		{
+			policies: []policies.Policy{&ngfAPI.ClientSettingsPolicy{}},
+			expected: nil,
+			name:     "no ObservabilityPolicy",
+		},
+		{
+			policies: createPolicy(nil),
+			expected: nil,
+			name:     "no tracing",
+		},
+		{
+			policies: createPolicy(&ngfAPI.Tracing{
+				Strategy: ngfAPI.TraceStrategyParent,
+			}),
+			expected: &Tracing{
+				Strategy: TraceStrategyParent,
+			},
+			name: "minimal tracing",
+		},
+		{
+			policies: createPolicy(&ngfAPI.Tracing{
+				Strategy: ngfAPI.TraceStrategyRatio,
+				Ratio:    helpers.GetPointer[int32](25),
+				Context:  helpers.GetPointer(ngfAPI.TraceContextExtract),
+				SpanName: helpers.GetPointer("my-span"),
+				SpanAttributes: []ngfAPI.SpanAttribute{
+					{Key: "key1", Value: "value1"},
+					{Key: "key2", Value: "value2"},
+				},
+			}),
+			expected: &Tracing{
+				Strategy: TraceStrategyRatio,
+				Ratio:    helpers.GetPointer[int32](25),
+				Context:  "extract",
+				SpanName: "my-span",
+				SpanAttributes: []SpanAttribute{
+					{Key: "key1", Value: "value1"},
+					{Key: "key2", Value: "value2"},
+				},
+			},
+			name: "full tracing",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := convertTracing(test.policies)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertRateLimit(t *testing.T) {
+	createPolicy := func(spec ngfAPI.RateLimitPolicySpec) []policies.Policy {
+		return []policies.Policy{
+			&ngfAPI.ClientSettingsPolicy{},
+			&ngfAPI.RateLimitPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "limit"},
+				Spec:       spec,
+			},
+		}
+	}
+
+	tests := []struct {
+		expected *RateLimit
+		name     string
+		policies []policies.Policy
+	}{
+		{
+			policies: []policies.Policy{&ngfAPI.ClientSettingsPolicy{}},
+			expected: nil,
+			name:     "no RateLimitPolicy",
+		},
+		{
+			policies: createPolicy(ngfAPI.RateLimitPolicySpec{Rate: "10r/s"}),
+			expected: &RateLimit{
+				Zone: RateLimitZone{
+					Name:    "test_limit",
+					KeyType: RateLimitKeyClientIP,
+					Rate:    "10r/s",
+				},
+			},
+			name: "minimal rate limit",
+		},
+		{
+			policies: createPolicy(ngfAPI.RateLimitPolicySpec{
+				Key: &ngfAPI.RateLimitKey{
+					Type:   ngfAPI.RateLimitKeyHeader,
+					Header: helpers.GetPointer("X-Api-Key"),
+				},
+				Rate:       "100r/m",
+				Burst:      helpers.GetPointer[int32](20),
+				NoDelay:    helpers.GetPointer(true),
+				RejectCode: helpers.GetPointer[int32](429),
+			}),
+			expected: &RateLimit{
+				Zone: RateLimitZone{
+					Name:    "test_limit",
+					KeyType: RateLimitKeyHeader,
+					KeyName: "X-Api-Key",
+					Rate:    "100r/m",
+				},
+				Burst:      helpers.GetPointer[int32](20),
+				RejectCode: helpers.GetPointer[int32](429),
+				NoDelay:    true,
+			},
+			name: "full rate limit with header key",
+		},
+		{
+			policies: createPolicy(ngfAPI.RateLimitPolicySpec{
+				Key: &ngfAPI.RateLimitKey{
+					Type:     ngfAPI.RateLimitKeyJWTClaim,
+					JWTClaim: helpers.GetPointer("sub"),
+				},
+				Rate:    "10r/s",
+				NoDelay: helpers.GetPointer(false),
+			}),
+			expected: &RateLimit{
+				Zone: RateLimitZone{
+					Name:    "test_limit",
+					KeyType: RateLimitKeyJWTClaim,
+					KeyName: "sub",
+					Rate:    "10r/s",
+				},
+			},
+			name: "JWT claim key",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := convertRateLimit(test.policies)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertRetry(t *testing.T) {
+	tests := []struct {
+		expected *Retry
+		name     string
+		policies []policies.Policy
+	}{
+		{
+			policies: []policies.Policy{&ngfAPI.ClientSettingsPolicy{}},
+			expected: nil,
+			name:     "no RetryPolicy",
+		},
+		{
+			policies: []policies.Policy{&ngfAPI.RetryPolicy{}},
+			expected: &Retry{},
+			name:     "empty RetryPolicy",
+		},
+		{
+			policies: []policies.Policy{
+				&ngfAPI.RetryPolicy{
+					Spec: ngfAPI.RetryPolicySpec{
+						Attempts: helpers.GetPointer[int32](3),
+						RetryOn: &ngfAPI.RetryOn{
+							Conditions: []ngfAPI.RetryCondition{
+								ngfAPI.RetryConditionError,
+								ngfAPI.RetryConditionTimeout,
+								ngfAPI.RetryConditionInvalidHeader,
+								ngfAPI.RetryConditionNonIdempotent,
+							},
+							StatusCodes: []ngfAPI.RetryStatusCode{502, 503},
+						},
+						TotalTimeout: helpers.GetPointer[ngfAPI.Duration]("10s"),
+					},
+				},
+			},
+			expected: &Retry{
+				Attempts:     helpers.GetPointer[int32](3),
+				TotalTimeout: "10s",
+				Conditions: []RetryCondition{
+					RetryConditionError,
+					RetryConditionTimeout,
+					RetryConditionInvalidHeader,
+					RetryConditionNonIdempotent,
+				},
+				StatusCodes: []int32{502, 503},
+			},
+			name: "full RetryPolicy",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := convertRetry(test.policies)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertRetryConditionPanics(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(func() { convertRetryCondition("invalid") }).To(Panic())
+}
+
+func TestConvertAccessControl(t *testing.T) {
+	tests := []struct {
+		expected *AccessControl
+		name     string
+		policies []policies.Policy
+	}{
+		{
+			policies: []policies.Policy{&ngfAPI.ClientSettingsPolicy{}},
+			expected: nil,
+			name:     "no AccessControlPolicy",
+		},
+		{
+			policies: []policies.Policy{
+				&ngfAPI.AccessControlPolicy{
+					Spec: ngfAPI.AccessControlPolicySpec{
+						Rules: []ngfAPI.AccessControlRule{
+							{Action: ngfAPI.AccessControlActionAllow, CIDR: "10.8.0.0/16"},
+						},
+					},
+				},
+			},
+			expected: &AccessControl{
+				Rules: []AccessControlRule{
+					{Action: AccessControlActionAllow, CIDR: "10.8.0.0/16"},
+				},
+			},
+			name: "AccessControlPolicy with rules only",
+		},
+		{
+			policies: []policies.Policy{
+				&ngfAPI.AccessControlPolicy{
+					Spec: ngfAPI.AccessControlPolicySpec{
+						Rules: []ngfAPI.AccessControlRule{
+							{Action: ngfAPI.AccessControlActionDeny, CIDR: "10.8.1.1"},
+							{Action: ngfAPI.AccessControlActionAllow, CIDR: "10.8.0.0/16"},
+						},
+						DefaultAction: helpers.GetPointer(ngfAPI.AccessControlActionDeny),
+						RealIP: &ngfAPI.RealIP{
+							TrustedAddresses: []ngfAPI.CIDR{"172.16.0.0/12"},
+							Recursive:        helpers.GetPointer(true),
+						},
+					},
+				},
+			},
+			expected: &AccessControl{
+				Rules: []AccessControlRule{
+					{Action: AccessControlActionDeny, CIDR: "10.8.1.1"},
+					{Action: AccessControlActionAllow, CIDR: "10.8.0.0/16"},
+				},
+				DefaultAction: AccessControlActionDeny,
+				RealIP: &RealIP{
+					Header:           "X-Forwarded-For",
+					TrustedAddresses: []string{"172.16.0.0/12"},
+					Recursive:        true,
+				},
+			},
+			name: "full AccessControlPolicy with default real IP header",
+		},
+		{
+			policies: []policies.Policy{
+				&ngfAPI.AccessControlPolicy{
+					Spec: ngfAPI.AccessControlPolicySpec{
+						Rules: []ngfAPI.AccessControlRule{
+							{Action: ngfAPI.AccessControlActionAllow, CIDR: "2001:db8::/32"},
+						},
+						RealIP: &ngfAPI.RealIP{
+							Header:           helpers.GetPointer(ngfAPI.RealIPHeaderXRealIP),
+							TrustedAddresses: []ngfAPI.CIDR{"10.0.0.1"},
+						},
+					},
+				},
+			},
+			expected: &AccessControl{
+				Rules: []AccessControlRule{
+					{Action: AccessControlActionAllow, CIDR: "2001:db8::/32"},
+				},
+				RealIP: &RealIP{
+					Header:           "X-Real-IP",
+					TrustedAddresses: []string{"10.0.0.1"},
+				},
+			},
+			name: "AccessControlPolicy with real IP header",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := convertAccessControl(test.policies)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertAccessControlActionPanics(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(func() { convertAccessControlAction("invalid") }).To(Panic())
+}
+
+func TestConvertCORS(t *testing.T) {
+	tests := []struct {
+		expected *CORS
+		name     string
+		policies []policies.Policy
+	}{
+		{
+			policies: []policies.Policy{&ngfAPI.ClientSettingsPolicy{}},
+			expected: nil,
+			name:     "no CORSPolicy",
+		},
+		{
+			policies: []policies.Policy{
+				&ngfAPI.CORSPolicy{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "cors"},
+					Spec: ngfAPI.CORSPolicySpec{
+						AllowOrigins: []ngfAPI.CORSOrigin{"*"},
+					},
+				},
+			},
+			expected: &CORS{
+				Name:         "test_cors",
+				AllowOrigins: []string{"*"},
+				AllowMethods: []string{"GET", "HEAD", "POST"},
+			},
+			name: "CORSPolicy with default methods",
+		},
+		{
+			policies: []policies.Policy{
+				&ngfAPI.CORSPolicy{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "cors"},
+					Spec: ngfAPI.CORSPolicySpec{
+						AllowOrigins:     []ngfAPI.CORSOrigin{"https://example.com", "https://*.example.com"},
+						AllowMethods:     []v1.HTTPMethod{v1.HTTPMethodGet, v1.HTTPMethodPut},
+						AllowHeaders:     []ngfAPI.HeaderName{"Authorization"},
+						ExposeHeaders:    []ngfAPI.HeaderName{"X-Request-Id"},
+						AllowCredentials: helpers.GetPointer(true),
+						MaxAge:           helpers.GetPointer[int32](600),
+					},
+				},
+			},
+			expected: &CORS{
+				Name:             "test_cors",
+				AllowOrigins:     []string{"https://example.com", "https://*.example.com"},
+				AllowMethods:     []string{"GET", "PUT"},
+				AllowHeaders:     []string{"Authorization"},
+				ExposeHeaders:    []string{"X-Request-Id"},
+				AllowCredentials: true,
+				MaxAge:           helpers.GetPointer[int32](600),
+			},
+			name: "full CORSPolicy",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := convertCORS(test.policies)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertCache(t *testing.T) {
+	tests := []struct {
+		expected *Cache
+		name     string
+		policies []policies.Policy
+	}{
+		{
+			policies: []policies.Policy{&ngfAPI.ClientSettingsPolicy{}},
+			expected: nil,
+			name:     "no CachePolicy",
+		},
+		{
+			policies: []policies.Policy{
+				&ngfAPI.CachePolicy{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "cache"},
+				},
+			},
+			expected: &Cache{
+				Zone: CacheZone{Name: "test_cache"},
+			},
+			name: "empty CachePolicy",
+		},
+		{
+			policies: []policies.Policy{
+				&ngfAPI.CachePolicy{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "cache"},
+					Spec: ngfAPI.CachePolicySpec{
+						ZoneSize: helpers.GetPointer[ngfAPI.Size]("1m"),
+						MaxSize:  helpers.GetPointer[ngfAPI.Size]("1g"),
+						Inactive: helpers.GetPointer[ngfAPI.Duration]("600s"),
+						Valid: []ngfAPI.CacheValid{
+							{Codes: []ngfAPI.CacheStatusCode{200, 301}, Time: "60s"},
+							{Time: "10s"},
+						},
+						Key:           helpers.GetPointer("$host$request_uri"),
+						BypassHeaders: []ngfAPI.HeaderName{"Cache-Bypass"},
+					},
+				},
+			},
+			expected: &Cache{
+				Zone: CacheZone{
+					Name:     "test_cache",
+					Size:     "1m",
+					MaxSize:  "1g",
+					Inactive: "600s",
+				},
+				Valid: []CacheValid{
+					{Codes: []int32{200, 301}, Time: "60s"},
+					{Time: "10s"},
+				},
+				Key:           "$host$request_uri",
+				BypassHeaders: []string{"Cache-Bypass"},
+			},
+			name: "full CachePolicy",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := convertCache(test.policies)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertCompression(t *testing.T) {
+	tests := []struct {
+		expected *Compression
+		name     string
+		policies []policies.Policy
+	}{
+		{
+			policies: []policies.Policy{&ngfAPI.ClientSettingsPolicy{}},
+			expected: nil,
+			name:     "no CompressionPolicy",
+		},
+		{
+			policies: []policies.Policy{&ngfAPI.CompressionPolicy{}},
+			expected: &Compression{Enabled: true},
+			name:     "empty CompressionPolicy enables compression",
+		},
+		{
+			policies: []policies.Policy{
+				&ngfAPI.CompressionPolicy{
+					Spec: ngfAPI.CompressionPolicySpec{
+						Enabled: helpers.GetPointer(false),
+					},
+				},
+			},
+			expected: &Compression{Enabled: false},
+			name:     "CompressionPolicy turns off compression",
+		},
+		{
+			policies: []policies.Policy{
+				&ngfAPI.CompressionPolicy{
+					Spec: ngfAPI.CompressionPolicySpec{
+						Enabled:   helpers.GetPointer(true),
+						MinLength: helpers.GetPointer[int32](1024),
+						Level:     helpers.GetPointer[int32](5),
+						Types:     []ngfAPI.MIMEType{"application/json", "text/css"},
+					},
+				},
+			},
+			expected: &Compression{
+				Enabled:   true,
+				MinLength: helpers.GetPointer[int32](1024),
+				Level:     helpers.GetPointer[int32](5),
+				Types:     []string{"application/json", "text/css"},
+			},
+			name: "full CompressionPolicy",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := convertCompression(test.policies)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertAccessLog(t *testing.T) {
+	tests := []struct {
+		expected *AccessLog
+		name     string
+		policies []policies.Policy
+	}{
+		{
+			policies: []policies.Policy{&ngfAPI.ClientSettingsPolicy{}},
+			expected: nil,
+			name:     "no AccessLogPolicy",
+		},
+		{
+			policies: []policies.Policy{
+				&ngfAPI.AccessLogPolicy{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "logs"},
+				},
+			},
+			expected: &AccessLog{Format: &LogFormat{Name: "test_logs"}},
+			name:     "empty AccessLogPolicy enables access logs",
+		},
+		{
+			policies: []policies.Policy{
+				&ngfAPI.AccessLogPolicy{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "logs"},
+					Spec: ngfAPI.AccessLogPolicySpec{
+						Enabled: helpers.GetPointer(false),
+						Format:  &ngfAPI.AccessLogFormat{TraceContext: helpers.GetPointer(true)},
+					},
+				},
+			},
+			expected: &AccessLog{},
+			name:     "AccessLogPolicy turns off access logs",
+		},
+		{
+			policies: []policies.Policy{
+				&ngfAPI.AccessLogPolicy{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "logs"},
+					Spec: ngfAPI.AccessLogPolicySpec{
+						Enabled: helpers.GetPointer(true),
+						Format: &ngfAPI.AccessLogFormat{
+							Fields: []ngfAPI.AccessLogField{
+								{Name: "status", Value: "$status"},
+								{Name: "request", Value: "$request_method $uri"},
+							},
+							TraceContext: helpers.GetPointer(true),
+							RouteInfo:    helpers.GetPointer(true),
+						},
+						Destination: &ngfAPI.AccessLogDestination{
+							Type: ngfAPI.AccessLogDestinationSyslog,
+							Syslog: &ngfAPI.SyslogDestination{
+								Server:   "syslog.example.com",
+								Facility: helpers.GetPointer[ngfAPI.SyslogFacility]("local0"),
+								Tag:      helpers.GetPointer("ngf"),
+							},
+						},
+					},
+				},
+			},
+			expected: &AccessLog{
+				Format: &LogFormat{
+					Name: "test_logs",
+					Fields: []LogField{
+						{Name: "status", Value: "$status"},
+						{Name: "request", Value: "$request_method $uri"},
+					},
+					TraceContext: true,
+					RouteInfo:    true,
+				},
+				Syslog: &SyslogServer{
+					Address:  "syslog.example.com",
+					Facility: "local0",
+					Tag:      "ngf",
+				},
+			},
+			name: "full AccessLogPolicy",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := convertAccessLog(test.policies)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertBasicAuth(t *testing.T) {
+	tests := []struct {
+		expected *BasicAuth
+		name     string
+		policies []policies.Policy
+	}{
+		{
+			policies: []policies.Policy{&ngfAPI.ClientSettingsPolicy{}},
+			expected: nil,
+			name:     "no BasicAuthPolicy",
+		},
+		{
+			policies: []policies.Policy{
+				&ngfAPI.BasicAuthPolicy{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "auth"},
+					Spec: ngfAPI.BasicAuthPolicySpec{
+						SecretRef: v1.SecretObjectReference{
+							Name: "htpasswd",
+						},
+					},
+				},
+			},
+			expected: &BasicAuth{
+				Realm:      "Restricted",
+				UserFileID: "auth_user_file_test_htpasswd",
+			},
+			name: "BasicAuthPolicy with default realm",
+		},
+		{
+			policies: []policies.Policy{
+				&ngfAPI.BasicAuthPolicy{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "auth"},
+					Spec: ngfAPI.BasicAuthPolicySpec{
+						SecretRef: v1.SecretObjectReference{
+							Namespace: helpers.GetPointer[v1.Namespace]("other"),
+							Name:      "htpasswd",
+						},
+						Realm: helpers.GetPointer("Dashboards"),
+					},
+				},
+			},
+			expected: &BasicAuth{
+				Realm:      "Dashboards",
+				UserFileID: "auth_user_file_other_htpasswd",
+			},
+			name: "BasicAuthPolicy with realm and Secret in another namespace",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := convertBasicAuth(test.policies)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertExternalAuth(t *testing.T) {
+	backendRef := &graph.BackendRef{
+		SvcNsName:   types.NamespacedName{Namespace: "test", Name: "authz"},
+		ServicePort: apiv1.ServicePort{Port: 80},
+		Weight:      1,
+		Valid:       true,
+	}
+
+	tests := []struct {
+		backendRef *graph.BackendRef
+		expected   *ExternalAuth
+		name       string
+		policies   []policies.Policy
+	}{
+		{
+			policies: []policies.Policy{&ngfAPI.ClientSettingsPolicy{}},
+			expected: nil,
+			name:     "no ExternalAuthPolicy",
+		},
+		{
+			policies:   []policies.Policy{&ngfAPI.ExternalAuthPolicy{}},
+			backendRef: backendRef,
+			expected: &ExternalAuth{
+				Backend: Backend{
+					UpstreamName: "test_authz_80",
+					Weight:       1,
+					Valid:        true,
+				},
+			},
+			name: "ExternalAuthPolicy with only backendRef",
+		},
+		{
+			policies: []policies.Policy{
+				&ngfAPI.ExternalAuthPolicy{
+					Spec: ngfAPI.ExternalAuthPolicySpec{
+						Path:                  helpers.GetPointer("/oauth2/auth"),
+						ForwardRequestHeaders: []ngfAPI.HeaderName{"Authorization", "Cookie"},
+						CopyResponseHeaders:   []ngfAPI.HeaderName{"X-User"},
+					},
+				},
+			},
+			backendRef: &graph.BackendRef{SvcNsName: types.NamespacedName{Namespace: "test", Name: "not-exist"}},
+			expected: &ExternalAuth{
+				Path:                  "/oauth2/auth",
+				ForwardRequestHeaders: []string{"Authorization", "Cookie"},
+				CopyResponseHeaders:   []string{"X-User"},
+				Backend:               Backend{},
+			},
+			name: "ExternalAuthPolicy with invalid backendRef",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := convertExternalAuth(test.policies, test.backendRef)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertUpstreamSettings(t *testing.T) {
+	tests := []struct {
+		expected *UpstreamSettings
+		name     string
+		policies []policies.Policy
+	}{
+		{
+			policies: []policies.Policy{&ngfAPI.ClientSettingsPolicy{}},
+			expected: nil,
+			name:     "no UpstreamSettingsPolicy",
+		},
+		{
+			policies: []policies.Policy{&ngfAPI.UpstreamSettingsPolicy{}},
+			expected: &UpstreamSettings{},
+			name:     "empty UpstreamSettingsPolicy",
+		},
+		{
+			policies: []policies.Policy{
+				&ngfAPI.UpstreamSettingsPolicy{
+					Spec: ngfAPI.UpstreamSettingsPolicySpec{
+						LoadBalancing: &ngfAPI.LoadBalancing{
+							Method:     ngfAPI.LoadBalancingHash,
+							Key:        helpers.GetPointer("$request_uri"),
+							Consistent: helpers.GetPointer(true),
+						},
+						KeepAlive: &ngfAPI.UpstreamKeepAlive{
+							Connections: helpers.GetPointer[int32](16),
+							Requests:    helpers.GetPointer[int32](1000),
+							Time:        helpers.GetPointer[ngfAPI.Duration]("1h"),
+							Timeout:     helpers.GetPointer[ngfAPI.Duration]("60s"),
+						},
+						ZoneSize: helpers.GetPointer[ngfAPI.Size]("2m"),
+					},
+				},
+			},
+			expected: &UpstreamSettings{
+				LoadBalancingMethod:  LoadBalancingHash,
+				HashKey:              "$request_uri",
+				HashConsistent:       true,
+				ZoneSize:             "2m",
+				KeepAliveConnections: helpers.GetPointer[int32](16),
+				KeepAliveRequests:    helpers.GetPointer[int32](1000),
+				KeepAliveTime:        "1h",
+				KeepAliveTimeout:     "60s",
+			},
+			name: "full UpstreamSettingsPolicy",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := convertUpstreamSettings(test.policies)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertLoadBalancingMethod(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(convertLoadBalancingMethod(ngfAPI.LoadBalancingRoundRobin)).To(Equal(LoadBalancingRoundRobin))
+	g.Expect(convertLoadBalancingMethod(ngfAPI.LoadBalancingLeastConn)).To(Equal(LoadBalancingLeastConn))
+	g.Expect(convertLoadBalancingMethod(ngfAPI.LoadBalancingIPHash)).To(Equal(LoadBalancingIPHash))
+	g.Expect(convertLoadBalancingMethod(ngfAPI.LoadBalancingHash)).To(Equal(LoadBalancingHash))
+	g.Expect(convertLoadBalancingMethod(ngfAPI.LoadBalancingRandom)).To(Equal(LoadBalancingRandom))
+	g.Expect(func() { convertLoadBalancingMethod("invalid") }).To(Panic())
+}
+
+func TestConvertErrorPages(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(convertErrorPages(nil)).To(BeNil())
+
+	errorPages := []graph.ErrorPage{
+		{
+			BackendRef: &graph.BackendRef{
+				SvcNsName:   types.NamespacedName{Namespace: "test", Name: "errors"},
+				ServicePort: apiv1.ServicePort{Port: 80},
+				Weight:      1,
+				Valid:       true,
+			},
+			Codes: []int32{502, 503},
+		},
+		{
+			BackendRef: &graph.BackendRef{SvcNsName: types.NamespacedName{Namespace: "test", Name: "unresolved"}},
+			Codes:      []int32{500},
+		},
+		{
+			ResponseCode: helpers.GetPointer[int32](200),
+			ContentType:  "text/html",
+			Content:      []byte("<h1>Not Found</h1>"),
+			Codes:        []int32{404},
+		},
+	}
+
+	expected := []ErrorPage{
+		{
+			Backend: &Backend{UpstreamName: "test_errors_80", Weight: 1, Valid: true},
+			Codes:   []int32{502, 503},
+		},
+		{
+			ResponseCode: helpers.GetPointer[int32](200),
+			FileID:       generateErrorPageFileID([]byte("<h1>Not Found</h1>")),
+			ContentType:  "text/html",
+			Codes:        []int32{404},
+		},
+	}
+
+	g.Expect(convertErrorPages(errorPages)).To(Equal(expected))
+}
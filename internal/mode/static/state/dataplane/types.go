@@ -2,6 +2,7 @@ package dataplane
 
 import (
 	"fmt"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -17,6 +18,8 @@ const (
 	PathTypePrefix PathType = "prefix"
 	// PathTypeExact indicates that the path is exact.
 	PathTypeExact PathType = "exact"
+	// PathTypeRegularExpression indicates that the path is a regular expression.
+	PathTypeRegularExpression PathType = "regularExpression"
 )
 
 // Configuration is an intermediate representation of dataplane configuration.
@@ -25,14 +28,34 @@ type Configuration struct {
 	SSLKeyPairs map[SSLKeyPairID]SSLKeyPair
 	// CertBundles holds all unique Certificate Bundles.
 	CertBundles map[CertBundleID]CertBundle
+	// AuthUserFiles holds all unique files with the users and passwords of the basic authentication.
+	AuthUserFiles map[AuthUserFileID]AuthUserFile
+	// ErrorPageFiles holds all unique files with the content of the error pages.
+	ErrorPageFiles map[ErrorPageFileID]ErrorPageFile
 	// HTTPServers holds all HTTPServers.
 	HTTPServers []VirtualServer
 	// SSLServers holds all SSLServers.
 	SSLServers []VirtualServer
+	// TLSPassthroughServers holds all TLSPassthroughServers.
+	TLSPassthroughServers []Layer4VirtualServer
+	// TCPServers holds all TCPServers.
+	TCPServers []Layer4VirtualServer
+	// UDPServers holds all UDPServers.
+	UDPServers []Layer4VirtualServer
 	// Upstreams holds all unique Upstreams.
 	Upstreams []Upstream
+	// StreamUpstreams holds all unique stream Upstreams.
+	StreamUpstreams []Upstream
 	// BackendGroups holds all unique BackendGroups.
 	BackendGroups []BackendGroup
+	// RateLimitZones holds all unique RateLimitZones.
+	RateLimitZones []RateLimitZone
+	// CacheZones holds all unique CacheZones.
+	CacheZones []CacheZone
+	// LogFormats holds all unique LogFormats.
+	LogFormats []LogFormat
+	// Telemetry holds the OpenTelemetry configuration of the data plane.
+	Telemetry Telemetry
 	// Version represents the version of the generated configuration.
 	Version int
 }
@@ -48,6 +71,20 @@ type CertBundleID string
 // CertBundle is a Certificate bundle.
 type CertBundle []byte
 
+// AuthUserFileID is a unique identifier for an AuthUserFile.
+// The ID is safe to use as a file name.
+type AuthUserFileID string
+
+// AuthUserFile is a file with the users and passwords of the basic authentication in the htpasswd format.
+type AuthUserFile []byte
+
+// ErrorPageFileID is a unique identifier for an ErrorPageFile.
+// The ID is safe to use as a file name.
+type ErrorPageFileID string
+
+// ErrorPageFile is a file with the content of an error page.
+type ErrorPageFile []byte
+
 // SSLKeyPair is an SSL private/public key pair.
 type SSLKeyPair struct {
 	// Cert is the certificate.
@@ -60,16 +97,44 @@ type SSLKeyPair struct {
 type VirtualServer struct {
 	// SSL holds the SSL configuration for the server.
 	SSL *SSL
+	// ClientSettings holds the client settings for the server. If nil, no client settings are configured.
+	ClientSettings *ClientSettings
+	// RateLimit holds the rate limit for the server. If nil, the requests are not limited.
+	RateLimit *RateLimit
+	// Retry holds the retry settings for the server. If nil, the NGINX defaults apply.
+	Retry *Retry
+	// AccessControl holds the access control settings for the server, from the listener of the server or from the
+	// Gateway. If nil, all requests are allowed.
+	AccessControl *AccessControl
+	// Compression holds the compression settings for the server. If nil, the responses are not compressed.
+	Compression *Compression
+	// AccessLog holds the access log settings for the server. If nil, the default access logs of NGINX are written.
+	// Like the error pages, it also applies to the default server.
+	AccessLog *AccessLog
 	// Hostname is the hostname of the server.
 	Hostname string
 	// PathRules is a collection of routing rules.
 	PathRules []PathRule
+	// ErrorPages are the error pages of the server. Unlike the other settings of the Gateway, they also apply to
+	// the default server.
+	ErrorPages []ErrorPage
 	// IsDefault indicates whether the server is the default server.
 	IsDefault bool
 	// Port is the port of the server.
 	Port int32
 }
 
+// Layer4VirtualServer is a virtual server for Layer 4 traffic.
+type Layer4VirtualServer struct {
+	// Hostname is the hostname of the server. For TLS passthrough, it is matched against the SNI of the connection.
+	// It is empty for TCP and UDP servers.
+	Hostname string
+	// BackendGroup is the group of Backends the traffic is proxied to.
+	BackendGroup BackendGroup
+	// Port is the port of the server.
+	Port int32
+}
+
 // Upstream is a pool of endpoints to be load balanced.
 type Upstream struct {
 	// Name is the name of the Upstream. Will be unique for each service/port combination.
@@ -78,6 +143,47 @@ type Upstream struct {
 	ErrorMsg string
 	// Endpoints are the endpoints of the Upstream.
 	Endpoints []resolver.Endpoint
+	// Settings holds the settings of the effective UpstreamSettingsPolicy of the Service of the Upstream.
+	// If nil, the default settings are used.
+	Settings *UpstreamSettings
+}
+
+// LoadBalancingMethod is the load balancing method of an Upstream.
+type LoadBalancingMethod string
+
+const (
+	// LoadBalancingRoundRobin distributes the requests between the servers in turn.
+	LoadBalancingRoundRobin LoadBalancingMethod = "roundRobin"
+	// LoadBalancingLeastConn passes a request to the server with the least number of active connections.
+	LoadBalancingLeastConn LoadBalancingMethod = "leastConn"
+	// LoadBalancingIPHash distributes the requests between the servers based on the client IP addresses.
+	LoadBalancingIPHash LoadBalancingMethod = "ipHash"
+	// LoadBalancingHash distributes the requests between the servers based on the hashed key.
+	LoadBalancingHash LoadBalancingMethod = "hash"
+	// LoadBalancingRandom passes a request to a randomly selected server.
+	LoadBalancingRandom LoadBalancingMethod = "random"
+)
+
+// UpstreamSettings holds the settings of an Upstream.
+// The values are in the NGINX format. An empty value means the setting is not configured.
+type UpstreamSettings struct {
+	// LoadBalancingMethod is the load balancing method. If empty, the default method is used.
+	LoadBalancingMethod LoadBalancingMethod
+	// HashKey is the key of the hash load balancing method.
+	HashKey string
+	// ZoneSize is the size of the shared memory zone of the Upstream. If empty, the default size is used.
+	ZoneSize string
+	// KeepAliveTime is the maximum time during which requests can be processed through one keep-alive connection.
+	KeepAliveTime string
+	// KeepAliveTimeout is the timeout during which an idle keep-alive connection to a server stays open.
+	KeepAliveTimeout string
+	// KeepAliveConnections is the maximum number of idle keep-alive connections to the servers per worker process.
+	// If nil, the keep-alive connections are disabled.
+	KeepAliveConnections *int32
+	// KeepAliveRequests is the maximum number of requests that can be served through one keep-alive connection.
+	KeepAliveRequests *int32
+	// HashConsistent indicates whether the hash load balancing method uses the ketama consistent hashing.
+	HashConsistent bool
 }
 
 // SSL is the SSL configuration for a server.
@@ -94,6 +200,8 @@ type PathRule struct {
 	PathType PathType
 	// MatchRules holds routing rules.
 	MatchRules []MatchRule
+	// GRPC indicates if this is a gRPC rule.
+	GRPC bool
 }
 
 // InvalidHTTPFilter is a special filter for handling the case when configured filters are invalid.
@@ -110,6 +218,10 @@ type HTTPFilters struct {
 	RequestURLRewrite *HTTPURLRewriteFilter
 	// RequestHeaderModifiers holds the HTTPHeaderFilter.
 	RequestHeaderModifiers *HTTPHeaderFilter
+	// ResponseHeaderModifiers holds the HTTPHeaderFilter.
+	ResponseHeaderModifiers *HTTPHeaderFilter
+	// RequestMirror holds the HTTPRequestMirrorFilter.
+	RequestMirror *HTTPRequestMirrorFilter
 }
 
 // HTTPHeader represents an HTTP header.
@@ -142,6 +254,12 @@ type HTTPRequestRedirectFilter struct {
 	StatusCode *int
 }
 
+// HTTPRequestMirrorFilter mirrors HTTP requests to a backend. Responses from the backend are ignored.
+type HTTPRequestMirrorFilter struct {
+	// Backend is the backend that receives the mirrored requests.
+	Backend Backend
+}
+
 // HTTPURLRewriteFilter rewrites HTTP requests.
 type HTTPURLRewriteFilter struct {
 	// Hostname is the hostname of the rewrite.
@@ -169,12 +287,24 @@ type HTTPPathModifier struct {
 	Type PathModifierType
 }
 
+// MatchType is the type of a header or query parameter match.
+type MatchType string
+
+const (
+	// MatchTypeExact indicates that the value must be equal to the value of the match.
+	MatchTypeExact MatchType = "exact"
+	// MatchTypeRegularExpression indicates that the value must match the regular expression of the match.
+	MatchTypeRegularExpression MatchType = "regularExpression"
+)
+
 // HTTPHeaderMatch matches an HTTP header.
 type HTTPHeaderMatch struct {
 	// Name is the name of the header to match.
 	Name string
 	// Value is the value of the header to match.
 	Value string
+	// Type is the type of the match.
+	Type MatchType
 }
 
 // HTTPQueryParamMatch matches an HTTP query parameter.
@@ -183,6 +313,8 @@ type HTTPQueryParamMatch struct {
 	Name string
 	// Value is the value of the query parameter to match.
 	Value string
+	// Type is the type of the match.
+	Type MatchType
 }
 
 // MatchRule represents a routing rule. It corresponds directly to a Match in the HTTPRoute resource.
@@ -196,10 +328,363 @@ type MatchRule struct {
 	Source *metav1.ObjectMeta
 	// Match holds the match for the rule.
 	Match Match
+	// Timeouts holds the timeouts for the rule. If nil, no timeouts are configured.
+	Timeouts *HTTPTimeouts
+	// Policies holds the settings of the Policies that apply to the rule.
+	Policies RulePolicies
 	// BackendGroup is the group of Backends that the rule routes to.
 	BackendGroup BackendGroup
 }
 
+// RulePolicies holds the settings of the Policies that apply to a routing rule. The settings are the same for all
+// the rules of a route.
+type RulePolicies struct {
+	// ClientSettings holds the client settings for the rule. If nil, no client settings are configured.
+	ClientSettings *ClientSettings
+	// Tracing holds the tracing settings for the rule. If nil, tracing is not configured.
+	Tracing *Tracing
+	// RateLimit holds the rate limit for the rule. If nil, the rate limit of the server applies.
+	RateLimit *RateLimit
+	// Retry holds the retry settings for the rule. If nil, the retry settings of the server apply.
+	Retry *Retry
+	// BasicAuth holds the basic authentication settings for the rule. If nil, the requests are not authenticated.
+	BasicAuth *BasicAuth
+	// ExternalAuth holds the external authorization settings for the rule. If nil, the requests are not authorized
+	// by an external service.
+	ExternalAuth *ExternalAuth
+	// AccessControl holds the access control settings for the rule. If nil, the access control settings of
+	// the server apply.
+	AccessControl *AccessControl
+	// CORS holds the CORS settings for the rule. If nil, the CORS requests are not handled.
+	CORS *CORS
+	// Cache holds the caching settings for the rule. If nil, the responses are not cached.
+	Cache *Cache
+	// Compression holds the compression settings for the rule. If nil, the compression settings of the server apply.
+	Compression *Compression
+	// AccessLog holds the access log settings for the rule. If nil, the access log settings of the server apply.
+	AccessLog *AccessLog
+	// ErrorPages are the error pages of the rule. The error pages of the server apply to the other status codes.
+	ErrorPages []ErrorPage
+}
+
+// HTTPTimeouts holds the timeouts of a routing rule. A zero duration means no timeout.
+type HTTPTimeouts struct {
+	// Request is the timeout for the entire request from the client, including retries to the backend.
+	Request *time.Duration
+	// BackendRequest is the timeout for a single request from the gateway to a backend.
+	BackendRequest *time.Duration
+}
+
+// ClientSettings holds the settings of the connection between the client and NGINX.
+// The values are in the NGINX format. An empty value means the setting is not configured.
+type ClientSettings struct {
+	// BodyMaxSize is the maximum allowed size of the client request body.
+	BodyMaxSize string
+	// BodyTimeout is the timeout for reading the client request body.
+	BodyTimeout string
+	// KeepAliveTime is the maximum time during which requests can be processed through one keep-alive connection.
+	KeepAliveTime string
+	// KeepAliveServerTimeout is the timeout during which a keep-alive client connection stays open on the server side.
+	KeepAliveServerTimeout string
+	// KeepAliveHeaderTimeout is the timeout in the "Keep-Alive: timeout=time" response header field.
+	KeepAliveHeaderTimeout string
+	// KeepAliveRequests is the maximum number of requests that can be served through one keep-alive connection.
+	KeepAliveRequests *int32
+}
+
+// RateLimitKeyType is the type of the key by which the requests are limited.
+type RateLimitKeyType string
+
+const (
+	// RateLimitKeyClientIP limits the requests per client IP address.
+	RateLimitKeyClientIP RateLimitKeyType = "clientIP"
+	// RateLimitKeyHeader limits the requests per value of a request header.
+	RateLimitKeyHeader RateLimitKeyType = "header"
+	// RateLimitKeyJWTClaim limits the requests per value of a JWT claim.
+	RateLimitKeyJWTClaim RateLimitKeyType = "jwtClaim"
+)
+
+// RateLimitZone is the shared state of a rate limit. The requests with the same key are limited together.
+type RateLimitZone struct {
+	// Name is the unique name of the zone.
+	Name string
+	// KeyType is the type of the key.
+	KeyType RateLimitKeyType
+	// KeyName is the name of the header or the JWT claim. It is empty for the client IP key.
+	KeyName string
+	// Rate is the maximum rate of the requests per key in the NGINX format, for example, 10r/s.
+	Rate string
+}
+
+// RateLimit holds the settings of a rate limit.
+type RateLimit struct {
+	// Burst is the maximum number of requests that exceed the rate and are queued. If nil, the NGINX default is used.
+	Burst *int32
+	// RejectCode is the status code of the rejected requests. If nil, the NGINX default is used.
+	RejectCode *int32
+	// Zone is the zone of the rate limit.
+	Zone RateLimitZone
+	// NoDelay indicates whether the queued requests are processed without delay.
+	NoDelay bool
+}
+
+// RetryCondition is a condition in which a request is passed to the next upstream server.
+type RetryCondition string
+
+const (
+	// RetryConditionError retries the request if an error occurred while communicating with the upstream server.
+	RetryConditionError RetryCondition = "error"
+	// RetryConditionTimeout retries the request if a timeout occurred while communicating with the upstream server.
+	RetryConditionTimeout RetryCondition = "timeout"
+	// RetryConditionInvalidHeader retries the request if the upstream server returned an invalid response.
+	RetryConditionInvalidHeader RetryCondition = "invalidHeader"
+	// RetryConditionNonIdempotent allows retrying the requests with a non-idempotent method.
+	RetryConditionNonIdempotent RetryCondition = "nonIdempotent"
+)
+
+// Retry holds the settings of passing the failed requests to the next upstream server.
+type Retry struct {
+	// Attempts is the maximum number of attempts, including the first one. If nil, the NGINX default is used.
+	Attempts *int32
+	// TotalTimeout limits the total time of all the attempts in the NGINX format.
+	// If empty, the NGINX default is used.
+	TotalTimeout string
+	// Conditions are the conditions in which a request is retried.
+	Conditions []RetryCondition
+	// StatusCodes are the response status codes with which a request is retried.
+	StatusCodes []int32
+}
+
+// BasicAuth holds the settings of the HTTP Basic authentication.
+type BasicAuth struct {
+	// Realm is the name of the protected area.
+	Realm string
+	// UserFileID is the ID of the AuthUserFile with the users and passwords.
+	UserFileID AuthUserFileID
+}
+
+// ExternalAuth holds the settings of the authorization of requests by an external service.
+type ExternalAuth struct {
+	// Path is the path of the authorization requests. If empty, the URI of the original request is used.
+	Path string
+	// ForwardRequestHeaders are the headers of the original request that are forwarded to the authorization service.
+	// If empty, all headers are forwarded.
+	ForwardRequestHeaders []string
+	// CopyResponseHeaders are the headers of the response of the authorization service that are set in the request
+	// to the backends.
+	CopyResponseHeaders []string
+	// Backend is the Backend of the authorization service. If it is invalid, the requests are denied.
+	Backend Backend
+}
+
+// AccessControlAction is the action for the requests that match an access control rule.
+type AccessControlAction string
+
+const (
+	// AccessControlActionAllow allows the requests.
+	AccessControlActionAllow AccessControlAction = "allow"
+	// AccessControlActionDeny denies the requests.
+	AccessControlActionDeny AccessControlAction = "deny"
+)
+
+// AccessControl holds the settings of allowing or denying the requests based on the IP address of the client.
+type AccessControl struct {
+	// RealIP holds the settings of determining the real IP address of the client. If nil, the address of the peer
+	// of the connection is used.
+	RealIP *RealIP
+	// DefaultAction is the action for the requests that don't match any rule. If empty, the requests are allowed.
+	DefaultAction AccessControlAction
+	// Rules are the access control rules, checked in order.
+	Rules []AccessControlRule
+}
+
+// AccessControlRule allows or denies the requests from a range of IP addresses.
+type AccessControlRule struct {
+	// Action is the action for the requests that match the rule.
+	Action AccessControlAction
+	// CIDR is the range of IP addresses in the CIDR notation, or a single IP address.
+	CIDR string
+}
+
+// RealIP holds the settings of determining the real IP address of the client from a request header.
+type RealIP struct {
+	// Header is the name of the request header with the IP address of the client.
+	Header string
+	// TrustedAddresses are the ranges of IP addresses of the trusted proxies.
+	TrustedAddresses []string
+	// Recursive enables the recursive search of the IP address of the client in the header.
+	Recursive bool
+}
+
+// CORS holds the settings of handling the Cross-Origin Resource Sharing requests.
+type CORS struct {
+	// MaxAge is the time in seconds during which the response to a preflight request can be cached.
+	// If nil, the browser default is used.
+	MaxAge *int32
+	// Name uniquely identifies the CORS settings.
+	Name string
+	// AllowOrigins are the allowed origins. An origin can be "*" or have a "*." wildcard at the start of the host.
+	AllowOrigins []string
+	// AllowMethods are the allowed methods.
+	AllowMethods []string
+	// AllowHeaders are the allowed request headers.
+	AllowHeaders []string
+	// ExposeHeaders are the response headers exposed to the scripts.
+	ExposeHeaders []string
+	// AllowCredentials allows the requests with credentials.
+	AllowCredentials bool
+}
+
+// CacheZone is the storage of the cached responses.
+type CacheZone struct {
+	// Name is the unique name of the zone.
+	Name string
+	// Size is the size of the shared memory zone of the keys in the NGINX format. If empty, the default size is used.
+	Size string
+	// MaxSize is the maximum size of the cached responses in the NGINX format. If empty, the NGINX default is used.
+	MaxSize string
+	// Inactive is the time after which the cached responses that were not accessed are removed in the NGINX format.
+	// If empty, the NGINX default is used.
+	Inactive string
+}
+
+// Cache holds the settings of caching the responses.
+type Cache struct {
+	// Key is the key of the cached responses. If empty, the NGINX default is used.
+	Key string
+	// Zone is the zone of the cached responses.
+	Zone CacheZone
+	// Valid are the caching times of the responses per status codes.
+	Valid []CacheValid
+	// BypassHeaders are the request headers that bypass the cache.
+	BypassHeaders []string
+}
+
+// CacheValid holds the caching time of the responses with the given status codes.
+type CacheValid struct {
+	// Time is the caching time in the NGINX format.
+	Time string
+	// Codes are the response status codes. If empty, the NGINX default codes are used.
+	Codes []int32
+}
+
+// Compression holds the settings of compressing the responses with gzip.
+type Compression struct {
+	// MinLength is the minimum length of the compressed responses in bytes. If nil, the NGINX default is used.
+	MinLength *int32
+	// Level is the compression level. If nil, the NGINX default is used.
+	Level *int32
+	// Types are the MIME types of the compressed responses in addition to text/html. If empty, the NGINX default
+	// is used.
+	Types []string
+	// Enabled turns the compression on or off.
+	Enabled bool
+}
+
+// ErrorPage holds the settings of an error page that replaces the error responses with the given status codes.
+type ErrorPage struct {
+	// Backend is the Backend that serves the page. It is nil if the page is served from a file.
+	Backend *Backend
+	// ResponseCode is the status code of the response with the page. If nil, the status code of the error
+	// response is kept.
+	ResponseCode *int32
+	// FileID is the ID of the ErrorPageFile with the content of the page. It is empty if the page is served by
+	// a Backend.
+	FileID ErrorPageFileID
+	// ContentType is the MIME type of the content of the file.
+	ContentType string
+	// Codes are the status codes of the error responses.
+	Codes []int32
+}
+
+// AccessLog holds the settings of writing the access logs.
+type AccessLog struct {
+	// Format is the format of the log entries. If nil, the access logs are turned off.
+	Format *LogFormat
+	// Syslog is the syslog server that receives the log entries. If nil, the entries are written to stdout.
+	Syslog *SyslogServer
+}
+
+// LogFormat is a format of the access log entries. The entries are JSON objects.
+type LogFormat struct {
+	// Name is the unique name of the format.
+	Name string
+	// Fields are the fields of the entries. If empty, the default fields are logged.
+	Fields []LogField
+	// TraceContext adds the IDs of the OpenTelemetry trace and span of the request to the entries.
+	TraceContext bool
+	// RouteInfo adds the namespace and the name of the route that matches the request to the entries.
+	RouteInfo bool
+}
+
+// LogField is a field of the access log entries.
+type LogField struct {
+	// Name is the name of the field.
+	Name string
+	// Value is the value of the field, which can include NGINX variables.
+	Value string
+}
+
+// SyslogServer holds the settings of sending the access log entries to a syslog server.
+type SyslogServer struct {
+	// Address is the address of the server with an optional port.
+	Address string
+	// Facility is the syslog facility of the entries. If empty, the NGINX default is used.
+	Facility string
+	// Tag is the syslog tag of the entries. If empty, the NGINX default is used.
+	Tag string
+}
+
+// TraceStrategy is the strategy for tracing requests.
+type TraceStrategy string
+
+const (
+	// TraceStrategyRatio traces a percentage of the requests.
+	TraceStrategyRatio TraceStrategy = "ratio"
+	// TraceStrategyParent traces a request only if its parent span was sampled.
+	TraceStrategyParent TraceStrategy = "parent"
+)
+
+// Tracing holds the OpenTelemetry tracing settings.
+type Tracing struct {
+	// Ratio is the percentage of the requests that are traced. Only applicable to the ratio strategy.
+	// If nil, all requests are traced.
+	Ratio *int32
+	// Strategy is the tracing strategy.
+	Strategy TraceStrategy
+	// Context specifies how to propagate the traceparent/tracestate headers. If empty, the NGINX default is used.
+	Context string
+	// SpanName is the name of the span. If empty, the NGINX default is used.
+	SpanName string
+	// SpanAttributes are the custom attributes that are added to each span.
+	SpanAttributes []SpanAttribute
+}
+
+// Telemetry holds the OpenTelemetry configuration of the data plane.
+// An empty Endpoint means the telemetry is not configured.
+type Telemetry struct {
+	// Endpoint is the address of the OTLP/gRPC endpoint that accepts the telemetry data.
+	Endpoint string
+	// ServiceName is the "service.name" attribute of the OpenTelemetry resource.
+	ServiceName string
+	// Interval is the maximum interval between two exports. If empty, the NGINX default is used.
+	Interval string
+	// SpanAttributes are the custom attributes that are added to each span.
+	SpanAttributes []SpanAttribute
+	// BatchSize is the maximum number of spans sent in one batch per worker. If zero, the NGINX default is used.
+	BatchSize int32
+	// BatchCount is the number of pending batches per worker. If zero, the NGINX default is used.
+	BatchCount int32
+}
+
+// SpanAttribute is a key/value attribute of a span.
+type SpanAttribute struct {
+	// Key is the key of the attribute.
+	Key string
+	// Value is the value of the attribute.
+	Value string
+}
+
 // Match represents a match for a routing rule which consist of matches against various HTTP request attributes.
 type Match struct {
 	// Method matches against the HTTP method.
@@ -212,11 +697,11 @@ type Match struct {
 
 // BackendGroup represents a group of Backends for a routing rule in an HTTPRoute.
 type BackendGroup struct {
-	// Source is the NamespacedName of the HTTPRoute the group belongs to.
+	// Source is the NamespacedName of the Route the group belongs to.
 	Source types.NamespacedName
 	// Backends is a list of Backends in the Group.
 	Backends []Backend
-	// RuleIdx is the index of the corresponding rule in the HTTPRoute.
+	// RuleIdx is the index of the corresponding rule in the Route.
 	RuleIdx int
 }
 
@@ -233,6 +718,10 @@ func (bg *BackendGroup) Name() string {
 type Backend struct {
 	// VerifyTLS holds the backend TLS verification configuration.
 	VerifyTLS *VerifyTLS
+	// Filters holds the filters that are applied only to the requests sent to this Backend.
+	// Only RequestHeaderModifiers and ResponseHeaderModifiers are supported.
+	// If nil, the Backend doesn't have any filters.
+	Filters *HTTPFilters
 	// UpstreamName is the name of the upstream for this backend.
 	UpstreamName string
 	// Weight is the weight of the BackendRef.
@@ -241,6 +730,8 @@ type Backend struct {
 	Weight int32
 	// Valid indicates whether the Backend is valid.
 	Valid bool
+	// KeepAlive indicates whether the upstream of the Backend keeps the connections to its servers alive.
+	KeepAlive bool
 }
 
 // VerifyTLS holds the backend TLS verification configuration.
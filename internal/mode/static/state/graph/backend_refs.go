@@ -16,7 +16,7 @@ import (
 	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
 )
 
-// BackendRef is an internal representation of a backendRef in an HTTPRoute.
+// BackendRef is an internal representation of a backendRef in a Route.
 type BackendRef struct {
 	// BackendTLSPolicy is the BackendTLSPolicy of the Service which is referenced by the backendRef.
 	BackendTLSPolicy *BackendTLSPolicy
@@ -24,6 +24,8 @@ type BackendRef struct {
 	SvcNsName types.NamespacedName
 	// ServicePort is the ServicePort of the Service which is referenced by the backendRef.
 	ServicePort v1.ServicePort
+	// Filters are the filters of the backendRef. They are applied only to the requests sent to this backendRef.
+	Filters []gatewayv1.HTTPRouteFilter
 	// Weight is the weight of the backendRef.
 	Weight int32
 	// Valid indicates whether the backendRef is valid.
@@ -40,7 +42,7 @@ func (b BackendRef) ServicePortReference() string {
 }
 
 func addBackendRefsToRouteRules(
-	routes map[types.NamespacedName]*Route,
+	routes map[RouteKey]*L7Route,
 	refGrantResolver *referenceGrantResolver,
 	services map[types.NamespacedName]*v1.Service,
 	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
@@ -50,11 +52,59 @@ func addBackendRefsToRouteRules(
 	}
 }
 
+func addBackendRefsToL4Routes(
+	routes map[RouteKey]*L4Route,
+	refGrantResolver *referenceGrantResolver,
+	services map[types.NamespacedName]*v1.Service,
+) {
+	for _, r := range routes {
+		addBackendRefToL4Route(r, refGrantResolver, services)
+	}
+}
+
+// addBackendRefToL4Route resolves the backendRefs of a layer 4 route and sets the BackendRefs of the route.
+// The route is modified in place.
+// If a reference is invalid, the function will add a condition to the route.
+func addBackendRefToL4Route(
+	route *L4Route,
+	refGrantResolver *referenceGrantResolver,
+	services map[types.NamespacedName]*v1.Service,
+) {
+	if !route.Valid {
+		return
+	}
+
+	backendRefs := make([]BackendRef, 0, len(route.Spec.RouteBackendRefs))
+
+	for refIdx, ref := range route.Spec.RouteBackendRefs {
+		// Layer 4 routes support only a single rule.
+		refPath := field.NewPath("spec").Child("rules").Index(0).Child("backendRefs").Index(refIdx)
+
+		// BackendTLSPolicies are not applicable to layer 4 routes.
+		ref, cond := createBackendRef(
+			RouteBackendRef{BackendRef: ref},
+			route.Source.GetNamespace(),
+			route.RouteType,
+			refGrantResolver,
+			services,
+			refPath,
+			nil,
+		)
+
+		backendRefs = append(backendRefs, ref)
+		if cond != nil {
+			route.Conditions = append(route.Conditions, *cond)
+		}
+	}
+
+	route.Spec.BackendRefs = backendRefs
+}
+
 // addBackendRefsToRules iterates over the rules of a route and adds a list of BackendRef to each rule.
 // The route is modified in place.
 // If a reference in a rule is invalid, the function will add a condition to the rule.
 func addBackendRefsToRules(
-	route *Route,
+	route *L7Route,
 	refGrantResolver *referenceGrantResolver,
 	services map[types.NamespacedName]*v1.Service,
 	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
@@ -63,27 +113,30 @@ func addBackendRefsToRules(
 		return
 	}
 
-	for idx, rule := range route.Source.Spec.Rules {
-		if !route.Rules[idx].ValidMatches {
+	for idx, rule := range route.Spec.Rules {
+		if !rule.ValidMatches {
 			continue
 		}
-		if !route.Rules[idx].ValidFilters {
+		if !rule.ValidFilters {
 			continue
 		}
 
+		addMirrorBackendRefToRule(route, idx, refGrantResolver, services, backendTLSPolicies)
+
 		// zero backendRefs is OK. For example, a rule can include a redirect filter.
-		if len(rule.BackendRefs) == 0 {
+		if len(rule.RouteBackendRefs) == 0 {
 			continue
 		}
 
-		backendRefs := make([]BackendRef, 0, len(rule.BackendRefs))
+		backendRefs := make([]BackendRef, 0, len(rule.RouteBackendRefs))
 
-		for refIdx, ref := range rule.BackendRefs {
+		for refIdx, ref := range rule.RouteBackendRefs {
 			refPath := field.NewPath("spec").Child("rules").Index(idx).Child("backendRefs").Index(refIdx)
 
 			ref, cond := createBackendRef(
 				ref,
-				route.Source.Namespace,
+				route.Source.GetNamespace(),
+				route.RouteType,
 				refGrantResolver,
 				services,
 				refPath,
@@ -107,13 +160,54 @@ func addBackendRefsToRules(
 			}
 		}
 
-		route.Rules[idx].BackendRefs = backendRefs
+		route.Spec.Rules[idx].BackendRefs = backendRefs
+	}
+}
+
+// addMirrorBackendRefToRule resolves the backendRef of the first RequestMirror filter of a rule and sets the
+// MirrorBackendRef of the rule. The route is modified in place.
+// If the reference is invalid, the function will add a condition to the route.
+func addMirrorBackendRefToRule(
+	route *L7Route,
+	ruleIdx int,
+	refGrantResolver *referenceGrantResolver,
+	services map[types.NamespacedName]*v1.Service,
+	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
+) {
+	for filterIdx, filter := range route.Spec.Rules[ruleIdx].Filters {
+		if filter.Type != gatewayv1.HTTPRouteFilterRequestMirror {
+			continue
+		}
+
+		refPath := field.NewPath("spec").Child("rules").Index(ruleIdx).Child("filters").Index(filterIdx).
+			Child("requestMirror").Child("backendRef")
+
+		ref, cond := createBackendRef(
+			RouteBackendRef{
+				BackendRef: gatewayv1.BackendRef{BackendObjectReference: filter.RequestMirror.BackendRef},
+			},
+			route.Source.GetNamespace(),
+			route.RouteType,
+			refGrantResolver,
+			services,
+			refPath,
+			backendTLSPolicies,
+		)
+
+		route.Spec.Rules[ruleIdx].MirrorBackendRef = &ref
+		if cond != nil {
+			route.Conditions = append(route.Conditions, *cond)
+		}
+
+		// only the first RequestMirror filter is used
+		return
 	}
 }
 
 func createBackendRef(
-	ref gatewayv1.HTTPBackendRef,
+	ref RouteBackendRef,
 	sourceNamespace string,
+	routeType RouteType,
 	refGrantResolver *referenceGrantResolver,
 	services map[types.NamespacedName]*v1.Service,
 	refPath *field.Path,
@@ -125,7 +219,7 @@ func createBackendRef(
 	weight := int32(1)
 	if ref.Weight != nil {
 		if validateWeight(*ref.Weight) != nil {
-			// We don't need to add a condition because validateHTTPBackendRef will do that.
+			// We don't need to add a condition because validateRouteBackendRef will do that.
 			weight = 0 // 0 will get no traffic
 		} else {
 			weight = *ref.Weight
@@ -134,7 +228,7 @@ func createBackendRef(
 
 	var backendRef BackendRef
 
-	valid, cond := validateHTTPBackendRef(ref, sourceNamespace, refGrantResolver, refPath)
+	valid, cond := validateRouteBackendRef(ref, sourceNamespace, routeType, refGrantResolver, refPath)
 	if !valid {
 		backendRef = BackendRef{
 			Weight: weight,
@@ -178,6 +272,7 @@ func createBackendRef(
 		SvcNsName:        svcNsName,
 		BackendTLSPolicy: backendTLSPolicy,
 		ServicePort:      svcPort,
+		Filters:          ref.Filters,
 		Valid:            true,
 		Weight:           weight,
 	}
@@ -231,7 +326,7 @@ func validateBackendTLSPolicyMatchingAllBackends(backendRefs []BackendRef) *cond
 
 func findBackendTLSPolicyForService(
 	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
-	ref gatewayv1.HTTPBackendRef,
+	ref RouteBackendRef,
 	routeNamespace string,
 ) (*BackendTLSPolicy, error) {
 	var beTLSPolicy *BackendTLSPolicy
@@ -301,25 +396,22 @@ func getServiceAndPortFromRef(
 	return svcNsName, svcPort, nil
 }
 
-func validateHTTPBackendRef(
-	ref gatewayv1.HTTPBackendRef,
+func validateRouteBackendRef(
+	ref RouteBackendRef,
 	routeNs string,
+	routeType RouteType,
 	refGrantResolver *referenceGrantResolver,
 	path *field.Path,
 ) (valid bool, cond conditions.Condition) {
-	// Because all errors cause the same condition but different reasons, we return as soon as we find an error
-
-	if len(ref.Filters) > 0 {
-		valErr := field.TooMany(path.Child("filters"), len(ref.Filters), 0)
-		return false, staticConds.NewRouteBackendRefUnsupportedValue(valErr.Error())
-	}
-
-	return validateBackendRef(ref.BackendRef, routeNs, refGrantResolver, path)
+	// The filters of the backendRef are validated along with the other fields of the rule,
+	// see validateBackendRefFilters.
+	return validateBackendRef(ref.BackendRef, routeNs, routeType, refGrantResolver, path)
 }
 
 func validateBackendRef(
 	ref gatewayv1.BackendRef,
 	routeNs string,
+	routeType RouteType,
 	refGrantResolver *referenceGrantResolver,
 	path *field.Path,
 ) (valid bool, cond conditions.Condition) {
@@ -340,7 +432,7 @@ func validateBackendRef(
 	if ref.Namespace != nil && string(*ref.Namespace) != routeNs {
 		refNsName := types.NamespacedName{Namespace: string(*ref.Namespace), Name: string(ref.Name)}
 
-		if !refGrantResolver.refAllowed(toService(refNsName), fromHTTPRoute(routeNs)) {
+		if !refGrantResolver.refAllowed(toService(refNsName), fromRoute(routeType, routeNs)) {
 			msg := fmt.Sprintf("Backend ref to Service %s not permitted by any ReferenceGrant", refNsName)
 
 			return false, staticConds.NewRouteBackendRefRefNotPermitted(msg)
@@ -34,24 +34,24 @@ func getModifiedRef(mod func(ref gatewayv1.BackendRef) gatewayv1.BackendRef) gat
 	return mod(getNormalRef())
 }
 
-func TestValidateHTTPBackendRef(t *testing.T) {
+func TestValidateRouteBackendRef(t *testing.T) {
 	tests := []struct {
 		expectedCondition conditions.Condition
 		name              string
-		ref               gatewayv1.HTTPBackendRef
+		ref               RouteBackendRef
 		expectedValid     bool
 	}{
 		{
 			name: "normal case",
-			ref: gatewayv1.HTTPBackendRef{
+			ref: RouteBackendRef{
 				BackendRef: getNormalRef(),
 				Filters:    nil,
 			},
 			expectedValid: true,
 		},
 		{
-			name: "filters not supported",
-			ref: gatewayv1.HTTPBackendRef{
+			name: "with filters",
+			ref: RouteBackendRef{
 				BackendRef: getNormalRef(),
 				Filters: []gatewayv1.HTTPRouteFilter{
 					{
@@ -59,14 +59,11 @@ func TestValidateHTTPBackendRef(t *testing.T) {
 					},
 				},
 			},
-			expectedValid: false,
-			expectedCondition: staticConds.NewRouteBackendRefUnsupportedValue(
-				"test.filters: Too many: 1: must have at most 0 items",
-			),
+			expectedValid: true,
 		},
 		{
 			name: "invalid base ref",
-			ref: gatewayv1.HTTPBackendRef{
+			ref: RouteBackendRef{
 				BackendRef: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
 					backend.Kind = helpers.GetPointer[gatewayv1.Kind]("NotService")
 					return backend
@@ -84,7 +81,7 @@ func TestValidateHTTPBackendRef(t *testing.T) {
 			g := NewWithT(t)
 			resolver := newReferenceGrantResolver(nil)
 
-			valid, cond := validateHTTPBackendRef(test.ref, "test", resolver, field.NewPath("test"))
+			valid, cond := validateRouteBackendRef(test.ref, "test", RouteTypeHTTP, resolver, field.NewPath("test"))
 
 			g.Expect(valid).To(Equal(test.expectedValid))
 			g.Expect(cond).To(Equal(test.expectedCondition))
@@ -114,11 +111,15 @@ func TestValidateBackendRef(t *testing.T) {
 	allInNamespaceRefGrant := specificRefGrant.DeepCopy()
 	allInNamespaceRefGrant.Spec.To[0].Name = nil
 
+	grpcRouteRefGrant := specificRefGrant.DeepCopy()
+	grpcRouteRefGrant.Spec.From[0].Kind = "GRPCRoute"
+
 	tests := []struct {
 		ref               gatewayv1.BackendRef
 		refGrants         map[types.NamespacedName]*v1beta1.ReferenceGrant
 		expectedCondition conditions.Condition
 		name              string
+		routeType         RouteType
 		expectedValid     bool
 	}{
 		{
@@ -164,6 +165,33 @@ func TestValidateBackendRef(t *testing.T) {
 			},
 			expectedValid: true,
 		},
+		{
+			name: "normal case with backend ref from GRPCRoute allowed by reference grant",
+			ref: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+				backend.Namespace = helpers.GetPointer[gatewayv1.Namespace]("cross-ns")
+				return backend
+			}),
+			refGrants: map[types.NamespacedName]*v1beta1.ReferenceGrant{
+				{Namespace: "cross-ns", Name: "rg"}: grpcRouteRefGrant,
+			},
+			routeType:     RouteTypeGRPC,
+			expectedValid: true,
+		},
+		{
+			name: "backend ref from GRPCRoute not allowed by reference grant for HTTPRoute",
+			ref: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+				backend.Namespace = helpers.GetPointer[gatewayv1.Namespace]("cross-ns")
+				return backend
+			}),
+			refGrants: map[types.NamespacedName]*v1beta1.ReferenceGrant{
+				{Namespace: "cross-ns", Name: "rg"}: specificRefGrant,
+			},
+			routeType:     RouteTypeGRPC,
+			expectedValid: false,
+			expectedCondition: staticConds.NewRouteBackendRefRefNotPermitted(
+				"Backend ref to Service cross-ns/service1 not permitted by any ReferenceGrant",
+			),
+		},
 		{
 			name: "invalid group",
 			ref: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
@@ -225,8 +253,13 @@ func TestValidateBackendRef(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
 
+			routeType := RouteTypeHTTP
+			if test.routeType != "" {
+				routeType = test.routeType
+			}
+
 			resolver := newReferenceGrantResolver(test.refGrants)
-			valid, cond := validateBackendRef(test.ref, "test", resolver, field.NewPath("test"))
+			valid, cond := validateBackendRef(test.ref, "test", routeType, resolver, field.NewPath("test"))
 
 			g.Expect(valid).To(Equal(test.expectedValid))
 			g.Expect(cond).To(Equal(test.expectedCondition))
@@ -384,11 +417,17 @@ func TestAddBackendRefsToRulesTest(t *testing.T) {
 		allInvalid = false
 	)
 
-	createRules := func(hr *gatewayv1.HTTPRoute, validMatches, validFilters bool) []Rule {
-		rules := make([]Rule, len(hr.Spec.Rules))
+	createRules := func(hr *gatewayv1.HTTPRoute, validMatches, validFilters bool) []RouteRule {
+		rules := make([]RouteRule, len(hr.Spec.Rules))
 		for i := range rules {
 			rules[i].ValidMatches = validMatches
 			rules[i].ValidFilters = validFilters
+			for _, ref := range hr.Spec.Rules[i].BackendRefs {
+				rules[i].RouteBackendRefs = append(rules[i].RouteBackendRefs, RouteBackendRef{
+					BackendRef: ref.BackendRef,
+					Filters:    ref.Filters,
+				})
+			}
 		}
 		return rules
 	}
@@ -411,6 +450,17 @@ func TestAddBackendRefsToRulesTest(t *testing.T) {
 	hrWithZeroBackendRefs.Spec.Rules[0].BackendRefs = nil
 	hrWithTwoDiffBackends.Spec.Rules[0].BackendRefs[1].Name = "svc2"
 
+	canaryFilters := []gatewayv1.HTTPRouteFilter{
+		{
+			Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+			RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+				Set: []gatewayv1.HTTPHeader{{Name: "X-Canary", Value: "true"}},
+			},
+		},
+	}
+	hrWithBackendFilters := createRoute("hr5", "Service", 2, "svc1")
+	hrWithBackendFilters.Spec.Rules[0].BackendRefs[1].Filters = canaryFilters
+
 	getSvc := func(name string) *v1.Service {
 		return &v1.Service{
 			ObjectMeta: metav1.ObjectMeta{
@@ -538,18 +588,21 @@ func TestAddBackendRefsToRulesTest(t *testing.T) {
 	)
 
 	tests := []struct {
-		route               *Route
+		route               *L7Route
 		policies            map[types.NamespacedName]*BackendTLSPolicy
 		name                string
 		expectedBackendRefs []BackendRef
 		expectedConditions  []conditions.Condition
 	}{
 		{
-			route: &Route{
+			route: &L7Route{
+				RouteType:  RouteTypeHTTP,
 				Source:     hrWithOneBackend,
 				ParentRefs: sectionNameRefs,
 				Valid:      true,
-				Rules:      createRules(hrWithOneBackend, allValid, allValid),
+				Spec: L7RouteSpec{
+					Rules: createRules(hrWithOneBackend, allValid, allValid),
+				},
 			},
 			expectedBackendRefs: []BackendRef{
 				{
@@ -564,11 +617,14 @@ func TestAddBackendRefsToRulesTest(t *testing.T) {
 			name:               "normal case with one rule with one backend",
 		},
 		{
-			route: &Route{
+			route: &L7Route{
+				RouteType:  RouteTypeHTTP,
 				Source:     hrWithTwoBackends,
 				ParentRefs: sectionNameRefs,
 				Valid:      true,
-				Rules:      createRules(hrWithTwoBackends, allValid, allValid),
+				Spec: L7RouteSpec{
+					Rules: createRules(hrWithTwoBackends, allValid, allValid),
+				},
 			},
 			expectedBackendRefs: []BackendRef{
 				{
@@ -589,11 +645,43 @@ func TestAddBackendRefsToRulesTest(t *testing.T) {
 			name:               "normal case with one rule with two backends",
 		},
 		{
-			route: &Route{
+			route: &L7Route{
+				RouteType:  RouteTypeHTTP,
+				Source:     hrWithBackendFilters,
+				ParentRefs: sectionNameRefs,
+				Valid:      true,
+				Spec: L7RouteSpec{
+					Rules: createRules(hrWithBackendFilters, allValid, allValid),
+				},
+			},
+			expectedBackendRefs: []BackendRef{
+				{
+					SvcNsName:   svc1NsName,
+					ServicePort: svc1.Spec.Ports[0],
+					Valid:       true,
+					Weight:      1,
+				},
+				{
+					SvcNsName:   svc1NsName,
+					ServicePort: svc1.Spec.Ports[1],
+					Filters:     canaryFilters,
+					Valid:       true,
+					Weight:      5,
+				},
+			},
+			expectedConditions: nil,
+			policies:           emptyPolicies,
+			name:               "normal case with one rule with two backends with filters",
+		},
+		{
+			route: &L7Route{
+				RouteType:  RouteTypeHTTP,
 				Source:     hrWithTwoBackends,
 				ParentRefs: sectionNameRefs,
 				Valid:      true,
-				Rules:      createRules(hrWithTwoBackends, allValid, allValid),
+				Spec: L7RouteSpec{
+					Rules: createRules(hrWithTwoBackends, allValid, allValid),
+				},
 			},
 			expectedBackendRefs: []BackendRef{
 				{
@@ -616,7 +704,8 @@ func TestAddBackendRefsToRulesTest(t *testing.T) {
 			name:               "normal case with one rule with two backends and matching policies",
 		},
 		{
-			route: &Route{
+			route: &L7Route{
+				RouteType:  RouteTypeHTTP,
 				Source:     hrWithOneBackend,
 				ParentRefs: sectionNameRefs,
 				Valid:      false,
@@ -627,11 +716,14 @@ func TestAddBackendRefsToRulesTest(t *testing.T) {
 			name:                "invalid route",
 		},
 		{
-			route: &Route{
+			route: &L7Route{
+				RouteType:  RouteTypeHTTP,
 				Source:     hrWithOneBackend,
 				ParentRefs: sectionNameRefs,
 				Valid:      true,
-				Rules:      createRules(hrWithOneBackend, allInvalid, allValid),
+				Spec: L7RouteSpec{
+					Rules: createRules(hrWithOneBackend, allInvalid, allValid),
+				},
 			},
 			expectedBackendRefs: nil,
 			expectedConditions:  nil,
@@ -639,11 +731,14 @@ func TestAddBackendRefsToRulesTest(t *testing.T) {
 			name:                "invalid matches",
 		},
 		{
-			route: &Route{
+			route: &L7Route{
+				RouteType:  RouteTypeHTTP,
 				Source:     hrWithOneBackend,
 				ParentRefs: sectionNameRefs,
 				Valid:      true,
-				Rules:      createRules(hrWithOneBackend, allValid, allInvalid),
+				Spec: L7RouteSpec{
+					Rules: createRules(hrWithOneBackend, allValid, allInvalid),
+				},
 			},
 			expectedBackendRefs: nil,
 			expectedConditions:  nil,
@@ -651,11 +746,14 @@ func TestAddBackendRefsToRulesTest(t *testing.T) {
 			name:                "invalid filters",
 		},
 		{
-			route: &Route{
+			route: &L7Route{
+				RouteType:  RouteTypeHTTP,
 				Source:     hrWithInvalidRule,
 				ParentRefs: sectionNameRefs,
 				Valid:      true,
-				Rules:      createRules(hrWithInvalidRule, allValid, allValid),
+				Spec: L7RouteSpec{
+					Rules: createRules(hrWithInvalidRule, allValid, allValid),
+				},
 			},
 			expectedBackendRefs: []BackendRef{
 				{
@@ -671,11 +769,14 @@ func TestAddBackendRefsToRulesTest(t *testing.T) {
 			name:     "invalid backendRef",
 		},
 		{
-			route: &Route{
+			route: &L7Route{
+				RouteType:  RouteTypeHTTP,
 				Source:     hrWithTwoDiffBackends,
 				ParentRefs: sectionNameRefs,
 				Valid:      true,
-				Rules:      createRules(hrWithTwoDiffBackends, allValid, allValid),
+				Spec: L7RouteSpec{
+					Rules: createRules(hrWithTwoDiffBackends, allValid, allValid),
+				},
 			},
 			expectedBackendRefs: []BackendRef{
 				{
@@ -702,11 +803,14 @@ func TestAddBackendRefsToRulesTest(t *testing.T) {
 			name:     "invalid backendRef - backend TLS policies do not match for all backends",
 		},
 		{
-			route: &Route{
+			route: &L7Route{
+				RouteType:  RouteTypeHTTP,
 				Source:     hrWithZeroBackendRefs,
 				ParentRefs: sectionNameRefs,
 				Valid:      true,
-				Rules:      createRules(hrWithZeroBackendRefs, allValid, allValid),
+				Spec: L7RouteSpec{
+					Rules: createRules(hrWithZeroBackendRefs, allValid, allValid),
+				},
 			},
 			expectedBackendRefs: nil,
 			expectedConditions:  nil,
@@ -721,8 +825,8 @@ func TestAddBackendRefsToRulesTest(t *testing.T) {
 			addBackendRefsToRules(test.route, resolver, services, test.policies)
 
 			var actual []BackendRef
-			if test.route.Rules != nil {
-				actual = test.route.Rules[0].BackendRefs
+			if test.route.Spec.Rules != nil {
+				actual = test.route.Spec.Rules[0].BackendRefs
 			}
 
 			g.Expect(helpers.Diff(test.expectedBackendRefs, actual)).To(BeEmpty())
@@ -731,6 +835,231 @@ func TestAddBackendRefsToRulesTest(t *testing.T) {
 	}
 }
 
+func TestAddMirrorBackendRefToRule(t *testing.T) {
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "mirror-svc",
+		},
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				{
+					Port: 80,
+				},
+			},
+		},
+	}
+	svcNsName := types.NamespacedName{Namespace: "test", Name: "mirror-svc"}
+
+	services := map[types.NamespacedName]*v1.Service{
+		svcNsName: svc,
+	}
+
+	createMirrorFilter := func(name string, namespace *gatewayv1.Namespace) gatewayv1.HTTPRouteFilter {
+		return gatewayv1.HTTPRouteFilter{
+			Type: gatewayv1.HTTPRouteFilterRequestMirror,
+			RequestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+				BackendRef: gatewayv1.BackendObjectReference{
+					Name:      gatewayv1.ObjectName(name),
+					Namespace: namespace,
+					Port:      helpers.GetPointer[gatewayv1.PortNumber](80),
+				},
+			},
+		}
+	}
+
+	createRoute := func(filters ...gatewayv1.HTTPRouteFilter) *L7Route {
+		return &L7Route{
+			RouteType: RouteTypeHTTP,
+			Source: &gatewayv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "hr",
+				},
+			},
+			Valid: true,
+			Spec: L7RouteSpec{
+				Rules: []RouteRule{
+					{
+						ValidMatches: true,
+						ValidFilters: true,
+						Filters:      filters,
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		route              *L7Route
+		expectedMirrorRef  *BackendRef
+		name               string
+		expectedConditions []conditions.Condition
+	}{
+		{
+			route: createRoute(createMirrorFilter("mirror-svc", nil)),
+			expectedMirrorRef: &BackendRef{
+				SvcNsName:   svcNsName,
+				ServicePort: svc.Spec.Ports[0],
+				Valid:       true,
+				Weight:      1,
+			},
+			name: "valid mirror",
+		},
+		{
+			route: createRoute(
+				createMirrorFilter("mirror-svc", nil),
+				createMirrorFilter("not-found", nil),
+			),
+			expectedMirrorRef: &BackendRef{
+				SvcNsName:   svcNsName,
+				ServicePort: svc.Spec.Ports[0],
+				Valid:       true,
+				Weight:      1,
+			},
+			name: "first mirror wins",
+		},
+		{
+			route: createRoute(createMirrorFilter("not-found", nil)),
+			expectedMirrorRef: &BackendRef{
+				SvcNsName: types.NamespacedName{Namespace: "test", Name: "not-found"},
+				Weight:    1,
+			},
+			expectedConditions: []conditions.Condition{
+				staticConds.NewRouteBackendRefRefBackendNotFound(
+					`spec.rules[0].filters[0].requestMirror.backendRef.name: Not found: "not-found"`,
+				),
+			},
+			name: "mirror service not found",
+		},
+		{
+			route: createRoute(createMirrorFilter("mirror-svc", helpers.GetPointer[gatewayv1.Namespace]("other"))),
+			expectedMirrorRef: &BackendRef{
+				Weight: 1,
+			},
+			expectedConditions: []conditions.Condition{
+				staticConds.NewRouteBackendRefRefNotPermitted(
+					"Backend ref to Service other/mirror-svc not permitted by any ReferenceGrant",
+				),
+			},
+			name: "mirror to another namespace not permitted",
+		},
+		{
+			route: createRoute(gatewayv1.HTTPRouteFilter{
+				Type:                  gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+				RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{},
+			}),
+			expectedMirrorRef: nil,
+			name:              "no mirror filter",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			resolver := newReferenceGrantResolver(nil)
+			addBackendRefsToRules(test.route, resolver, services, nil)
+
+			g.Expect(helpers.Diff(test.expectedMirrorRef, test.route.Spec.Rules[0].MirrorBackendRef)).To(BeEmpty())
+			g.Expect(test.route.Conditions).To(Equal(test.expectedConditions))
+		})
+	}
+}
+
+func TestAddBackendRefToL4Route(t *testing.T) {
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "svc1"},
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{{Port: 443}},
+		},
+	}
+
+	services := map[types.NamespacedName]*v1.Service{
+		client.ObjectKeyFromObject(svc): svc,
+	}
+
+	createRoute := func(valid bool, svcNames ...string) *L4Route {
+		refs := make([]gatewayv1.BackendRef, 0, len(svcNames))
+		for _, svcName := range svcNames {
+			refs = append(refs, gatewayv1.BackendRef{
+				BackendObjectReference: gatewayv1.BackendObjectReference{
+					Name: gatewayv1.ObjectName(svcName),
+					Port: helpers.GetPointer[gatewayv1.PortNumber](443),
+				},
+			})
+		}
+
+		return &L4Route{
+			RouteType: RouteTypeTLS,
+			Source: &v1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "tr"},
+			},
+			Valid: valid,
+			Spec: L4RouteSpec{
+				RouteBackendRefs: refs,
+			},
+		}
+	}
+
+	validBackendRef := BackendRef{
+		SvcNsName:   types.NamespacedName{Namespace: "test", Name: "svc1"},
+		ServicePort: v1.ServicePort{Port: 443},
+		Valid:       true,
+		Weight:      1,
+	}
+
+	tests := []struct {
+		route               *L4Route
+		name                string
+		expectedBackendRefs []BackendRef
+		expectedConditions  []conditions.Condition
+	}{
+		{
+			route:               createRoute(true, "svc1"),
+			expectedBackendRefs: []BackendRef{validBackendRef},
+			name:                "valid backendRef",
+		},
+		{
+			route:               createRoute(true, "svc1", "svc1"),
+			expectedBackendRefs: []BackendRef{validBackendRef, validBackendRef},
+			name:                "multiple valid backendRefs",
+		},
+		{
+			route: createRoute(true, "svc1", "svc-does-not-exist"),
+			expectedBackendRefs: []BackendRef{
+				validBackendRef,
+				{
+					SvcNsName: types.NamespacedName{Namespace: "test", Name: "svc-does-not-exist"},
+					Valid:     false,
+					Weight:    1,
+				},
+			},
+			expectedConditions: []conditions.Condition{
+				staticConds.NewRouteBackendRefRefBackendNotFound(
+					`spec.rules[0].backendRefs[1].name: Not found: "svc-does-not-exist"`,
+				),
+			},
+			name: "service does not exist",
+		},
+		{
+			route:               createRoute(false, "svc1"),
+			expectedBackendRefs: nil,
+			name:                "invalid route",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			addBackendRefToL4Route(test.route, newReferenceGrantResolver(nil), services)
+
+			g.Expect(helpers.Diff(test.expectedBackendRefs, test.route.Spec.BackendRefs)).To(BeEmpty())
+			g.Expect(test.route.Conditions).To(Equal(test.expectedConditions))
+		})
+	}
+}
+
 func TestCreateBackend(t *testing.T) {
 	createService := func(name string) *v1.Service {
 		return &v1.Service{
@@ -809,11 +1138,11 @@ func TestCreateBackend(t *testing.T) {
 		expectedCondition            *conditions.Condition
 		name                         string
 		expectedServicePortReference string
-		ref                          gatewayv1.HTTPBackendRef
+		ref                          RouteBackendRef
 		expectedBackend              BackendRef
 	}{
 		{
-			ref: gatewayv1.HTTPBackendRef{
+			ref: RouteBackendRef{
 				BackendRef: getNormalRef(),
 			},
 			expectedBackend: BackendRef{
@@ -827,7 +1156,7 @@ func TestCreateBackend(t *testing.T) {
 			name:                         "normal case",
 		},
 		{
-			ref: gatewayv1.HTTPBackendRef{
+			ref: RouteBackendRef{
 				BackendRef: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
 					backend.Weight = nil
 					return backend
@@ -844,7 +1173,7 @@ func TestCreateBackend(t *testing.T) {
 			name:                         "normal with nil weight",
 		},
 		{
-			ref: gatewayv1.HTTPBackendRef{
+			ref: RouteBackendRef{
 				BackendRef: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
 					backend.Weight = helpers.GetPointer[int32](-1)
 					return backend
@@ -865,7 +1194,7 @@ func TestCreateBackend(t *testing.T) {
 			name: "invalid weight",
 		},
 		{
-			ref: gatewayv1.HTTPBackendRef{
+			ref: RouteBackendRef{
 				BackendRef: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
 					backend.Kind = helpers.GetPointer[gatewayv1.Kind]("NotService")
 					return backend
@@ -886,7 +1215,7 @@ func TestCreateBackend(t *testing.T) {
 			name: "invalid kind",
 		},
 		{
-			ref: gatewayv1.HTTPBackendRef{
+			ref: RouteBackendRef{
 				BackendRef: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
 					backend.Name = "not-exist"
 					return backend
@@ -905,7 +1234,7 @@ func TestCreateBackend(t *testing.T) {
 			name: "service doesn't exist",
 		},
 		{
-			ref: gatewayv1.HTTPBackendRef{
+			ref: RouteBackendRef{
 				BackendRef: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
 					backend.Name = "service2"
 					return backend
@@ -923,7 +1252,7 @@ func TestCreateBackend(t *testing.T) {
 			name:                         "normal case with policy",
 		},
 		{
-			ref: gatewayv1.HTTPBackendRef{
+			ref: RouteBackendRef{
 				BackendRef: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
 					backend.Name = "service3"
 					return backend
@@ -967,6 +1296,7 @@ func TestCreateBackend(t *testing.T) {
 			backend, cond := createBackendRef(
 				test.ref,
 				sourceNamespace,
+				RouteTypeHTTP,
 				resolver,
 				services,
 				refPath,
@@ -1142,7 +1472,7 @@ func TestFindBackendTLSPolicyForService(t *testing.T) {
 	newestBtp := getBtp("newest", newCreationTimestamp)
 	alphaFirstBtp := getBtp("alphabeticallyfirst", oldCreationTimestamp)
 
-	ref := gatewayv1.HTTPBackendRef{
+	ref := RouteBackendRef{
 		BackendRef: gatewayv1.BackendRef{
 			BackendObjectReference: gatewayv1.BackendObjectReference{
 				Kind:      helpers.GetPointer[gatewayv1.Kind]("Service"),
@@ -0,0 +1,61 @@
+package graph
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// basicAuthPolicyProcessor validates the Secret referenced by a BasicAuthPolicy.
+type basicAuthPolicyProcessor struct{}
+
+func (basicAuthPolicyProcessor) validateRefs(
+	policy policies.Policy,
+	resources policyResources,
+) []conditions.Condition {
+	bap := helpers.MustCastObject[*ngfAPI.BasicAuthPolicy](policy)
+	return validateBasicAuthSecretRef(bap, resources.secretResolver, resources.refGrantResolver)
+}
+
+func validateBasicAuthSecretRef(
+	policy *ngfAPI.BasicAuthPolicy,
+	secretResolver *secretResolver,
+	refGrantResolver *referenceGrantResolver,
+) []conditions.Condition {
+	secretNsName := GetBasicAuthSecretNsName(policy)
+
+	if secretNsName.Namespace != policy.Namespace &&
+		!refGrantResolver.refAllowed(toSecret(secretNsName), fromBasicAuthPolicy(policy.Namespace)) {
+		msg := fmt.Sprintf("Secret ref to secret %s not permitted by any ReferenceGrant", secretNsName)
+		return []conditions.Condition{staticConds.NewPolicyInvalid(msg)}
+	}
+
+	if err := secretResolver.resolve(secretNsName, SecretTypeHtpasswd); err != nil {
+		path := field.NewPath("spec", "secretRef")
+		valErr := field.Invalid(path, secretNsName, err.Error())
+
+		return []conditions.Condition{staticConds.NewPolicyInvalid(valErr.Error())}
+	}
+
+	return nil
+}
+
+// GetBasicAuthSecretNsName returns the NamespacedName of the Secret referenced by a BasicAuthPolicy.
+// The Secret is in the namespace of the Policy unless the reference specifies another namespace.
+func GetBasicAuthSecretNsName(policy *ngfAPI.BasicAuthPolicy) types.NamespacedName {
+	ref := policy.Spec.SecretRef
+
+	namespace := policy.Namespace
+	if ref.Namespace != nil {
+		namespace = string(*ref.Namespace)
+	}
+
+	return types.NamespacedName{Namespace: namespace, Name: string(ref.Name)}
+}
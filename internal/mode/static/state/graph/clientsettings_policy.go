@@ -0,0 +1,83 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// clientSettingsPolicyProcessor reports the ClientSettingsPolicies whose body timeout is overridden by the request
+// timeouts of the HTTPRoutes.
+type clientSettingsPolicyProcessor struct{}
+
+// updateConditions sets the Overridden condition on the accepted ClientSettingsPolicies of the group if the request
+// timeout of an HTTPRoute rule takes precedence over their body timeout in the locations of the rule.
+// The body timeout of a ClientSettingsPolicy that targets the Gateway applies to the HTTPRoutes attached to
+// the Gateway, except for the HTTPRoutes whose own ClientSettingsPolicies set the body timeout, unless the body
+// timeout of the Gateway is an override.
+func (clientSettingsPolicyProcessor) updateConditions(
+	group []*Policy,
+	groupKey policyGroupKey,
+	routes map[RouteKey]*L7Route,
+	routePolicies map[RouteKey]kindPolicies,
+) {
+	for _, p := range group {
+		csp, ok := p.Source.(*ngfAPI.ClientSettingsPolicy)
+		if !ok || !p.Valid {
+			continue
+		}
+
+		override := getClientBodyTimeoutOverride(csp) != nil
+		if !override && getClientBodyTimeout(csp) == nil {
+			continue
+		}
+
+		overriddenBy := getRequestTimeoutRoutes(groupKey, routes, routePolicies, func(attached []policies.Policy) bool {
+			return override || !setsClientBodyTimeout(attached)
+		})
+		if len(overriddenBy) == 0 {
+			continue
+		}
+
+		setting := "spec.body.timeout"
+		if override {
+			setting = "spec.override.body.timeout"
+		}
+
+		p.Conditions = append(p.Conditions, staticConds.NewPolicyOverriddenByRequestTimeout(fmt.Sprintf(
+			"The request timeout of the rules of HTTPRoutes %s takes precedence over %s",
+			strings.Join(overriddenBy, ", "),
+			setting,
+		)))
+	}
+}
+
+func setsClientBodyTimeout(accepted []policies.Policy) bool {
+	for _, p := range accepted {
+		csp, ok := p.(*ngfAPI.ClientSettingsPolicy)
+		if ok && (getClientBodyTimeout(csp) != nil || getClientBodyTimeoutOverride(csp) != nil) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getClientBodyTimeout(csp *ngfAPI.ClientSettingsPolicy) *ngfAPI.Duration {
+	if csp.Spec.Body == nil {
+		return nil
+	}
+
+	return csp.Spec.Body.Timeout
+}
+
+func getClientBodyTimeoutOverride(csp *ngfAPI.ClientSettingsPolicy) *ngfAPI.Duration {
+	if csp.Spec.Override == nil || csp.Spec.Override.Body == nil {
+		return nil
+	}
+
+	return csp.Spec.Override.Body.Timeout
+}
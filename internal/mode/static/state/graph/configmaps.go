@@ -9,6 +9,8 @@ import (
 
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 )
 
 const CAKey = "ca.crt"
@@ -98,6 +100,30 @@ func (r *configMapResolver) getResolvedConfigMaps() map[types.NamespacedName]*Ca
 	return resolved
 }
 
+// buildReferencedErrorPageConfigMaps builds the NamespacedNames of the ConfigMaps referenced by the processed
+// ErrorPagePolicies. Like the Secrets, the ConfigMaps of the invalid Policies and the ConfigMaps that do not exist
+// are included, so that a change of the ConfigMaps, including their creation, updates the Policies.
+func buildReferencedErrorPageConfigMaps(pols map[PolicyKey]*Policy) map[types.NamespacedName]struct{} {
+	referenced := make(map[types.NamespacedName]struct{})
+
+	for _, policy := range pols {
+		epp, ok := policy.Source.(*ngfAPI.ErrorPagePolicy)
+		if !ok {
+			continue
+		}
+
+		for _, nsName := range GetErrorPageConfigMapNsNames(epp) {
+			referenced[nsName] = struct{}{}
+		}
+	}
+
+	if len(referenced) == 0 {
+		return nil
+	}
+
+	return referenced
+}
+
 // validateCA validates the ca.crt entry in the ConfigMap. If it is valid, the function returns nil.
 func validateCA(caData []byte) error {
 	data := make([]byte, base64.StdEncoding.DecodedLen(len(caData)))
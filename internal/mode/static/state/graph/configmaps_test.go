@@ -8,6 +8,9 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 )
 
 const (
@@ -219,3 +222,39 @@ func TestResolve(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildReferencedErrorPageConfigMaps(t *testing.T) {
+	g := NewWithT(t)
+
+	createErrorPagePolicy := func(name string, configMaps ...string) *Policy {
+		pages := []ngfAPI.ErrorPage{
+			{BackendRef: &gatewayv1.BackendObjectReference{Name: "errors"}},
+		}
+		for _, cm := range configMaps {
+			pages = append(pages, ngfAPI.ErrorPage{
+				Content: &ngfAPI.ErrorPageContent{ConfigMapName: gatewayv1.ObjectName(cm), Key: "page.html"},
+			})
+		}
+
+		return &Policy{
+			Source: &ngfAPI.ErrorPagePolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+				Spec:       ngfAPI.ErrorPagePolicySpec{Pages: pages},
+			},
+		}
+	}
+
+	g.Expect(buildReferencedErrorPageConfigMaps(nil)).To(BeNil())
+
+	pols := map[PolicyKey]*Policy{
+		{NsName: types.NamespacedName{Namespace: "test", Name: "gw"}}:     createErrorPagePolicy("gw", "pages", "more"),
+		{NsName: types.NamespacedName{Namespace: "test", Name: "hr"}}:     createErrorPagePolicy("hr", "pages"),
+		{NsName: types.NamespacedName{Namespace: "test", Name: "svc"}}:    createErrorPagePolicy("svc"),
+		{NsName: types.NamespacedName{Namespace: "test", Name: "client"}}: {Source: &ngfAPI.ClientSettingsPolicy{}},
+	}
+
+	g.Expect(buildReferencedErrorPageConfigMaps(pols)).To(Equal(map[types.NamespacedName]struct{}{
+		{Namespace: "test", Name: "pages"}: {},
+		{Namespace: "test", Name: "more"}:  {},
+	}))
+}
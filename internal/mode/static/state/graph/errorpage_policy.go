@@ -0,0 +1,167 @@
+package graph
+
+import (
+	"fmt"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// errorPagePolicyProcessor validates and resolves the references of the pages of an ErrorPagePolicy and sets
+// the pages on the targeted Gateway or Route.
+type errorPagePolicyProcessor struct{}
+
+func (errorPagePolicyProcessor) validateRefs(
+	policy policies.Policy,
+	resources policyResources,
+) []conditions.Condition {
+	epp := helpers.MustCastObject[*ngfAPI.ErrorPagePolicy](policy)
+	return validateErrorPageConfigMapRefs(epp, resources.configMaps)
+}
+
+func (errorPagePolicyProcessor) resolveRefs(p *Policy, resources policyResources) {
+	epp := helpers.MustCastObject[*ngfAPI.ErrorPagePolicy](p.Source)
+
+	errorPages, cond := resolveErrorPages(epp, resources.services, resources.configMaps, resources.refGrantResolver)
+	p.ErrorPages = errorPages
+	p.Conditions = []conditions.Condition{cond}
+}
+
+func (errorPagePolicyProcessor) updateTarget(p *Policy, gateway *Gateway, route *L7Route) {
+	if gateway != nil {
+		gateway.ErrorPages = p.ErrorPages
+		return
+	}
+
+	route.ErrorPages = p.ErrorPages
+}
+
+// validateErrorPageConfigMapRefs validates that the ConfigMaps referenced by the pages of an ErrorPagePolicy
+// exist and include the content of the pages.
+func validateErrorPageConfigMapRefs(
+	policy *ngfAPI.ErrorPagePolicy,
+	clusterConfigMaps map[types.NamespacedName]*apiv1.ConfigMap,
+) []conditions.Condition {
+	var allErrs field.ErrorList
+
+	for i, page := range policy.Spec.Pages {
+		if page.Content == nil {
+			continue
+		}
+
+		path := field.NewPath("spec", "pages").Index(i).Child("content")
+		cmNsName := types.NamespacedName{Namespace: policy.Namespace, Name: string(page.Content.ConfigMapName)}
+
+		cm, exists := clusterConfigMaps[cmNsName]
+		if !exists {
+			allErrs = append(allErrs, field.NotFound(path.Child("configMapName"), cmNsName.String()))
+			continue
+		}
+
+		if _, exists := getConfigMapContent(cm, page.Content.Key); !exists {
+			allErrs = append(allErrs, field.Invalid(
+				path.Child("key"),
+				page.Content.Key,
+				fmt.Sprintf("ConfigMap %s does not have the key", cmNsName),
+			))
+		}
+	}
+
+	if len(allErrs) > 0 {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(allErrs.ToAggregate().Error())}
+	}
+
+	return nil
+}
+
+// GetErrorPageConfigMapNsNames returns the NamespacedNames of the ConfigMaps referenced by the pages of
+// an ErrorPagePolicy. The ConfigMaps are in the namespace of the Policy.
+func GetErrorPageConfigMapNsNames(policy *ngfAPI.ErrorPagePolicy) []types.NamespacedName {
+	var nsNames []types.NamespacedName
+
+	for _, page := range policy.Spec.Pages {
+		if page.Content != nil {
+			nsNames = append(nsNames, types.NamespacedName{
+				Namespace: policy.Namespace,
+				Name:      string(page.Content.ConfigMapName),
+			})
+		}
+	}
+
+	return nsNames
+}
+
+// GetErrorPageServiceNsName returns the NamespacedName of the Service referenced by a page of an ErrorPagePolicy.
+// The Service is in the namespace of the Policy unless the reference specifies another namespace.
+func GetErrorPageServiceNsName(policy *ngfAPI.ErrorPagePolicy, ref v1.BackendObjectReference) types.NamespacedName {
+	namespace := policy.Namespace
+	if ref.Namespace != nil {
+		namespace = string(*ref.Namespace)
+	}
+
+	return types.NamespacedName{Namespace: namespace, Name: string(ref.Name)}
+}
+
+// resolveErrorPages resolves the references of the pages of an ErrorPagePolicy. It returns the ErrorPages and
+// the ResolvedRefs condition of the Policy. The ConfigMaps of the pages must be validated by
+// validateErrorPageConfigMapRefs. If the backendRef of a page can't be resolved, the BackendRef of the page is
+// invalid and the condition reports the first unresolved backendRef.
+func resolveErrorPages(
+	policy *ngfAPI.ErrorPagePolicy,
+	services map[types.NamespacedName]*apiv1.Service,
+	clusterConfigMaps map[types.NamespacedName]*apiv1.ConfigMap,
+	refGrantResolver *referenceGrantResolver,
+) ([]ErrorPage, conditions.Condition) {
+	errorPages := make([]ErrorPage, 0, len(policy.Spec.Pages))
+	cond := staticConds.NewPolicyResolvedRefs()
+
+	for i, page := range policy.Spec.Pages {
+		errorPage := ErrorPage{
+			ResponseCode: page.ResponseCode,
+			Codes:        make([]int32, 0, len(page.Codes)),
+		}
+
+		for _, code := range page.Codes {
+			errorPage.Codes = append(errorPage.Codes, int32(code))
+		}
+
+		if page.Content != nil {
+			cmNsName := types.NamespacedName{Namespace: policy.Namespace, Name: string(page.Content.ConfigMapName)}
+			errorPage.Content, _ = getConfigMapContent(clusterConfigMaps[cmNsName], page.Content.Key)
+
+			errorPage.ContentType = "text/html"
+			if page.Content.ContentType != nil {
+				errorPage.ContentType = string(*page.Content.ContentType)
+			}
+		}
+
+		if page.BackendRef != nil {
+			backendRef, refCond := resolvePolicyBackendRef(
+				*page.BackendRef,
+				GetErrorPageServiceNsName(policy, *page.BackendRef),
+				fromErrorPagePolicy(policy.Namespace),
+				field.NewPath("spec", "pages").Index(i).Child("backendRef"),
+				services,
+				refGrantResolver,
+			)
+			errorPage.BackendRef = &backendRef
+
+			if !backendRef.Valid && cond.Status == metav1.ConditionTrue {
+				cond = refCond
+			}
+		}
+
+		errorPages = append(errorPages, errorPage)
+	}
+
+	return errorPages, cond
+}
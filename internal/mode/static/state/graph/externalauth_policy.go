@@ -0,0 +1,57 @@
+package graph
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+// externalAuthPolicyProcessor resolves the backendRef of an ExternalAuthPolicy and sets it on the targeted Route.
+type externalAuthPolicyProcessor struct{}
+
+func (externalAuthPolicyProcessor) resolveRefs(p *Policy, resources policyResources) {
+	eap := helpers.MustCastObject[*ngfAPI.ExternalAuthPolicy](p.Source)
+
+	backendRef, cond := resolveExternalAuthBackendRef(eap, resources.services, resources.refGrantResolver)
+	p.BackendRef = &backendRef
+	p.Conditions = []conditions.Condition{cond}
+}
+
+// ExternalAuthPolicies only target HTTPRoutes.
+func (externalAuthPolicyProcessor) updateTarget(p *Policy, _ *Gateway, route *L7Route) {
+	route.ExternalAuthBackendRef = p.BackendRef
+}
+
+// GetExternalAuthServiceNsName returns the NamespacedName of the Service referenced by an ExternalAuthPolicy.
+// The Service is in the namespace of the Policy unless the reference specifies another namespace.
+func GetExternalAuthServiceNsName(policy *ngfAPI.ExternalAuthPolicy) types.NamespacedName {
+	ref := policy.Spec.BackendRef
+
+	namespace := policy.Namespace
+	if ref.Namespace != nil {
+		namespace = string(*ref.Namespace)
+	}
+
+	return types.NamespacedName{Namespace: namespace, Name: string(ref.Name)}
+}
+
+// resolveExternalAuthBackendRef resolves the backendRef of an ExternalAuthPolicy. It returns the BackendRef and the
+// ResolvedRefs condition of the Policy. If the backendRef can't be resolved, the BackendRef is invalid.
+func resolveExternalAuthBackendRef(
+	policy *ngfAPI.ExternalAuthPolicy,
+	services map[types.NamespacedName]*apiv1.Service,
+	refGrantResolver *referenceGrantResolver,
+) (BackendRef, conditions.Condition) {
+	return resolvePolicyBackendRef(
+		policy.Spec.BackendRef,
+		GetExternalAuthServiceNsName(policy),
+		fromExternalAuthPolicy(policy.Namespace),
+		field.NewPath("spec", "backendRef"),
+		services,
+		refGrantResolver,
+	)
+}
@@ -9,6 +9,7 @@ import (
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
 	ngfsort "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/sort"
 	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
 )
@@ -21,6 +22,12 @@ type Gateway struct {
 	Listeners []*Listener
 	// Conditions holds the conditions for the Gateway.
 	Conditions []conditions.Condition
+	// EffectivePolicies holds the effective Policies of the Gateway, one per Policy kind, sorted by their kinds.
+	// An effective Policy is the result of merging the valid Policies of its kind that target the Gateway.
+	EffectivePolicies []policies.Policy
+	// ErrorPages are the error pages of the effective ErrorPagePolicy of the Gateway. They also apply to
+	// the requests that don't match any Route.
+	ErrorPages []ErrorPage
 	// Valid indicates whether the Gateway Spec is valid.
 	Valid bool
 }
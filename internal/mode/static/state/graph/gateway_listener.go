@@ -3,7 +3,9 @@ package graph
 import (
 	"errors"
 	"fmt"
+	"slices"
 
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -11,23 +13,36 @@ import (
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
 	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
 )
 
+const (
+	kindGRPCRoute v1.Kind = "GRPCRoute"
+	kindTLSRoute  v1.Kind = "TLSRoute"
+	kindTCPRoute  v1.Kind = "TCPRoute"
+	kindUDPRoute  v1.Kind = "UDPRoute"
+)
+
 // Listener represents a Listener of the Gateway resource.
-// For now, we only support HTTP and HTTPS listeners.
+// For now, we only support HTTP, HTTPS, TLS (Passthrough mode), TCP and UDP listeners.
 type Listener struct {
 	Name string
 	// Source holds the source of the Listener from the Gateway resource.
 	Source v1.Listener
 	// Routes holds the routes attached to the Listener.
 	// Only valid routes are attached.
-	Routes map[types.NamespacedName]*Route
+	Routes map[RouteKey]*L7Route
+	// L4Routes holds the layer 4 routes attached to the Listener.
+	L4Routes map[RouteKey]*L4Route
 	// AllowedRouteLabelSelector is the label selector for this Listener's allowed routes, if defined.
 	AllowedRouteLabelSelector labels.Selector
 	// ResolvedSecret is the namespaced name of the Secret resolved for this listener.
 	// Only applicable for HTTPS listeners.
 	ResolvedSecret *types.NamespacedName
+	// EffectivePolicies holds the effective Policies of the kinds of the Policies that target the Listener.
+	// The Policies that target the Gateway are merged into them.
+	EffectivePolicies []policies.Policy
 	// Conditions holds the conditions of the Listener.
 	Conditions []conditions.Condition
 	// SupportedKinds is the list of RouteGroupKinds allowed by the listener.
@@ -59,7 +74,7 @@ func buildListeners(
 }
 
 type listenerConfiguratorFactory struct {
-	http, https, unsupportedProtocol *listenerConfigurator
+	http, https, tls, tcp, udp, unsupportedProtocol *listenerConfigurator
 }
 
 func (f *listenerConfiguratorFactory) getConfiguratorForListener(l v1.Listener) *listenerConfigurator {
@@ -68,6 +83,12 @@ func (f *listenerConfiguratorFactory) getConfiguratorForListener(l v1.Listener)
 		return f.http
 	case v1.HTTPSProtocolType:
 		return f.https
+	case v1.TLSProtocolType:
+		return f.tls
+	case v1.TCPProtocolType:
+		return f.tcp
+	case v1.UDPProtocolType:
+		return f.udp
 	default:
 		return f.unsupportedProtocol
 	}
@@ -88,7 +109,13 @@ func newListenerConfiguratorFactory(
 					valErr := field.NotSupported(
 						field.NewPath("protocol"),
 						listener.Protocol,
-						[]string{string(v1.HTTPProtocolType), string(v1.HTTPSProtocolType)},
+						[]string{
+							string(v1.HTTPProtocolType),
+							string(v1.HTTPSProtocolType),
+							string(v1.TLSProtocolType),
+							string(v1.TCPProtocolType),
+							string(v1.UDPProtocolType),
+						},
 					)
 					return staticConds.NewListenerUnsupportedProtocol(valErr.Error()), false /* not attachable */
 				},
@@ -119,6 +146,37 @@ func newListenerConfiguratorFactory(
 				createExternalReferencesForTLSSecretsResolver(gw.Namespace, secretResolver, refGrantResolver),
 			},
 		},
+		tls: &listenerConfigurator{
+			validators: []listenerValidator{
+				validateListenerAllowedRouteKind,
+				validateListenerLabelSelector,
+				validateListenerHostname,
+				createTLSListenerValidator(protectedPorts),
+			},
+			conflictResolvers: []listenerConflictResolver{
+				sharedPortConflictResolver,
+			},
+		},
+		tcp: &listenerConfigurator{
+			validators: []listenerValidator{
+				validateListenerAllowedRouteKind,
+				validateListenerLabelSelector,
+				createTCPListenerValidator(protectedPorts),
+			},
+			conflictResolvers: []listenerConflictResolver{
+				sharedPortConflictResolver,
+			},
+		},
+		udp: &listenerConfigurator{
+			validators: []listenerValidator{
+				validateListenerAllowedRouteKind,
+				validateListenerLabelSelector,
+				createUDPListenerValidator(protectedPorts),
+			},
+			conflictResolvers: []listenerConflictResolver{
+				sharedPortConflictResolver,
+			},
+		},
 	}
 }
 
@@ -181,7 +239,8 @@ func (c *listenerConfigurator) configure(listener v1.Listener) *Listener {
 		Source:                    listener,
 		Conditions:                conds,
 		AllowedRouteLabelSelector: allowedRouteSelector,
-		Routes:                    make(map[types.NamespacedName]*Route),
+		Routes:                    make(map[RouteKey]*L7Route),
+		L4Routes:                  make(map[RouteKey]*L4Route),
 		Valid:                     valid,
 		Attachable:                attachable,
 		SupportedKinds:            supportedKinds,
@@ -227,19 +286,32 @@ func getAndValidateListenerSupportedKinds(listener v1.Listener) (
 	[]conditions.Condition,
 	[]v1.RouteGroupKind,
 ) {
+	var validKinds []v1.Kind
+
+	switch listener.Protocol {
+	case v1.HTTPProtocolType, v1.HTTPSProtocolType:
+		validKinds = []v1.Kind{policies.KindHTTPRoute, kindGRPCRoute}
+	case v1.TLSProtocolType:
+		validKinds = []v1.Kind{kindTLSRoute}
+	case v1.TCPProtocolType:
+		validKinds = []v1.Kind{kindTCPRoute}
+	case v1.UDPProtocolType:
+		validKinds = []v1.Kind{kindUDPRoute}
+	}
+
 	if listener.AllowedRoutes == nil || listener.AllowedRoutes.Kinds == nil {
-		return nil, []v1.RouteGroupKind{
-			{
-				Kind: "HTTPRoute",
-			},
+		supportedKinds := make([]v1.RouteGroupKind, 0, len(validKinds))
+		for _, kind := range validKinds {
+			supportedKinds = append(supportedKinds, v1.RouteGroupKind{Kind: kind})
 		}
+		return nil, supportedKinds
 	}
 	var conds []conditions.Condition
 
 	supportedKinds := make([]v1.RouteGroupKind, 0, len(listener.AllowedRoutes.Kinds))
 
-	validHTTPRouteKind := func(kind v1.RouteGroupKind) bool {
-		if kind.Kind != v1.Kind("HTTPRoute") {
+	validRouteKind := func(kind v1.RouteGroupKind) bool {
+		if !slices.Contains(validKinds, kind.Kind) {
 			return false
 		}
 		if kind.Group == nil || *kind.Group != v1.GroupName {
@@ -248,10 +320,9 @@ func getAndValidateListenerSupportedKinds(listener v1.Listener) (
 		return true
 	}
 
-	switch listener.Protocol {
-	case v1.HTTPProtocolType, v1.HTTPSProtocolType:
+	if len(validKinds) > 0 {
 		for _, kind := range listener.AllowedRoutes.Kinds {
-			if !validHTTPRouteKind(kind) {
+			if !validRouteKind(kind) {
 				msg := fmt.Sprintf("Unsupported route kind \"%s/%s\"", *kind.Group, kind.Kind)
 				conds = append(conds, staticConds.NewListenerInvalidRouteKinds(msg)...)
 				continue
@@ -380,22 +451,105 @@ func createHTTPSListenerValidator(protectedPorts ProtectedPorts) listenerValidat
 	}
 }
 
+func createTLSListenerValidator(protectedPorts ProtectedPorts) listenerValidator {
+	return func(listener v1.Listener) (conds []conditions.Condition, attachable bool) {
+		if err := validateListenerPort(listener.Port, protectedPorts); err != nil {
+			path := field.NewPath("port")
+			valErr := field.Invalid(path, listener.Port, err.Error())
+			conds = append(conds, staticConds.NewListenerUnsupportedValue(valErr.Error())...)
+		}
+
+		if listener.TLS == nil {
+			valErr := field.Required(field.NewPath("TLS"), "tls must be defined for TLS listener")
+			conds = append(conds, staticConds.NewListenerUnsupportedValue(valErr.Error())...)
+			return conds, true
+		}
+
+		tlsPath := field.NewPath("tls")
+
+		if *listener.TLS.Mode != v1.TLSModePassthrough {
+			valErr := field.NotSupported(
+				tlsPath.Child("mode"),
+				*listener.TLS.Mode,
+				[]string{string(v1.TLSModePassthrough)},
+			)
+			conds = append(conds, staticConds.NewListenerUnsupportedValue(valErr.Error())...)
+		}
+
+		if len(listener.TLS.Options) > 0 {
+			path := tlsPath.Child("options")
+			valErr := field.Forbidden(path, "options are not supported")
+			conds = append(conds, staticConds.NewListenerUnsupportedValue(valErr.Error())...)
+		}
+
+		return conds, true
+	}
+}
+
+func createTCPListenerValidator(protectedPorts ProtectedPorts) listenerValidator {
+	return func(listener v1.Listener) (conds []conditions.Condition, attachable bool) {
+		if err := validateListenerPort(listener.Port, protectedPorts); err != nil {
+			path := field.NewPath("port")
+			valErr := field.Invalid(path, listener.Port, err.Error())
+			conds = append(conds, staticConds.NewListenerUnsupportedValue(valErr.Error())...)
+		}
+
+		if listener.TLS != nil {
+			path := field.NewPath("tls")
+			valErr := field.Forbidden(path, "tls is not supported for TCP listener")
+			conds = append(conds, staticConds.NewListenerUnsupportedValue(valErr.Error())...)
+		}
+
+		return conds, true
+	}
+}
+
+func createUDPListenerValidator(protectedPorts ProtectedPorts) listenerValidator {
+	return func(listener v1.Listener) (conds []conditions.Condition, attachable bool) {
+		if err := validateListenerPort(listener.Port, protectedPorts); err != nil {
+			path := field.NewPath("port")
+			valErr := field.Invalid(path, listener.Port, err.Error())
+			conds = append(conds, staticConds.NewListenerUnsupportedValue(valErr.Error())...)
+		}
+
+		if listener.TLS != nil {
+			path := field.NewPath("tls")
+			valErr := field.Forbidden(path, "tls is not supported for UDP listener")
+			conds = append(conds, staticConds.NewListenerUnsupportedValue(valErr.Error())...)
+		}
+
+		return conds, true
+	}
+}
+
+// listenerPort identifies the port of a listener within its transport protocol.
+// UDP listeners don't share the port space with the listeners of the other protocols, which all run over TCP.
+type listenerPort struct {
+	number v1.PortNumber
+	udp    bool
+}
+
 func createPortConflictResolver() listenerConflictResolver {
-	conflictedPorts := make(map[v1.PortNumber]bool)
-	portProtocolOwner := make(map[v1.PortNumber]v1.ProtocolType)
-	listenersByPort := make(map[v1.PortNumber][]*Listener)
+	// conflictedPorts maps the conflicted ports to the messages of the conflict conditions.
+	conflictedPorts := make(map[listenerPort]string)
+	portProtocolOwner := make(map[listenerPort]v1.ProtocolType)
+	listenersByPort := make(map[listenerPort][]*Listener)
 
 	format := "Multiple listeners for the same port %d specify incompatible protocols; " +
 		"ensure only one protocol per port"
+	l4Format := "Multiple %[1]s listeners for the same port %[2]d; ensure only one %[1]s listener per port"
 
 	return func(l *Listener) {
-		port := l.Source.Port
+		port := listenerPort{
+			number: l.Source.Port,
+			udp:    l.Source.Protocol == v1.UDPProtocolType,
+		}
 
 		// if port is in map of conflictedPorts then we only need to set the current listener to invalid
-		if conflictedPorts[port] {
+		if msg, conflicted := conflictedPorts[port]; conflicted {
 			l.Valid = false
 
-			conflictedConds := staticConds.NewListenerProtocolConflict(fmt.Sprintf(format, port))
+			conflictedConds := staticConds.NewListenerProtocolConflict(msg)
 			l.Conditions = append(l.Conditions, conflictedConds...)
 			return
 		}
@@ -411,15 +565,25 @@ func createPortConflictResolver() listenerConflictResolver {
 			return
 		}
 
-		// if protocol owner doesn't match the listener's protocol we mark the port as conflicted,
-		// and invalidate all listeners we've seen for this port.
-		if protocol != l.Source.Protocol {
-			conflictedPorts[port] = true
-			for _, l := range listenersByPort[port] {
-				l.Valid = false
-				conflictedConds := staticConds.NewListenerProtocolConflict(fmt.Sprintf(format, port))
-				l.Conditions = append(l.Conditions, conflictedConds...)
-			}
+		var msg string
+
+		switch {
+		// if protocol owner doesn't match the listener's protocol we mark the port as conflicted.
+		case protocol != l.Source.Protocol:
+			msg = fmt.Sprintf(format, port.number)
+		// TCP and UDP listeners can't be distinguished by hostname, so only one such listener per port is allowed.
+		case protocol == v1.TCPProtocolType, protocol == v1.UDPProtocolType:
+			msg = fmt.Sprintf(l4Format, protocol, port.number)
+		default:
+			return
+		}
+
+		// invalidate all listeners we've seen for this port.
+		conflictedPorts[port] = msg
+		for _, l := range listenersByPort[port] {
+			l.Valid = false
+			conflictedConds := staticConds.NewListenerProtocolConflict(msg)
+			l.Conditions = append(l.Conditions, conflictedConds...)
 		}
 	}
 }
@@ -452,7 +616,7 @@ func createExternalReferencesForTLSSecretsResolver(
 			}
 		}
 
-		if err := secretResolver.resolve(certRefNsName); err != nil {
+		if err := secretResolver.resolve(certRefNsName, apiv1.SecretTypeTLS); err != nil {
 			path := field.NewPath("tls", "certificateRefs").Index(0)
 			// field.NotFound could be better, but it doesn't allow us to set the error message.
 			valErr := field.Invalid(path, certRefNsName, err.Error())
@@ -237,6 +237,205 @@ func TestValidateHTTPSListener(t *testing.T) {
 	}
 }
 
+func TestValidateTLSListener(t *testing.T) {
+	protectedPorts := ProtectedPorts{9113: "MetricsPort"}
+
+	tests := []struct {
+		l        v1.Listener
+		name     string
+		expected []conditions.Condition
+	}{
+		{
+			l: v1.Listener{
+				Port: 443,
+				TLS: &v1.GatewayTLSConfig{
+					Mode: helpers.GetPointer(v1.TLSModePassthrough),
+				},
+			},
+			expected: nil,
+			name:     "valid",
+		},
+		{
+			l: v1.Listener{
+				Port: 0,
+				TLS: &v1.GatewayTLSConfig{
+					Mode: helpers.GetPointer(v1.TLSModePassthrough),
+				},
+			},
+			expected: staticConds.NewListenerUnsupportedValue(`port: Invalid value: 0: port must be between 1-65535`),
+			name:     "invalid port",
+		},
+		{
+			l: v1.Listener{
+				Port: 9113,
+				TLS: &v1.GatewayTLSConfig{
+					Mode: helpers.GetPointer(v1.TLSModePassthrough),
+				},
+			},
+			expected: staticConds.NewListenerUnsupportedValue(
+				`port: Invalid value: 9113: port is already in use as MetricsPort`,
+			),
+			name: "invalid protected port",
+		},
+		{
+			l: v1.Listener{
+				Port: 443,
+			},
+			expected: staticConds.NewListenerUnsupportedValue(
+				"TLS: Required value: tls must be defined for TLS listener",
+			),
+			name: "no tls",
+		},
+		{
+			l: v1.Listener{
+				Port: 443,
+				TLS: &v1.GatewayTLSConfig{
+					Mode:    helpers.GetPointer(v1.TLSModePassthrough),
+					Options: map[v1.AnnotationKey]v1.AnnotationValue{"key": "val"},
+				},
+			},
+			expected: staticConds.NewListenerUnsupportedValue("tls.options: Forbidden: options are not supported"),
+			name:     "invalid options",
+		},
+		{
+			l: v1.Listener{
+				Port: 443,
+				TLS: &v1.GatewayTLSConfig{
+					Mode: helpers.GetPointer(v1.TLSModeTerminate),
+				},
+			},
+			expected: staticConds.NewListenerUnsupportedValue(
+				`tls.mode: Unsupported value: "Terminate": supported values: "Passthrough"`,
+			),
+			name: "invalid tls mode",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			v := createTLSListenerValidator(protectedPorts)
+
+			result, attachable := v(test.l)
+			g.Expect(result).To(Equal(test.expected))
+			g.Expect(attachable).To(BeTrue())
+		})
+	}
+}
+
+func TestValidateTCPListener(t *testing.T) {
+	protectedPorts := ProtectedPorts{9113: "MetricsPort"}
+
+	tests := []struct {
+		l        v1.Listener
+		name     string
+		expected []conditions.Condition
+	}{
+		{
+			l: v1.Listener{
+				Port: 5432,
+			},
+			expected: nil,
+			name:     "valid",
+		},
+		{
+			l: v1.Listener{
+				Port: 0,
+			},
+			expected: staticConds.NewListenerUnsupportedValue(`port: Invalid value: 0: port must be between 1-65535`),
+			name:     "invalid port",
+		},
+		{
+			l: v1.Listener{
+				Port: 9113,
+			},
+			expected: staticConds.NewListenerUnsupportedValue(
+				`port: Invalid value: 9113: port is already in use as MetricsPort`,
+			),
+			name: "invalid protected port",
+		},
+		{
+			l: v1.Listener{
+				Port: 5432,
+				TLS: &v1.GatewayTLSConfig{
+					Mode: helpers.GetPointer(v1.TLSModePassthrough),
+				},
+			},
+			expected: staticConds.NewListenerUnsupportedValue("tls: Forbidden: tls is not supported for TCP listener"),
+			name:     "tls defined",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			v := createTCPListenerValidator(protectedPorts)
+
+			result, attachable := v(test.l)
+			g.Expect(result).To(Equal(test.expected))
+			g.Expect(attachable).To(BeTrue())
+		})
+	}
+}
+
+func TestValidateUDPListener(t *testing.T) {
+	protectedPorts := ProtectedPorts{9113: "MetricsPort"}
+
+	tests := []struct {
+		l        v1.Listener
+		name     string
+		expected []conditions.Condition
+	}{
+		{
+			l: v1.Listener{
+				Port: 53,
+			},
+			expected: nil,
+			name:     "valid",
+		},
+		{
+			l: v1.Listener{
+				Port: 0,
+			},
+			expected: staticConds.NewListenerUnsupportedValue(`port: Invalid value: 0: port must be between 1-65535`),
+			name:     "invalid port",
+		},
+		{
+			l: v1.Listener{
+				Port: 9113,
+			},
+			expected: staticConds.NewListenerUnsupportedValue(
+				`port: Invalid value: 9113: port is already in use as MetricsPort`,
+			),
+			name: "invalid protected port",
+		},
+		{
+			l: v1.Listener{
+				Port: 53,
+				TLS: &v1.GatewayTLSConfig{
+					Mode: helpers.GetPointer(v1.TLSModePassthrough),
+				},
+			},
+			expected: staticConds.NewListenerUnsupportedValue("tls: Forbidden: tls is not supported for UDP listener"),
+			name:     "tls defined",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			v := createUDPListenerValidator(protectedPorts)
+
+			result, attachable := v(test.l)
+			g.Expect(result).To(Equal(test.expected))
+			g.Expect(attachable).To(BeTrue())
+		})
+	}
+}
+
 func TestValidateListenerHostname(t *testing.T) {
 	tests := []struct {
 		hostname  *v1.Hostname
@@ -294,12 +493,30 @@ func TestGetAndValidateListenerSupportedKinds(t *testing.T) {
 			Group: helpers.GetPointer[v1.Group](v1.GroupName),
 		},
 	}
+	GRPCRouteGroupKind := []v1.RouteGroupKind{
+		{
+			Kind:  "GRPCRoute",
+			Group: helpers.GetPointer[v1.Group](v1.GroupName),
+		},
+	}
+	TLSRouteGroupKind := []v1.RouteGroupKind{
+		{
+			Kind:  "TLSRoute",
+			Group: helpers.GetPointer[v1.Group](v1.GroupName),
+		},
+	}
 	TCPRouteGroupKind := []v1.RouteGroupKind{
 		{
 			Kind:  "TCPRoute",
 			Group: helpers.GetPointer[v1.Group](v1.GroupName),
 		},
 	}
+	UDPRouteGroupKind := []v1.RouteGroupKind{
+		{
+			Kind:  "UDPRoute",
+			Group: helpers.GetPointer[v1.Group](v1.GroupName),
+		},
+	}
 	tests := []struct {
 		protocol  v1.ProtocolType
 		name      string
@@ -308,7 +525,7 @@ func TestGetAndValidateListenerSupportedKinds(t *testing.T) {
 		expectErr bool
 	}{
 		{
-			protocol:  v1.TCPProtocolType,
+			protocol:  "SCTP",
 			expectErr: false,
 			name:      "unsupported protocol is ignored",
 			kind:      TCPRouteGroupKind,
@@ -355,8 +572,18 @@ func TestGetAndValidateListenerSupportedKinds(t *testing.T) {
 				{
 					Kind: "HTTPRoute",
 				},
+				{
+					Kind: "GRPCRoute",
+				},
 			},
 		},
+		{
+			protocol:  v1.HTTPProtocolType,
+			kind:      GRPCRouteGroupKind,
+			expectErr: false,
+			name:      "valid GRPC",
+			expected:  GRPCRouteGroupKind,
+		},
 		{
 			protocol: v1.HTTPProtocolType,
 			kind: []v1.RouteGroupKind{
@@ -373,6 +600,78 @@ func TestGetAndValidateListenerSupportedKinds(t *testing.T) {
 			name:      "valid and invalid kinds",
 			expected:  HTTPRouteGroupKind,
 		},
+		{
+			protocol:  v1.TLSProtocolType,
+			kind:      TLSRouteGroupKind,
+			expectErr: false,
+			name:      "valid TLS",
+			expected:  TLSRouteGroupKind,
+		},
+		{
+			protocol:  v1.TLSProtocolType,
+			expectErr: false,
+			name:      "valid TLS no kind specified",
+			expected: []v1.RouteGroupKind{
+				{
+					Kind: "TLSRoute",
+				},
+			},
+		},
+		{
+			protocol:  v1.TLSProtocolType,
+			kind:      HTTPRouteGroupKind,
+			expectErr: true,
+			name:      "invalid kind for TLS",
+			expected:  []v1.RouteGroupKind{},
+		},
+		{
+			protocol:  v1.TCPProtocolType,
+			kind:      TCPRouteGroupKind,
+			expectErr: false,
+			name:      "valid TCP",
+			expected:  TCPRouteGroupKind,
+		},
+		{
+			protocol:  v1.TCPProtocolType,
+			expectErr: false,
+			name:      "valid TCP no kind specified",
+			expected: []v1.RouteGroupKind{
+				{
+					Kind: "TCPRoute",
+				},
+			},
+		},
+		{
+			protocol:  v1.TCPProtocolType,
+			kind:      TLSRouteGroupKind,
+			expectErr: true,
+			name:      "invalid kind for TCP",
+			expected:  []v1.RouteGroupKind{},
+		},
+		{
+			protocol:  v1.UDPProtocolType,
+			kind:      UDPRouteGroupKind,
+			expectErr: false,
+			name:      "valid UDP",
+			expected:  UDPRouteGroupKind,
+		},
+		{
+			protocol:  v1.UDPProtocolType,
+			expectErr: false,
+			name:      "valid UDP no kind specified",
+			expected: []v1.RouteGroupKind{
+				{
+					Kind: "UDPRoute",
+				},
+			},
+		},
+		{
+			protocol:  v1.UDPProtocolType,
+			kind:      TCPRouteGroupKind,
+			expectErr: true,
+			name:      "invalid kind for UDP",
+			expected:  []v1.RouteGroupKind{},
+		},
 	}
 
 	for _, test := range tests {
@@ -247,12 +247,26 @@ func TestBuildGateway(t *testing.T) {
 	createHTTPListener := func(name, hostname string, port int) v1.Listener {
 		return createListener(name, hostname, port, v1.HTTPProtocolType, nil)
 	}
-	createTCPListener := func(name, hostname string, port int) v1.Listener {
-		return createListener(name, hostname, port, v1.TCPProtocolType, nil)
+	createSCTPListener := func(name, hostname string, port int) v1.Listener {
+		return createListener(name, hostname, port, "SCTP", nil)
 	}
 	createHTTPSListener := func(name, hostname string, port int, tls *v1.GatewayTLSConfig) v1.Listener {
 		return createListener(name, hostname, port, v1.HTTPSProtocolType, tls)
 	}
+	createTCPListener := func(name string, port int) v1.Listener {
+		return v1.Listener{
+			Name:     v1.SectionName(name),
+			Port:     v1.PortNumber(port),
+			Protocol: v1.TCPProtocolType,
+		}
+	}
+	createUDPListener := func(name string, port int) v1.Listener {
+		return v1.Listener{
+			Name:     v1.SectionName(name),
+			Port:     v1.PortNumber(port),
+			Protocol: v1.UDPProtocolType,
+		}
+	}
 
 	// foo http listeners
 	foo80Listener1 := createHTTPListener("foo-80-1", "foo.example.com", 80)
@@ -272,6 +286,17 @@ func TestBuildGateway(t *testing.T) {
 	bar443HTTPSListener := createHTTPSListener("bar-443-https", "bar.example.com", 443, gatewayTLSConfigSameNs)
 	bar8443HTTPSListener := createHTTPSListener("bar-8443-https", "bar.example.com", 8443, gatewayTLSConfigSameNs)
 
+	// tcp listeners
+	tcp5432Listener1 := createTCPListener("tcp-5432-1", 5432)
+	tcp5432Listener2 := createTCPListener("tcp-5432-2", 5432)
+	tcp6379Listener := createTCPListener("tcp-6379", 6379)
+	tcp53Listener := createTCPListener("tcp-53", 53)
+
+	// udp listeners
+	udp53Listener := createUDPListener("udp-53", 53)
+	udp514Listener1 := createUDPListener("udp-514-1", 514)
+	udp514Listener2 := createUDPListener("udp-514-2", 514)
+
 	// https listener that references secret in different namespace
 	crossNamespaceSecretListener := createHTTPSListener(
 		"listener-cross-ns-secret",
@@ -281,7 +306,7 @@ func TestBuildGateway(t *testing.T) {
 	)
 
 	// invalid listeners
-	invalidProtocolListener := createTCPListener("invalid-protocol", "bar.example.com", 80)
+	invalidProtocolListener := createSCTPListener("invalid-protocol", "bar.example.com", 80)
 	invalidPortListener := createHTTPListener("invalid-port", "invalid-port", 0)
 	invalidProtectedPortListener := createHTTPListener("invalid-protected-port", "invalid-protected-port", 9113)
 	invalidHostnameListener := createHTTPListener("invalid-hostname", "$example.com", 80)
@@ -310,6 +335,10 @@ func TestBuildGateway(t *testing.T) {
 
 		conflict443PortMsg = "Multiple listeners for the same port 443 specify incompatible protocols; " +
 			"ensure only one protocol per port"
+
+		conflict5432PortMsg = "Multiple TCP listeners for the same port 5432; ensure only one TCP listener per port"
+
+		conflict514PortMsg = "Multiple UDP listeners for the same port 514; ensure only one UDP listener per port"
 	)
 
 	type gatewayCfg struct {
@@ -360,9 +389,11 @@ func TestBuildGateway(t *testing.T) {
 						Source:     foo80Listener1,
 						Valid:      true,
 						Attachable: true,
-						Routes:     map[types.NamespacedName]*Route{},
+						Routes:     map[RouteKey]*L7Route{},
+						L4Routes:   map[RouteKey]*L4Route{},
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 					{
@@ -370,9 +401,11 @@ func TestBuildGateway(t *testing.T) {
 						Source:     foo8080Listener,
 						Valid:      true,
 						Attachable: true,
-						Routes:     map[types.NamespacedName]*Route{},
+						Routes:     map[RouteKey]*L7Route{},
+						L4Routes:   map[RouteKey]*L4Route{},
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 				},
@@ -393,10 +426,12 @@ func TestBuildGateway(t *testing.T) {
 						Source:         foo443HTTPSListener1,
 						Valid:          true,
 						Attachable:     true,
-						Routes:         map[types.NamespacedName]*Route{},
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[RouteKey]*L4Route{},
 						ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(secretSameNs)),
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 					{
@@ -404,10 +439,12 @@ func TestBuildGateway(t *testing.T) {
 						Source:         foo8443HTTPSListener,
 						Valid:          true,
 						Attachable:     true,
-						Routes:         map[types.NamespacedName]*Route{},
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[RouteKey]*L4Route{},
 						ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(secretSameNs)),
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 				},
@@ -427,7 +464,8 @@ func TestBuildGateway(t *testing.T) {
 						Valid:                     true,
 						Attachable:                true,
 						AllowedRouteLabelSelector: labels.SelectorFromSet(labels.Set(labelSet)),
-						Routes:                    map[types.NamespacedName]*Route{},
+						Routes:                    map[RouteKey]*L7Route{},
+						L4Routes:                  map[RouteKey]*L4Route{},
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute", Group: helpers.GetPointer[v1.Group](v1.GroupName)},
 						},
@@ -472,10 +510,12 @@ func TestBuildGateway(t *testing.T) {
 						Source:         crossNamespaceSecretListener,
 						Valid:          true,
 						Attachable:     true,
-						Routes:         map[types.NamespacedName]*Route{},
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[RouteKey]*L4Route{},
 						ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(secretDiffNamespace)),
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 				},
@@ -497,9 +537,11 @@ func TestBuildGateway(t *testing.T) {
 						Conditions: staticConds.NewListenerRefNotPermitted(
 							`Certificate ref to secret diff-ns/secret not permitted by any ReferenceGrant`,
 						),
-						Routes: map[types.NamespacedName]*Route{},
+						Routes:   map[RouteKey]*L7Route{},
+						L4Routes: map[RouteKey]*L4Route{},
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 				},
@@ -521,7 +563,8 @@ func TestBuildGateway(t *testing.T) {
 						Conditions: staticConds.NewListenerUnsupportedValue(
 							`invalid label selector: "invalid" is not a valid label selector operator`,
 						),
-						Routes: map[types.NamespacedName]*Route{},
+						Routes:   map[RouteKey]*L7Route{},
+						L4Routes: map[RouteKey]*L4Route{},
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute", Group: helpers.GetPointer[v1.Group](v1.GroupName)},
 						},
@@ -543,12 +586,11 @@ func TestBuildGateway(t *testing.T) {
 						Valid:      false,
 						Attachable: false,
 						Conditions: staticConds.NewListenerUnsupportedProtocol(
-							`protocol: Unsupported value: "TCP": supported values: "HTTP", "HTTPS"`,
+							`protocol: Unsupported value: "SCTP": supported values: "HTTP", "HTTPS", "TLS", "TCP", "UDP"`,
 						),
-						Routes: map[types.NamespacedName]*Route{},
-						SupportedKinds: []v1.RouteGroupKind{
-							{Kind: "HTTPRoute"},
-						},
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[RouteKey]*L4Route{},
+						SupportedKinds: []v1.RouteGroupKind{},
 					},
 				},
 				Valid: true,
@@ -577,9 +619,11 @@ func TestBuildGateway(t *testing.T) {
 						Conditions: staticConds.NewListenerUnsupportedValue(
 							`port: Invalid value: 0: port must be between 1-65535`,
 						),
-						Routes: map[types.NamespacedName]*Route{},
+						Routes:   map[RouteKey]*L7Route{},
+						L4Routes: map[RouteKey]*L4Route{},
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 					{
@@ -590,9 +634,11 @@ func TestBuildGateway(t *testing.T) {
 						Conditions: staticConds.NewListenerUnsupportedValue(
 							`port: Invalid value: 65536: port must be between 1-65535`,
 						),
-						Routes: map[types.NamespacedName]*Route{},
+						Routes:   map[RouteKey]*L7Route{},
+						L4Routes: map[RouteKey]*L4Route{},
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 					{
@@ -603,9 +649,11 @@ func TestBuildGateway(t *testing.T) {
 						Conditions: staticConds.NewListenerUnsupportedValue(
 							`port: Invalid value: 9113: port is already in use as MetricsPort`,
 						),
-						Routes: map[types.NamespacedName]*Route{},
+						Routes:   map[RouteKey]*L7Route{},
+						L4Routes: map[RouteKey]*L4Route{},
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 				},
@@ -626,9 +674,11 @@ func TestBuildGateway(t *testing.T) {
 						Source:     invalidHostnameListener,
 						Valid:      false,
 						Conditions: staticConds.NewListenerUnsupportedValue(invalidHostnameMsg),
-						Routes:     map[types.NamespacedName]*Route{},
+						Routes:     map[RouteKey]*L7Route{},
+						L4Routes:   map[RouteKey]*L4Route{},
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 					{
@@ -636,9 +686,11 @@ func TestBuildGateway(t *testing.T) {
 						Source:     invalidHTTPSHostnameListener,
 						Valid:      false,
 						Conditions: staticConds.NewListenerUnsupportedValue(invalidHostnameMsg),
-						Routes:     map[types.NamespacedName]*Route{},
+						Routes:     map[RouteKey]*L7Route{},
+						L4Routes:   map[RouteKey]*L4Route{},
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 				},
@@ -657,12 +709,14 @@ func TestBuildGateway(t *testing.T) {
 						Source:     invalidTLSConfigListener,
 						Valid:      false,
 						Attachable: true,
-						Routes:     map[types.NamespacedName]*Route{},
+						Routes:     map[RouteKey]*L7Route{},
+						L4Routes:   map[RouteKey]*L4Route{},
 						Conditions: staticConds.NewListenerInvalidCertificateRef(
 							`tls.certificateRefs[0]: Invalid value: test/does-not-exist: secret does not exist`,
 						),
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 				},
@@ -694,9 +748,11 @@ func TestBuildGateway(t *testing.T) {
 						Source:     foo80Listener1,
 						Valid:      true,
 						Attachable: true,
-						Routes:     map[types.NamespacedName]*Route{},
+						Routes:     map[RouteKey]*L7Route{},
+						L4Routes:   map[RouteKey]*L4Route{},
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 					{
@@ -704,9 +760,11 @@ func TestBuildGateway(t *testing.T) {
 						Source:     foo8080Listener,
 						Valid:      true,
 						Attachable: true,
-						Routes:     map[types.NamespacedName]*Route{},
+						Routes:     map[RouteKey]*L7Route{},
+						L4Routes:   map[RouteKey]*L4Route{},
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 					{
@@ -714,9 +772,11 @@ func TestBuildGateway(t *testing.T) {
 						Source:     foo8081Listener,
 						Valid:      true,
 						Attachable: true,
-						Routes:     map[types.NamespacedName]*Route{},
+						Routes:     map[RouteKey]*L7Route{},
+						L4Routes:   map[RouteKey]*L4Route{},
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 					{
@@ -724,10 +784,12 @@ func TestBuildGateway(t *testing.T) {
 						Source:         foo443HTTPSListener1,
 						Valid:          true,
 						Attachable:     true,
-						Routes:         map[types.NamespacedName]*Route{},
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[RouteKey]*L4Route{},
 						ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(secretSameNs)),
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 					{
@@ -735,10 +797,12 @@ func TestBuildGateway(t *testing.T) {
 						Source:         foo8443HTTPSListener,
 						Valid:          true,
 						Attachable:     true,
-						Routes:         map[types.NamespacedName]*Route{},
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[RouteKey]*L4Route{},
 						ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(secretSameNs)),
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 					{
@@ -746,9 +810,11 @@ func TestBuildGateway(t *testing.T) {
 						Source:     bar80Listener,
 						Valid:      true,
 						Attachable: true,
-						Routes:     map[types.NamespacedName]*Route{},
+						Routes:     map[RouteKey]*L7Route{},
+						L4Routes:   map[RouteKey]*L4Route{},
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 					{
@@ -756,10 +822,12 @@ func TestBuildGateway(t *testing.T) {
 						Source:         bar443HTTPSListener,
 						Valid:          true,
 						Attachable:     true,
-						Routes:         map[types.NamespacedName]*Route{},
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[RouteKey]*L4Route{},
 						ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(secretSameNs)),
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 					{
@@ -767,10 +835,12 @@ func TestBuildGateway(t *testing.T) {
 						Source:         bar8443HTTPSListener,
 						Valid:          true,
 						Attachable:     true,
-						Routes:         map[types.NamespacedName]*Route{},
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[RouteKey]*L4Route{},
 						ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(secretSameNs)),
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 				},
@@ -800,10 +870,12 @@ func TestBuildGateway(t *testing.T) {
 						Source:     foo80Listener1,
 						Valid:      false,
 						Attachable: true,
-						Routes:     map[types.NamespacedName]*Route{},
+						Routes:     map[RouteKey]*L7Route{},
+						L4Routes:   map[RouteKey]*L4Route{},
 						Conditions: staticConds.NewListenerProtocolConflict(conflict80PortMsg),
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 					{
@@ -811,10 +883,12 @@ func TestBuildGateway(t *testing.T) {
 						Source:     bar80Listener,
 						Valid:      false,
 						Attachable: true,
-						Routes:     map[types.NamespacedName]*Route{},
+						Routes:     map[RouteKey]*L7Route{},
+						L4Routes:   map[RouteKey]*L4Route{},
 						Conditions: staticConds.NewListenerProtocolConflict(conflict80PortMsg),
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 					{
@@ -822,10 +896,12 @@ func TestBuildGateway(t *testing.T) {
 						Source:     foo443Listener,
 						Valid:      false,
 						Attachable: true,
-						Routes:     map[types.NamespacedName]*Route{},
+						Routes:     map[RouteKey]*L7Route{},
+						L4Routes:   map[RouteKey]*L4Route{},
 						Conditions: staticConds.NewListenerProtocolConflict(conflict443PortMsg),
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 					{
@@ -833,11 +909,13 @@ func TestBuildGateway(t *testing.T) {
 						Source:         foo80HTTPSListener,
 						Valid:          false,
 						Attachable:     true,
-						Routes:         map[types.NamespacedName]*Route{},
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[RouteKey]*L4Route{},
 						Conditions:     staticConds.NewListenerProtocolConflict(conflict80PortMsg),
 						ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(secretSameNs)),
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 					{
@@ -845,11 +923,13 @@ func TestBuildGateway(t *testing.T) {
 						Source:         foo443HTTPSListener1,
 						Valid:          false,
 						Attachable:     true,
-						Routes:         map[types.NamespacedName]*Route{},
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[RouteKey]*L4Route{},
 						Conditions:     staticConds.NewListenerProtocolConflict(conflict443PortMsg),
 						ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(secretSameNs)),
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 					{
@@ -857,11 +937,13 @@ func TestBuildGateway(t *testing.T) {
 						Source:         bar443HTTPSListener,
 						Valid:          false,
 						Attachable:     true,
-						Routes:         map[types.NamespacedName]*Route{},
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[RouteKey]*L4Route{},
 						Conditions:     staticConds.NewListenerProtocolConflict(conflict443PortMsg),
 						ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(secretSameNs)),
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: "HTTPRoute"},
+							{Kind: "GRPCRoute"},
 						},
 					},
 				},
@@ -869,6 +951,99 @@ func TestBuildGateway(t *testing.T) {
 			},
 			name: "port/protocol collisions",
 		},
+		{
+			gateway: createGateway(
+				gatewayCfg{listeners: []v1.Listener{tcp5432Listener1, tcp5432Listener2, tcp6379Listener}},
+			),
+			gatewayClass: validGC,
+			expected: &Gateway{
+				Source: getLastCreatedGetaway(),
+				Listeners: []*Listener{
+					{
+						Name:           "tcp-5432-1",
+						Source:         tcp5432Listener1,
+						Valid:          false,
+						Attachable:     true,
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[RouteKey]*L4Route{},
+						Conditions:     staticConds.NewListenerProtocolConflict(conflict5432PortMsg),
+						SupportedKinds: []v1.RouteGroupKind{{Kind: "TCPRoute"}},
+					},
+					{
+						Name:           "tcp-5432-2",
+						Source:         tcp5432Listener2,
+						Valid:          false,
+						Attachable:     true,
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[RouteKey]*L4Route{},
+						Conditions:     staticConds.NewListenerProtocolConflict(conflict5432PortMsg),
+						SupportedKinds: []v1.RouteGroupKind{{Kind: "TCPRoute"}},
+					},
+					{
+						Name:           "tcp-6379",
+						Source:         tcp6379Listener,
+						Valid:          true,
+						Attachable:     true,
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[RouteKey]*L4Route{},
+						SupportedKinds: []v1.RouteGroupKind{{Kind: "TCPRoute"}},
+					},
+				},
+				Valid: true,
+			},
+			name: "tcp listeners with port collision",
+		},
+		{
+			gateway: createGateway(
+				gatewayCfg{listeners: []v1.Listener{tcp53Listener, udp53Listener, udp514Listener1, udp514Listener2}},
+			),
+			gatewayClass: validGC,
+			expected: &Gateway{
+				Source: getLastCreatedGetaway(),
+				Listeners: []*Listener{
+					{
+						Name:           "tcp-53",
+						Source:         tcp53Listener,
+						Valid:          true,
+						Attachable:     true,
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[RouteKey]*L4Route{},
+						SupportedKinds: []v1.RouteGroupKind{{Kind: "TCPRoute"}},
+					},
+					{
+						Name:           "udp-53",
+						Source:         udp53Listener,
+						Valid:          true,
+						Attachable:     true,
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[RouteKey]*L4Route{},
+						SupportedKinds: []v1.RouteGroupKind{{Kind: "UDPRoute"}},
+					},
+					{
+						Name:           "udp-514-1",
+						Source:         udp514Listener1,
+						Valid:          false,
+						Attachable:     true,
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[RouteKey]*L4Route{},
+						Conditions:     staticConds.NewListenerProtocolConflict(conflict514PortMsg),
+						SupportedKinds: []v1.RouteGroupKind{{Kind: "UDPRoute"}},
+					},
+					{
+						Name:           "udp-514-2",
+						Source:         udp514Listener2,
+						Valid:          false,
+						Attachable:     true,
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[RouteKey]*L4Route{},
+						Conditions:     staticConds.NewListenerProtocolConflict(conflict514PortMsg),
+						SupportedKinds: []v1.RouteGroupKind{{Kind: "UDPRoute"}},
+					},
+				},
+				Valid: true,
+			},
+			name: "tcp and udp listeners on the same port and udp listeners with port collision",
+		},
 		{
 			gateway: createGateway(
 				gatewayCfg{
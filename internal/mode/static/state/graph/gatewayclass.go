@@ -7,6 +7,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/gatewayclass"
 	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
@@ -60,13 +61,14 @@ func processGatewayClasses(
 
 func buildGatewayClass(
 	gc *v1.GatewayClass,
+	np *NginxProxy,
 	crdVersions map[types.NamespacedName]*metav1.PartialObjectMetadata,
 ) *GatewayClass {
 	if gc == nil {
 		return nil
 	}
 
-	conds, valid := validateGatewayClass(gc, crdVersions)
+	conds, valid := validateGatewayClass(gc, np, crdVersions)
 
 	return &GatewayClass{
 		Source:     gc,
@@ -77,20 +79,53 @@ func buildGatewayClass(
 
 func validateGatewayClass(
 	gc *v1.GatewayClass,
+	np *NginxProxy,
 	crdVersions map[types.NamespacedName]*metav1.PartialObjectMetadata,
 ) ([]conditions.Condition, bool) {
 	var conds []conditions.Condition
 
-	valid := true
-
+	// An invalid parametersRef doesn't invalidate the GatewayClass. Instead, the parametersRef is ignored.
 	if gc.Spec.ParametersRef != nil {
-		path := field.NewPath("spec").Child("parametersRef")
-		err := field.Forbidden(path, "parametersRef is not supported")
-		conds = append(conds, staticConds.NewGatewayClassInvalidParameters(err.Error()))
-		valid = false
+		conds = append(conds, validateGatewayClassParametersRef(gc.Spec.ParametersRef, np)...)
 	}
 
 	supportedVersionConds, versionsValid := gatewayclass.ValidateCRDVersions(crdVersions)
 
-	return append(conds, supportedVersionConds...), valid && versionsValid
+	return append(conds, supportedVersionConds...), versionsValid
+}
+
+func validateGatewayClassParametersRef(ref *v1.ParametersReference, np *NginxProxy) []conditions.Condition {
+	path := field.NewPath("spec").Child("parametersRef")
+
+	if ref.Group != ngfAPI.GroupName || ref.Kind != kindNginxProxy {
+		var errs field.ErrorList
+		if ref.Group != ngfAPI.GroupName {
+			errs = append(errs, field.NotSupported(path.Child("group"), ref.Group, []string{ngfAPI.GroupName}))
+		}
+		if ref.Kind != kindNginxProxy {
+			errs = append(errs, field.NotSupported(path.Child("kind"), ref.Kind, []string{string(kindNginxProxy)}))
+		}
+
+		return []conditions.Condition{
+			staticConds.NewGatewayClassRefInvalidKind(),
+			staticConds.NewGatewayClassInvalidParameters(errs.ToAggregate().Error()),
+		}
+	}
+
+	if np == nil {
+		err := field.NotFound(path.Child("name"), ref.Name)
+
+		return []conditions.Condition{
+			staticConds.NewGatewayClassRefNotFound(),
+			staticConds.NewGatewayClassInvalidParameters(err.Error()),
+		}
+	}
+
+	conds := []conditions.Condition{staticConds.NewGatewayClassResolvedRefs()}
+
+	if !np.Valid {
+		conds = append(conds, staticConds.NewGatewayClassInvalidParameters(np.ErrMsgs.ToAggregate().Error()))
+	}
+
+	return conds
 }
@@ -6,9 +6,11 @@ import (
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/gatewayclass"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
@@ -122,12 +124,39 @@ func TestProcessGatewayClasses(t *testing.T) {
 func TestBuildGatewayClass(t *testing.T) {
 	validGC := &v1.GatewayClass{}
 
-	invalidGC := &v1.GatewayClass{
+	gcWithNginxProxy := &v1.GatewayClass{
 		Spec: v1.GatewayClassSpec{
-			ParametersRef: &v1.ParametersReference{},
+			ParametersRef: &v1.ParametersReference{
+				Group: ngfAPI.GroupName,
+				Kind:  v1.Kind("NginxProxy"),
+				Name:  "nginx-proxy",
+			},
+		},
+	}
+
+	gcWithInvalidKindRef := &v1.GatewayClass{
+		Spec: v1.GatewayClassSpec{
+			ParametersRef: &v1.ParametersReference{
+				Group: "example.com",
+				Kind:  v1.Kind("Invalid"),
+				Name:  "invalid",
+			},
 		},
 	}
 
+	validNP := &NginxProxy{
+		Source: &ngfAPI.NginxProxy{ObjectMeta: metav1.ObjectMeta{Name: "nginx-proxy"}},
+		Valid:  true,
+	}
+
+	invalidNP := &NginxProxy{
+		Source: &ngfAPI.NginxProxy{ObjectMeta: metav1.ObjectMeta{Name: "nginx-proxy"}},
+		ErrMsgs: field.ErrorList{
+			field.Invalid(field.NewPath("spec", "telemetry", "serviceName"), "my-svc", "error"),
+		},
+		Valid: false,
+	}
+
 	validCRDs := map[types.NamespacedName]*metav1.PartialObjectMetadata{
 		{Name: "gateways.gateway.networking.k8s.io"}: {
 			ObjectMeta: metav1.ObjectMeta{
@@ -150,6 +179,7 @@ func TestBuildGatewayClass(t *testing.T) {
 
 	tests := []struct {
 		gc          *v1.GatewayClass
+		np          *NginxProxy
 		crdMetadata map[types.NamespacedName]*metav1.PartialObjectMetadata
 		expected    *GatewayClass
 		name        string
@@ -169,18 +199,63 @@ func TestBuildGatewayClass(t *testing.T) {
 			name:     "no gatewayclass",
 		},
 		{
-			gc:          invalidGC,
+			gc:          gcWithNginxProxy,
+			np:          validNP,
 			crdMetadata: validCRDs,
 			expected: &GatewayClass{
-				Source: invalidGC,
-				Valid:  false,
+				Source:     gcWithNginxProxy,
+				Valid:      true,
+				Conditions: []conditions.Condition{staticConds.NewGatewayClassResolvedRefs()},
+			},
+			name: "valid gatewayclass with NginxProxy",
+		},
+		{
+			gc:          gcWithInvalidKindRef,
+			crdMetadata: validCRDs,
+			expected: &GatewayClass{
+				Source: gcWithInvalidKindRef,
+				Valid:  true,
+				Conditions: []conditions.Condition{
+					staticConds.NewGatewayClassRefInvalidKind(),
+					staticConds.NewGatewayClassInvalidParameters(
+						"[spec.parametersRef.group: Unsupported value: \"example.com\": " +
+							"supported values: \"gateway.nginx.org\", " +
+							"spec.parametersRef.kind: Unsupported value: \"Invalid\": supported values: \"NginxProxy\"]",
+					),
+				},
+			},
+			name: "parametersRef with invalid kind",
+		},
+		{
+			gc:          gcWithNginxProxy,
+			crdMetadata: validCRDs,
+			expected: &GatewayClass{
+				Source: gcWithNginxProxy,
+				Valid:  true,
+				Conditions: []conditions.Condition{
+					staticConds.NewGatewayClassRefNotFound(),
+					staticConds.NewGatewayClassInvalidParameters(
+						"spec.parametersRef.name: Not found: \"nginx-proxy\"",
+					),
+				},
+			},
+			name: "parametersRef to non-existent NginxProxy",
+		},
+		{
+			gc:          gcWithNginxProxy,
+			np:          invalidNP,
+			crdMetadata: validCRDs,
+			expected: &GatewayClass{
+				Source: gcWithNginxProxy,
+				Valid:  true,
 				Conditions: []conditions.Condition{
+					staticConds.NewGatewayClassResolvedRefs(),
 					staticConds.NewGatewayClassInvalidParameters(
-						"spec.parametersRef: Forbidden: parametersRef is not supported",
+						"spec.telemetry.serviceName: Invalid value: \"my-svc\": error",
 					),
 				},
 			},
-			name: "invalid gatewayclass; parameters ref",
+			name: "parametersRef to invalid NginxProxy",
 		},
 		{
 			gc:          validGC,
@@ -198,7 +273,7 @@ func TestBuildGatewayClass(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
 
-			result := buildGatewayClass(test.gc, test.crdMetadata)
+			result := buildGatewayClass(test.gc, test.np, test.crdMetadata)
 			g.Expect(helpers.Diff(test.expected, result)).To(BeEmpty())
 		})
 	}
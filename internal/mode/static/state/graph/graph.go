@@ -10,7 +10,9 @@ import (
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/controller/index"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
 )
 
@@ -19,6 +21,10 @@ type ClusterState struct {
 	GatewayClasses     map[types.NamespacedName]*gatewayv1.GatewayClass
 	Gateways           map[types.NamespacedName]*gatewayv1.Gateway
 	HTTPRoutes         map[types.NamespacedName]*gatewayv1.HTTPRoute
+	GRPCRoutes         map[types.NamespacedName]*v1alpha2.GRPCRoute
+	TLSRoutes          map[types.NamespacedName]*v1alpha2.TLSRoute
+	TCPRoutes          map[types.NamespacedName]*v1alpha2.TCPRoute
+	UDPRoutes          map[types.NamespacedName]*v1alpha2.UDPRoute
 	Services           map[types.NamespacedName]*v1.Service
 	Namespaces         map[types.NamespacedName]*v1.Namespace
 	ReferenceGrants    map[types.NamespacedName]*v1beta1.ReferenceGrant
@@ -26,6 +32,8 @@ type ClusterState struct {
 	CRDMetadata        map[types.NamespacedName]*metav1.PartialObjectMetadata
 	BackendTLSPolicies map[types.NamespacedName]*v1alpha2.BackendTLSPolicy
 	ConfigMaps         map[types.NamespacedName]*v1.ConfigMap
+	NGFPolicies        map[PolicyKey]policies.Policy
+	NginxProxies       map[types.NamespacedName]*ngfAPI.NginxProxy
 }
 
 // Graph is a Graph-like representation of Gateway API resources.
@@ -42,22 +50,31 @@ type Graph struct {
 	// GatewayClassName field of the resource) but ignored. It doesn't hold the Gateway resources that do not belong to
 	// the NGINX Gateway Fabric.
 	IgnoredGateways map[types.NamespacedName]*gatewayv1.Gateway
-	// Routes holds Route resources.
-	Routes map[types.NamespacedName]*Route
-	// ReferencedSecrets includes Secrets referenced by Gateway Listeners, including invalid ones.
+	// Routes holds layer 7 Route resources.
+	Routes map[RouteKey]*L7Route
+	// L4Routes holds layer 4 Route resources.
+	L4Routes map[RouteKey]*L4Route
+	// ReferencedSecrets includes Secrets referenced by Gateway Listeners and NGF Policies, including invalid ones.
 	// It is different from the other maps, because it includes entries for Secrets that do not exist
 	// in the cluster. We need such entries so that we can query the Graph to determine if a Secret is referenced
 	// by the Gateway, including the case when the Secret is newly created.
 	ReferencedSecrets map[types.NamespacedName]*Secret
 	// ReferencedNamespaces includes Namespaces with labels that match the Gateway Listener's label selector.
 	ReferencedNamespaces map[types.NamespacedName]*v1.Namespace
-	// ReferencedServices includes the NamespacedNames of all the Services that are referenced by at least one HTTPRoute.
+	// ReferencedServices includes the NamespacedNames of all the Services that are referenced by at least one Route.
 	// Storing the whole resource is not necessary, compared to the similar maps above.
-	ReferencedServices map[types.NamespacedName]struct{}
+	ReferencedServices map[types.NamespacedName]*ReferencedService
 	// ReferencedCaCertConfigMaps includes ConfigMaps that have been referenced by any BackendTLSPolicies.
 	ReferencedCaCertConfigMaps map[types.NamespacedName]*CaCertConfigMap
+	// ReferencedErrorPageConfigMaps includes the NamespacedNames of the ConfigMaps referenced by the ErrorPagePolicies
+	// of the Gateway or Routes, including the ConfigMaps that do not exist in the cluster.
+	ReferencedErrorPageConfigMaps map[types.NamespacedName]struct{}
 	// BackendTLSPolicies holds BackendTLSPolicy resources.
 	BackendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy
+	// NGFPolicies holds the NGF Policy resources that target the Gateway or Routes of NGF.
+	NGFPolicies map[PolicyKey]*Policy
+	// NginxProxy holds the NginxProxy resource referenced by the GatewayClass, if it exists.
+	NginxProxy *NginxProxy
 }
 
 // ProtectedPorts are the ports that may not be configured by a listener with a descriptive name of each port.
@@ -70,8 +87,12 @@ func (g *Graph) IsReferenced(resourceType client.Object, nsname types.Namespaced
 		_, exists := g.ReferencedSecrets[nsname]
 		return exists
 	case *v1.ConfigMap:
-		_, exists := g.ReferencedCaCertConfigMaps[nsname]
-		return exists
+		_, existsCaCert := g.ReferencedCaCertConfigMaps[nsname]
+		_, existsErrorPage := g.ReferencedErrorPageConfigMaps[nsname]
+		return existsCaCert || existsErrorPage
+	// NginxProxy reference exists if the GatewayClass references it, even if the NginxProxy doesn't exist yet.
+	case *ngfAPI.NginxProxy:
+		return isNginxProxyReferenced(nsname, g.GatewayClass)
 	case *v1.Namespace:
 		// `existed` is needed as it checks the graph's ReferencedNamespaces which stores all the namespaces that
 		// match the Gateway listener's label selector when the graph was created. This covers the case when
@@ -88,11 +109,11 @@ func (g *Graph) IsReferenced(resourceType client.Object, nsname types.Namespaced
 		_, existed := g.ReferencedNamespaces[nsname]
 		exists := isNamespaceReferenced(obj, g.Gateway)
 		return existed || exists
-	// Service reference exists if at least one HTTPRoute references it.
+	// Service reference exists if at least one Route references it.
 	case *v1.Service:
 		_, exists := g.ReferencedServices[nsname]
 		return exists
-	// EndpointSlice reference exists if its Service owner is referenced by at least one HTTPRoute.
+	// EndpointSlice reference exists if its Service owner is referenced by at least one Route.
 	case *discoveryV1.EndpointSlice:
 		svcName := index.GetServiceNameFromEndpointSlice(obj)
 
@@ -118,7 +139,11 @@ func BuildGraph(
 		return &Graph{}
 	}
 
-	gc := buildGatewayClass(processedGwClasses.Winner, state.CRDMetadata)
+	npCfg := buildNginxProxy(
+		getNginxProxy(state.NginxProxies, processedGwClasses.Winner),
+		validators.GenericValidator,
+	)
+	gc := buildGatewayClass(processedGwClasses.Winner, npCfg, state.CRDMetadata)
 
 	secretResolver := newSecretResolver(state.Secrets)
 	configMapResolver := newConfigMapResolver(state.ConfigMaps)
@@ -135,25 +160,56 @@ func BuildGraph(
 		gw,
 	)
 
-	routes := buildRoutesForGateways(validators.HTTPFieldsValidator, state.HTTPRoutes, processedGws.GetAllNsNames())
+	routes := buildRoutesForGateways(
+		validators.HTTPFieldsValidator,
+		state.HTTPRoutes,
+		state.GRPCRoutes,
+		processedGws.GetAllNsNames(),
+	)
 	bindRoutesToListeners(routes, gw, state.Namespaces)
 	addBackendRefsToRouteRules(routes, refGrantResolver, state.Services, processedBackendTLSPolicies)
 
+	l4Routes := buildL4RoutesForGateways(
+		state.TLSRoutes,
+		state.TCPRoutes,
+		state.UDPRoutes,
+		processedGws.GetAllNsNames(),
+	)
+	bindL4RoutesToListeners(l4Routes, gw, state.Namespaces)
+	addBackendRefsToL4Routes(l4Routes, refGrantResolver, state.Services)
+
 	referencedNamespaces := buildReferencedNamespaces(state.Namespaces, gw)
 
-	referencedServices := buildReferencedServices(routes)
+	referencedServices := buildReferencedServices(routes, l4Routes, state.NGFPolicies, gw)
+
+	processedPolicies := processPolicies(
+		state.NGFPolicies,
+		validators.PolicyValidator,
+		gw,
+		routes,
+		referencedServices,
+		secretResolver,
+		refGrantResolver,
+		state.Services,
+		state.ConfigMaps,
+		&policies.GlobalSettings{TelemetryEnabled: npCfg.TelemetryEnabled()},
+	)
 
 	g := &Graph{
-		GatewayClass:               gc,
-		Gateway:                    gw,
-		Routes:                     routes,
-		IgnoredGatewayClasses:      processedGwClasses.Ignored,
-		IgnoredGateways:            processedGws.Ignored,
-		ReferencedSecrets:          secretResolver.getResolvedSecrets(),
-		ReferencedNamespaces:       referencedNamespaces,
-		ReferencedServices:         referencedServices,
-		ReferencedCaCertConfigMaps: configMapResolver.getResolvedConfigMaps(),
-		BackendTLSPolicies:         processedBackendTLSPolicies,
+		GatewayClass:                  gc,
+		Gateway:                       gw,
+		Routes:                        routes,
+		L4Routes:                      l4Routes,
+		IgnoredGatewayClasses:         processedGwClasses.Ignored,
+		IgnoredGateways:               processedGws.Ignored,
+		ReferencedSecrets:             secretResolver.getResolvedSecrets(),
+		ReferencedNamespaces:          referencedNamespaces,
+		ReferencedServices:            referencedServices,
+		ReferencedCaCertConfigMaps:    configMapResolver.getResolvedConfigMaps(),
+		ReferencedErrorPageConfigMaps: buildReferencedErrorPageConfigMaps(processedPolicies),
+		BackendTLSPolicies:            processedBackendTLSPolicies,
+		NGFPolicies:                   processedPolicies,
+		NginxProxy:                    npCfg,
 	}
 
 	return g
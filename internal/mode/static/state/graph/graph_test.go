@@ -9,15 +9,18 @@ import (
 	discoveryV1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/controller/index"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
 	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
@@ -34,14 +37,36 @@ func TestBuildGraph(t *testing.T) {
 		8081: "HealthPort",
 	}
 
-	createValidRuleWithBackendRefs := func(refs []BackendRef) Rule {
-		return Rule{
+	createValidRuleWithBackendRefs := func(matches []gatewayv1.HTTPRouteMatch, refs []BackendRef) RouteRule {
+		return RouteRule{
 			ValidMatches: true,
 			ValidFilters: true,
 			BackendRefs:  refs,
+			Matches:      matches,
+			RouteBackendRefs: []RouteBackendRef{
+				{
+					BackendRef: gatewayv1.BackendRef{
+						BackendObjectReference: gatewayv1.BackendObjectReference{
+							Kind:      (*gatewayv1.Kind)(helpers.GetPointer("Service")),
+							Name:      "foo",
+							Namespace: (*gatewayv1.Namespace)(helpers.GetPointer("service")),
+							Port:      (*gatewayv1.PortNumber)(helpers.GetPointer[int32](80)),
+						},
+					},
+				},
+			},
 		}
 	}
 
+	routeMatches := []gatewayv1.HTTPRouteMatch{
+		{
+			Path: &gatewayv1.HTTPPathMatch{
+				Type:  helpers.GetPointer(gatewayv1.PathMatchPathPrefix),
+				Value: helpers.GetPointer("/"),
+			},
+		},
+	}
+
 	createRoute := func(name string, gatewayName string, listenerName string) *gatewayv1.HTTPRoute {
 		return &gatewayv1.HTTPRoute{
 			ObjectMeta: metav1.ObjectMeta{
@@ -63,14 +88,7 @@ func TestBuildGraph(t *testing.T) {
 				},
 				Rules: []gatewayv1.HTTPRouteRule{
 					{
-						Matches: []gatewayv1.HTTPRouteMatch{
-							{
-								Path: &gatewayv1.HTTPPathMatch{
-									Type:  helpers.GetPointer(gatewayv1.PathMatchPathPrefix),
-									Value: helpers.GetPointer("/"),
-								},
-							},
-						},
+						Matches: routeMatches,
 						BackendRefs: []gatewayv1.HTTPBackendRef{
 							{
 								BackendRef: gatewayv1.BackendRef{
@@ -93,6 +111,78 @@ func TestBuildGraph(t *testing.T) {
 	hr2 := createRoute("hr-2", "wrong-gateway", "listener-80-1")
 	hr3 := createRoute("hr-3", "gateway-1", "listener-443-1") // https listener; should not conflict with hr1
 
+	gr := &v1alpha2.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "gr",
+		},
+		Spec: v1alpha2.GRPCRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{
+					{
+						Namespace:   (*gatewayv1.Namespace)(helpers.GetPointer("test")),
+						Name:        gatewayv1.ObjectName("gateway-1"),
+						SectionName: (*gatewayv1.SectionName)(helpers.GetPointer("listener-80-1")),
+					},
+				},
+			},
+			Hostnames: []gatewayv1.Hostname{
+				"bar.example.com",
+			},
+			Rules: []v1alpha2.GRPCRouteRule{
+				{
+					BackendRefs: []v1alpha2.GRPCBackendRef{
+						{
+							BackendRef: gatewayv1.BackendRef{
+								BackendObjectReference: gatewayv1.BackendObjectReference{
+									Kind:      (*gatewayv1.Kind)(helpers.GetPointer("Service")),
+									Name:      "foo",
+									Namespace: (*gatewayv1.Namespace)(helpers.GetPointer("service")),
+									Port:      (*gatewayv1.PortNumber)(helpers.GetPointer[int32](80)),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tr := &v1alpha2.TLSRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "tr",
+		},
+		Spec: v1alpha2.TLSRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{
+					{
+						Namespace:   (*gatewayv1.Namespace)(helpers.GetPointer("test")),
+						Name:        gatewayv1.ObjectName("gateway-1"),
+						SectionName: (*gatewayv1.SectionName)(helpers.GetPointer("listener-8443-1")),
+					},
+				},
+			},
+			Hostnames: []gatewayv1.Hostname{
+				"app.example.com",
+			},
+			Rules: []v1alpha2.TLSRouteRule{
+				{
+					BackendRefs: []gatewayv1.BackendRef{
+						{
+							BackendObjectReference: gatewayv1.BackendObjectReference{
+								Kind:      (*gatewayv1.Kind)(helpers.GetPointer("Service")),
+								Name:      "foo",
+								Namespace: (*gatewayv1.Namespace)(helpers.GetPointer("service")),
+								Port:      (*gatewayv1.PortNumber)(helpers.GetPointer[int32](80)),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
 	cm := &v1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "configmap",
@@ -106,6 +196,7 @@ func TestBuildGraph(t *testing.T) {
 	btpAcceptedConds := []conditions.Condition{
 		staticConds.NewBackendTLSPolicyAccepted(),
 		staticConds.NewBackendTLSPolicyAccepted(),
+		staticConds.NewBackendTLSPolicyAccepted(),
 	}
 
 	btp := BackendTLSPolicy{
@@ -142,6 +233,85 @@ func TestBuildGraph(t *testing.T) {
 		CaCertRef:    types.NamespacedName{Namespace: "service", Name: "configmap"},
 	}
 
+	cspGVK := schema.GroupVersionKind{Group: ngfAPI.GroupName, Version: "v1alpha1", Kind: "ClientSettingsPolicy"}
+	opGVK := schema.GroupVersionKind{Group: ngfAPI.GroupName, Version: "v1alpha1", Kind: "ObservabilityPolicy"}
+
+	createClientSettingsPolicy := func(name string, kind gatewayv1.Kind, targetName string) *Policy {
+		return &Policy{
+			Source: &ngfAPI.ClientSettingsPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "test",
+				},
+				Spec: ngfAPI.ClientSettingsPolicySpec{
+					TargetRef: v1alpha2.PolicyTargetReference{
+						Group: gatewayv1.GroupName,
+						Kind:  kind,
+						Name:  gatewayv1.ObjectName(targetName),
+					},
+					Body: &ngfAPI.ClientBody{
+						MaxSize: helpers.GetPointer[ngfAPI.Size]("10m"),
+					},
+				},
+			},
+			Ancestor: gatewayv1.ParentReference{
+				Group:     helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName),
+				Kind:      helpers.GetPointer(kind),
+				Namespace: helpers.GetPointer[gatewayv1.Namespace]("test"),
+				Name:      gatewayv1.ObjectName(targetName),
+			},
+			Conditions: []conditions.Condition{staticConds.NewPolicyAccepted()},
+			Valid:      true,
+		}
+	}
+
+	gwClientSettingsPolicy := createClientSettingsPolicy("gw-csp", "Gateway", "gateway-1")
+	hrClientSettingsPolicy := createClientSettingsPolicy("hr-csp", "HTTPRoute", "hr-1")
+
+	np := &ngfAPI.NginxProxy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nginx-proxy",
+		},
+		Spec: ngfAPI.NginxProxySpec{
+			Telemetry: &ngfAPI.Telemetry{
+				Exporter: &ngfAPI.TelemetryExporter{
+					Endpoint: "my-otel.svc:4317",
+				},
+			},
+		},
+	}
+
+	hrObservabilityPolicy := &Policy{
+		Source: &ngfAPI.ObservabilityPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "hr-obs",
+				Namespace: "test",
+			},
+			Spec: ngfAPI.ObservabilityPolicySpec{
+				TargetRef: v1alpha2.PolicyTargetReference{
+					Group: gatewayv1.GroupName,
+					Kind:  "HTTPRoute",
+					Name:  "hr-1",
+				},
+				Tracing: &ngfAPI.Tracing{
+					Strategy: ngfAPI.TraceStrategyRatio,
+				},
+			},
+		},
+		Ancestor: gatewayv1.ParentReference{
+			Group:     helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName),
+			Kind:      helpers.GetPointer[gatewayv1.Kind]("HTTPRoute"),
+			Namespace: helpers.GetPointer[gatewayv1.Namespace]("test"),
+			Name:      "hr-1",
+		},
+		Conditions: []conditions.Condition{staticConds.NewPolicyAccepted()},
+		Valid:      true,
+	}
+
+	gwClientSettingsPolicyKey := PolicyKey{NsName: types.NamespacedName{Namespace: "test", Name: "gw-csp"}, GVK: cspGVK}
+	hrClientSettingsPolicyKey := PolicyKey{NsName: types.NamespacedName{Namespace: "test", Name: "hr-csp"}, GVK: cspGVK}
+	hrObservabilityPolicyKey := PolicyKey{NsName: types.NamespacedName{Namespace: "test", Name: "hr-obs"}, GVK: opGVK}
+
 	hr1Refs := []BackendRef{
 		{
 			SvcNsName:        types.NamespacedName{Namespace: "service", Name: "foo"},
@@ -152,6 +322,16 @@ func TestBuildGraph(t *testing.T) {
 		},
 	}
 
+	grRefs := []BackendRef{
+		{
+			SvcNsName:        types.NamespacedName{Namespace: "service", Name: "foo"},
+			ServicePort:      v1.ServicePort{Port: 80},
+			Valid:            true,
+			Weight:           1,
+			BackendTLSPolicy: &btp,
+		},
+	}
+
 	hr3Refs := []BackendRef{
 		{
 			SvcNsName:        types.NamespacedName{Namespace: "service", Name: "foo"},
@@ -225,6 +405,16 @@ func TestBuildGraph(t *testing.T) {
 						},
 						Protocol: gatewayv1.HTTPSProtocolType,
 					},
+
+					{
+						Name:     "listener-8443-1",
+						Hostname: nil,
+						Port:     8443,
+						TLS: &gatewayv1.GatewayTLSConfig{
+							Mode: helpers.GetPointer(gatewayv1.TLSModePassthrough),
+						},
+						Protocol: gatewayv1.TLSProtocolType,
+					},
 				},
 			},
 		}
@@ -279,6 +469,16 @@ func TestBuildGraph(t *testing.T) {
 					Kind:      "HTTPRoute",
 					Namespace: "test",
 				},
+				{
+					Group:     gatewayv1.GroupName,
+					Kind:      "GRPCRoute",
+					Namespace: "test",
+				},
+				{
+					Group:     gatewayv1.GroupName,
+					Kind:      "TLSRoute",
+					Namespace: "test",
+				},
 			},
 			To: []v1beta1.ReferenceGrantTo{
 				{
@@ -302,6 +502,12 @@ func TestBuildGraph(t *testing.T) {
 				client.ObjectKeyFromObject(hr2): hr2,
 				client.ObjectKeyFromObject(hr3): hr3,
 			},
+			GRPCRoutes: map[types.NamespacedName]*v1alpha2.GRPCRoute{
+				client.ObjectKeyFromObject(gr): gr,
+			},
+			TLSRoutes: map[types.NamespacedName]*v1alpha2.TLSRoute{
+				client.ObjectKeyFromObject(tr): tr,
+			},
 			Services: map[types.NamespacedName]*v1.Service{
 				client.ObjectKeyFromObject(svc): svc,
 			},
@@ -321,48 +527,120 @@ func TestBuildGraph(t *testing.T) {
 			ConfigMaps: map[types.NamespacedName]*v1.ConfigMap{
 				client.ObjectKeyFromObject(cm): cm,
 			},
+			NGFPolicies: map[PolicyKey]policies.Policy{
+				gwClientSettingsPolicyKey: gwClientSettingsPolicy.Source,
+				hrClientSettingsPolicyKey: hrClientSettingsPolicy.Source,
+				hrObservabilityPolicyKey:  hrObservabilityPolicy.Source,
+			},
+			NginxProxies: map[types.NamespacedName]*ngfAPI.NginxProxy{
+				client.ObjectKeyFromObject(np): np,
+			},
 		}
 	}
 
-	routeHR1 := &Route{
+	routeHR1 := &L7Route{
+		RouteType:  RouteTypeHTTP,
 		Valid:      true,
 		Attachable: true,
 		Source:     hr1,
 		ParentRefs: []ParentRef{
 			{
-				Idx:     0,
-				Gateway: client.ObjectKeyFromObject(gw1),
+				Idx:         0,
+				Gateway:     client.ObjectKeyFromObject(gw1),
+				SectionName: hr1.Spec.ParentRefs[0].SectionName,
 				Attachment: &ParentRefAttachmentStatus{
 					Attached:          true,
 					AcceptedHostnames: map[string][]string{"listener-80-1": {"foo.example.com"}},
 				},
 			},
 		},
-		Rules: []Rule{createValidRuleWithBackendRefs(hr1Refs)},
+		Spec: L7RouteSpec{
+			Hostnames: hr1.Spec.Hostnames,
+			Rules:     []RouteRule{createValidRuleWithBackendRefs(routeMatches, hr1Refs)},
+		},
+		EffectivePolicies: []policies.Policy{hrClientSettingsPolicy.Source, hrObservabilityPolicy.Source},
 	}
 
-	routeHR3 := &Route{
+	routeHR3 := &L7Route{
+		RouteType:  RouteTypeHTTP,
 		Valid:      true,
 		Attachable: true,
 		Source:     hr3,
 		ParentRefs: []ParentRef{
 			{
-				Idx:     0,
-				Gateway: client.ObjectKeyFromObject(gw1),
+				Idx:         0,
+				Gateway:     client.ObjectKeyFromObject(gw1),
+				SectionName: hr3.Spec.ParentRefs[0].SectionName,
 				Attachment: &ParentRefAttachmentStatus{
 					Attached:          true,
 					AcceptedHostnames: map[string][]string{"listener-443-1": {"foo.example.com"}},
 				},
 			},
 		},
-		Rules: []Rule{createValidRuleWithBackendRefs(hr3Refs)},
+		Spec: L7RouteSpec{
+			Hostnames: hr3.Spec.Hostnames,
+			Rules:     []RouteRule{createValidRuleWithBackendRefs(routeMatches, hr3Refs)},
+		},
+	}
+
+	routeGR := &L7Route{
+		RouteType:  RouteTypeGRPC,
+		Valid:      true,
+		Attachable: true,
+		Source:     gr,
+		ParentRefs: []ParentRef{
+			{
+				Idx:         0,
+				Gateway:     client.ObjectKeyFromObject(gw1),
+				SectionName: gr.Spec.ParentRefs[0].SectionName,
+				Attachment: &ParentRefAttachmentStatus{
+					Attached:          true,
+					AcceptedHostnames: map[string][]string{"listener-80-1": {"bar.example.com"}},
+				},
+			},
+		},
+		Spec: L7RouteSpec{
+			Hostnames: gr.Spec.Hostnames,
+			Rules:     []RouteRule{createValidRuleWithBackendRefs(routeMatches, grRefs)},
+		},
+	}
+
+	routeTR := &L4Route{
+		RouteType:  RouteTypeTLS,
+		Valid:      true,
+		Attachable: true,
+		Source:     tr,
+		ParentRefs: []ParentRef{
+			{
+				Idx:         0,
+				Gateway:     client.ObjectKeyFromObject(gw1),
+				SectionName: tr.Spec.ParentRefs[0].SectionName,
+				Attachment: &ParentRefAttachmentStatus{
+					Attached:          true,
+					AcceptedHostnames: map[string][]string{"listener-8443-1": {"app.example.com"}},
+				},
+			},
+		},
+		Spec: L4RouteSpec{
+			Hostnames:        tr.Spec.Hostnames,
+			RouteBackendRefs: tr.Spec.Rules[0].BackendRefs,
+			BackendRefs: []BackendRef{
+				{
+					SvcNsName:   types.NamespacedName{Namespace: "service", Name: "foo"},
+					ServicePort: v1.ServicePort{Port: 80},
+					Valid:       true,
+					Weight:      1,
+				},
+			},
+		},
 	}
 
 	createExpectedGraphWithGatewayClass := func(gc *gatewayv1.GatewayClass) *Graph {
 		return &Graph{
 			GatewayClass: &GatewayClass{
-				Source: gc,
-				Valid:  true,
+				Source:     gc,
+				Valid:      true,
+				Conditions: []conditions.Condition{staticConds.NewGatewayClassResolvedRefs()},
 			},
 			Gateway: &Gateway{
 				Source: gw1,
@@ -372,10 +650,12 @@ func TestBuildGraph(t *testing.T) {
 						Source:     gw1.Spec.Listeners[0],
 						Valid:      true,
 						Attachable: true,
-						Routes: map[types.NamespacedName]*Route{
-							{Namespace: "test", Name: "hr-1"}: routeHR1,
+						Routes: map[RouteKey]*L7Route{
+							CreateRouteKey(hr1): routeHR1,
+							CreateRouteKey(gr):  routeGR,
 						},
-						SupportedKinds:            []gatewayv1.RouteGroupKind{{Kind: "HTTPRoute"}},
+						L4Routes:                  map[RouteKey]*L4Route{},
+						SupportedKinds:            []gatewayv1.RouteGroupKind{{Kind: "HTTPRoute"}, {Kind: "GRPCRoute"}},
 						AllowedRouteLabelSelector: labels.SelectorFromSet(map[string]string{"app": "allowed"}),
 					},
 					{
@@ -383,21 +663,38 @@ func TestBuildGraph(t *testing.T) {
 						Source:     gw1.Spec.Listeners[1],
 						Valid:      true,
 						Attachable: true,
-						Routes: map[types.NamespacedName]*Route{
-							{Namespace: "test", Name: "hr-3"}: routeHR3,
+						Routes: map[RouteKey]*L7Route{
+							CreateRouteKey(hr3): routeHR3,
 						},
+						L4Routes:       map[RouteKey]*L4Route{},
 						ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(secret)),
-						SupportedKinds: []gatewayv1.RouteGroupKind{{Kind: "HTTPRoute"}},
+						SupportedKinds: []gatewayv1.RouteGroupKind{{Kind: "HTTPRoute"}, {Kind: "GRPCRoute"}},
+					},
+					{
+						Name:       "listener-8443-1",
+						Source:     gw1.Spec.Listeners[2],
+						Valid:      true,
+						Attachable: true,
+						Routes:     map[RouteKey]*L7Route{},
+						L4Routes: map[RouteKey]*L4Route{
+							CreateRouteKey(tr): routeTR,
+						},
+						SupportedKinds: []gatewayv1.RouteGroupKind{{Kind: "TLSRoute"}},
 					},
 				},
-				Valid: true,
+				EffectivePolicies: []policies.Policy{gwClientSettingsPolicy.Source},
+				Valid:             true,
 			},
 			IgnoredGateways: map[types.NamespacedName]*gatewayv1.Gateway{
 				{Namespace: "test", Name: "gateway-2"}: gw2,
 			},
-			Routes: map[types.NamespacedName]*Route{
-				{Namespace: "test", Name: "hr-1"}: routeHR1,
-				{Namespace: "test", Name: "hr-3"}: routeHR3,
+			Routes: map[RouteKey]*L7Route{
+				CreateRouteKey(hr1): routeHR1,
+				CreateRouteKey(hr3): routeHR3,
+				CreateRouteKey(gr):  routeGR,
+			},
+			L4Routes: map[RouteKey]*L4Route{
+				CreateRouteKey(tr): routeTR,
 			},
 			ReferencedSecrets: map[types.NamespacedName]*Secret{
 				client.ObjectKeyFromObject(secret): {
@@ -407,7 +704,7 @@ func TestBuildGraph(t *testing.T) {
 			ReferencedNamespaces: map[types.NamespacedName]*v1.Namespace{
 				client.ObjectKeyFromObject(ns): ns,
 			},
-			ReferencedServices: map[types.NamespacedName]struct{}{
+			ReferencedServices: map[types.NamespacedName]*ReferencedService{
 				client.ObjectKeyFromObject(svc): {},
 			},
 			ReferencedCaCertConfigMaps: map[types.NamespacedName]*CaCertConfigMap{
@@ -419,6 +716,15 @@ func TestBuildGraph(t *testing.T) {
 			BackendTLSPolicies: map[types.NamespacedName]*BackendTLSPolicy{
 				client.ObjectKeyFromObject(btp.Source): &btp,
 			},
+			NGFPolicies: map[PolicyKey]*Policy{
+				gwClientSettingsPolicyKey: gwClientSettingsPolicy,
+				hrClientSettingsPolicyKey: hrClientSettingsPolicy,
+				hrObservabilityPolicyKey:  hrObservabilityPolicy,
+			},
+			NginxProxy: &NginxProxy{
+				Source: np,
+				Valid:  true,
+			},
 		}
 	}
 
@@ -428,6 +734,11 @@ func TestBuildGraph(t *testing.T) {
 		},
 		Spec: gatewayv1.GatewayClassSpec{
 			ControllerName: controllerName,
+			ParametersRef: &gatewayv1.ParametersReference{
+				Group: ngfAPI.GroupName,
+				Kind:  gatewayv1.Kind("NginxProxy"),
+				Name:  "nginx-proxy",
+			},
 		},
 	}
 	differentControllerGC := &gatewayv1.GatewayClass{
@@ -464,7 +775,15 @@ func TestBuildGraph(t *testing.T) {
 				test.store,
 				controllerName,
 				gcName,
-				validation.Validators{HTTPFieldsValidator: &validationfakes.FakeHTTPFieldsValidator{}},
+				validation.Validators{
+					HTTPFieldsValidator: &validationfakes.FakeHTTPFieldsValidator{},
+					GenericValidator:    &validationfakes.FakeGenericValidator{},
+					PolicyValidator: &validationfakes.FakePolicyValidator{
+						MergeStub: func(_, child policies.Policy) policies.Policy {
+							return child
+						},
+					},
+				},
 				protectedPorts,
 			)
 
@@ -581,9 +900,39 @@ func TestIsReferenced(t *testing.T) {
 			Name:      "configmap",
 		},
 	}
+	errorPageConfigMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "error-pages",
+		},
+	}
+
+	npNotReferenced := &ngfAPI.NginxProxy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nginx-proxy-not-referenced",
+		},
+	}
+	npReferenced := &ngfAPI.NginxProxy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nginx-proxy",
+		},
+	}
+
+	gc := &GatewayClass{
+		Source: &gatewayv1.GatewayClass{
+			Spec: gatewayv1.GatewayClassSpec{
+				ParametersRef: &gatewayv1.ParametersReference{
+					Group: ngfAPI.GroupName,
+					Kind:  gatewayv1.Kind("NginxProxy"),
+					Name:  "nginx-proxy",
+				},
+			},
+		},
+	}
 
 	graph := &Graph{
-		Gateway: gw,
+		GatewayClass: gc,
+		Gateway:      gw,
 		ReferencedSecrets: map[types.NamespacedName]*Secret{
 			client.ObjectKeyFromObject(baseSecret): {
 				Source: baseSecret,
@@ -592,7 +941,7 @@ func TestIsReferenced(t *testing.T) {
 		ReferencedNamespaces: map[types.NamespacedName]*v1.Namespace{
 			client.ObjectKeyFromObject(nsInGraph): nsInGraph,
 		},
-		ReferencedServices: map[types.NamespacedName]struct{}{
+		ReferencedServices: map[types.NamespacedName]*ReferencedService{
 			client.ObjectKeyFromObject(serviceInGraph): {},
 		},
 		ReferencedCaCertConfigMaps: map[types.NamespacedName]*CaCertConfigMap{
@@ -601,6 +950,9 @@ func TestIsReferenced(t *testing.T) {
 				CACert: []byte(caBlock),
 			},
 		},
+		ReferencedErrorPageConfigMaps: map[types.NamespacedName]struct{}{
+			client.ObjectKeyFromObject(errorPageConfigMap): {},
+		},
 	}
 
 	tests := []struct {
@@ -703,6 +1055,12 @@ func TestIsReferenced(t *testing.T) {
 			graph:    graph,
 			expected: true,
 		},
+		{
+			name:     "ConfigMap in graph's ReferencedErrorPageConfigMaps is referenced",
+			resource: errorPageConfigMap,
+			graph:    graph,
+			expected: true,
+		},
 		{
 			name:     "ConfigMap not in ReferencedConfigMaps with same Namespace and different Name is not referenced",
 			resource: sameNamespaceDifferentNameConfigMap,
@@ -716,6 +1074,26 @@ func TestIsReferenced(t *testing.T) {
 			expected: false,
 		},
 
+		// NginxProxy cases
+		{
+			name:     "NginxProxy referenced by the GatewayClass is referenced",
+			resource: npReferenced,
+			graph:    graph,
+			expected: true,
+		},
+		{
+			name:     "NginxProxy not referenced by the GatewayClass is not referenced",
+			resource: npNotReferenced,
+			graph:    graph,
+			expected: false,
+		},
+		{
+			name:     "NginxProxy is not referenced when there is no GatewayClass",
+			resource: npReferenced,
+			graph:    &Graph{},
+			expected: false,
+		},
+
 		// Edge cases
 		{
 			name:     "Resource is not supported by IsReferenced",
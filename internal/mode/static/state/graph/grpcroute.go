@@ -0,0 +1,263 @@
+package graph
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+func buildGRPCRoute(
+	validator validation.HTTPFieldsValidator,
+	ghr *v1alpha2.GRPCRoute,
+	gatewayNsNames []types.NamespacedName,
+) *L7Route {
+	r := &L7Route{
+		Source:    ghr,
+		RouteType: RouteTypeGRPC,
+	}
+	sectionNameRefs, err := buildSectionNameRefs(ghr.Spec.ParentRefs, ghr.Namespace, gatewayNsNames)
+	if err != nil {
+		r.Valid = false
+
+		return r
+	}
+	// route doesn't belong to any of the Gateways
+	if len(sectionNameRefs) == 0 {
+		return nil
+	}
+	r.ParentRefs = sectionNameRefs
+
+	if err := validateHostnames(
+		ghr.Spec.Hostnames,
+		field.NewPath("spec").Child("hostnames"),
+	); err != nil {
+		r.Valid = false
+		r.Conditions = append(r.Conditions, staticConds.NewRouteUnsupportedValue(err.Error()))
+
+		return r
+	}
+
+	r.Spec.Hostnames = ghr.Spec.Hostnames
+
+	r.Valid = true
+	r.Attachable = true
+
+	r.Spec.Rules = make([]RouteRule, len(ghr.Spec.Rules))
+
+	for i, rule := range ghr.Spec.Rules {
+		backendRefs := make([]RouteBackendRef, 0, len(rule.BackendRefs))
+		for _, ref := range rule.BackendRefs {
+			backendRefs = append(backendRefs, RouteBackendRef{
+				BackendRef: ref.BackendRef,
+				Filters:    convertGRPCFilters(ref.Filters),
+			})
+		}
+
+		r.Spec.Rules[i] = RouteRule{
+			Matches:          convertGRPCMatches(rule.Matches),
+			Filters:          convertGRPCFilters(rule.Filters),
+			RouteBackendRefs: backendRefs,
+		}
+	}
+
+	validateRouteRules(r, func(idx int, rulePath *field.Path) (matchesErrs, filtersErrs field.ErrorList) {
+		rule := ghr.Spec.Rules[idx]
+
+		for j, match := range rule.Matches {
+			matchPath := rulePath.Child("matches").Index(j)
+			matchesErrs = append(matchesErrs, validateGRPCMatch(validator, match, matchPath)...)
+		}
+
+		for j, filter := range rule.Filters {
+			filterPath := rulePath.Child("filters").Index(j)
+			filtersErrs = append(filtersErrs, validateGRPCFilter(validator, filter, filterPath)...)
+		}
+
+		filtersErrs = append(filtersErrs, validateBackendRefFilters(
+			validator,
+			r.Spec.Rules[idx].RouteBackendRefs,
+			rulePath.Child("backendRefs"),
+		)...)
+
+		return matchesErrs, filtersErrs
+	})
+
+	return r
+}
+
+// convertGRPCMatches converts GRPCRouteMatches to the equivalent HTTPRouteMatches.
+// gRPC requests are HTTP/2 POST requests to the path /<service>/<method>, so a method match is converted to
+// a path match. A rule without matches matches all gRPC requests.
+// The matches are expected to be validated by validateGRPCMatch. The result for invalid matches is undefined.
+func convertGRPCMatches(grpcMatches []v1alpha2.GRPCRouteMatch) []v1.HTTPRouteMatch {
+	if len(grpcMatches) == 0 {
+		return []v1.HTTPRouteMatch{
+			{
+				Path: &v1.HTTPPathMatch{
+					Type:  helpers.GetPointer(v1.PathMatchPathPrefix),
+					Value: helpers.GetPointer("/"),
+				},
+			},
+		}
+	}
+
+	hms := make([]v1.HTTPRouteMatch, 0, len(grpcMatches))
+
+	for _, gm := range grpcMatches {
+		pathType, pathValue := v1.PathMatchPathPrefix, "/"
+
+		if gm.Method != nil && gm.Method.Service != nil {
+			if gm.Method.Method != nil {
+				pathType = v1.PathMatchExact
+				pathValue = fmt.Sprintf("/%s/%s", *gm.Method.Service, *gm.Method.Method)
+			} else {
+				pathValue = fmt.Sprintf("/%s/", *gm.Method.Service)
+			}
+		}
+
+		hm := v1.HTTPRouteMatch{
+			Path: &v1.HTTPPathMatch{
+				Type:  helpers.GetPointer(pathType),
+				Value: helpers.GetPointer(pathValue),
+			},
+		}
+
+		if len(gm.Headers) > 0 {
+			hm.Headers = make([]v1.HTTPHeaderMatch, 0, len(gm.Headers))
+			for _, h := range gm.Headers {
+				hm.Headers = append(hm.Headers, v1.HTTPHeaderMatch{
+					Type:  h.Type,
+					Name:  v1.HTTPHeaderName(h.Name),
+					Value: h.Value,
+				})
+			}
+		}
+
+		hms = append(hms, hm)
+	}
+
+	return hms
+}
+
+// convertGRPCFilters converts GRPCRouteFilters to the equivalent HTTPRouteFilters.
+// The GRPCRouteFilter types are a subset of the HTTPRouteFilter types.
+func convertGRPCFilters(grpcFilters []v1alpha2.GRPCRouteFilter) []v1.HTTPRouteFilter {
+	if len(grpcFilters) == 0 {
+		return nil
+	}
+
+	hfs := make([]v1.HTTPRouteFilter, 0, len(grpcFilters))
+
+	for _, gf := range grpcFilters {
+		hfs = append(hfs, v1.HTTPRouteFilter{
+			Type:                   v1.HTTPRouteFilterType(gf.Type),
+			RequestHeaderModifier:  gf.RequestHeaderModifier,
+			ResponseHeaderModifier: gf.ResponseHeaderModifier,
+			RequestMirror:          gf.RequestMirror,
+			ExtensionRef:           gf.ExtensionRef,
+		})
+	}
+
+	return hfs
+}
+
+func validateGRPCMatch(
+	validator validation.HTTPFieldsValidator,
+	match v1alpha2.GRPCRouteMatch,
+	matchPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	methodPath := matchPath.Child("method")
+	allErrs = append(allErrs, validateGRPCMethodMatch(validator, match.Method, methodPath)...)
+
+	for j, h := range match.Headers {
+		headerPath := matchPath.Child("headers").Index(j)
+		allErrs = append(
+			allErrs,
+			validateHeaderMatch(validator, h.Type, string(h.Name), h.Value, headerPath)...,
+		)
+	}
+
+	return allErrs
+}
+
+func validateGRPCMethodMatch(
+	validator validation.HTTPFieldsValidator,
+	method *v1alpha2.GRPCMethodMatch,
+	methodPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if method == nil {
+		return allErrs
+	}
+
+	if method.Type != nil && *method.Type != v1alpha2.GRPCMethodMatchExact {
+		valErr := field.NotSupported(
+			methodPath.Child("type"),
+			*method.Type,
+			[]string{string(v1alpha2.GRPCMethodMatchExact)},
+		)
+		allErrs = append(allErrs, valErr)
+	}
+
+	if method.Service == nil || *method.Service == "" {
+		allErrs = append(allErrs, field.Required(methodPath.Child("service"), "service is required"))
+		return allErrs
+	}
+
+	path := fmt.Sprintf("/%s/", *method.Service)
+	if method.Method != nil {
+		if *method.Method == "" {
+			allErrs = append(allErrs, field.Required(methodPath.Child("method"), "method cannot be empty"))
+			return allErrs
+		}
+		path += *method.Method
+	}
+
+	if err := validator.ValidatePathInMatch(path); err != nil {
+		valErr := field.Invalid(methodPath, path, err.Error())
+		allErrs = append(allErrs, valErr)
+	}
+
+	return allErrs
+}
+
+func validateGRPCFilter(
+	validator validation.HTTPFieldsValidator,
+	filter v1alpha2.GRPCRouteFilter,
+	filterPath *field.Path,
+) field.ErrorList {
+	switch filter.Type {
+	case v1alpha2.GRPCRouteFilterRequestHeaderModifier:
+		return validateFilterHeaderModifier(
+			validator,
+			filter.RequestHeaderModifier,
+			filterPath.Child("requestHeaderModifier"),
+		)
+	case v1alpha2.GRPCRouteFilterResponseHeaderModifier:
+		return validateFilterResponseHeaderModifier(
+			validator,
+			filter.ResponseHeaderModifier,
+			filterPath.Child("responseHeaderModifier"),
+		)
+	default:
+		valErr := field.NotSupported(
+			filterPath.Child("type"),
+			filter.Type,
+			[]string{
+				string(v1alpha2.GRPCRouteFilterRequestHeaderModifier),
+				string(v1alpha2.GRPCRouteFilterResponseHeaderModifier),
+			},
+		)
+		return field.ErrorList{valErr}
+	}
+}
@@ -0,0 +1,754 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
+)
+
+func createGRPCMethodMatch(service, method string) v1alpha2.GRPCRouteRule {
+	return v1alpha2.GRPCRouteRule{
+		Matches: []v1alpha2.GRPCRouteMatch{
+			{
+				Method: &v1alpha2.GRPCMethodMatch{
+					Type:    helpers.GetPointer(v1alpha2.GRPCMethodMatchExact),
+					Service: &service,
+					Method:  &method,
+				},
+			},
+		},
+	}
+}
+
+func createGRPCRoute(
+	name string,
+	refName string,
+	hostname v1.Hostname,
+	rules []v1alpha2.GRPCRouteRule,
+) *v1alpha2.GRPCRoute {
+	return &v1alpha2.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: v1alpha2.GRPCRouteSpec{
+			CommonRouteSpec: v1.CommonRouteSpec{
+				ParentRefs: []v1.ParentReference{
+					{
+						Namespace:   helpers.GetPointer[v1.Namespace]("test"),
+						Name:        v1.ObjectName(refName),
+						SectionName: helpers.GetPointer[v1.SectionName](sectionNameOfCreateHTTPRoute),
+					},
+				},
+			},
+			Hostnames: []v1.Hostname{hostname},
+			Rules:     rules,
+		},
+	}
+}
+
+func TestBuildGRPCRoutes(t *testing.T) {
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	gr := createGRPCRoute("gr-1", gwNsName.Name, "example.com", []v1alpha2.GRPCRouteRule{})
+
+	grWrongGateway := createGRPCRoute("gr-2", "some-gateway", "example.com", []v1alpha2.GRPCRouteRule{})
+
+	grRoutes := map[types.NamespacedName]*v1alpha2.GRPCRoute{
+		client.ObjectKeyFromObject(gr):             gr,
+		client.ObjectKeyFromObject(grWrongGateway): grWrongGateway,
+	}
+
+	tests := []struct {
+		expected  map[RouteKey]*L7Route
+		name      string
+		gwNsNames []types.NamespacedName
+	}{
+		{
+			gwNsNames: []types.NamespacedName{gwNsName},
+			expected: map[RouteKey]*L7Route{
+				CreateRouteKey(gr): {
+					RouteType: RouteTypeGRPC,
+					Source:    gr,
+					ParentRefs: []ParentRef{
+						{
+							Idx:         0,
+							Gateway:     gwNsName,
+							SectionName: gr.Spec.ParentRefs[0].SectionName,
+						},
+					},
+					Valid:      true,
+					Attachable: true,
+					Spec: L7RouteSpec{
+						Hostnames: gr.Spec.Hostnames,
+						Rules:     []RouteRule{},
+					},
+				},
+			},
+			name: "normal case",
+		},
+		{
+			gwNsNames: []types.NamespacedName{},
+			expected:  nil,
+			name:      "no gateways",
+		},
+	}
+
+	validator := &validationfakes.FakeHTTPFieldsValidator{}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			routes := buildRoutesForGateways(
+				validator,
+				map[types.NamespacedName]*v1.HTTPRoute{},
+				grRoutes,
+				test.gwNsNames,
+			)
+			g.Expect(helpers.Diff(test.expected, routes)).To(BeEmpty())
+		})
+	}
+}
+
+func TestBuildGRPCRoute(t *testing.T) {
+	gatewayNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	methodMatchRule := createGRPCMethodMatch("myService", "myMethod")
+
+	headersMatchRule := v1alpha2.GRPCRouteRule{
+		Matches: []v1alpha2.GRPCRouteMatch{
+			{
+				Headers: []v1alpha2.GRPCHeaderMatch{
+					{
+						Type:  helpers.GetPointer(v1.HeaderMatchExact),
+						Name:  "MyHeader",
+						Value: "SomeValue",
+					},
+				},
+			},
+		},
+	}
+
+	headerModifierFilter := v1alpha2.GRPCRouteFilter{
+		Type: v1alpha2.GRPCRouteFilterRequestHeaderModifier,
+		RequestHeaderModifier: &v1.HTTPHeaderFilter{
+			Set: []v1.HTTPHeader{{Name: "MyHeader", Value: "Value"}},
+		},
+	}
+
+	backendRef := v1alpha2.BackendRef{
+		BackendObjectReference: v1.BackendObjectReference{
+			Kind: helpers.GetPointer[v1.Kind]("Service"),
+			Name: "service1",
+			Port: helpers.GetPointer[v1.PortNumber](80),
+		},
+	}
+
+	grBothMatches := createGRPCRoute(
+		"gr-1",
+		gatewayNsName.Name,
+		"example.com",
+		[]v1alpha2.GRPCRouteRule{methodMatchRule, headersMatchRule},
+	)
+
+	grEmptyMatch := createGRPCRoute(
+		"gr-1",
+		gatewayNsName.Name,
+		"example.com",
+		[]v1alpha2.GRPCRouteRule{{BackendRefs: []v1alpha2.GRPCBackendRef{{BackendRef: backendRef}}}},
+	)
+
+	grValidFilterRule := createGRPCMethodMatch("myService", "myMethod")
+	grValidFilterRule.Filters = []v1alpha2.GRPCRouteFilter{headerModifierFilter}
+
+	grValidFilter := createGRPCRoute(
+		"gr",
+		gatewayNsName.Name,
+		"example.com",
+		[]v1alpha2.GRPCRouteRule{grValidFilterRule},
+	)
+
+	grInvalidMatchesRule := createGRPCMethodMatch("myService", "myMethod")
+	grInvalidMatchesRule.Matches[0].Method.Type = helpers.GetPointer(v1alpha2.GRPCMethodMatchRegularExpression)
+
+	grInvalidMatches := createGRPCRoute(
+		"gr",
+		gatewayNsName.Name,
+		"example.com",
+		[]v1alpha2.GRPCRouteRule{grInvalidMatchesRule},
+	)
+
+	grInvalidFilterRule := createGRPCMethodMatch("myService", "myMethod")
+	grInvalidFilterRule.Filters = []v1alpha2.GRPCRouteFilter{
+		{
+			Type: v1alpha2.GRPCRouteFilterRequestMirror,
+		},
+	}
+
+	grInvalidFilter := createGRPCRoute(
+		"gr",
+		gatewayNsName.Name,
+		"example.com",
+		[]v1alpha2.GRPCRouteRule{grInvalidFilterRule},
+	)
+
+	grInvalidHostname := createGRPCRoute("gr", gatewayNsName.Name, "", []v1alpha2.GRPCRouteRule{methodMatchRule})
+
+	grNotNGF := createGRPCRoute("gr", "some-gateway", "example.com", []v1alpha2.GRPCRouteRule{methodMatchRule})
+
+	grDuplicateSectionName := createGRPCRoute(
+		"gr",
+		gatewayNsName.Name,
+		"example.com",
+		[]v1alpha2.GRPCRouteRule{methodMatchRule},
+	)
+	grDuplicateSectionName.Spec.ParentRefs = append(
+		grDuplicateSectionName.Spec.ParentRefs,
+		grDuplicateSectionName.Spec.ParentRefs[0],
+	)
+
+	createAllValidValidator := func() *validationfakes.FakeHTTPFieldsValidator {
+		v := &validationfakes.FakeHTTPFieldsValidator{}
+		v.ValidateMethodInMatchReturns(true, nil)
+		return v
+	}
+
+	tests := []struct {
+		validator *validationfakes.FakeHTTPFieldsValidator
+		gr        *v1alpha2.GRPCRoute
+		expected  *L7Route
+		name      string
+	}{
+		{
+			validator: createAllValidValidator(),
+			gr:        grBothMatches,
+			expected: &L7Route{
+				RouteType: RouteTypeGRPC,
+				Source:    grBothMatches,
+				ParentRefs: []ParentRef{
+					{
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: grBothMatches.Spec.ParentRefs[0].SectionName,
+					},
+				},
+				Valid:      true,
+				Attachable: true,
+				Spec: L7RouteSpec{
+					Hostnames: grBothMatches.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							ValidMatches: true,
+							ValidFilters: true,
+							Matches: []v1.HTTPRouteMatch{
+								{
+									Path: &v1.HTTPPathMatch{
+										Type:  helpers.GetPointer(v1.PathMatchExact),
+										Value: helpers.GetPointer("/myService/myMethod"),
+									},
+								},
+							},
+							RouteBackendRefs: []RouteBackendRef{},
+						},
+						{
+							ValidMatches: true,
+							ValidFilters: true,
+							Matches: []v1.HTTPRouteMatch{
+								{
+									Path: &v1.HTTPPathMatch{
+										Type:  helpers.GetPointer(v1.PathMatchPathPrefix),
+										Value: helpers.GetPointer("/"),
+									},
+									Headers: []v1.HTTPHeaderMatch{
+										{
+											Type:  helpers.GetPointer(v1.HeaderMatchExact),
+											Name:  "MyHeader",
+											Value: "SomeValue",
+										},
+									},
+								},
+							},
+							RouteBackendRefs: []RouteBackendRef{},
+						},
+					},
+				},
+			},
+			name: "normal case with both method and header matches",
+		},
+		{
+			validator: createAllValidValidator(),
+			gr:        grEmptyMatch,
+			expected: &L7Route{
+				RouteType: RouteTypeGRPC,
+				Source:    grEmptyMatch,
+				ParentRefs: []ParentRef{
+					{
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: grEmptyMatch.Spec.ParentRefs[0].SectionName,
+					},
+				},
+				Valid:      true,
+				Attachable: true,
+				Spec: L7RouteSpec{
+					Hostnames: grEmptyMatch.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							ValidMatches: true,
+							ValidFilters: true,
+							Matches: []v1.HTTPRouteMatch{
+								{
+									Path: &v1.HTTPPathMatch{
+										Type:  helpers.GetPointer(v1.PathMatchPathPrefix),
+										Value: helpers.GetPointer("/"),
+									},
+								},
+							},
+							RouteBackendRefs: []RouteBackendRef{{BackendRef: backendRef}},
+						},
+					},
+				},
+			},
+			name: "valid rule with empty match",
+		},
+		{
+			validator: createAllValidValidator(),
+			gr:        grValidFilter,
+			expected: &L7Route{
+				RouteType: RouteTypeGRPC,
+				Source:    grValidFilter,
+				ParentRefs: []ParentRef{
+					{
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: grValidFilter.Spec.ParentRefs[0].SectionName,
+					},
+				},
+				Valid:      true,
+				Attachable: true,
+				Spec: L7RouteSpec{
+					Hostnames: grValidFilter.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							ValidMatches: true,
+							ValidFilters: true,
+							Matches: []v1.HTTPRouteMatch{
+								{
+									Path: &v1.HTTPPathMatch{
+										Type:  helpers.GetPointer(v1.PathMatchExact),
+										Value: helpers.GetPointer("/myService/myMethod"),
+									},
+								},
+							},
+							Filters: []v1.HTTPRouteFilter{
+								{
+									Type:                  v1.HTTPRouteFilterRequestHeaderModifier,
+									RequestHeaderModifier: headerModifierFilter.RequestHeaderModifier,
+								},
+							},
+							RouteBackendRefs: []RouteBackendRef{},
+						},
+					},
+				},
+			},
+			name: "valid filter",
+		},
+		{
+			validator: createAllValidValidator(),
+			gr:        grInvalidMatches,
+			expected: &L7Route{
+				RouteType: RouteTypeGRPC,
+				Source:    grInvalidMatches,
+				ParentRefs: []ParentRef{
+					{
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: grInvalidMatches.Spec.ParentRefs[0].SectionName,
+					},
+				},
+				Valid:      false,
+				Attachable: true,
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue(
+						`All rules are invalid: spec.rules[0].matches[0].method.type: Unsupported value: ` +
+							`"RegularExpression": supported values: "Exact"`,
+					),
+				},
+				Spec: L7RouteSpec{
+					Hostnames: grInvalidMatches.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							ValidMatches:     false,
+							ValidFilters:     true,
+							Matches:          convertGRPCMatches(grInvalidMatchesRule.Matches),
+							RouteBackendRefs: []RouteBackendRef{},
+						},
+					},
+				},
+			},
+			name: "invalid matches",
+		},
+		{
+			validator: createAllValidValidator(),
+			gr:        grInvalidFilter,
+			expected: &L7Route{
+				RouteType: RouteTypeGRPC,
+				Source:    grInvalidFilter,
+				ParentRefs: []ParentRef{
+					{
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: grInvalidFilter.Spec.ParentRefs[0].SectionName,
+					},
+				},
+				Valid:      false,
+				Attachable: true,
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue(
+						`All rules are invalid: spec.rules[0].filters[0].type: Unsupported value: ` +
+							`"RequestMirror": supported values: "RequestHeaderModifier", "ResponseHeaderModifier"`,
+					),
+				},
+				Spec: L7RouteSpec{
+					Hostnames: grInvalidFilter.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							ValidMatches:     true,
+							ValidFilters:     false,
+							Matches:          convertGRPCMatches(grInvalidFilterRule.Matches),
+							Filters:          convertGRPCFilters(grInvalidFilterRule.Filters),
+							RouteBackendRefs: []RouteBackendRef{},
+						},
+					},
+				},
+			},
+			name: "invalid filter",
+		},
+		{
+			validator: createAllValidValidator(),
+			gr:        grInvalidHostname,
+			expected: &L7Route{
+				RouteType: RouteTypeGRPC,
+				Source:    grInvalidHostname,
+				ParentRefs: []ParentRef{
+					{
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: grInvalidHostname.Spec.ParentRefs[0].SectionName,
+					},
+				},
+				Valid: false,
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue(
+						`spec.hostnames[0]: Invalid value: "": cannot be empty string`,
+					),
+				},
+			},
+			name: "invalid hostname",
+		},
+		{
+			validator: createAllValidValidator(),
+			gr:        grNotNGF,
+			expected:  nil,
+			name:      "not NGF route",
+		},
+		{
+			validator: createAllValidValidator(),
+			gr:        grDuplicateSectionName,
+			expected: &L7Route{
+				RouteType: RouteTypeGRPC,
+				Source:    grDuplicateSectionName,
+			},
+			name: "invalid route with duplicate sectionName",
+		},
+	}
+
+	gatewayNsNames := []types.NamespacedName{gatewayNsName}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			route := buildGRPCRoute(test.validator, test.gr, gatewayNsNames)
+			g.Expect(helpers.Diff(test.expected, route)).To(BeEmpty())
+		})
+	}
+}
+
+func TestConvertGRPCMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		matches  []v1alpha2.GRPCRouteMatch
+		expected []v1.HTTPRouteMatch
+	}{
+		{
+			name: "no matches",
+			expected: []v1.HTTPRouteMatch{
+				{
+					Path: &v1.HTTPPathMatch{
+						Type:  helpers.GetPointer(v1.PathMatchPathPrefix),
+						Value: helpers.GetPointer("/"),
+					},
+				},
+			},
+		},
+		{
+			name: "service and method",
+			matches: []v1alpha2.GRPCRouteMatch{
+				{
+					Method: &v1alpha2.GRPCMethodMatch{
+						Service: helpers.GetPointer("helloworld.Greeter"),
+						Method:  helpers.GetPointer("SayHello"),
+					},
+				},
+			},
+			expected: []v1.HTTPRouteMatch{
+				{
+					Path: &v1.HTTPPathMatch{
+						Type:  helpers.GetPointer(v1.PathMatchExact),
+						Value: helpers.GetPointer("/helloworld.Greeter/SayHello"),
+					},
+				},
+			},
+		},
+		{
+			name: "service only",
+			matches: []v1alpha2.GRPCRouteMatch{
+				{
+					Method: &v1alpha2.GRPCMethodMatch{
+						Service: helpers.GetPointer("helloworld.Greeter"),
+					},
+				},
+			},
+			expected: []v1.HTTPRouteMatch{
+				{
+					Path: &v1.HTTPPathMatch{
+						Type:  helpers.GetPointer(v1.PathMatchPathPrefix),
+						Value: helpers.GetPointer("/helloworld.Greeter/"),
+					},
+				},
+			},
+		},
+		{
+			name: "headers only",
+			matches: []v1alpha2.GRPCRouteMatch{
+				{
+					Headers: []v1alpha2.GRPCHeaderMatch{
+						{
+							Name:  "version",
+							Value: "2",
+						},
+					},
+				},
+			},
+			expected: []v1.HTTPRouteMatch{
+				{
+					Path: &v1.HTTPPathMatch{
+						Type:  helpers.GetPointer(v1.PathMatchPathPrefix),
+						Value: helpers.GetPointer("/"),
+					},
+					Headers: []v1.HTTPHeaderMatch{
+						{
+							Name:  "version",
+							Value: "2",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := convertGRPCMatches(test.matches)
+			g.Expect(helpers.Diff(test.expected, result)).To(BeEmpty())
+		})
+	}
+}
+
+func TestValidateGRPCMatch(t *testing.T) {
+	createAllValidValidator := func() *validationfakes.FakeHTTPFieldsValidator {
+		v := &validationfakes.FakeHTTPFieldsValidator{}
+		return v
+	}
+
+	tests := []struct {
+		validator      *validationfakes.FakeHTTPFieldsValidator
+		match          v1alpha2.GRPCRouteMatch
+		name           string
+		expectErrCount int
+	}{
+		{
+			validator: createAllValidValidator(),
+			match: v1alpha2.GRPCRouteMatch{
+				Method: &v1alpha2.GRPCMethodMatch{
+					Type:    helpers.GetPointer(v1alpha2.GRPCMethodMatchExact),
+					Service: helpers.GetPointer("helloworld.Greeter"),
+					Method:  helpers.GetPointer("SayHello"),
+				},
+				Headers: []v1alpha2.GRPCHeaderMatch{
+					{
+						Type:  helpers.GetPointer(v1.HeaderMatchExact),
+						Name:  "MyHeader",
+						Value: "SomeValue",
+					},
+				},
+			},
+			expectErrCount: 0,
+			name:           "valid match",
+		},
+		{
+			validator: createAllValidValidator(),
+			match: v1alpha2.GRPCRouteMatch{
+				Method: &v1alpha2.GRPCMethodMatch{
+					Type:    helpers.GetPointer(v1alpha2.GRPCMethodMatchRegularExpression),
+					Service: helpers.GetPointer("helloworld.Greeter"),
+					Method:  helpers.GetPointer("SayHello"),
+				},
+			},
+			expectErrCount: 1,
+			name:           "unsupported method type",
+		},
+		{
+			validator: createAllValidValidator(),
+			match: v1alpha2.GRPCRouteMatch{
+				Method: &v1alpha2.GRPCMethodMatch{
+					Type:   helpers.GetPointer(v1alpha2.GRPCMethodMatchExact),
+					Method: helpers.GetPointer("SayHello"),
+				},
+			},
+			expectErrCount: 1,
+			name:           "method without service",
+		},
+		{
+			validator: createAllValidValidator(),
+			match: v1alpha2.GRPCRouteMatch{
+				Method: &v1alpha2.GRPCMethodMatch{
+					Type:    helpers.GetPointer(v1alpha2.GRPCMethodMatchExact),
+					Service: helpers.GetPointer("helloworld.Greeter"),
+					Method:  helpers.GetPointer(""),
+				},
+			},
+			expectErrCount: 1,
+			name:           "empty method",
+		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				v := createAllValidValidator()
+				v.ValidatePathInMatchReturns(errors.New("invalid path"))
+				return v
+			}(),
+			match: v1alpha2.GRPCRouteMatch{
+				Method: &v1alpha2.GRPCMethodMatch{
+					Type:    helpers.GetPointer(v1alpha2.GRPCMethodMatchExact),
+					Service: helpers.GetPointer("helloworld.Greeter"),
+					Method:  helpers.GetPointer("SayHello"),
+				},
+			},
+			expectErrCount: 1,
+			name:           "invalid path",
+		},
+		{
+			validator: createAllValidValidator(),
+			match: v1alpha2.GRPCRouteMatch{
+				Headers: []v1alpha2.GRPCHeaderMatch{
+					{
+						Type:  helpers.GetPointer[v1.HeaderMatchType]("Unknown"),
+						Name:  "MyHeader",
+						Value: "SomeValue",
+					},
+				},
+			},
+			expectErrCount: 1,
+			name:           "unsupported header match type",
+		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				v := createAllValidValidator()
+				v.ValidateHeaderNameInMatchReturns(errors.New("invalid header name"))
+				return v
+			}(),
+			match: v1alpha2.GRPCRouteMatch{
+				Headers: []v1alpha2.GRPCHeaderMatch{
+					{
+						Type:  helpers.GetPointer(v1.HeaderMatchExact),
+						Name:  "MyHeader",
+						Value: "SomeValue",
+					},
+				},
+			},
+			expectErrCount: 1,
+			name:           "invalid header name",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			allErrs := validateGRPCMatch(test.validator, test.match, field.NewPath("test"))
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+		})
+	}
+}
+
+func TestValidateGRPCFilter(t *testing.T) {
+	tests := []struct {
+		filter         v1alpha2.GRPCRouteFilter
+		name           string
+		expectErrCount int
+	}{
+		{
+			filter: v1alpha2.GRPCRouteFilter{
+				Type: v1alpha2.GRPCRouteFilterRequestHeaderModifier,
+				RequestHeaderModifier: &v1.HTTPHeaderFilter{
+					Set: []v1.HTTPHeader{{Name: "MyHeader", Value: "Value"}},
+				},
+			},
+			expectErrCount: 0,
+			name:           "valid request header modifier filter",
+		},
+		{
+			filter: v1alpha2.GRPCRouteFilter{
+				Type: v1alpha2.GRPCRouteFilterResponseHeaderModifier,
+				ResponseHeaderModifier: &v1.HTTPHeaderFilter{
+					Remove: []string{"MyHeader"},
+				},
+			},
+			expectErrCount: 0,
+			name:           "valid response header modifier filter",
+		},
+		{
+			filter: v1alpha2.GRPCRouteFilter{
+				Type: v1alpha2.GRPCRouteFilterRequestMirror,
+			},
+			expectErrCount: 1,
+			name:           "unsupported filter",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			allErrs := validateGRPCFilter(
+				&validationfakes.FakeHTTPFieldsValidator{},
+				test.filter,
+				field.NewPath("test"),
+			)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+		})
+	}
+}
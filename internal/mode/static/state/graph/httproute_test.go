@@ -5,9 +5,7 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
-	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -100,27 +98,35 @@ func TestBuildRoutes(t *testing.T) {
 	}
 
 	tests := []struct {
-		expected  map[types.NamespacedName]*Route
+		expected  map[RouteKey]*L7Route
 		name      string
 		gwNsNames []types.NamespacedName
 	}{
 		{
 			gwNsNames: []types.NamespacedName{gwNsName},
-			expected: map[types.NamespacedName]*Route{
-				client.ObjectKeyFromObject(hr): {
-					Source: hr,
+			expected: map[RouteKey]*L7Route{
+				CreateRouteKey(hr): {
+					Source:    hr,
+					RouteType: RouteTypeHTTP,
 					ParentRefs: []ParentRef{
 						{
-							Idx:     0,
-							Gateway: gwNsName,
+							Idx:         0,
+							Gateway:     gwNsName,
+							SectionName: hr.Spec.ParentRefs[0].SectionName,
 						},
 					},
 					Valid:      true,
 					Attachable: true,
-					Rules: []Rule{
-						{
-							ValidMatches: true,
-							ValidFilters: true,
+					Spec: L7RouteSpec{
+						Hostnames: hr.Spec.Hostnames,
+						Rules: []RouteRule{
+							{
+								Matches:          hr.Spec.Rules[0].Matches,
+								Filters:          hr.Spec.Rules[0].Filters,
+								RouteBackendRefs: []RouteBackendRef{},
+								ValidMatches:     true,
+								ValidFilters:     true,
+							},
 						},
 					},
 				},
@@ -139,208 +145,12 @@ func TestBuildRoutes(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
-			routes := buildRoutesForGateways(validator, hrRoutes, test.gwNsNames)
+			routes := buildRoutesForGateways(validator, hrRoutes, nil, test.gwNsNames)
 			g.Expect(helpers.Diff(test.expected, routes)).To(BeEmpty())
 		})
 	}
 }
 
-func TestBuildSectionNameRefs(t *testing.T) {
-	const routeNamespace = "test"
-
-	gwNsName1 := types.NamespacedName{Namespace: routeNamespace, Name: "gateway-1"}
-	gwNsName2 := types.NamespacedName{Namespace: routeNamespace, Name: "gateway-2"}
-
-	parentRefs := []gatewayv1.ParentReference{
-		{
-			Name:        gatewayv1.ObjectName(gwNsName1.Name),
-			SectionName: helpers.GetPointer[gatewayv1.SectionName]("one"),
-		},
-		{
-			Name:        gatewayv1.ObjectName("some-other-gateway"),
-			SectionName: helpers.GetPointer[gatewayv1.SectionName]("two"),
-		},
-		{
-			Name:        gatewayv1.ObjectName(gwNsName2.Name),
-			SectionName: helpers.GetPointer[gatewayv1.SectionName]("three"),
-		},
-		{
-			Name:        gatewayv1.ObjectName(gwNsName1.Name),
-			SectionName: helpers.GetPointer[gatewayv1.SectionName]("same-name"),
-		},
-		{
-			Name:        gatewayv1.ObjectName(gwNsName2.Name),
-			SectionName: helpers.GetPointer[gatewayv1.SectionName]("same-name"),
-		},
-		{
-			Name:        gatewayv1.ObjectName("some-other-gateway"),
-			SectionName: helpers.GetPointer[gatewayv1.SectionName]("same-name"),
-		},
-	}
-
-	gwNsNames := []types.NamespacedName{gwNsName1, gwNsName2}
-
-	expected := []ParentRef{
-		{
-			Idx:     0,
-			Gateway: gwNsName1,
-		},
-		{
-			Idx:     2,
-			Gateway: gwNsName2,
-		},
-		{
-			Idx:     3,
-			Gateway: gwNsName1,
-		},
-		{
-			Idx:     4,
-			Gateway: gwNsName2,
-		},
-	}
-
-	tests := []struct {
-		expectedError error
-		name          string
-		parentRefs    []gatewayv1.ParentReference
-		expectedRefs  []ParentRef
-	}{
-		{
-			name:          "normal case",
-			parentRefs:    parentRefs,
-			expectedRefs:  expected,
-			expectedError: nil,
-		},
-		{
-			parentRefs: []gatewayv1.ParentReference{
-				{
-					Name:        gatewayv1.ObjectName(gwNsName1.Name),
-					SectionName: helpers.GetPointer[gatewayv1.SectionName]("http"),
-				},
-				{
-					Name:        gatewayv1.ObjectName(gwNsName1.Name),
-					SectionName: helpers.GetPointer[gatewayv1.SectionName]("http"),
-				},
-			},
-			name:          "duplicate sectionNames",
-			expectedError: errors.New("duplicate section name \"http\" for Gateway test/gateway-1"),
-		},
-		{
-			parentRefs: []gatewayv1.ParentReference{
-				{
-					Name:        gatewayv1.ObjectName(gwNsName1.Name),
-					SectionName: nil,
-				},
-				{
-					Name:        gatewayv1.ObjectName(gwNsName1.Name),
-					SectionName: nil,
-				},
-			},
-			name:          "nil sectionNames",
-			expectedError: errors.New("duplicate section name \"\" for Gateway test/gateway-1"),
-		},
-	}
-
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			g := NewWithT(t)
-
-			result, err := buildSectionNameRefs(test.parentRefs, routeNamespace, gwNsNames)
-			g.Expect(result).To(Equal(test.expectedRefs))
-			if test.expectedError != nil {
-				g.Expect(err).To(Equal(test.expectedError))
-			} else {
-				g.Expect(err).ToNot(HaveOccurred())
-			}
-		})
-	}
-}
-
-func TestFindGatewayForParentRef(t *testing.T) {
-	gwNsName1 := types.NamespacedName{Namespace: "test-1", Name: "gateway-1"}
-	gwNsName2 := types.NamespacedName{Namespace: "test-2", Name: "gateway-2"}
-
-	tests := []struct {
-		ref              gatewayv1.ParentReference
-		expectedGwNsName types.NamespacedName
-		name             string
-		expectedFound    bool
-	}{
-		{
-			ref: gatewayv1.ParentReference{
-				Namespace: helpers.GetPointer(gatewayv1.Namespace(gwNsName1.Namespace)),
-				Name:      gatewayv1.ObjectName(gwNsName1.Name),
-			},
-			expectedFound:    true,
-			expectedGwNsName: gwNsName1,
-			name:             "found",
-		},
-		{
-			ref: gatewayv1.ParentReference{
-				Group:     helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName),
-				Kind:      helpers.GetPointer[gatewayv1.Kind]("Gateway"),
-				Namespace: helpers.GetPointer(gatewayv1.Namespace(gwNsName1.Namespace)),
-				Name:      gatewayv1.ObjectName(gwNsName1.Name),
-			},
-			expectedFound:    true,
-			expectedGwNsName: gwNsName1,
-			name:             "found with explicit group and kind",
-		},
-		{
-			ref: gatewayv1.ParentReference{
-				Name: gatewayv1.ObjectName(gwNsName2.Name),
-			},
-			expectedFound:    true,
-			expectedGwNsName: gwNsName2,
-			name:             "found with implicit namespace",
-		},
-		{
-			ref: gatewayv1.ParentReference{
-				Kind: helpers.GetPointer[gatewayv1.Kind]("NotGateway"),
-				Name: gatewayv1.ObjectName(gwNsName2.Name),
-			},
-			expectedFound:    false,
-			expectedGwNsName: types.NamespacedName{},
-			name:             "wrong kind",
-		},
-		{
-			ref: gatewayv1.ParentReference{
-				Group: helpers.GetPointer[gatewayv1.Group]("wrong-group"),
-				Name:  gatewayv1.ObjectName(gwNsName2.Name),
-			},
-			expectedFound:    false,
-			expectedGwNsName: types.NamespacedName{},
-			name:             "wrong group",
-		},
-		{
-			ref: gatewayv1.ParentReference{
-				Namespace: helpers.GetPointer(gatewayv1.Namespace(gwNsName1.Namespace)),
-				Name:      "some-gateway",
-			},
-			expectedFound:    false,
-			expectedGwNsName: types.NamespacedName{},
-			name:             "not found",
-		},
-	}
-
-	routeNamespace := "test-2"
-
-	gwNsNames := []types.NamespacedName{
-		gwNsName1,
-		gwNsName2,
-	}
-
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			g := NewWithT(t)
-
-			gw, found := findGatewayForParentRef(test.ref, routeNamespace, gwNsNames)
-			g.Expect(found).To(Equal(test.expectedFound))
-			g.Expect(gw).To(Equal(test.expectedGwNsName))
-		})
-	}
-}
-
 func TestBuildRoute(t *testing.T) {
 	const (
 		invalidPath             = "/invalid"
@@ -386,6 +196,15 @@ func TestBuildRoute(t *testing.T) {
 	addFilterToPath(hrDroppedInvalidFilters, "/filter", validFilter)
 	addFilterToPath(hrDroppedInvalidFilters, "/", invalidFilter)
 
+	hrTimeouts := createHTTPRoute("hr", gatewayNsName.Name, "example.com", "/", "/invalid-timeouts")
+	hrTimeouts.Spec.Rules[0].Timeouts = &gatewayv1.HTTPRouteTimeouts{
+		Request:        helpers.GetPointer[gatewayv1.Duration]("10s"),
+		BackendRequest: helpers.GetPointer[gatewayv1.Duration]("5s"),
+	}
+	hrTimeouts.Spec.Rules[1].Timeouts = &gatewayv1.HTTPRouteTimeouts{
+		Request: helpers.GetPointer[gatewayv1.Duration]("10"),
+	}
+
 	hrDuplicateSectionName := createHTTPRoute("hr", gatewayNsName.Name, "example.com", "/")
 	hrDuplicateSectionName.Spec.ParentRefs = append(
 		hrDuplicateSectionName.Spec.ParentRefs,
@@ -410,30 +229,41 @@ func TestBuildRoute(t *testing.T) {
 	tests := []struct {
 		validator *validationfakes.FakeHTTPFieldsValidator
 		hr        *gatewayv1.HTTPRoute
-		expected  *Route
+		expected  *L7Route
 		name      string
 	}{
 		{
 			validator: &validationfakes.FakeHTTPFieldsValidator{},
 			hr:        hr,
-			expected: &Route{
-				Source: hr,
+			expected: &L7Route{
+				Source:    hr,
+				RouteType: RouteTypeHTTP,
 				ParentRefs: []ParentRef{
 					{
-						Idx:     0,
-						Gateway: gatewayNsName,
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: hr.Spec.ParentRefs[0].SectionName,
 					},
 				},
 				Valid:      true,
 				Attachable: true,
-				Rules: []Rule{
-					{
-						ValidMatches: true,
-						ValidFilters: true,
-					},
-					{
-						ValidMatches: true,
-						ValidFilters: true,
+				Spec: L7RouteSpec{
+					Hostnames: hr.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							Matches:          hr.Spec.Rules[0].Matches,
+							Filters:          hr.Spec.Rules[0].Filters,
+							RouteBackendRefs: []RouteBackendRef{},
+							ValidMatches:     true,
+							ValidFilters:     true,
+						},
+						{
+							Matches:          hr.Spec.Rules[1].Matches,
+							Filters:          hr.Spec.Rules[1].Filters,
+							RouteBackendRefs: []RouteBackendRef{},
+							ValidMatches:     true,
+							ValidFilters:     true,
+						},
 					},
 				},
 			},
@@ -442,14 +272,16 @@ func TestBuildRoute(t *testing.T) {
 		{
 			validator: &validationfakes.FakeHTTPFieldsValidator{},
 			hr:        hrInvalidMatchesEmptyPathType,
-			expected: &Route{
+			expected: &L7Route{
 				Source:     hrInvalidMatchesEmptyPathType,
+				RouteType:  RouteTypeHTTP,
 				Valid:      false,
 				Attachable: true,
 				ParentRefs: []ParentRef{
 					{
-						Idx:     0,
-						Gateway: gatewayNsName,
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: hrInvalidMatchesEmptyPathType.Spec.ParentRefs[0].SectionName,
 					},
 				},
 				Conditions: []conditions.Condition{
@@ -457,10 +289,16 @@ func TestBuildRoute(t *testing.T) {
 						`All rules are invalid: spec.rules[0].matches[0].path.type: Required value: path type cannot be nil`,
 					),
 				},
-				Rules: []Rule{
-					{
-						ValidMatches: false,
-						ValidFilters: true,
+				Spec: L7RouteSpec{
+					Hostnames: hrInvalidMatchesEmptyPathType.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							Matches:          hrInvalidMatchesEmptyPathType.Spec.Rules[0].Matches,
+							Filters:          hrInvalidMatchesEmptyPathType.Spec.Rules[0].Filters,
+							RouteBackendRefs: []RouteBackendRef{},
+							ValidMatches:     false,
+							ValidFilters:     true,
+						},
 					},
 				},
 			},
@@ -469,22 +307,25 @@ func TestBuildRoute(t *testing.T) {
 		{
 			validator: &validationfakes.FakeHTTPFieldsValidator{},
 			hr:        hrDuplicateSectionName,
-			expected: &Route{
-				Source: hrDuplicateSectionName,
+			expected: &L7Route{
+				Source:    hrDuplicateSectionName,
+				RouteType: RouteTypeHTTP,
 			},
 			name: "invalid route with duplicate sectionName",
 		},
 		{
 			validator: &validationfakes.FakeHTTPFieldsValidator{},
 			hr:        hrInvalidMatchesEmptyPathValue,
-			expected: &Route{
+			expected: &L7Route{
 				Source:     hrInvalidMatchesEmptyPathValue,
+				RouteType:  RouteTypeHTTP,
 				Valid:      false,
 				Attachable: true,
 				ParentRefs: []ParentRef{
 					{
-						Idx:     0,
-						Gateway: gatewayNsName,
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: hrInvalidMatchesEmptyPathValue.Spec.ParentRefs[0].SectionName,
 					},
 				},
 				Conditions: []conditions.Condition{
@@ -492,10 +333,16 @@ func TestBuildRoute(t *testing.T) {
 						`All rules are invalid: spec.rules[0].matches[0].path.value: Required value: path value cannot be nil`,
 					),
 				},
-				Rules: []Rule{
-					{
-						ValidMatches: false,
-						ValidFilters: true,
+				Spec: L7RouteSpec{
+					Hostnames: hrInvalidMatchesEmptyPathValue.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							Matches:          hrInvalidMatchesEmptyPathValue.Spec.Rules[0].Matches,
+							Filters:          hrInvalidMatchesEmptyPathValue.Spec.Rules[0].Filters,
+							RouteBackendRefs: []RouteBackendRef{},
+							ValidMatches:     false,
+							ValidFilters:     true,
+						},
 					},
 				},
 			},
@@ -510,14 +357,16 @@ func TestBuildRoute(t *testing.T) {
 		{
 			validator: &validationfakes.FakeHTTPFieldsValidator{},
 			hr:        hrInvalidHostname,
-			expected: &Route{
+			expected: &L7Route{
 				Source:     hrInvalidHostname,
+				RouteType:  RouteTypeHTTP,
 				Valid:      false,
 				Attachable: false,
 				ParentRefs: []ParentRef{
 					{
-						Idx:     0,
-						Gateway: gatewayNsName,
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: hrInvalidHostname.Spec.ParentRefs[0].SectionName,
 					},
 				},
 				Conditions: []conditions.Condition{
@@ -531,14 +380,16 @@ func TestBuildRoute(t *testing.T) {
 		{
 			validator: validatorInvalidFieldsInRule,
 			hr:        hrInvalidMatches,
-			expected: &Route{
+			expected: &L7Route{
 				Source:     hrInvalidMatches,
+				RouteType:  RouteTypeHTTP,
 				Valid:      false,
 				Attachable: true,
 				ParentRefs: []ParentRef{
 					{
-						Idx:     0,
-						Gateway: gatewayNsName,
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: hrInvalidMatches.Spec.ParentRefs[0].SectionName,
 					},
 				},
 				Conditions: []conditions.Condition{
@@ -546,10 +397,16 @@ func TestBuildRoute(t *testing.T) {
 						`All rules are invalid: spec.rules[0].matches[0].path.value: Invalid value: "/invalid": invalid path`,
 					),
 				},
-				Rules: []Rule{
-					{
-						ValidMatches: false,
-						ValidFilters: true,
+				Spec: L7RouteSpec{
+					Hostnames: hrInvalidMatches.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							Matches:          hrInvalidMatches.Spec.Rules[0].Matches,
+							Filters:          hrInvalidMatches.Spec.Rules[0].Filters,
+							RouteBackendRefs: []RouteBackendRef{},
+							ValidMatches:     false,
+							ValidFilters:     true,
+						},
 					},
 				},
 			},
@@ -558,14 +415,16 @@ func TestBuildRoute(t *testing.T) {
 		{
 			validator: validatorInvalidFieldsInRule,
 			hr:        hrInvalidFilters,
-			expected: &Route{
+			expected: &L7Route{
 				Source:     hrInvalidFilters,
+				RouteType:  RouteTypeHTTP,
 				Valid:      false,
 				Attachable: true,
 				ParentRefs: []ParentRef{
 					{
-						Idx:     0,
-						Gateway: gatewayNsName,
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: hrInvalidFilters.Spec.ParentRefs[0].SectionName,
 					},
 				},
 				Conditions: []conditions.Condition{
@@ -574,10 +433,16 @@ func TestBuildRoute(t *testing.T) {
 							`Invalid value: "invalid.example.com": invalid hostname`,
 					),
 				},
-				Rules: []Rule{
-					{
-						ValidMatches: true,
-						ValidFilters: false,
+				Spec: L7RouteSpec{
+					Hostnames: hrInvalidFilters.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							Matches:          hrInvalidFilters.Spec.Rules[0].Matches,
+							Filters:          hrInvalidFilters.Spec.Rules[0].Filters,
+							RouteBackendRefs: []RouteBackendRef{},
+							ValidMatches:     true,
+							ValidFilters:     false,
+						},
 					},
 				},
 			},
@@ -586,14 +451,16 @@ func TestBuildRoute(t *testing.T) {
 		{
 			validator: validatorInvalidFieldsInRule,
 			hr:        hrDroppedInvalidMatches,
-			expected: &Route{
+			expected: &L7Route{
 				Source:     hrDroppedInvalidMatches,
+				RouteType:  RouteTypeHTTP,
 				Valid:      true,
 				Attachable: true,
 				ParentRefs: []ParentRef{
 					{
-						Idx:     0,
-						Gateway: gatewayNsName,
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: hrDroppedInvalidMatches.Spec.ParentRefs[0].SectionName,
 					},
 				},
 				Conditions: []conditions.Condition{
@@ -601,14 +468,23 @@ func TestBuildRoute(t *testing.T) {
 						`spec.rules[0].matches[0].path.value: Invalid value: "/invalid": invalid path`,
 					),
 				},
-				Rules: []Rule{
-					{
-						ValidMatches: false,
-						ValidFilters: true,
-					},
-					{
-						ValidMatches: true,
-						ValidFilters: true,
+				Spec: L7RouteSpec{
+					Hostnames: hrDroppedInvalidMatches.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							Matches:          hrDroppedInvalidMatches.Spec.Rules[0].Matches,
+							Filters:          hrDroppedInvalidMatches.Spec.Rules[0].Filters,
+							RouteBackendRefs: []RouteBackendRef{},
+							ValidMatches:     false,
+							ValidFilters:     true,
+						},
+						{
+							Matches:          hrDroppedInvalidMatches.Spec.Rules[1].Matches,
+							Filters:          hrDroppedInvalidMatches.Spec.Rules[1].Filters,
+							RouteBackendRefs: []RouteBackendRef{},
+							ValidMatches:     true,
+							ValidFilters:     true,
+						},
 					},
 				},
 			},
@@ -618,14 +494,16 @@ func TestBuildRoute(t *testing.T) {
 		{
 			validator: validatorInvalidFieldsInRule,
 			hr:        hrDroppedInvalidMatchesAndInvalidFilters,
-			expected: &Route{
+			expected: &L7Route{
 				Source:     hrDroppedInvalidMatchesAndInvalidFilters,
+				RouteType:  RouteTypeHTTP,
 				Valid:      true,
 				Attachable: true,
 				ParentRefs: []ParentRef{
 					{
-						Idx:     0,
-						Gateway: gatewayNsName,
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: hrDroppedInvalidMatchesAndInvalidFilters.Spec.ParentRefs[0].SectionName,
 					},
 				},
 				Conditions: []conditions.Condition{
@@ -635,18 +513,30 @@ func TestBuildRoute(t *testing.T) {
 							`"invalid.example.com": invalid hostname]`,
 					),
 				},
-				Rules: []Rule{
-					{
-						ValidMatches: false,
-						ValidFilters: true,
-					},
-					{
-						ValidMatches: true,
-						ValidFilters: false,
-					},
-					{
-						ValidMatches: true,
-						ValidFilters: true,
+				Spec: L7RouteSpec{
+					Hostnames: hrDroppedInvalidMatchesAndInvalidFilters.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							Matches:          hrDroppedInvalidMatchesAndInvalidFilters.Spec.Rules[0].Matches,
+							Filters:          hrDroppedInvalidMatchesAndInvalidFilters.Spec.Rules[0].Filters,
+							RouteBackendRefs: []RouteBackendRef{},
+							ValidMatches:     false,
+							ValidFilters:     true,
+						},
+						{
+							Matches:          hrDroppedInvalidMatchesAndInvalidFilters.Spec.Rules[1].Matches,
+							Filters:          hrDroppedInvalidMatchesAndInvalidFilters.Spec.Rules[1].Filters,
+							RouteBackendRefs: []RouteBackendRef{},
+							ValidMatches:     true,
+							ValidFilters:     false,
+						},
+						{
+							Matches:          hrDroppedInvalidMatchesAndInvalidFilters.Spec.Rules[2].Matches,
+							Filters:          hrDroppedInvalidMatchesAndInvalidFilters.Spec.Rules[2].Filters,
+							RouteBackendRefs: []RouteBackendRef{},
+							ValidMatches:     true,
+							ValidFilters:     true,
+						},
 					},
 				},
 			},
@@ -655,14 +545,16 @@ func TestBuildRoute(t *testing.T) {
 		{
 			validator: validatorInvalidFieldsInRule,
 			hr:        hrDroppedInvalidFilters,
-			expected: &Route{
+			expected: &L7Route{
 				Source:     hrDroppedInvalidFilters,
+				RouteType:  RouteTypeHTTP,
 				Valid:      true,
 				Attachable: true,
 				ParentRefs: []ParentRef{
 					{
-						Idx:     0,
-						Gateway: gatewayNsName,
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: hrDroppedInvalidFilters.Spec.ParentRefs[0].SectionName,
 					},
 				},
 				Conditions: []conditions.Condition{
@@ -671,1007 +563,82 @@ func TestBuildRoute(t *testing.T) {
 							`"invalid.example.com": invalid hostname`,
 					),
 				},
-				Rules: []Rule{
-					{
-						ValidMatches: true,
-						ValidFilters: true,
-					},
-					{
-						ValidMatches: true,
-						ValidFilters: false,
-					},
-				},
-			},
-			name: "dropped invalid rule with invalid filters",
-		},
-	}
-
-	gatewayNsNames := []types.NamespacedName{gatewayNsName}
-
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			g := NewWithT(t)
-
-			route := buildRoute(test.validator, test.hr, gatewayNsNames)
-			g.Expect(helpers.Diff(test.expected, route)).To(BeEmpty())
-		})
-	}
-}
-
-func TestBindRouteToListeners(t *testing.T) {
-	// we create a new listener each time because the function under test can modify it
-	createListener := func(name string) *Listener {
-		return &Listener{
-			Name: name,
-			Source: gatewayv1.Listener{
-				Name:     gatewayv1.SectionName(name),
-				Hostname: (*gatewayv1.Hostname)(helpers.GetPointer("foo.example.com")),
-			},
-			Valid:      true,
-			Attachable: true,
-			Routes:     map[types.NamespacedName]*Route{},
-		}
-	}
-	createModifiedListener := func(name string, m func(*Listener)) *Listener {
-		l := createListener(name)
-		m(l)
-		return l
-	}
-
-	gw := &gatewayv1.Gateway{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: "test",
-			Name:      "gateway",
-		},
-	}
-	gwDiffNamespace := &gatewayv1.Gateway{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: "diff-namespace",
-			Name:      "gateway",
-		},
-	}
-
-	createHTTPRouteWithSectionNameAndPort := func(
-		sectionName *gatewayv1.SectionName,
-		port *gatewayv1.PortNumber,
-	) *gatewayv1.HTTPRoute {
-		return &gatewayv1.HTTPRoute{
-			ObjectMeta: metav1.ObjectMeta{
-				Namespace: "test",
-				Name:      "hr",
-			},
-			Spec: gatewayv1.HTTPRouteSpec{
-				CommonRouteSpec: gatewayv1.CommonRouteSpec{
-					ParentRefs: []gatewayv1.ParentReference{
+				Spec: L7RouteSpec{
+					Hostnames: hrDroppedInvalidFilters.Spec.Hostnames,
+					Rules: []RouteRule{
 						{
-							Name:        gatewayv1.ObjectName(gw.Name),
-							SectionName: sectionName,
-							Port:        port,
+							Matches:          hrDroppedInvalidFilters.Spec.Rules[0].Matches,
+							Filters:          hrDroppedInvalidFilters.Spec.Rules[0].Filters,
+							RouteBackendRefs: []RouteBackendRef{},
+							ValidMatches:     true,
+							ValidFilters:     true,
 						},
-					},
-				},
-				Hostnames: []gatewayv1.Hostname{
-					"foo.example.com",
-				},
-			},
-		}
-	}
-
-	hr := createHTTPRouteWithSectionNameAndPort(helpers.GetPointer[gatewayv1.SectionName]("listener-80-1"), nil)
-	hrWithNilSectionName := createHTTPRouteWithSectionNameAndPort(nil, nil)
-	hrWithEmptySectionName := createHTTPRouteWithSectionNameAndPort(helpers.GetPointer[gatewayv1.SectionName](""), nil)
-	hrWithPort := createHTTPRouteWithSectionNameAndPort(
-		helpers.GetPointer[gatewayv1.SectionName]("listener-80-1"),
-		helpers.GetPointer[gatewayv1.PortNumber](80),
-	)
-	hrWithNonExistingListener := createHTTPRouteWithSectionNameAndPort(
-		helpers.GetPointer[gatewayv1.SectionName]("listener-80-2"),
-		nil,
-	)
-
-	var normalRoute *Route
-	createNormalRoute := func(gateway *gatewayv1.Gateway) *Route {
-		normalRoute = &Route{
-			Source:     hr,
-			Valid:      true,
-			Attachable: true,
-			ParentRefs: []ParentRef{
-				{
-					Idx:     0,
-					Gateway: client.ObjectKeyFromObject(gateway),
-				},
-			},
-		}
-		return normalRoute
-	}
-	getLastNormalRoute := func() *Route {
-		return normalRoute
-	}
-
-	invalidAttachableRoute1 := &Route{
-		Source:     hr,
-		Valid:      false,
-		Attachable: true,
-		ParentRefs: []ParentRef{
-			{
-				Idx:     0,
-				Gateway: client.ObjectKeyFromObject(gw),
-			},
-		},
-	}
-	invalidAttachableRoute2 := &Route{
-		Source:     hr,
-		Valid:      false,
-		Attachable: true,
-		ParentRefs: []ParentRef{
-			{
-				Idx:     0,
-				Gateway: client.ObjectKeyFromObject(gw),
-			},
-		},
-	}
-
-	routeWithMissingSectionName := &Route{
-		Source:     hrWithNilSectionName,
-		Valid:      true,
-		Attachable: true,
-		ParentRefs: []ParentRef{
-			{
-				Idx:     0,
-				Gateway: client.ObjectKeyFromObject(gw),
-			},
-		},
-	}
-	routeWithEmptySectionName := &Route{
-		Source:     hrWithEmptySectionName,
-		Valid:      true,
-		Attachable: true,
-		ParentRefs: []ParentRef{
-			{
-				Idx:     0,
-				Gateway: client.ObjectKeyFromObject(gw),
-			},
-		},
-	}
-	routeWithNonExistingListener := &Route{
-		Source:     hrWithNonExistingListener,
-		Valid:      true,
-		Attachable: true,
-		ParentRefs: []ParentRef{
-			{
-				Idx:     0,
-				Gateway: client.ObjectKeyFromObject(gw),
-			},
-		},
-	}
-	routeWithPort := &Route{
-		Source:     hrWithPort,
-		Valid:      true,
-		Attachable: true,
-		ParentRefs: []ParentRef{
-			{
-				Idx:     0,
-				Gateway: client.ObjectKeyFromObject(gw),
-			},
-		},
-	}
-	ignoredGwNsName := types.NamespacedName{Namespace: "test", Name: "ignored-gateway"}
-	routeWithIgnoredGateway := &Route{
-		Source:     hr,
-		Valid:      true,
-		Attachable: true,
-		ParentRefs: []ParentRef{
-			{
-				Idx:     0,
-				Gateway: ignoredGwNsName,
-			},
-		},
-	}
-	invalidRoute := &Route{
-		Valid: false,
-		ParentRefs: []ParentRef{
-			{
-				Idx:     0,
-				Gateway: client.ObjectKeyFromObject(gw),
-			},
-		},
-	}
-
-	invalidNotAttachableListener := createModifiedListener("listener-80-1", func(l *Listener) {
-		l.Valid = false
-		l.Attachable = false
-	})
-	nonMatchingHostnameListener := createModifiedListener("listener-80-1", func(l *Listener) {
-		l.Source.Hostname = helpers.GetPointer[gatewayv1.Hostname]("bar.example.com")
-	})
-
-	tests := []struct {
-		route                    *Route
-		gateway                  *Gateway
-		expectedGatewayListeners []*Listener
-		name                     string
-		expectedSectionNameRefs  []ParentRef
-		expectedConditions       []conditions.Condition
-	}{
-		{
-			route: createNormalRoute(gw),
-			gateway: &Gateway{
-				Source: gw,
-				Valid:  true,
-				Listeners: []*Listener{
-					createListener("listener-80-1"),
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Idx:     0,
-					Gateway: client.ObjectKeyFromObject(gw),
-					Attachment: &ParentRefAttachmentStatus{
-						Attached: true,
-						AcceptedHostnames: map[string][]string{
-							"listener-80-1": {"foo.example.com"},
-						},
-					},
-				},
-			},
-			expectedGatewayListeners: []*Listener{
-				createModifiedListener("listener-80-1", func(l *Listener) {
-					l.Routes = map[types.NamespacedName]*Route{
-						client.ObjectKeyFromObject(hr): getLastNormalRoute(),
-					}
-				}),
-			},
-			name: "normal case",
-		},
-		{
-			route: routeWithMissingSectionName,
-			gateway: &Gateway{
-				Source: gw,
-				Valid:  true,
-				Listeners: []*Listener{
-					createListener("listener-80-1"),
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Idx:     0,
-					Gateway: client.ObjectKeyFromObject(gw),
-					Attachment: &ParentRefAttachmentStatus{
-						Attached: true,
-						AcceptedHostnames: map[string][]string{
-							"listener-80-1": {"foo.example.com"},
-						},
-					},
-				},
-			},
-			expectedGatewayListeners: []*Listener{
-				createModifiedListener("listener-80-1", func(l *Listener) {
-					l.Routes = map[types.NamespacedName]*Route{
-						client.ObjectKeyFromObject(hr): routeWithMissingSectionName,
-					}
-				}),
-			},
-			name: "section name is nil",
-		},
-		{
-			route: routeWithEmptySectionName,
-			gateway: &Gateway{
-				Source: gw,
-				Valid:  true,
-				Listeners: []*Listener{
-					createListener("listener-80"),
-					createListener("listener-8080"),
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Idx:     0,
-					Gateway: client.ObjectKeyFromObject(gw),
-					Attachment: &ParentRefAttachmentStatus{
-						Attached: true,
-						AcceptedHostnames: map[string][]string{
-							"listener-80":   {"foo.example.com"},
-							"listener-8080": {"foo.example.com"},
-						},
-					},
-				},
-			},
-			expectedGatewayListeners: []*Listener{
-				createModifiedListener("listener-80", func(l *Listener) {
-					l.Routes = map[types.NamespacedName]*Route{
-						client.ObjectKeyFromObject(hr): routeWithEmptySectionName,
-					}
-				}),
-				createModifiedListener("listener-8080", func(l *Listener) {
-					l.Routes = map[types.NamespacedName]*Route{
-						client.ObjectKeyFromObject(hr): routeWithEmptySectionName,
-					}
-				}),
-			},
-			name: "section name is empty; bind to multiple listeners",
-		},
-		{
-			route: routeWithEmptySectionName,
-			gateway: &Gateway{
-				Source: gw,
-				Valid:  true,
-				Listeners: []*Listener{
-					invalidNotAttachableListener,
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Idx:     0,
-					Gateway: client.ObjectKeyFromObject(gw),
-					Attachment: &ParentRefAttachmentStatus{
-						Attached:          false,
-						FailedCondition:   staticConds.NewRouteInvalidListener(),
-						AcceptedHostnames: map[string][]string{},
-					},
-				},
-			},
-			expectedGatewayListeners: []*Listener{
-				invalidNotAttachableListener,
-			},
-			name: "empty section name with no valid and attachable listeners",
-		},
-		{
-			route: routeWithPort,
-			gateway: &Gateway{
-				Source: gw,
-				Valid:  true,
-				Listeners: []*Listener{
-					createListener("listener-80-1"),
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Idx:     0,
-					Gateway: client.ObjectKeyFromObject(gw),
-					Attachment: &ParentRefAttachmentStatus{
-						Attached: false,
-						FailedCondition: staticConds.NewRouteUnsupportedValue(
-							`spec.parentRefs[0].port: Forbidden: cannot be set`,
-						),
-						AcceptedHostnames: map[string][]string{},
-					},
-				},
-			},
-			expectedGatewayListeners: []*Listener{
-				createListener("listener-80-1"),
-			},
-			name: "port is configured",
-		},
-		{
-			route: routeWithNonExistingListener,
-			gateway: &Gateway{
-				Source: gw,
-				Valid:  true,
-				Listeners: []*Listener{
-					createListener("listener-80-1"),
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Idx:     0,
-					Gateway: client.ObjectKeyFromObject(gw),
-					Attachment: &ParentRefAttachmentStatus{
-						Attached:          false,
-						FailedCondition:   staticConds.NewRouteNoMatchingParent(),
-						AcceptedHostnames: map[string][]string{},
-					},
-				},
-			},
-			expectedGatewayListeners: []*Listener{
-				createListener("listener-80-1"),
-			},
-			name: "listener doesn't exist",
-		},
-		{
-			route: createNormalRoute(gw),
-			gateway: &Gateway{
-				Source: gw,
-				Valid:  true,
-				Listeners: []*Listener{
-					invalidNotAttachableListener,
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Idx:     0,
-					Gateway: client.ObjectKeyFromObject(gw),
-					Attachment: &ParentRefAttachmentStatus{
-						Attached:          false,
-						FailedCondition:   staticConds.NewRouteInvalidListener(),
-						AcceptedHostnames: map[string][]string{},
-					},
-				},
-			},
-			expectedGatewayListeners: []*Listener{
-				invalidNotAttachableListener,
-			},
-			name: "listener isn't valid and attachable",
-		},
-		{
-			route: createNormalRoute(gw),
-			gateway: &Gateway{
-				Source: gw,
-				Valid:  true,
-				Listeners: []*Listener{
-					nonMatchingHostnameListener,
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Idx:     0,
-					Gateway: client.ObjectKeyFromObject(gw),
-					Attachment: &ParentRefAttachmentStatus{
-						Attached:          false,
-						FailedCondition:   staticConds.NewRouteNoMatchingListenerHostname(),
-						AcceptedHostnames: map[string][]string{},
-					},
-				},
-			},
-			expectedGatewayListeners: []*Listener{
-				nonMatchingHostnameListener,
-			},
-			name: "no matching listener hostname",
-		},
-		{
-			route: routeWithIgnoredGateway,
-			gateway: &Gateway{
-				Source: gw,
-				Valid:  true,
-				Listeners: []*Listener{
-					createListener("listener-80-1"),
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Idx:     0,
-					Gateway: ignoredGwNsName,
-					Attachment: &ParentRefAttachmentStatus{
-						Attached:          false,
-						FailedCondition:   staticConds.NewTODO("Gateway is ignored"),
-						AcceptedHostnames: map[string][]string{},
-					},
-				},
-			},
-			expectedGatewayListeners: []*Listener{
-				createListener("listener-80-1"),
-			},
-			name: "gateway is ignored",
-		},
-		{
-			route: invalidRoute,
-			gateway: &Gateway{
-				Source: gw,
-				Valid:  true,
-				Listeners: []*Listener{
-					createListener("listener-80-1"),
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Idx:        0,
-					Gateway:    client.ObjectKeyFromObject(gw),
-					Attachment: nil,
-				},
-			},
-			expectedGatewayListeners: []*Listener{
-				createListener("listener-80-1"),
-			},
-			name: "route isn't valid",
-		},
-		{
-			route: createNormalRoute(gw),
-			gateway: &Gateway{
-				Source: gw,
-				Valid:  false,
-				Listeners: []*Listener{
-					createListener("listener-80-1"),
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Idx:     0,
-					Gateway: client.ObjectKeyFromObject(gw),
-					Attachment: &ParentRefAttachmentStatus{
-						Attached:          false,
-						FailedCondition:   staticConds.NewRouteInvalidGateway(),
-						AcceptedHostnames: map[string][]string{},
-					},
-				},
-			},
-			expectedGatewayListeners: []*Listener{
-				createListener("listener-80-1"),
-			},
-			name: "invalid gateway",
-		},
-		{
-			route: createNormalRoute(gw),
-			gateway: &Gateway{
-				Source: gw,
-				Valid:  true,
-				Listeners: []*Listener{
-					createModifiedListener("listener-80-1", func(l *Listener) {
-						l.Valid = false
-					}),
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Idx:     0,
-					Gateway: client.ObjectKeyFromObject(gw),
-					Attachment: &ParentRefAttachmentStatus{
-						Attached: true,
-						AcceptedHostnames: map[string][]string{
-							"listener-80-1": {"foo.example.com"},
-						},
-					},
-				},
-			},
-			expectedGatewayListeners: []*Listener{
-				createModifiedListener("listener-80-1", func(l *Listener) {
-					l.Valid = false
-					l.Routes = map[types.NamespacedName]*Route{
-						client.ObjectKeyFromObject(hr): getLastNormalRoute(),
-					}
-				}),
-			},
-			expectedConditions: []conditions.Condition{staticConds.NewRouteInvalidListener()},
-			name:               "invalid attachable listener",
-		},
-		{
-			route: invalidAttachableRoute1,
-			gateway: &Gateway{
-				Source: gw,
-				Valid:  true,
-				Listeners: []*Listener{
-					createListener("listener-80-1"),
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Idx:     0,
-					Gateway: client.ObjectKeyFromObject(gw),
-					Attachment: &ParentRefAttachmentStatus{
-						Attached: true,
-						AcceptedHostnames: map[string][]string{
-							"listener-80-1": {"foo.example.com"},
-						},
-					},
-				},
-			},
-			expectedGatewayListeners: []*Listener{
-				createModifiedListener("listener-80-1", func(l *Listener) {
-					l.Routes = map[types.NamespacedName]*Route{
-						client.ObjectKeyFromObject(hr): invalidAttachableRoute1,
-					}
-				}),
-			},
-			name: "invalid attachable route",
-		},
-		{
-			route: invalidAttachableRoute2,
-			gateway: &Gateway{
-				Source: gw,
-				Valid:  true,
-				Listeners: []*Listener{
-					createModifiedListener("listener-80-1", func(l *Listener) {
-						l.Valid = false
-					}),
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Idx:     0,
-					Gateway: client.ObjectKeyFromObject(gw),
-					Attachment: &ParentRefAttachmentStatus{
-						Attached: true,
-						AcceptedHostnames: map[string][]string{
-							"listener-80-1": {"foo.example.com"},
+						{
+							Matches:          hrDroppedInvalidFilters.Spec.Rules[1].Matches,
+							Filters:          hrDroppedInvalidFilters.Spec.Rules[1].Filters,
+							RouteBackendRefs: []RouteBackendRef{},
+							ValidMatches:     true,
+							ValidFilters:     false,
 						},
 					},
 				},
 			},
-			expectedGatewayListeners: []*Listener{
-				createModifiedListener("listener-80-1", func(l *Listener) {
-					l.Valid = false
-					l.Routes = map[types.NamespacedName]*Route{
-						client.ObjectKeyFromObject(hr): invalidAttachableRoute2,
-					}
-				}),
-			},
-			expectedConditions: []conditions.Condition{staticConds.NewRouteInvalidListener()},
-			name:               "invalid attachable listener with invalid attachable route",
+			name: "dropped invalid rule with invalid filters",
 		},
 		{
-			route: createNormalRoute(gw),
-			gateway: &Gateway{
-				Source: gw,
-				Valid:  true,
-				Listeners: []*Listener{
-					createModifiedListener("listener-80-1", func(l *Listener) {
-						l.Source.AllowedRoutes = &gatewayv1.AllowedRoutes{
-							Namespaces: &gatewayv1.RouteNamespaces{
-								From: helpers.GetPointer(gatewayv1.NamespacesFromSelector),
-							},
-						}
-						allowedLabels := map[string]string{"app": "not-allowed"}
-						l.AllowedRouteLabelSelector = labels.SelectorFromSet(allowedLabels)
-					}),
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Idx:     0,
-					Gateway: client.ObjectKeyFromObject(gw),
-					Attachment: &ParentRefAttachmentStatus{
-						Attached:          false,
-						FailedCondition:   staticConds.NewRouteNotAllowedByListeners(),
-						AcceptedHostnames: map[string][]string{},
-					},
-				},
-			},
-			expectedGatewayListeners: []*Listener{
-				createModifiedListener("listener-80-1", func(l *Listener) {
-					l.Source.AllowedRoutes = &gatewayv1.AllowedRoutes{
-						Namespaces: &gatewayv1.RouteNamespaces{
-							From: helpers.GetPointer(gatewayv1.NamespacesFromSelector),
-						},
-					}
-					allowedLabels := map[string]string{"app": "not-allowed"}
-					l.AllowedRouteLabelSelector = labels.SelectorFromSet(allowedLabels)
-				}),
-			},
-			name: "route not allowed via labels",
-		},
-		{
-			route: createNormalRoute(gw),
-			gateway: &Gateway{
-				Source: gw,
-				Valid:  true,
-				Listeners: []*Listener{
-					createModifiedListener("listener-80-1", func(l *Listener) {
-						l.Source.AllowedRoutes = &gatewayv1.AllowedRoutes{
-							Namespaces: &gatewayv1.RouteNamespaces{
-								From: helpers.GetPointer(gatewayv1.NamespacesFromSelector),
-							},
-						}
-						allowedLabels := map[string]string{"app": "allowed"}
-						l.AllowedRouteLabelSelector = labels.SelectorFromSet(allowedLabels)
-					}),
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Idx:     0,
-					Gateway: client.ObjectKeyFromObject(gw),
-					Attachment: &ParentRefAttachmentStatus{
-						Attached: true,
-						AcceptedHostnames: map[string][]string{
-							"listener-80-1": {"foo.example.com"},
-						},
-					},
-				},
-			},
-			expectedGatewayListeners: []*Listener{
-				createModifiedListener("listener-80-1", func(l *Listener) {
-					allowedLabels := map[string]string{"app": "allowed"}
-					l.AllowedRouteLabelSelector = labels.SelectorFromSet(allowedLabels)
-					l.Source.AllowedRoutes = &gatewayv1.AllowedRoutes{
-						Namespaces: &gatewayv1.RouteNamespaces{
-							From: helpers.GetPointer(gatewayv1.NamespacesFromSelector),
-						},
-					}
-					l.Routes = map[types.NamespacedName]*Route{
-						client.ObjectKeyFromObject(hr): getLastNormalRoute(),
-					}
-				}),
-			},
-			name: "route allowed via labels",
-		},
-		{
-			route: createNormalRoute(gwDiffNamespace),
-			gateway: &Gateway{
-				Source: gwDiffNamespace,
-				Valid:  true,
-				Listeners: []*Listener{
-					createModifiedListener("listener-80-1", func(l *Listener) {
-						l.Source.AllowedRoutes = &gatewayv1.AllowedRoutes{
-							Namespaces: &gatewayv1.RouteNamespaces{
-								From: helpers.GetPointer(gatewayv1.NamespacesFromSame),
-							},
-						}
-					}),
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Idx:     0,
-					Gateway: client.ObjectKeyFromObject(gwDiffNamespace),
-					Attachment: &ParentRefAttachmentStatus{
-						Attached:          false,
-						FailedCondition:   staticConds.NewRouteNotAllowedByListeners(),
-						AcceptedHostnames: map[string][]string{},
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+			hr:        hrTimeouts,
+			expected: &L7Route{
+				Source:     hrTimeouts,
+				RouteType:  RouteTypeHTTP,
+				Valid:      true,
+				Attachable: true,
+				ParentRefs: []ParentRef{
+					{
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: hrTimeouts.Spec.ParentRefs[0].SectionName,
 					},
 				},
-			},
-			expectedGatewayListeners: []*Listener{
-				createModifiedListener("listener-80-1", func(l *Listener) {
-					l.Source.AllowedRoutes = &gatewayv1.AllowedRoutes{
-						Namespaces: &gatewayv1.RouteNamespaces{
-							From: helpers.GetPointer(gatewayv1.NamespacesFromSame),
-						},
-					}
-				}),
-			},
-			name: "route not allowed via same namespace",
-		},
-		{
-			route: createNormalRoute(gw),
-			gateway: &Gateway{
-				Source: gw,
-				Valid:  true,
-				Listeners: []*Listener{
-					createModifiedListener("listener-80-1", func(l *Listener) {
-						l.Source.AllowedRoutes = &gatewayv1.AllowedRoutes{
-							Namespaces: &gatewayv1.RouteNamespaces{
-								From: helpers.GetPointer(gatewayv1.NamespacesFromSame),
-							},
-						}
-					}),
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Idx:     0,
-					Gateway: client.ObjectKeyFromObject(gw),
-					Attachment: &ParentRefAttachmentStatus{
-						Attached: true,
-						AcceptedHostnames: map[string][]string{
-							"listener-80-1": {"foo.example.com"},
-						},
-					},
+				Conditions: []conditions.Condition{
+					staticConds.NewRoutePartiallyInvalid(
+						`spec.rules[1].timeouts.request: Invalid value: "10": must be a valid duration ` +
+							`(e.g. '1h',  or '10s',  or '500ms', regex used for validation is ` +
+							`'^([0-9]{1,5}(h|m|s|ms)){1,4}$')`,
+					),
 				},
-			},
-			expectedGatewayListeners: []*Listener{
-				createModifiedListener("listener-80-1", func(l *Listener) {
-					l.Source.AllowedRoutes = &gatewayv1.AllowedRoutes{
-						Namespaces: &gatewayv1.RouteNamespaces{
-							From: helpers.GetPointer(gatewayv1.NamespacesFromSame),
+				Spec: L7RouteSpec{
+					Hostnames: hrTimeouts.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							Matches:          hrTimeouts.Spec.Rules[0].Matches,
+							Timeouts:         hrTimeouts.Spec.Rules[0].Timeouts,
+							RouteBackendRefs: []RouteBackendRef{},
+							ValidMatches:     true,
+							ValidFilters:     true,
 						},
-					}
-					l.Routes = map[types.NamespacedName]*Route{
-						client.ObjectKeyFromObject(hr): getLastNormalRoute(),
-					}
-				}),
-			},
-			name: "route allowed via same namespace",
-		},
-		{
-			route: createNormalRoute(gwDiffNamespace),
-			gateway: &Gateway{
-				Source: gwDiffNamespace,
-				Valid:  true,
-				Listeners: []*Listener{
-					createModifiedListener("listener-80-1", func(l *Listener) {
-						l.Source.AllowedRoutes = &gatewayv1.AllowedRoutes{
-							Namespaces: &gatewayv1.RouteNamespaces{
-								From: helpers.GetPointer(gatewayv1.NamespacesFromAll),
-							},
-						}
-					}),
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Idx:     0,
-					Gateway: client.ObjectKeyFromObject(gwDiffNamespace),
-					Attachment: &ParentRefAttachmentStatus{
-						Attached: true,
-						AcceptedHostnames: map[string][]string{
-							"listener-80-1": {"foo.example.com"},
+						{
+							Matches:          hrTimeouts.Spec.Rules[1].Matches,
+							Timeouts:         hrTimeouts.Spec.Rules[1].Timeouts,
+							RouteBackendRefs: []RouteBackendRef{},
+							ValidMatches:     true,
+							ValidFilters:     false,
 						},
 					},
 				},
 			},
-			expectedGatewayListeners: []*Listener{
-				createModifiedListener("listener-80-1", func(l *Listener) {
-					l.Source.AllowedRoutes = &gatewayv1.AllowedRoutes{
-						Namespaces: &gatewayv1.RouteNamespaces{
-							From: helpers.GetPointer(gatewayv1.NamespacesFromAll),
-						},
-					}
-					l.Routes = map[types.NamespacedName]*Route{
-						client.ObjectKeyFromObject(hr): getLastNormalRoute(),
-					}
-				}),
-			},
-			name: "route allowed via all namespaces",
-		},
-	}
-
-	namespaces := map[types.NamespacedName]*v1.Namespace{
-		{Name: "test"}: {
-			ObjectMeta: metav1.ObjectMeta{
-				Name:   "test",
-				Labels: map[string]string{"app": "allowed"},
-			},
-		},
-	}
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			g := NewWithT(t)
-
-			bindRouteToListeners(test.route, test.gateway, namespaces)
-
-			g.Expect(test.route.ParentRefs).To(Equal(test.expectedSectionNameRefs))
-			g.Expect(helpers.Diff(test.gateway.Listeners, test.expectedGatewayListeners)).To(BeEmpty())
-			g.Expect(helpers.Diff(test.route.Conditions, test.expectedConditions)).To(BeEmpty())
-		})
-	}
-}
-
-func TestFindAcceptedHostnames(t *testing.T) {
-	var listenerHostnameFoo gatewayv1.Hostname = "foo.example.com"
-	var listenerHostnameCafe gatewayv1.Hostname = "cafe.example.com"
-	var listenerHostnameWildcard gatewayv1.Hostname = "*.example.com"
-	routeHostnames := []gatewayv1.Hostname{"foo.example.com", "bar.example.com"}
-
-	tests := []struct {
-		listenerHostname *gatewayv1.Hostname
-		msg              string
-		routeHostnames   []gatewayv1.Hostname
-		expected         []string
-	}{
-		{
-			listenerHostname: &listenerHostnameFoo,
-			routeHostnames:   routeHostnames,
-			expected:         []string{"foo.example.com"},
-			msg:              "one match",
-		},
-		{
-			listenerHostname: &listenerHostnameCafe,
-			routeHostnames:   routeHostnames,
-			expected:         nil,
-			msg:              "no match",
-		},
-		{
-			listenerHostname: nil,
-			routeHostnames:   routeHostnames,
-			expected:         []string{"foo.example.com", "bar.example.com"},
-			msg:              "nil listener hostname",
-		},
-		{
-			listenerHostname: &listenerHostnameFoo,
-			routeHostnames:   nil,
-			expected:         []string{"foo.example.com"},
-			msg:              "route has empty hostnames",
-		},
-		{
-			listenerHostname: nil,
-			routeHostnames:   nil,
-			expected:         []string{wildcardHostname},
-			msg:              "both listener and route have empty hostnames",
-		},
-		{
-			listenerHostname: &listenerHostnameWildcard,
-			routeHostnames:   routeHostnames,
-			expected:         []string{"foo.example.com", "bar.example.com"},
-			msg:              "listener wildcard hostname",
-		},
-		{
-			listenerHostname: &listenerHostnameFoo,
-			routeHostnames:   []gatewayv1.Hostname{"*.example.com"},
-			expected:         []string{"foo.example.com"},
-			msg:              "route wildcard hostname; specific listener hostname",
-		},
-		{
-			listenerHostname: &listenerHostnameWildcard,
-			routeHostnames:   nil,
-			expected:         []string{"*.example.com"},
-			msg:              "listener wildcard hostname; nil route hostname",
-		},
-		{
-			listenerHostname: nil,
-			routeHostnames:   []gatewayv1.Hostname{"*.example.com"},
-			expected:         []string{"*.example.com"},
-			msg:              "route wildcard hostname; nil listener hostname",
-		},
-		{
-			listenerHostname: &listenerHostnameWildcard,
-			routeHostnames:   []gatewayv1.Hostname{"*.bar.example.com"},
-			expected:         []string{"*.bar.example.com"},
-			msg:              "route and listener wildcard hostnames",
-		},
-	}
-
-	for _, test := range tests {
-		t.Run(test.msg, func(t *testing.T) {
-			g := NewWithT(t)
-			result := findAcceptedHostnames(test.listenerHostname, test.routeHostnames)
-			g.Expect(result).To(Equal(test.expected))
-		})
-	}
-}
-
-func TestGetHostname(t *testing.T) {
-	var emptyHostname gatewayv1.Hostname
-	var hostname gatewayv1.Hostname = "example.com"
-
-	tests := []struct {
-		h        *gatewayv1.Hostname
-		expected string
-		msg      string
-	}{
-		{
-			h:        nil,
-			expected: "",
-			msg:      "nil hostname",
-		},
-		{
-			h:        &emptyHostname,
-			expected: "",
-			msg:      "empty hostname",
-		},
-		{
-			h:        &hostname,
-			expected: string(hostname),
-			msg:      "normal hostname",
-		},
-	}
-
-	for _, test := range tests {
-		t.Run(test.msg, func(t *testing.T) {
-			g := NewWithT(t)
-			result := getHostname(test.h)
-			g.Expect(result).To(Equal(test.expected))
-		})
-	}
-}
-
-func TestValidateHostnames(t *testing.T) {
-	const validHostname = "example.com"
-
-	tests := []struct {
-		name      string
-		hostnames []gatewayv1.Hostname
-		expectErr bool
-	}{
-		{
-			hostnames: []gatewayv1.Hostname{
-				validHostname,
-				"example.org",
-				"foo.example.net",
-			},
-			expectErr: false,
-			name:      "multiple valid",
-		},
-		{
-			hostnames: []gatewayv1.Hostname{
-				validHostname,
-				"",
-			},
-			expectErr: true,
-			name:      "valid and invalid",
+			name: "dropped invalid rule with invalid timeouts",
 		},
 	}
 
-	path := field.NewPath("test")
+	gatewayNsNames := []types.NamespacedName{gatewayNsName}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
 
-			err := validateHostnames(test.hostnames, path)
-
-			if test.expectErr {
-				g.Expect(err).To(HaveOccurred())
-			} else {
-				g.Expect(err).ToNot(HaveOccurred())
-			}
+			route := buildHTTPRoute(test.validator, test.hr, gatewayNsNames)
+			g.Expect(helpers.Diff(test.expected, route)).To(BeEmpty())
 		})
 	}
 }
@@ -1710,27 +677,53 @@ func TestValidateMatch(t *testing.T) {
 						Value: "y",
 					},
 				},
-				Method: helpers.GetPointer(gatewayv1.HTTPMethodGet),
+				Method: helpers.GetPointer(gatewayv1.HTTPMethodGet),
+			},
+			expectErrCount: 0,
+			name:           "valid",
+		},
+		{
+			validator: createAllValidValidator(),
+			match: gatewayv1.HTTPRouteMatch{
+				Path: &gatewayv1.HTTPPathMatch{
+					Type:  helpers.GetPointer(gatewayv1.PathMatchExact),
+					Value: helpers.GetPointer("/"),
+				},
+			},
+			expectErrCount: 0,
+			name:           "valid exact match",
+		},
+		{
+			validator: createAllValidValidator(),
+			match: gatewayv1.HTTPRouteMatch{
+				Path: &gatewayv1.HTTPPathMatch{
+					Type:  helpers.GetPointer(gatewayv1.PathMatchRegularExpression),
+					Value: helpers.GetPointer("/api/v[0-9]+/users"),
+				},
 			},
 			expectErrCount: 0,
-			name:           "valid",
+			name:           "valid regex match",
 		},
 		{
-			validator: createAllValidValidator(),
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				validator := createAllValidValidator()
+				validator.ValidatePathRegexInMatchReturns(errors.New("invalid regex"))
+				return validator
+			}(),
 			match: gatewayv1.HTTPRouteMatch{
 				Path: &gatewayv1.HTTPPathMatch{
-					Type:  helpers.GetPointer(gatewayv1.PathMatchExact),
-					Value: helpers.GetPointer("/"),
+					Type:  helpers.GetPointer(gatewayv1.PathMatchRegularExpression),
+					Value: helpers.GetPointer("/api/v[0-9+/users"),
 				},
 			},
-			expectErrCount: 0,
-			name:           "valid exact match",
+			expectErrCount: 1,
+			name:           "wrong regex path value",
 		},
 		{
 			validator: createAllValidValidator(),
 			match: gatewayv1.HTTPRouteMatch{
 				Path: &gatewayv1.HTTPPathMatch{
-					Type:  helpers.GetPointer(gatewayv1.PathMatchRegularExpression),
+					Type:  helpers.GetPointer[gatewayv1.PathMatchType]("Unknown"),
 					Value: helpers.GetPointer("/"),
 				},
 			},
@@ -1773,6 +766,20 @@ func TestValidateMatch(t *testing.T) {
 					{
 						Type:  helpers.GetPointer(gatewayv1.HeaderMatchRegularExpression),
 						Name:  "header",
+						Value: "^x$",
+					},
+				},
+			},
+			expectErrCount: 0,
+			name:           "valid header regex match",
+		},
+		{
+			validator: createAllValidValidator(),
+			match: gatewayv1.HTTPRouteMatch{
+				Headers: []gatewayv1.HTTPHeaderMatch{
+					{
+						Type:  helpers.GetPointer[gatewayv1.HeaderMatchType]("Unknown"),
+						Name:  "header",
 						Value: "x",
 					},
 				},
@@ -1780,6 +787,24 @@ func TestValidateMatch(t *testing.T) {
 			expectErrCount: 1,
 			name:           "header match type is invalid",
 		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				validator := createAllValidValidator()
+				validator.ValidateHeaderValueRegexInMatchReturns(errors.New("invalid header regex"))
+				return validator
+			}(),
+			match: gatewayv1.HTTPRouteMatch{
+				Headers: []gatewayv1.HTTPHeaderMatch{
+					{
+						Type:  helpers.GetPointer(gatewayv1.HeaderMatchRegularExpression),
+						Name:  "header",
+						Value: "x", // any value is invalid by the validator
+					},
+				},
+			},
+			expectErrCount: 1,
+			name:           "header regex is invalid",
+		},
 		{
 			validator: func() *validationfakes.FakeHTTPFieldsValidator {
 				validator := createAllValidValidator()
@@ -1837,6 +862,20 @@ func TestValidateMatch(t *testing.T) {
 					{
 						Type:  helpers.GetPointer(gatewayv1.QueryParamMatchRegularExpression),
 						Name:  "param",
+						Value: "^y$",
+					},
+				},
+			},
+			expectErrCount: 0,
+			name:           "valid query param regex match",
+		},
+		{
+			validator: createAllValidValidator(),
+			match: gatewayv1.HTTPRouteMatch{
+				QueryParams: []gatewayv1.HTTPQueryParamMatch{
+					{
+						Type:  helpers.GetPointer[gatewayv1.QueryParamMatchType]("Unknown"),
+						Name:  "param",
 						Value: "y",
 					},
 				},
@@ -1844,6 +883,24 @@ func TestValidateMatch(t *testing.T) {
 			expectErrCount: 1,
 			name:           "query param match type is invalid",
 		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				validator := createAllValidValidator()
+				validator.ValidateQueryParamValueRegexInMatchReturns(errors.New("invalid query param regex"))
+				return validator
+			}(),
+			match: gatewayv1.HTTPRouteMatch{
+				QueryParams: []gatewayv1.HTTPQueryParamMatch{
+					{
+						Type:  helpers.GetPointer(gatewayv1.QueryParamMatchRegularExpression),
+						Name:  "param",
+						Value: "y", // any value is invalid by the validator
+					},
+				},
+			},
+			expectErrCount: 1,
+			name:           "query param regex is invalid",
+		},
 		{
 			validator: func() *validationfakes.FakeHTTPFieldsValidator {
 				validator := createAllValidValidator()
@@ -1896,19 +953,19 @@ func TestValidateMatch(t *testing.T) {
 			validator: createAllValidValidator(),
 			match: gatewayv1.HTTPRouteMatch{
 				Path: &gatewayv1.HTTPPathMatch{
-					Type:  helpers.GetPointer(gatewayv1.PathMatchRegularExpression), // invalid
+					Type:  helpers.GetPointer[gatewayv1.PathMatchType]("Unknown"), // invalid
 					Value: helpers.GetPointer("/"),
 				},
 				Headers: []gatewayv1.HTTPHeaderMatch{
 					{
-						Type:  helpers.GetPointer(gatewayv1.HeaderMatchRegularExpression), // invalid
+						Type:  helpers.GetPointer[gatewayv1.HeaderMatchType]("Unknown"), // invalid
 						Name:  "header",
 						Value: "x",
 					},
 				},
 				QueryParams: []gatewayv1.HTTPQueryParamMatch{
 					{
-						Type:  helpers.GetPointer(gatewayv1.QueryParamMatchRegularExpression), // invalid
+						Type:  helpers.GetPointer[gatewayv1.QueryParamMatchType]("Unknown"), // invalid
 						Name:  "param",
 						Value: "y",
 					},
@@ -1928,6 +985,67 @@ func TestValidateMatch(t *testing.T) {
 	}
 }
 
+func TestValidateTimeouts(t *testing.T) {
+	tests := []struct {
+		timeouts       *gatewayv1.HTTPRouteTimeouts
+		name           string
+		expectErrCount int
+	}{
+		{
+			timeouts:       nil,
+			expectErrCount: 0,
+			name:           "nil timeouts",
+		},
+		{
+			timeouts: &gatewayv1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[gatewayv1.Duration]("1m30s"),
+				BackendRequest: helpers.GetPointer[gatewayv1.Duration]("500ms"),
+			},
+			expectErrCount: 0,
+			name:           "valid timeouts",
+		},
+		{
+			timeouts: &gatewayv1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[gatewayv1.Duration]("0s"),
+				BackendRequest: helpers.GetPointer[gatewayv1.Duration]("1h"),
+			},
+			expectErrCount: 0,
+			name:           "zero request timeout with backendRequest timeout",
+		},
+		{
+			timeouts: &gatewayv1.HTTPRouteTimeouts{
+				BackendRequest: helpers.GetPointer[gatewayv1.Duration]("10s"),
+			},
+			expectErrCount: 0,
+			name:           "only backendRequest timeout",
+		},
+		{
+			timeouts: &gatewayv1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[gatewayv1.Duration]("1.5s"),
+				BackendRequest: helpers.GetPointer[gatewayv1.Duration]("-1s"),
+			},
+			expectErrCount: 2,
+			name:           "invalid durations",
+		},
+		{
+			timeouts: &gatewayv1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[gatewayv1.Duration]("5s"),
+				BackendRequest: helpers.GetPointer[gatewayv1.Duration]("10s"),
+			},
+			expectErrCount: 1,
+			name:           "backendRequest timeout longer than request timeout",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			allErrs := validateTimeouts(test.timeouts, field.NewPath("test"))
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+		})
+	}
+}
+
 func TestValidateFilter(t *testing.T) {
 	tests := []struct {
 		filter         gatewayv1.HTTPRouteFilter
@@ -1958,11 +1076,36 @@ func TestValidateFilter(t *testing.T) {
 			expectErrCount: 0,
 			name:           "valid request header modifiers filter",
 		},
+		{
+			filter: gatewayv1.HTTPRouteFilter{
+				Type:                   gatewayv1.HTTPRouteFilterResponseHeaderModifier,
+				ResponseHeaderModifier: &gatewayv1.HTTPHeaderFilter{},
+			},
+			expectErrCount: 0,
+			name:           "valid response header modifiers filter",
+		},
+		{
+			filter: gatewayv1.HTTPRouteFilter{
+				Type: gatewayv1.HTTPRouteFilterRequestMirror,
+				RequestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+					BackendRef: gatewayv1.BackendObjectReference{Name: "mirror-svc"},
+				},
+			},
+			expectErrCount: 0,
+			name:           "valid request mirror filter",
+		},
 		{
 			filter: gatewayv1.HTTPRouteFilter{
 				Type: gatewayv1.HTTPRouteFilterRequestMirror,
 			},
 			expectErrCount: 1,
+			name:           "nil request mirror filter",
+		},
+		{
+			filter: gatewayv1.HTTPRouteFilter{
+				Type: gatewayv1.HTTPRouteFilterExtensionRef,
+			},
+			expectErrCount: 1,
 			name:           "unsupported filter",
 		},
 	}
@@ -2411,7 +1554,201 @@ func TestValidateFilterRequestHeaderModifier(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
-			allErrs := validateFilterHeaderModifier(test.validator, test.filter, filterPath)
+			allErrs := validateFilterHeaderModifier(
+				test.validator, test.filter.RequestHeaderModifier, filterPath.Child("requestHeaderModifier"),
+			)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+		})
+	}
+}
+
+func TestValidateFilterResponseHeaderModifier(t *testing.T) {
+	createAllValidValidator := func() *validationfakes.FakeHTTPFieldsValidator {
+		v := &validationfakes.FakeHTTPFieldsValidator{}
+		return v
+	}
+
+	tests := []struct {
+		filter         gatewayv1.HTTPRouteFilter
+		validator      *validationfakes.FakeHTTPFieldsValidator
+		name           string
+		expectErrCount int
+	}{
+		{
+			validator: createAllValidValidator(),
+			filter: gatewayv1.HTTPRouteFilter{
+				Type: gatewayv1.HTTPRouteFilterResponseHeaderModifier,
+				ResponseHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+					Set: []gatewayv1.HTTPHeader{
+						{Name: "Cache-Control", Value: "no-store"},
+					},
+					Add: []gatewayv1.HTTPHeader{
+						{Name: "X-Frame-Options", Value: "DENY"},
+					},
+					Remove: []string{"X-Powered-By"},
+				},
+			},
+			expectErrCount: 0,
+			name:           "valid response header modifier filter",
+		},
+		{
+			validator: createAllValidValidator(),
+			filter: gatewayv1.HTTPRouteFilter{
+				Type:                   gatewayv1.HTTPRouteFilterResponseHeaderModifier,
+				ResponseHeaderModifier: nil,
+			},
+			expectErrCount: 1,
+			name:           "nil response header modifier filter",
+		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				v := createAllValidValidator()
+				v.ValidateResponseHeaderNameReturns(errors.New("Invalid header"))
+				return v
+			}(),
+			filter: gatewayv1.HTTPRouteFilter{
+				Type: gatewayv1.HTTPRouteFilterResponseHeaderModifier,
+				ResponseHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+					Remove: []string{"Server"},
+				},
+			},
+			expectErrCount: 1,
+			name:           "response header modifier filter with invalid remove",
+		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				v := createAllValidValidator()
+				v.ValidateResponseHeaderValueReturns(errors.New("Invalid header value"))
+				v.ValidateResponseHeaderNameReturns(errors.New("Invalid header"))
+				return v
+			}(),
+			filter: gatewayv1.HTTPRouteFilter{
+				Type: gatewayv1.HTTPRouteFilterResponseHeaderModifier,
+				ResponseHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+					Set: []gatewayv1.HTTPHeader{
+						{Name: "Server", Value: "my-server$"},
+					},
+					Add: []gatewayv1.HTTPHeader{
+						{Name: "X-Accel-Redirect", Value: "/internal$"},
+					},
+					Remove: []string{"Date"},
+				},
+			},
+			expectErrCount: 5,
+			name:           "response header modifier filter all fields invalid",
+		},
+		{
+			validator: createAllValidValidator(),
+			filter: gatewayv1.HTTPRouteFilter{
+				Type: gatewayv1.HTTPRouteFilterResponseHeaderModifier,
+				ResponseHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+					Set: []gatewayv1.HTTPHeader{
+						{Name: "Cache-Control", Value: "no-store"},
+						{Name: "cache-control", Value: "duplicate"},
+					},
+				},
+			},
+			expectErrCount: 1,
+			name:           "response header modifier filter not unique names",
+		},
+	}
+
+	filterPath := field.NewPath("test")
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			allErrs := validateFilterResponseHeaderModifier(
+				test.validator, test.filter.ResponseHeaderModifier, filterPath.Child("responseHeaderModifier"),
+			)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+		})
+	}
+}
+
+func TestValidateBackendRefFilters(t *testing.T) {
+	tests := []struct {
+		validator      *validationfakes.FakeHTTPFieldsValidator
+		name           string
+		refs           []RouteBackendRef
+		expectErrCount int
+	}{
+		{
+			validator:      &validationfakes.FakeHTTPFieldsValidator{},
+			refs:           []RouteBackendRef{{}, {}},
+			expectErrCount: 0,
+			name:           "no filters",
+		},
+		{
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+			refs: []RouteBackendRef{
+				{
+					Filters: []gatewayv1.HTTPRouteFilter{
+						{
+							Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+							RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+								Set: []gatewayv1.HTTPHeader{{Name: "X-Canary", Value: "true"}},
+							},
+						},
+						{
+							Type: gatewayv1.HTTPRouteFilterResponseHeaderModifier,
+							ResponseHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+								Remove: []string{"X-Backend"},
+							},
+						},
+					},
+				},
+			},
+			expectErrCount: 0,
+			name:           "valid header modifier filters",
+		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				v := &validationfakes.FakeHTTPFieldsValidator{}
+				v.ValidateRequestHeaderValueReturns(errors.New("Invalid header value"))
+				return v
+			}(),
+			refs: []RouteBackendRef{
+				{},
+				{
+					Filters: []gatewayv1.HTTPRouteFilter{
+						{
+							Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+							RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+								Add: []gatewayv1.HTTPHeader{{Name: "X-Canary", Value: "$invalid"}},
+							},
+						},
+					},
+				},
+			},
+			expectErrCount: 1,
+			name:           "invalid request header modifier filter",
+		},
+		{
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+			refs: []RouteBackendRef{
+				{
+					Filters: []gatewayv1.HTTPRouteFilter{
+						{
+							Type:       gatewayv1.HTTPRouteFilterURLRewrite,
+							URLRewrite: &gatewayv1.HTTPURLRewriteFilter{},
+						},
+						{
+							Type:                   gatewayv1.HTTPRouteFilterResponseHeaderModifier,
+							ResponseHeaderModifier: nil,
+						},
+					},
+				},
+			},
+			expectErrCount: 2,
+			name:           "unsupported and nil filters",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			allErrs := validateBackendRefFilters(test.validator, test.refs, field.NewPath("test"))
 			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
 		})
 	}
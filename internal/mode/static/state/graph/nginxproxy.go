@@ -0,0 +1,124 @@
+package graph
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+const kindNginxProxy v1.Kind = "NginxProxy"
+
+// NginxProxy represents the NginxProxy resource referenced by the parametersRef of the GatewayClass.
+type NginxProxy struct {
+	// Source is the source resource.
+	Source *ngfAPI.NginxProxy
+	// ErrMsgs contains the validation errors if they exist, to be included in the GatewayClass condition.
+	ErrMsgs field.ErrorList
+	// Valid shows whether the NginxProxy is valid. Only a valid NginxProxy configures NGINX.
+	Valid bool
+}
+
+// TelemetryEnabled returns true if the NginxProxy is valid and configures the OpenTelemetry exporter.
+func (np *NginxProxy) TelemetryEnabled() bool {
+	if np == nil || !np.Valid {
+		return false
+	}
+
+	telemetry := np.Source.Spec.Telemetry
+
+	return telemetry != nil && telemetry.Exporter != nil && telemetry.Exporter.Endpoint != ""
+}
+
+// getNginxProxy returns the NginxProxy referenced by the parametersRef of the GatewayClass, if it exists.
+func getNginxProxy(
+	nps map[types.NamespacedName]*ngfAPI.NginxProxy,
+	gc *v1.GatewayClass,
+) *ngfAPI.NginxProxy {
+	if gc == nil || !gcReferencesNginxProxy(gc) {
+		return nil
+	}
+
+	// NginxProxy is a cluster-scoped resource.
+	return nps[types.NamespacedName{Name: gc.Spec.ParametersRef.Name}]
+}
+
+// buildNginxProxy validates the NginxProxy and returns its graph representation.
+func buildNginxProxy(np *ngfAPI.NginxProxy, validator validation.GenericValidator) *NginxProxy {
+	if np == nil {
+		return nil
+	}
+
+	errs := validateNginxProxy(np, validator)
+
+	return &NginxProxy{
+		Source:  np,
+		ErrMsgs: errs,
+		Valid:   len(errs) == 0,
+	}
+}
+
+// gcReferencesNginxProxy returns true if the parametersRef of the GatewayClass references an NginxProxy.
+func gcReferencesNginxProxy(gc *v1.GatewayClass) bool {
+	ref := gc.Spec.ParametersRef
+
+	return ref != nil && ref.Group == ngfAPI.GroupName && ref.Kind == kindNginxProxy
+}
+
+// isNginxProxyReferenced returns true if the NginxProxy is referenced by the GatewayClass.
+func isNginxProxyReferenced(npNsName types.NamespacedName, gc *GatewayClass) bool {
+	if gc == nil || !gcReferencesNginxProxy(gc.Source) {
+		return false
+	}
+
+	return gc.Source.Spec.ParametersRef.Name == npNsName.Name
+}
+
+func validateNginxProxy(np *ngfAPI.NginxProxy, validator validation.GenericValidator) field.ErrorList {
+	telemetry := np.Spec.Telemetry
+	if telemetry == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	telemetryPath := field.NewPath("spec").Child("telemetry")
+
+	if telemetry.ServiceName != nil {
+		if err := validator.ValidateServiceName(*telemetry.ServiceName); err != nil {
+			allErrs = append(
+				allErrs,
+				field.Invalid(telemetryPath.Child("serviceName"), *telemetry.ServiceName, err.Error()),
+			)
+		}
+	}
+
+	if exp := telemetry.Exporter; exp != nil {
+		expPath := telemetryPath.Child("exporter")
+
+		if err := validator.ValidateEndpoint(exp.Endpoint); err != nil {
+			allErrs = append(allErrs, field.Invalid(expPath.Child("endpoint"), exp.Endpoint, err.Error()))
+		}
+
+		if exp.Interval != nil {
+			if err := validator.ValidateNginxDuration(string(*exp.Interval)); err != nil {
+				allErrs = append(allErrs, field.Invalid(expPath.Child("interval"), *exp.Interval, err.Error()))
+			}
+		}
+	}
+
+	for i, attr := range telemetry.SpanAttributes {
+		attrPath := telemetryPath.Child("spanAttributes").Index(i)
+
+		if err := validator.ValidateEscapedStringNoVarExpansion(attr.Key); err != nil {
+			allErrs = append(allErrs, field.Invalid(attrPath.Child("key"), attr.Key, err.Error()))
+		}
+
+		if err := validator.ValidateEscapedStringNoVarExpansion(attr.Value); err != nil {
+			allErrs = append(allErrs, field.Invalid(attrPath.Child("value"), attr.Value, err.Error()))
+		}
+	}
+
+	return allErrs
+}
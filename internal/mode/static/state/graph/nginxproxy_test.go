@@ -0,0 +1,283 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
+)
+
+func TestGetNginxProxy(t *testing.T) {
+	np := &ngfAPI.NginxProxy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "np",
+		},
+	}
+
+	nps := map[types.NamespacedName]*ngfAPI.NginxProxy{
+		{Name: "np"}: np,
+	}
+
+	createGatewayClass := func(ref *v1.ParametersReference) *v1.GatewayClass {
+		return &v1.GatewayClass{
+			Spec: v1.GatewayClassSpec{
+				ParametersRef: ref,
+			},
+		}
+	}
+
+	tests := []struct {
+		gc       *v1.GatewayClass
+		expected *ngfAPI.NginxProxy
+		name     string
+	}{
+		{
+			gc:       nil,
+			expected: nil,
+			name:     "nil gatewayclass",
+		},
+		{
+			gc:       createGatewayClass(nil),
+			expected: nil,
+			name:     "no parametersRef",
+		},
+		{
+			gc: createGatewayClass(&v1.ParametersReference{
+				Group: "example.com",
+				Kind:  "NginxProxy",
+				Name:  "np",
+			}),
+			expected: nil,
+			name:     "parametersRef with unsupported group",
+		},
+		{
+			gc: createGatewayClass(&v1.ParametersReference{
+				Group: ngfAPI.GroupName,
+				Kind:  "NginxProxy",
+				Name:  "does-not-exist",
+			}),
+			expected: nil,
+			name:     "NginxProxy doesn't exist",
+		},
+		{
+			gc: createGatewayClass(&v1.ParametersReference{
+				Group: ngfAPI.GroupName,
+				Kind:  "NginxProxy",
+				Name:  "np",
+			}),
+			expected: np,
+			name:     "NginxProxy exists",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(getNginxProxy(nps, test.gc)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestBuildNginxProxy(t *testing.T) {
+	validator := &validationfakes.FakeGenericValidator{
+		ValidateEscapedStringNoVarExpansionStub: func(value string) error {
+			if value == "invalid" {
+				return errors.New("error")
+			}
+			return nil
+		},
+		ValidateServiceNameStub: func(name string) error {
+			if name == "invalid" {
+				return errors.New("error")
+			}
+			return nil
+		},
+		ValidateEndpointStub: func(endpoint string) error {
+			if endpoint == "invalid" {
+				return errors.New("error")
+			}
+			return nil
+		},
+		ValidateNginxDurationStub: func(duration string) error {
+			if duration == "invalid" {
+				return errors.New("error")
+			}
+			return nil
+		},
+	}
+
+	createNginxProxy := func(telemetry *ngfAPI.Telemetry) *ngfAPI.NginxProxy {
+		return &ngfAPI.NginxProxy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "np",
+			},
+			Spec: ngfAPI.NginxProxySpec{
+				Telemetry: telemetry,
+			},
+		}
+	}
+
+	emptyNP := createNginxProxy(nil)
+
+	validNP := createNginxProxy(&ngfAPI.Telemetry{
+		Exporter: &ngfAPI.TelemetryExporter{
+			Endpoint:   "my-otel.svc:4317",
+			Interval:   helpers.GetPointer[ngfAPI.Duration]("5s"),
+			BatchSize:  helpers.GetPointer[int32](512),
+			BatchCount: helpers.GetPointer[int32](4),
+		},
+		ServiceName: helpers.GetPointer("my-svc"),
+		SpanAttributes: []ngfAPI.SpanAttribute{
+			{Key: "key", Value: "value"},
+		},
+	})
+
+	invalidNP := createNginxProxy(&ngfAPI.Telemetry{
+		Exporter: &ngfAPI.TelemetryExporter{
+			Endpoint: "invalid",
+			Interval: helpers.GetPointer[ngfAPI.Duration]("invalid"),
+		},
+		ServiceName: helpers.GetPointer("invalid"),
+		SpanAttributes: []ngfAPI.SpanAttribute{
+			{Key: "invalid", Value: "invalid"},
+		},
+	})
+
+	telemetryPath := field.NewPath("spec", "telemetry")
+
+	tests := []struct {
+		np       *ngfAPI.NginxProxy
+		expected *NginxProxy
+		name     string
+	}{
+		{
+			np:       nil,
+			expected: nil,
+			name:     "nil NginxProxy",
+		},
+		{
+			np: emptyNP,
+			expected: &NginxProxy{
+				Source: emptyNP,
+				Valid:  true,
+			},
+			name: "empty NginxProxy",
+		},
+		{
+			np: validNP,
+			expected: &NginxProxy{
+				Source: validNP,
+				Valid:  true,
+			},
+			name: "valid NginxProxy",
+		},
+		{
+			np: invalidNP,
+			expected: &NginxProxy{
+				Source: invalidNP,
+				ErrMsgs: field.ErrorList{
+					field.Invalid(telemetryPath.Child("serviceName"), "invalid", "error"),
+					field.Invalid(telemetryPath.Child("exporter", "endpoint"), "invalid", "error"),
+					field.Invalid(
+						telemetryPath.Child("exporter", "interval"),
+						ngfAPI.Duration("invalid"),
+						"error",
+					),
+					field.Invalid(telemetryPath.Child("spanAttributes").Index(0).Child("key"), "invalid", "error"),
+					field.Invalid(telemetryPath.Child("spanAttributes").Index(0).Child("value"), "invalid", "error"),
+				},
+				Valid: false,
+			},
+			name: "invalid NginxProxy",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(buildNginxProxy(test.np, validator)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestNginxProxyTelemetryEnabled(t *testing.T) {
+	tests := []struct {
+		np       *NginxProxy
+		name     string
+		expected bool
+	}{
+		{
+			np:       nil,
+			expected: false,
+			name:     "nil NginxProxy",
+		},
+		{
+			np: &NginxProxy{
+				Source: &ngfAPI.NginxProxy{},
+				Valid:  true,
+			},
+			expected: false,
+			name:     "telemetry is not configured",
+		},
+		{
+			np: &NginxProxy{
+				Source: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						Telemetry: &ngfAPI.Telemetry{
+							ServiceName: helpers.GetPointer("my-svc"),
+						},
+					},
+				},
+				Valid: true,
+			},
+			expected: false,
+			name:     "exporter is not configured",
+		},
+		{
+			np: &NginxProxy{
+				Source: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						Telemetry: &ngfAPI.Telemetry{
+							Exporter: &ngfAPI.TelemetryExporter{Endpoint: "my-otel.svc:4317"},
+						},
+					},
+				},
+				Valid: false,
+			},
+			expected: false,
+			name:     "invalid NginxProxy",
+		},
+		{
+			np: &NginxProxy{
+				Source: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						Telemetry: &ngfAPI.Telemetry{
+							Exporter: &ngfAPI.TelemetryExporter{Endpoint: "my-otel.svc:4317"},
+						},
+					},
+				},
+				Valid: true,
+			},
+			expected: true,
+			name:     "telemetry is enabled",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(test.np.TelemetryEnabled()).To(Equal(test.expected))
+		})
+	}
+}
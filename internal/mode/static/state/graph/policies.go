@@ -0,0 +1,367 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	ngfsort "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/sort"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// Policy represents an NGF Policy that targets the Gateway, a Route or a referenced Service of NGF.
+type Policy struct {
+	// Source is the source resource.
+	Source policies.Policy
+	// Ancestor is the reference to the target of the Policy. It is reported in the ancestor status of the Policy.
+	Ancestor v1.ParentReference
+	// Conditions include Conditions for the Policy.
+	Conditions []conditions.Condition
+	// BackendRef is the resolved backendRef of a Policy that sends requests to a backend, like an ExternalAuthPolicy.
+	// It is nil for the other Policies.
+	BackendRef *BackendRef
+	// ErrorPages are the pages of an ErrorPagePolicy with their resolved references. They are nil for the other
+	// Policies.
+	ErrorPages []ErrorPage
+	// Valid shows whether the Policy is valid and doesn't conflict with other Policies.
+	// Only valid Policies are applied to their targets.
+	Valid bool
+}
+
+// ErrorPage is a page of an ErrorPagePolicy with its resolved references.
+type ErrorPage struct {
+	// BackendRef is the resolved backendRef of the page. It is nil if the page is served from a ConfigMap.
+	BackendRef *BackendRef
+	// ResponseCode is the status code of the response with the page. It is nil if the status code of the error
+	// response is kept.
+	ResponseCode *int32
+	// ContentType is the MIME type of the Content.
+	ContentType string
+	// Content is the content of the page from a ConfigMap. It is nil if the page is proxied to a backend.
+	Content []byte
+	// Codes are the status codes of the error responses that the page replaces.
+	Codes []int32
+}
+
+// PolicyKey is a unique identifier for an NGF Policy.
+type PolicyKey struct {
+	NsName types.NamespacedName
+	GVK    schema.GroupVersionKind
+}
+
+// policyGroupKey identifies the Policies of the same kind that target the same resource.
+type policyGroupKey struct {
+	target policyTarget
+	gvk    schema.GroupVersionKind
+}
+
+// kindPolicies holds Policies grouped by their kind.
+type kindPolicies map[schema.GroupVersionKind][]policies.Policy
+
+// processPolicies processes the NGF Policies, attaches the valid ones to their targets and computes the effective
+// Policies of the Gateway, its listeners, the Routes and the referenced Services.
+// The effective Policies of a listener only include the kinds of the Policies that target the listener with
+// a sectionName. The Policies that target the whole Gateway apply to the listeners that have no Policy of their kind.
+// Services are not part of the Gateway hierarchy, so their effective Policies only include the Policies attached to
+// them.
+// Policies that target resources which don't belong to NGF are ignored.
+// The resources referenced by the Policies, like Secrets, are resolved and validated.
+// The backendRefs of the Policies are resolved too. Unlike the other references, an unresolved backendRef doesn't
+// invalidate the Policy, so that the requests are still processed according to the Policy. Instead, the
+// ResolvedRefs condition of the Policy is false.
+// The processing that is specific to a Policy kind, like resolving its references, is done by the processor of
+// the kind.
+// If Policies of the same kind that target the same resource conflict, the oldest Policy wins and the rest are marked
+// as conflicted.
+func processPolicies(
+	pols map[PolicyKey]policies.Policy,
+	validator validation.PolicyValidator,
+	gateway *Gateway,
+	routes map[RouteKey]*L7Route,
+	services map[types.NamespacedName]*ReferencedService,
+	secretResolver *secretResolver,
+	refGrantResolver *referenceGrantResolver,
+	clusterServices map[types.NamespacedName]*apiv1.Service,
+	clusterConfigMaps map[types.NamespacedName]*apiv1.ConfigMap,
+	globalSettings *policies.GlobalSettings,
+) map[PolicyKey]*Policy {
+	if len(pols) == 0 || gateway == nil {
+		return nil
+	}
+
+	processedPolicies := make(map[PolicyKey]*Policy)
+	// policyGroups groups the valid Policies by their targets and kinds, so that we can resolve conflicts between them.
+	policyGroups := make(map[policyGroupKey][]*Policy)
+
+	resources := policyResources{
+		secretResolver:   secretResolver,
+		refGrantResolver: refGrantResolver,
+		services:         clusterServices,
+		configMaps:       clusterConfigMaps,
+	}
+
+	for key, policy := range pols {
+		target, ok := getPolicyTarget(
+			policy.GetTargetRef(),
+			getPolicySectionName(policy),
+			policy.GetNamespace(),
+			gateway,
+			routes,
+			services,
+		)
+		if !ok {
+			continue
+		}
+
+		p := &Policy{
+			Source:   policy,
+			Ancestor: createPolicyAncestor(target),
+		}
+
+		processedPolicies[key] = p
+
+		if conds := validator.Validate(policy, globalSettings); len(conds) > 0 {
+			p.Conditions = conds
+			continue
+		}
+
+		if conds := validatePolicyTargetListener(target, gateway); len(conds) > 0 {
+			p.Conditions = conds
+			continue
+		}
+
+		if conds := validatePolicyRefs(policy, key.GVK, resources); len(conds) > 0 {
+			p.Conditions = conds
+			continue
+		}
+
+		resolvePolicyRefs(p, key.GVK, resources)
+
+		groupKey := policyGroupKey{target: target, gvk: key.GVK}
+		policyGroups[groupKey] = append(policyGroups[groupKey], p)
+	}
+
+	gatewayPolicies := make(kindPolicies)
+	listenerPolicies := make(map[string]kindPolicies)
+	routePolicies := make(map[RouteKey]kindPolicies)
+	servicePolicies := make(map[types.NamespacedName]kindPolicies)
+
+	for groupKey, group := range policyGroups {
+		accepted := resolvePolicyConflicts(group, groupKey, validator)
+
+		switch {
+		case groupKey.target.Kind == policies.KindGateway && groupKey.target.SectionName != "":
+			listenerName := string(groupKey.target.SectionName)
+			if listenerPolicies[listenerName] == nil {
+				listenerPolicies[listenerName] = make(kindPolicies)
+			}
+			listenerPolicies[listenerName][groupKey.gvk] = accepted
+		case groupKey.target.Kind == policies.KindGateway:
+			gatewayPolicies[groupKey.gvk] = accepted
+			updatePolicyTarget(group, groupKey.gvk, gateway, nil)
+		case groupKey.target.Kind == policies.KindService:
+			svcNsName := groupKey.target.NsName
+			if servicePolicies[svcNsName] == nil {
+				servicePolicies[svcNsName] = make(kindPolicies)
+			}
+			servicePolicies[svcNsName][groupKey.gvk] = accepted
+		default:
+			routeKey := RouteKey{NamespacedName: groupKey.target.NsName, RouteType: RouteTypeHTTP}
+			if routePolicies[routeKey] == nil {
+				routePolicies[routeKey] = make(kindPolicies)
+			}
+			routePolicies[routeKey][groupKey.gvk] = accepted
+			updatePolicyTarget(group, groupKey.gvk, nil, routes[routeKey])
+		}
+	}
+
+	for groupKey, group := range policyGroups {
+		updatePolicyConditions(group, groupKey, routes, routePolicies)
+	}
+
+	gatewayEffective := buildEffectivePolicies(nil, gatewayPolicies, validator)
+	gateway.EffectivePolicies = sortEffectivePolicies(gatewayEffective)
+
+	for _, l := range gateway.Listeners {
+		if attached, exists := listenerPolicies[l.Name]; exists {
+			listenerEffective := buildEffectivePolicies(gatewayEffective, attached, validator)
+			l.EffectivePolicies = sortEffectivePolicies(listenerEffective)
+		}
+	}
+
+	for routeKey, attached := range routePolicies {
+		routeEffective := buildEffectivePolicies(gatewayEffective, attached, validator)
+		routes[routeKey].EffectivePolicies = sortEffectivePolicies(routeEffective)
+	}
+
+	for svcNsName, attached := range servicePolicies {
+		svcEffective := buildEffectivePolicies(nil, attached, validator)
+		services[svcNsName].EffectivePolicies = sortEffectivePolicies(svcEffective)
+	}
+
+	return processedPolicies
+}
+
+// resolvePolicyBackendRef resolves a backendRef of a Policy to the Service svcNsName. It returns the BackendRef and
+// the ResolvedRefs condition of the Policy. If the backendRef can't be resolved, the BackendRef is invalid.
+func resolvePolicyBackendRef(
+	ref v1.BackendObjectReference,
+	svcNsName types.NamespacedName,
+	from fromResource,
+	path *field.Path,
+	services map[types.NamespacedName]*apiv1.Service,
+	refGrantResolver *referenceGrantResolver,
+) (BackendRef, conditions.Condition) {
+	if svcNsName.Namespace != from.namespace && !refGrantResolver.refAllowed(toService(svcNsName), from) {
+		msg := fmt.Sprintf("Backend ref to Service %s not permitted by any ReferenceGrant", svcNsName)
+		return BackendRef{SvcNsName: svcNsName}, staticConds.NewPolicyBackendRefNotPermitted(msg)
+	}
+
+	// the port is guaranteed to be set by the Policy validators
+	_, svcPort, err := getServiceAndPortFromRef(
+		v1.BackendRef{BackendObjectReference: ref},
+		from.namespace,
+		services,
+		path,
+	)
+	if err != nil {
+		return BackendRef{SvcNsName: svcNsName}, staticConds.NewPolicyBackendRefNotFound(err.Error())
+	}
+
+	backendRef := BackendRef{
+		SvcNsName:   svcNsName,
+		ServicePort: svcPort,
+		Weight:      1,
+		Valid:       true,
+	}
+
+	return backendRef, staticConds.NewPolicyResolvedRefs()
+}
+
+// getConfigMapContent returns the content of a key of a ConfigMap, either from its data or its binaryData.
+func getConfigMapContent(cm *apiv1.ConfigMap, key string) ([]byte, bool) {
+	if data, exists := cm.Data[key]; exists {
+		return []byte(data), true
+	}
+
+	data, exists := cm.BinaryData[key]
+	return data, exists
+}
+
+// resolvePolicyConflicts marks the Policies that conflict with an older Policy of the group as conflicted and the rest
+// as accepted. It returns the accepted Policies, sorted from the oldest to the newest.
+func resolvePolicyConflicts(
+	group []*Policy,
+	groupKey policyGroupKey,
+	validator validation.PolicyValidator,
+) []policies.Policy {
+	// the oldest policy wins a conflict
+	slices.SortFunc(group, func(p1, p2 *Policy) int {
+		switch {
+		case ngfsort.LessClientObject(p1.Source, p2.Source):
+			return -1
+		case ngfsort.LessClientObject(p2.Source, p1.Source):
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	var accepted []*Policy
+
+	for _, p := range group {
+		winner := findConflictingPolicy(accepted, p, validator)
+		if winner != nil {
+			p.Conditions = []conditions.Condition{
+				staticConds.NewPolicyConflicted(fmt.Sprintf(
+					"Conflicts with another %s %s that targets the same %s",
+					groupKey.gvk.Kind,
+					client.ObjectKeyFromObject(winner.Source),
+					groupKey.target.Kind,
+				)),
+			}
+			continue
+		}
+
+		p.Valid = true
+		// keep the conditions about the references of the Policy, like the ResolvedRefs condition
+		p.Conditions = append([]conditions.Condition{staticConds.NewPolicyAccepted()}, p.Conditions...)
+		accepted = append(accepted, p)
+	}
+
+	sources := make([]policies.Policy, 0, len(accepted))
+	for _, p := range accepted {
+		sources = append(sources, p.Source)
+	}
+
+	return sources
+}
+
+func findConflictingPolicy(accepted []*Policy, policy *Policy, validator validation.PolicyValidator) *Policy {
+	for _, a := range accepted {
+		if validator.Conflicts(a.Source, policy.Source) {
+			return a
+		}
+	}
+
+	return nil
+}
+
+// buildEffectivePolicies merges the attached Policies of each kind into the effective Policy of the kind.
+// The attached Policies of a kind are merged from the oldest to the newest and then merged as the child with
+// the inherited Policy of the same kind, if any. Only the kinds of the attached Policies are included.
+func buildEffectivePolicies(
+	inherited map[schema.GroupVersionKind]policies.Policy,
+	attached kindPolicies,
+	validator validation.PolicyValidator,
+) map[schema.GroupVersionKind]policies.Policy {
+	effective := make(map[schema.GroupVersionKind]policies.Policy, len(attached))
+
+	for gvk, pols := range attached {
+		merged := pols[0]
+		for _, p := range pols[1:] {
+			merged = validator.Merge(merged, p)
+		}
+
+		if parent, exists := inherited[gvk]; exists {
+			merged = validator.Merge(parent, merged)
+		}
+
+		effective[gvk] = merged
+	}
+
+	return effective
+}
+
+// sortEffectivePolicies returns the effective Policies sorted by their kinds, so that the order is deterministic.
+func sortEffectivePolicies(effective map[schema.GroupVersionKind]policies.Policy) []policies.Policy {
+	if len(effective) == 0 {
+		return nil
+	}
+
+	gvks := make([]schema.GroupVersionKind, 0, len(effective))
+	for gvk := range effective {
+		gvks = append(gvks, gvk)
+	}
+
+	slices.SortFunc(gvks, func(gvk1, gvk2 schema.GroupVersionKind) int {
+		return strings.Compare(gvk1.String(), gvk2.String())
+	})
+
+	sorted := make([]policies.Policy, 0, len(gvks))
+	for _, gvk := range gvks {
+		sorted = append(sorted, effective[gvk])
+	}
+
+	return sorted
+}
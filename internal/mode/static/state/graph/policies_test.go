@@ -0,0 +1,1337 @@
+package graph
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
+)
+
+func TestProcessPolicies(t *testing.T) {
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	hrNsName := types.NamespacedName{Namespace: "test", Name: "hr"}
+	hrKey := RouteKey{NamespacedName: hrNsName, RouteType: RouteTypeHTTP}
+	svcNsName := types.NamespacedName{Namespace: "test", Name: "svc"}
+
+	cspGVK := schema.GroupVersionKind{Group: ngfAPI.GroupName, Version: "v1alpha1", Kind: "ClientSettingsPolicy"}
+	opGVK := schema.GroupVersionKind{Group: ngfAPI.GroupName, Version: "v1alpha1", Kind: "ObservabilityPolicy"}
+
+	createGateway := func() *Gateway {
+		return &Gateway{
+			Source: &v1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: gwNsName.Namespace,
+					Name:      gwNsName.Name,
+				},
+			},
+			Valid: true,
+		}
+	}
+
+	createRoutes := func() map[RouteKey]*L7Route {
+		return map[RouteKey]*L7Route{
+			hrKey: {
+				RouteType: RouteTypeHTTP,
+				Source: &v1.HTTPRoute{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: hrNsName.Namespace,
+						Name:      hrNsName.Name,
+					},
+				},
+				Valid: true,
+			},
+		}
+	}
+
+	createServices := func() map[types.NamespacedName]*ReferencedService {
+		return map[types.NamespacedName]*ReferencedService{
+			svcNsName: {},
+		}
+	}
+
+	now := time.Now()
+
+	createObjectMeta := func(name string, age time.Duration) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Namespace:         "test",
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(now.Add(-age)),
+		}
+	}
+
+	createTargetRef := func(kind v1.Kind, targetName string) v1alpha2.PolicyTargetReference {
+		group := v1.Group(v1.GroupName)
+		if kind == "Service" {
+			group = ""
+		}
+
+		return v1alpha2.PolicyTargetReference{
+			Group: group,
+			Kind:  kind,
+			Name:  v1.ObjectName(targetName),
+		}
+	}
+
+	createCSP := func(name string, kind v1.Kind, targetName string, age time.Duration) *ngfAPI.ClientSettingsPolicy {
+		return &ngfAPI.ClientSettingsPolicy{
+			ObjectMeta: createObjectMeta(name, age),
+			Spec: ngfAPI.ClientSettingsPolicySpec{
+				TargetRef: createTargetRef(kind, targetName),
+			},
+		}
+	}
+
+	createOP := func(name string, kind v1.Kind, targetName string, age time.Duration) *ngfAPI.ObservabilityPolicy {
+		return &ngfAPI.ObservabilityPolicy{
+			ObjectMeta: createObjectMeta(name, age),
+			Spec: ngfAPI.ObservabilityPolicySpec{
+				TargetRef: createTargetRef(kind, targetName),
+			},
+		}
+	}
+
+	createKey := func(policy policies.Policy, gvk schema.GroupVersionKind) PolicyKey {
+		return PolicyKey{
+			NsName: types.NamespacedName{Namespace: policy.GetNamespace(), Name: policy.GetName()},
+			GVK:    gvk,
+		}
+	}
+
+	gwOldPolicy := createCSP("gw-old", "Gateway", "gateway", 2*time.Hour)
+	gwNewPolicy := createCSP("gw-new", "Gateway", "gateway", time.Hour)
+	hrPolicy := createCSP("hr", "HTTPRoute", "hr", 2*time.Hour)
+	hrConflictedPolicy := createCSP("hr-conflicted", "HTTPRoute", "hr", time.Hour)
+	hrInvalidPolicy := createCSP("hr-invalid", "HTTPRoute", "hr", time.Hour)
+	hrOP := createOP("hr-op", "HTTPRoute", "hr", time.Hour)
+	svcPolicy := createCSP("svc", "Service", "svc", time.Hour)
+	wrongGroupPolicy := createCSP("wrong-group", "HTTPRoute", "hr", time.Hour)
+	wrongGroupPolicy.Spec.TargetRef.Group = "foo.example.com"
+
+	otherGwPolicy := createCSP("other-gw", "Gateway", "other-gateway", time.Hour)
+	otherHrPolicy := createCSP("other-hr", "HTTPRoute", "other-hr", time.Hour)
+	unsupportedKindPolicy := createCSP("unsupported-kind", "GRPCRoute", "hr", time.Hour)
+	otherSvcPolicy := createCSP("other-svc", "Service", "other-svc", time.Hour)
+	otherNsPolicy := createCSP("other-ns", "Gateway", "gateway", time.Hour)
+	otherNsPolicy.Spec.TargetRef.Namespace = helpers.GetPointer[v1.Namespace]("other-ns")
+
+	gwAncestor := v1.ParentReference{
+		Group:     helpers.GetPointer[v1.Group](v1.GroupName),
+		Kind:      helpers.GetPointer[v1.Kind]("Gateway"),
+		Namespace: helpers.GetPointer[v1.Namespace]("test"),
+		Name:      "gateway",
+	}
+
+	hrAncestor := v1.ParentReference{
+		Group:     helpers.GetPointer[v1.Group](v1.GroupName),
+		Kind:      helpers.GetPointer[v1.Kind]("HTTPRoute"),
+		Namespace: helpers.GetPointer[v1.Namespace]("test"),
+		Name:      "hr",
+	}
+
+	svcAncestor := v1.ParentReference{
+		Group:     helpers.GetPointer[v1.Group](""),
+		Kind:      helpers.GetPointer[v1.Kind]("Service"),
+		Namespace: helpers.GetPointer[v1.Namespace]("test"),
+		Name:      "svc",
+	}
+
+	// mergedPolicy records the names of the merged policies, so that the tests can check the order of the merges.
+	mergedPolicy := func(parent, child string) *ngfAPI.ClientSettingsPolicy {
+		return &ngfAPI.ClientSettingsPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: parent + "+" + child},
+		}
+	}
+
+	acceptedConds := []conditions.Condition{staticConds.NewPolicyAccepted()}
+	invalidConds := []conditions.Condition{staticConds.NewPolicyInvalid("invalid")}
+
+	tests := []struct {
+		gateway             *Gateway
+		policies            map[PolicyKey]policies.Policy
+		expected            map[PolicyKey]*Policy
+		name                string
+		expGwEffective      []policies.Policy
+		expRouteEffective   []policies.Policy
+		expSvcEffective     []policies.Policy
+		expTelemetryEnabled bool
+	}{
+		{
+			name:    "no gateway",
+			gateway: nil,
+			policies: map[PolicyKey]policies.Policy{
+				createKey(gwOldPolicy, cspGVK): gwOldPolicy,
+			},
+			expected: nil,
+		},
+		{
+			name:     "no policies",
+			gateway:  createGateway(),
+			expected: nil,
+		},
+		{
+			name:    "policies with targets that don't belong to NGF are ignored",
+			gateway: createGateway(),
+			policies: map[PolicyKey]policies.Policy{
+				createKey(otherGwPolicy, cspGVK):         otherGwPolicy,
+				createKey(otherHrPolicy, cspGVK):         otherHrPolicy,
+				createKey(unsupportedKindPolicy, cspGVK): unsupportedKindPolicy,
+				createKey(otherSvcPolicy, cspGVK):        otherSvcPolicy,
+				createKey(otherNsPolicy, cspGVK):         otherNsPolicy,
+			},
+			expected: map[PolicyKey]*Policy{},
+		},
+		{
+			name:    "gateway policies are merged and inherited by the route",
+			gateway: createGateway(),
+			policies: map[PolicyKey]policies.Policy{
+				createKey(gwNewPolicy, cspGVK): gwNewPolicy,
+				createKey(gwOldPolicy, cspGVK): gwOldPolicy,
+				createKey(hrPolicy, cspGVK):    hrPolicy,
+				createKey(hrOP, opGVK):         hrOP,
+			},
+			expected: map[PolicyKey]*Policy{
+				createKey(gwNewPolicy, cspGVK): {
+					Source:     gwNewPolicy,
+					Ancestor:   gwAncestor,
+					Conditions: acceptedConds,
+					Valid:      true,
+				},
+				createKey(gwOldPolicy, cspGVK): {
+					Source:     gwOldPolicy,
+					Ancestor:   gwAncestor,
+					Conditions: acceptedConds,
+					Valid:      true,
+				},
+				createKey(hrPolicy, cspGVK): {
+					Source:     hrPolicy,
+					Ancestor:   hrAncestor,
+					Conditions: acceptedConds,
+					Valid:      true,
+				},
+				createKey(hrOP, opGVK): {
+					Source:     hrOP,
+					Ancestor:   hrAncestor,
+					Conditions: acceptedConds,
+					Valid:      true,
+				},
+			},
+			expGwEffective: []policies.Policy{mergedPolicy("gw-old", "gw-new")},
+			expRouteEffective: []policies.Policy{
+				mergedPolicy("gw-old+gw-new", "hr"),
+				hrOP,
+			},
+			expTelemetryEnabled: true,
+		},
+		{
+			name:    "invalid and conflicted policies",
+			gateway: createGateway(),
+			policies: map[PolicyKey]policies.Policy{
+				createKey(hrConflictedPolicy, cspGVK): hrConflictedPolicy,
+				createKey(hrPolicy, cspGVK):           hrPolicy,
+				createKey(hrInvalidPolicy, cspGVK):    hrInvalidPolicy,
+			},
+			expected: map[PolicyKey]*Policy{
+				createKey(hrConflictedPolicy, cspGVK): {
+					Source:   hrConflictedPolicy,
+					Ancestor: hrAncestor,
+					Conditions: []conditions.Condition{
+						staticConds.NewPolicyConflicted(
+							"Conflicts with another ClientSettingsPolicy test/hr that targets the same HTTPRoute",
+						),
+					},
+				},
+				createKey(hrPolicy, cspGVK): {
+					Source:     hrPolicy,
+					Ancestor:   hrAncestor,
+					Conditions: acceptedConds,
+					Valid:      true,
+				},
+				createKey(hrInvalidPolicy, cspGVK): {
+					Source:     hrInvalidPolicy,
+					Ancestor:   hrAncestor,
+					Conditions: invalidConds,
+				},
+			},
+			expRouteEffective: []policies.Policy{hrPolicy},
+		},
+		{
+			name:    "policy with a wrong target group is reported as invalid",
+			gateway: createGateway(),
+			policies: map[PolicyKey]policies.Policy{
+				createKey(wrongGroupPolicy, cspGVK): wrongGroupPolicy,
+			},
+			expected: map[PolicyKey]*Policy{
+				createKey(wrongGroupPolicy, cspGVK): {
+					Source: wrongGroupPolicy,
+					Ancestor: v1.ParentReference{
+						Group:     helpers.GetPointer[v1.Group]("foo.example.com"),
+						Kind:      helpers.GetPointer[v1.Kind]("HTTPRoute"),
+						Namespace: helpers.GetPointer[v1.Namespace]("test"),
+						Name:      "hr",
+					},
+					Conditions: invalidConds,
+				},
+			},
+		},
+		{
+			name:    "service policies are not merged with gateway policies",
+			gateway: createGateway(),
+			policies: map[PolicyKey]policies.Policy{
+				createKey(gwOldPolicy, cspGVK): gwOldPolicy,
+				createKey(svcPolicy, cspGVK):   svcPolicy,
+			},
+			expected: map[PolicyKey]*Policy{
+				createKey(gwOldPolicy, cspGVK): {
+					Source:     gwOldPolicy,
+					Ancestor:   gwAncestor,
+					Conditions: acceptedConds,
+					Valid:      true,
+				},
+				createKey(svcPolicy, cspGVK): {
+					Source:     svcPolicy,
+					Ancestor:   svcAncestor,
+					Conditions: acceptedConds,
+					Valid:      true,
+				},
+			},
+			expGwEffective:  []policies.Policy{gwOldPolicy},
+			expSvcEffective: []policies.Policy{svcPolicy},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakePolicyValidator{
+				ValidateStub: func(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+					if policy.GetName() == "hr-invalid" || policy.GetName() == "wrong-group" {
+						return invalidConds
+					}
+					return nil
+				},
+				ConflictsStub: func(a, b policies.Policy) bool {
+					return a.GetName() == "hr-conflicted" || b.GetName() == "hr-conflicted"
+				},
+				MergeStub: func(parent, child policies.Policy) policies.Policy {
+					return mergedPolicy(parent.GetName(), child.GetName())
+				},
+			}
+
+			routes := createRoutes()
+			services := createServices()
+			globalSettings := &policies.GlobalSettings{TelemetryEnabled: test.expTelemetryEnabled}
+
+			result := processPolicies(
+				test.policies,
+				validator,
+				test.gateway,
+				routes,
+				services,
+				newSecretResolver(nil),
+				newReferenceGrantResolver(nil),
+				nil,
+				nil,
+				globalSettings,
+			)
+			g.Expect(helpers.Diff(test.expected, result)).To(BeEmpty())
+
+			for i := 0; i < validator.ValidateCallCount(); i++ {
+				_, passedSettings := validator.ValidateArgsForCall(i)
+				g.Expect(passedSettings).To(Equal(globalSettings))
+			}
+
+			if test.gateway == nil {
+				return
+			}
+
+			g.Expect(helpers.Diff(test.expGwEffective, test.gateway.EffectivePolicies)).To(BeEmpty())
+			g.Expect(helpers.Diff(test.expRouteEffective, routes[hrKey].EffectivePolicies)).To(BeEmpty())
+			g.Expect(helpers.Diff(test.expSvcEffective, services[svcNsName].EffectivePolicies)).To(BeEmpty())
+		})
+	}
+}
+
+func TestProcessPoliciesExternalAuth(t *testing.T) {
+	hrNsName := types.NamespacedName{Namespace: "test", Name: "hr"}
+	hrKey := RouteKey{NamespacedName: hrNsName, RouteType: RouteTypeHTTP}
+	eapGVK := schema.GroupVersionKind{Group: ngfAPI.GroupName, Version: "v1alpha1", Kind: "ExternalAuthPolicy"}
+
+	authzSvc := &apiv1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "authz"},
+		Spec: apiv1.ServiceSpec{
+			Ports: []apiv1.ServicePort{{Port: 80}},
+		},
+	}
+	clusterServices := map[types.NamespacedName]*apiv1.Service{
+		client.ObjectKeyFromObject(authzSvc): authzSvc,
+	}
+
+	now := time.Now()
+
+	createEAP := func(name string, age time.Duration, backendRef v1.BackendObjectReference) *ngfAPI.ExternalAuthPolicy {
+		return &ngfAPI.ExternalAuthPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "test",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+			Spec: ngfAPI.ExternalAuthPolicySpec{
+				TargetRef: v1alpha2.PolicyTargetReference{
+					Group: v1.GroupName,
+					Kind:  policies.KindHTTPRoute,
+					Name:  v1.ObjectName(hrNsName.Name),
+				},
+				BackendRef: backendRef,
+			},
+		}
+	}
+
+	createKey := func(name string) PolicyKey {
+		return PolicyKey{NsName: types.NamespacedName{Namespace: "test", Name: name}, GVK: eapGVK}
+	}
+
+	createBackendRef := func(namespace *string, name string, port int32) v1.BackendObjectReference {
+		return v1.BackendObjectReference{
+			Namespace: (*v1.Namespace)(namespace),
+			Name:      v1.ObjectName(name),
+			Port:      helpers.GetPointer(v1.PortNumber(port)),
+		}
+	}
+
+	resolvedBackendRef := &BackendRef{
+		SvcNsName:   client.ObjectKeyFromObject(authzSvc),
+		ServicePort: apiv1.ServicePort{Port: 80},
+		Weight:      1,
+		Valid:       true,
+	}
+
+	tests := []struct {
+		policy        *ngfAPI.ExternalAuthPolicy
+		expBackendRef *BackendRef
+		name          string
+		expConds      []conditions.Condition
+	}{
+		{
+			name:          "resolved backend ref",
+			policy:        createEAP("resolved", time.Hour, createBackendRef(nil, "authz", 80)),
+			expBackendRef: resolvedBackendRef,
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyAccepted(),
+				staticConds.NewPolicyResolvedRefs(),
+			},
+		},
+		{
+			name:   "Service not found",
+			policy: createEAP("not-found", time.Hour, createBackendRef(nil, "not-exist", 80)),
+			expBackendRef: &BackendRef{
+				SvcNsName: types.NamespacedName{Namespace: "test", Name: "not-exist"},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyAccepted(),
+				staticConds.NewPolicyBackendRefNotFound("spec.backendRef.name: Not found: \"not-exist\""),
+			},
+		},
+		{
+			name:   "port not found",
+			policy: createEAP("port-not-found", time.Hour, createBackendRef(nil, "authz", 8080)),
+			expBackendRef: &BackendRef{
+				SvcNsName: client.ObjectKeyFromObject(authzSvc),
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyAccepted(),
+				staticConds.NewPolicyBackendRefNotFound("no matching port for Service authz and port 8080"),
+			},
+		},
+		{
+			name:   "Service in another namespace not permitted",
+			policy: createEAP("not-permitted", time.Hour, createBackendRef(helpers.GetPointer("other"), "authz", 80)),
+			expBackendRef: &BackendRef{
+				SvcNsName: types.NamespacedName{Namespace: "other", Name: "authz"},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyAccepted(),
+				staticConds.NewPolicyBackendRefNotPermitted(
+					"Backend ref to Service other/authz not permitted by any ReferenceGrant",
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakePolicyValidator{
+				ConflictsStub: func(_, _ policies.Policy) bool { return true },
+				MergeStub:     func(_, child policies.Policy) policies.Policy { return child },
+			}
+
+			conflicted := createEAP("conflicted", 0, createBackendRef(nil, "authz", 80))
+
+			routes := map[RouteKey]*L7Route{
+				hrKey: {
+					RouteType: RouteTypeHTTP,
+					Source:    &v1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "hr"}},
+					Valid:     true,
+				},
+			}
+
+			result := processPolicies(
+				map[PolicyKey]policies.Policy{
+					createKey(test.policy.Name): test.policy,
+					createKey(conflicted.Name):  conflicted,
+				},
+				validator,
+				&Gateway{Source: &v1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gw"}}},
+				routes,
+				nil,
+				newSecretResolver(nil),
+				newReferenceGrantResolver(nil),
+				clusterServices,
+				nil,
+				&policies.GlobalSettings{},
+			)
+
+			policy := result[createKey(test.policy.Name)]
+			g.Expect(policy.Valid).To(BeTrue())
+			g.Expect(policy.Conditions).To(Equal(test.expConds))
+			g.Expect(policy.BackendRef).To(Equal(test.expBackendRef))
+
+			g.Expect(result[createKey(conflicted.Name)].Valid).To(BeFalse())
+			g.Expect(routes[hrKey].ExternalAuthBackendRef).To(Equal(test.expBackendRef))
+			g.Expect(routes[hrKey].EffectivePolicies).To(Equal([]policies.Policy{test.policy}))
+		})
+	}
+}
+
+func TestProcessPoliciesErrorPages(t *testing.T) {
+	hrNsName := types.NamespacedName{Namespace: "test", Name: "hr"}
+	hrKey := RouteKey{NamespacedName: hrNsName, RouteType: RouteTypeHTTP}
+	eppGVK := schema.GroupVersionKind{Group: ngfAPI.GroupName, Version: "v1alpha1", Kind: "ErrorPagePolicy"}
+
+	errorsSvc := &apiv1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "errors"},
+		Spec: apiv1.ServiceSpec{
+			Ports: []apiv1.ServicePort{{Port: 80}},
+		},
+	}
+	clusterServices := map[types.NamespacedName]*apiv1.Service{
+		client.ObjectKeyFromObject(errorsSvc): errorsSvc,
+	}
+
+	pagesConfigMap := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "pages"},
+		Data:       map[string]string{"404.json": `{"error":"not found"}`},
+	}
+	clusterConfigMaps := map[types.NamespacedName]*apiv1.ConfigMap{
+		client.ObjectKeyFromObject(pagesConfigMap): pagesConfigMap,
+	}
+
+	createEPP := func(name string, kind v1.Kind, target string, pages ...ngfAPI.ErrorPage) *ngfAPI.ErrorPagePolicy {
+		return &ngfAPI.ErrorPagePolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+			Spec: ngfAPI.ErrorPagePolicySpec{
+				TargetRef: v1alpha2.PolicyTargetReference{
+					Group: v1.GroupName,
+					Kind:  kind,
+					Name:  v1.ObjectName(target),
+				},
+				Pages: pages,
+			},
+		}
+	}
+
+	createBackendRef := func(name string, port int32) *v1.BackendObjectReference {
+		return &v1.BackendObjectReference{
+			Name: v1.ObjectName(name),
+			Port: helpers.GetPointer(v1.PortNumber(port)),
+		}
+	}
+
+	gwPolicy := createEPP(
+		"gw",
+		policies.KindGateway,
+		"gw",
+		ngfAPI.ErrorPage{
+			Codes:      []ngfAPI.ErrorPageStatusCode{502, 503},
+			BackendRef: createBackendRef("errors", 80),
+		},
+		ngfAPI.ErrorPage{
+			Codes:        []ngfAPI.ErrorPageStatusCode{404},
+			ResponseCode: helpers.GetPointer[int32](200),
+			Content: &ngfAPI.ErrorPageContent{
+				ConfigMapName: "pages",
+				Key:           "404.json",
+				ContentType:   helpers.GetPointer[ngfAPI.MIMEType]("application/json"),
+			},
+		},
+	)
+
+	hrPolicy := createEPP(
+		"hr",
+		policies.KindHTTPRoute,
+		hrNsName.Name,
+		ngfAPI.ErrorPage{
+			Codes:   []ngfAPI.ErrorPageStatusCode{404},
+			Content: &ngfAPI.ErrorPageContent{ConfigMapName: "pages", Key: "404.json"},
+		},
+		ngfAPI.ErrorPage{
+			Codes:      []ngfAPI.ErrorPageStatusCode{500},
+			BackendRef: createBackendRef("not-exist", 80),
+		},
+	)
+
+	createKey := func(name string) PolicyKey {
+		return PolicyKey{NsName: types.NamespacedName{Namespace: "test", Name: name}, GVK: eppGVK}
+	}
+
+	validator := &validationfakes.FakePolicyValidator{
+		ConflictsStub: func(_, _ policies.Policy) bool { return true },
+		MergeStub:     func(_, child policies.Policy) policies.Policy { return child },
+	}
+
+	gateway := &Gateway{Source: &v1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gw"}}}
+
+	routes := map[RouteKey]*L7Route{
+		hrKey: {
+			RouteType: RouteTypeHTTP,
+			Source:    &v1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "hr"}},
+			Valid:     true,
+		},
+	}
+
+	result := processPolicies(
+		map[PolicyKey]policies.Policy{
+			createKey(gwPolicy.Name): gwPolicy,
+			createKey(hrPolicy.Name): hrPolicy,
+		},
+		validator,
+		gateway,
+		routes,
+		nil,
+		newSecretResolver(nil),
+		newReferenceGrantResolver(nil),
+		clusterServices,
+		clusterConfigMaps,
+		&policies.GlobalSettings{},
+	)
+
+	expGwErrorPages := []ErrorPage{
+		{
+			BackendRef: &BackendRef{
+				SvcNsName:   client.ObjectKeyFromObject(errorsSvc),
+				ServicePort: apiv1.ServicePort{Port: 80},
+				Weight:      1,
+				Valid:       true,
+			},
+			Codes: []int32{502, 503},
+		},
+		{
+			ResponseCode: helpers.GetPointer[int32](200),
+			ContentType:  "application/json",
+			Content:      []byte(`{"error":"not found"}`),
+			Codes:        []int32{404},
+		},
+	}
+
+	expHRErrorPages := []ErrorPage{
+		{
+			ContentType: "text/html",
+			Content:     []byte(`{"error":"not found"}`),
+			Codes:       []int32{404},
+		},
+		{
+			BackendRef: &BackendRef{SvcNsName: types.NamespacedName{Namespace: "test", Name: "not-exist"}},
+			Codes:      []int32{500},
+		},
+	}
+
+	g := NewWithT(t)
+
+	gwResult := result[createKey(gwPolicy.Name)]
+	g.Expect(gwResult.Valid).To(BeTrue())
+	g.Expect(gwResult.Conditions).To(Equal([]conditions.Condition{
+		staticConds.NewPolicyAccepted(),
+		staticConds.NewPolicyResolvedRefs(),
+	}))
+	g.Expect(helpers.Diff(expGwErrorPages, gateway.ErrorPages)).To(BeEmpty())
+
+	hrResult := result[createKey(hrPolicy.Name)]
+	g.Expect(hrResult.Valid).To(BeTrue())
+	g.Expect(hrResult.Conditions).To(Equal([]conditions.Condition{
+		staticConds.NewPolicyAccepted(),
+		staticConds.NewPolicyBackendRefNotFound("spec.pages[1].backendRef.name: Not found: \"not-exist\""),
+	}))
+	g.Expect(helpers.Diff(expHRErrorPages, routes[hrKey].ErrorPages)).To(BeEmpty())
+}
+
+func TestProcessPoliciesRetryTimeoutOverridden(t *testing.T) {
+	hrNsName := types.NamespacedName{Namespace: "test", Name: "hr"}
+	hrKey := RouteKey{NamespacedName: hrNsName, RouteType: RouteTypeHTTP}
+	rpGVK := schema.GroupVersionKind{Group: ngfAPI.GroupName, Version: "v1alpha1", Kind: "RetryPolicy"}
+
+	now := time.Now()
+
+	createRP := func(name string, kind v1.Kind, targetName string, totalTimeout *ngfAPI.Duration) *ngfAPI.RetryPolicy {
+		return &ngfAPI.RetryPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "test",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(now),
+			},
+			Spec: ngfAPI.RetryPolicySpec{
+				TargetRef: v1alpha2.PolicyTargetReference{
+					Group: v1.GroupName,
+					Kind:  kind,
+					Name:  v1.ObjectName(targetName),
+				},
+				TotalTimeout: totalTimeout,
+			},
+		}
+	}
+
+	createKey := func(name string) PolicyKey {
+		return PolicyKey{NsName: types.NamespacedName{Namespace: "test", Name: name}, GVK: rpGVK}
+	}
+
+	totalTimeout := helpers.GetPointer[ngfAPI.Duration]("10s")
+	overriddenCond := staticConds.NewPolicyOverriddenByRequestTimeout(
+		"The request timeout of the rules of HTTPRoutes test/hr takes precedence over spec.totalTimeout",
+	)
+
+	tests := []struct {
+		requestTimeout *v1.Duration
+		expConds       map[string][]conditions.Condition
+		name           string
+		policies       []*ngfAPI.RetryPolicy
+	}{
+		{
+			name:           "HTTPRoute policy overridden by the request timeout",
+			policies:       []*ngfAPI.RetryPolicy{createRP("hr-rp", policies.KindHTTPRoute, "hr", totalTimeout)},
+			requestTimeout: helpers.GetPointer[v1.Duration]("5s"),
+			expConds: map[string][]conditions.Condition{
+				"hr-rp": {staticConds.NewPolicyAccepted(), overriddenCond},
+			},
+		},
+		{
+			name:           "Gateway policy overridden by the request timeout",
+			policies:       []*ngfAPI.RetryPolicy{createRP("gw-rp", "Gateway", "gateway", totalTimeout)},
+			requestTimeout: helpers.GetPointer[v1.Duration]("5s"),
+			expConds: map[string][]conditions.Condition{
+				"gw-rp": {staticConds.NewPolicyAccepted(), overriddenCond},
+			},
+		},
+		{
+			name: "Gateway policy replaced by the HTTPRoute policy",
+			policies: []*ngfAPI.RetryPolicy{
+				createRP("gw-rp", "Gateway", "gateway", totalTimeout),
+				createRP("hr-rp", policies.KindHTTPRoute, "hr", totalTimeout),
+			},
+			requestTimeout: helpers.GetPointer[v1.Duration]("5s"),
+			expConds: map[string][]conditions.Condition{
+				"gw-rp": {staticConds.NewPolicyAccepted()},
+				"hr-rp": {staticConds.NewPolicyAccepted(), overriddenCond},
+			},
+		},
+		{
+			name:     "no request timeout",
+			policies: []*ngfAPI.RetryPolicy{createRP("hr-rp", policies.KindHTTPRoute, "hr", totalTimeout)},
+			expConds: map[string][]conditions.Condition{
+				"hr-rp": {staticConds.NewPolicyAccepted()},
+			},
+		},
+		{
+			name:           "zero request timeout",
+			policies:       []*ngfAPI.RetryPolicy{createRP("hr-rp", policies.KindHTTPRoute, "hr", totalTimeout)},
+			requestTimeout: helpers.GetPointer[v1.Duration]("0s"),
+			expConds: map[string][]conditions.Condition{
+				"hr-rp": {staticConds.NewPolicyAccepted()},
+			},
+		},
+		{
+			name:           "no total timeout",
+			policies:       []*ngfAPI.RetryPolicy{createRP("hr-rp", policies.KindHTTPRoute, "hr", nil)},
+			requestTimeout: helpers.GetPointer[v1.Duration]("5s"),
+			expConds: map[string][]conditions.Condition{
+				"hr-rp": {staticConds.NewPolicyAccepted()},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakePolicyValidator{
+				ConflictsStub: func(_, _ policies.Policy) bool { return true },
+				MergeStub:     func(_, child policies.Policy) policies.Policy { return child },
+			}
+
+			routes := map[RouteKey]*L7Route{
+				hrKey: {
+					RouteType: RouteTypeHTTP,
+					Source:    &v1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "hr"}},
+					ParentRefs: []ParentRef{
+						{
+							Gateway:    types.NamespacedName{Namespace: "test", Name: "gateway"},
+							Attachment: &ParentRefAttachmentStatus{Attached: true},
+						},
+					},
+					Spec: L7RouteSpec{
+						Rules: []RouteRule{
+							{
+								Timeouts:     &v1.HTTPRouteTimeouts{Request: test.requestTimeout},
+								ValidMatches: true,
+								ValidFilters: true,
+							},
+						},
+					},
+					Valid: true,
+				},
+			}
+
+			pols := make(map[PolicyKey]policies.Policy, len(test.policies))
+			for _, p := range test.policies {
+				pols[createKey(p.Name)] = p
+			}
+
+			result := processPolicies(
+				pols,
+				validator,
+				&Gateway{Source: &v1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway"}}},
+				routes,
+				nil,
+				newSecretResolver(nil),
+				newReferenceGrantResolver(nil),
+				nil,
+				nil,
+				&policies.GlobalSettings{},
+			)
+
+			for name, expConds := range test.expConds {
+				g.Expect(result[createKey(name)].Conditions).To(Equal(expConds), name)
+			}
+		})
+	}
+}
+
+func TestProcessPoliciesClientBodyTimeoutOverridden(t *testing.T) {
+	hrNsName := types.NamespacedName{Namespace: "test", Name: "hr"}
+	hrKey := RouteKey{NamespacedName: hrNsName, RouteType: RouteTypeHTTP}
+	cspGVK := schema.GroupVersionKind{Group: ngfAPI.GroupName, Version: "v1alpha1", Kind: "ClientSettingsPolicy"}
+
+	now := time.Now()
+
+	createCSP := func(
+		name string,
+		kind v1.Kind,
+		targetName string,
+		body *ngfAPI.ClientBody,
+		override *ngfAPI.ClientSettingsOverride,
+	) *ngfAPI.ClientSettingsPolicy {
+		return &ngfAPI.ClientSettingsPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "test",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(now),
+			},
+			Spec: ngfAPI.ClientSettingsPolicySpec{
+				TargetRef: v1alpha2.PolicyTargetReference{
+					Group: v1.GroupName,
+					Kind:  kind,
+					Name:  v1.ObjectName(targetName),
+				},
+				Body:     body,
+				Override: override,
+			},
+		}
+	}
+
+	createKey := func(name string) PolicyKey {
+		return PolicyKey{NsName: types.NamespacedName{Namespace: "test", Name: name}, GVK: cspGVK}
+	}
+
+	bodyTimeout := &ngfAPI.ClientBody{Timeout: helpers.GetPointer[ngfAPI.Duration]("30s")}
+	bodyMaxSize := &ngfAPI.ClientBody{MaxSize: helpers.GetPointer[ngfAPI.Size]("10m")}
+	overriddenCond := staticConds.NewPolicyOverriddenByRequestTimeout(
+		"The request timeout of the rules of HTTPRoutes test/hr takes precedence over spec.body.timeout",
+	)
+	overrideOverriddenCond := staticConds.NewPolicyOverriddenByRequestTimeout(
+		"The request timeout of the rules of HTTPRoutes test/hr takes precedence over spec.override.body.timeout",
+	)
+
+	tests := []struct {
+		requestTimeout *v1.Duration
+		expConds       map[string][]conditions.Condition
+		name           string
+		policies       []*ngfAPI.ClientSettingsPolicy
+	}{
+		{
+			name: "HTTPRoute policy overridden by the request timeout",
+			policies: []*ngfAPI.ClientSettingsPolicy{
+				createCSP("hr-csp", policies.KindHTTPRoute, "hr", bodyTimeout, nil),
+			},
+			requestTimeout: helpers.GetPointer[v1.Duration]("5s"),
+			expConds: map[string][]conditions.Condition{
+				"hr-csp": {staticConds.NewPolicyAccepted(), overriddenCond},
+			},
+		},
+		{
+			name: "Gateway policy overridden by the request timeout",
+			policies: []*ngfAPI.ClientSettingsPolicy{
+				createCSP("gw-csp", "Gateway", "gateway", bodyTimeout, nil),
+			},
+			requestTimeout: helpers.GetPointer[v1.Duration]("5s"),
+			expConds: map[string][]conditions.Condition{
+				"gw-csp": {staticConds.NewPolicyAccepted(), overriddenCond},
+			},
+		},
+		{
+			name: "Gateway policy replaced by the HTTPRoute policy",
+			policies: []*ngfAPI.ClientSettingsPolicy{
+				createCSP("gw-csp", "Gateway", "gateway", bodyTimeout, nil),
+				createCSP("hr-csp", policies.KindHTTPRoute, "hr", bodyTimeout, nil),
+			},
+			requestTimeout: helpers.GetPointer[v1.Duration]("5s"),
+			expConds: map[string][]conditions.Condition{
+				"gw-csp": {staticConds.NewPolicyAccepted()},
+				"hr-csp": {staticConds.NewPolicyAccepted(), overriddenCond},
+			},
+		},
+		{
+			name: "Gateway override not replaced by the HTTPRoute policy",
+			policies: []*ngfAPI.ClientSettingsPolicy{
+				createCSP("gw-csp", "Gateway", "gateway", nil, &ngfAPI.ClientSettingsOverride{Body: bodyTimeout}),
+				createCSP("hr-csp", policies.KindHTTPRoute, "hr", bodyTimeout, nil),
+			},
+			requestTimeout: helpers.GetPointer[v1.Duration]("5s"),
+			expConds: map[string][]conditions.Condition{
+				"gw-csp": {staticConds.NewPolicyAccepted(), overrideOverriddenCond},
+				"hr-csp": {staticConds.NewPolicyAccepted(), overriddenCond},
+			},
+		},
+		{
+			name: "no request timeout",
+			policies: []*ngfAPI.ClientSettingsPolicy{
+				createCSP("hr-csp", policies.KindHTTPRoute, "hr", bodyTimeout, nil),
+			},
+			expConds: map[string][]conditions.Condition{
+				"hr-csp": {staticConds.NewPolicyAccepted()},
+			},
+		},
+		{
+			name: "no body timeout",
+			policies: []*ngfAPI.ClientSettingsPolicy{
+				createCSP("hr-csp", policies.KindHTTPRoute, "hr", bodyMaxSize, nil),
+			},
+			requestTimeout: helpers.GetPointer[v1.Duration]("5s"),
+			expConds: map[string][]conditions.Condition{
+				"hr-csp": {staticConds.NewPolicyAccepted()},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakePolicyValidator{
+				ConflictsStub: func(_, _ policies.Policy) bool { return true },
+				MergeStub:     func(_, child policies.Policy) policies.Policy { return child },
+			}
+
+			routes := map[RouteKey]*L7Route{
+				hrKey: {
+					RouteType: RouteTypeHTTP,
+					Source:    &v1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "hr"}},
+					ParentRefs: []ParentRef{
+						{
+							Gateway:    types.NamespacedName{Namespace: "test", Name: "gateway"},
+							Attachment: &ParentRefAttachmentStatus{Attached: true},
+						},
+					},
+					Spec: L7RouteSpec{
+						Rules: []RouteRule{
+							{
+								Timeouts:     &v1.HTTPRouteTimeouts{Request: test.requestTimeout},
+								ValidMatches: true,
+								ValidFilters: true,
+							},
+						},
+					},
+					Valid: true,
+				},
+			}
+
+			pols := make(map[PolicyKey]policies.Policy, len(test.policies))
+			for _, p := range test.policies {
+				pols[createKey(p.Name)] = p
+			}
+
+			result := processPolicies(
+				pols,
+				validator,
+				&Gateway{Source: &v1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway"}}},
+				routes,
+				nil,
+				newSecretResolver(nil),
+				newReferenceGrantResolver(nil),
+				nil,
+				nil,
+				&policies.GlobalSettings{},
+			)
+
+			for name, expConds := range test.expConds {
+				g.Expect(result[createKey(name)].Conditions).To(Equal(expConds), name)
+			}
+		})
+	}
+}
+
+func TestProcessPoliciesListenerTarget(t *testing.T) {
+	acpGVK := schema.GroupVersionKind{Group: ngfAPI.GroupName, Version: "v1alpha1", Kind: "AccessControlPolicy"}
+
+	now := time.Now()
+
+	createACP := func(name string, sectionName *v1.SectionName, created time.Time) *ngfAPI.AccessControlPolicy {
+		return &ngfAPI.AccessControlPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "test",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Spec: ngfAPI.AccessControlPolicySpec{
+				TargetRef: v1alpha2.PolicyTargetReferenceWithSectionName{
+					PolicyTargetReference: v1alpha2.PolicyTargetReference{
+						Group: v1.GroupName,
+						Kind:  policies.KindGateway,
+						Name:  "gateway",
+					},
+					SectionName: sectionName,
+				},
+			},
+		}
+	}
+
+	createKey := func(name string) PolicyKey {
+		return PolicyKey{NsName: types.NamespacedName{Namespace: "test", Name: name}, GVK: acpGVK}
+	}
+
+	createAncestor := func(sectionName *v1.SectionName) v1.ParentReference {
+		return v1.ParentReference{
+			Group:       helpers.GetPointer[v1.Group](v1.GroupName),
+			Kind:        helpers.GetPointer[v1.Kind](policies.KindGateway),
+			Namespace:   helpers.GetPointer[v1.Namespace]("test"),
+			Name:        "gateway",
+			SectionName: sectionName,
+		}
+	}
+
+	httpSection := helpers.GetPointer[v1.SectionName]("http")
+
+	gatewayACP := createACP("gw-acp", nil, now)
+	httpACP := createACP("http-acp", httpSection, now)
+	newerHTTPACP := createACP("newer-http-acp", httpSection, now.Add(time.Second))
+	missingACP := createACP("missing-acp", helpers.GetPointer[v1.SectionName]("missing"), now)
+	tcpACP := createACP("tcp-acp", helpers.GetPointer[v1.SectionName]("tcp"), now)
+
+	tests := []struct {
+		expPolicies         map[string]*Policy
+		expListenerPolicies map[string][]policies.Policy
+		name                string
+		policies            []*ngfAPI.AccessControlPolicy
+		expGatewayEffective []policies.Policy
+	}{
+		{
+			name:     "listener policy takes precedence over the Gateway policy for the listener",
+			policies: []*ngfAPI.AccessControlPolicy{gatewayACP, httpACP},
+			expPolicies: map[string]*Policy{
+				"gw-acp": {
+					Source:     gatewayACP,
+					Ancestor:   createAncestor(nil),
+					Conditions: []conditions.Condition{staticConds.NewPolicyAccepted()},
+					Valid:      true,
+				},
+				"http-acp": {
+					Source:     httpACP,
+					Ancestor:   createAncestor(httpSection),
+					Conditions: []conditions.Condition{staticConds.NewPolicyAccepted()},
+					Valid:      true,
+				},
+			},
+			expGatewayEffective: []policies.Policy{gatewayACP},
+			expListenerPolicies: map[string][]policies.Policy{
+				"http": {httpACP},
+			},
+		},
+		{
+			name:     "policies that target the same listener conflict",
+			policies: []*ngfAPI.AccessControlPolicy{httpACP, newerHTTPACP},
+			expPolicies: map[string]*Policy{
+				"http-acp": {
+					Source:     httpACP,
+					Ancestor:   createAncestor(httpSection),
+					Conditions: []conditions.Condition{staticConds.NewPolicyAccepted()},
+					Valid:      true,
+				},
+				"newer-http-acp": {
+					Source:   newerHTTPACP,
+					Ancestor: createAncestor(httpSection),
+					Conditions: []conditions.Condition{
+						staticConds.NewPolicyConflicted(
+							"Conflicts with another AccessControlPolicy test/http-acp that targets the same Gateway",
+						),
+					},
+				},
+			},
+			expListenerPolicies: map[string][]policies.Policy{
+				"http": {httpACP},
+			},
+		},
+		{
+			name:        "policy that targets a missing listener is ignored",
+			policies:    []*ngfAPI.AccessControlPolicy{missingACP},
+			expPolicies: map[string]*Policy{},
+		},
+		{
+			name:     "policy that targets a TCP listener is invalid",
+			policies: []*ngfAPI.AccessControlPolicy{tcpACP},
+			expPolicies: map[string]*Policy{
+				"tcp-acp": {
+					Source:   tcpACP,
+					Ancestor: createAncestor(helpers.GetPointer[v1.SectionName]("tcp")),
+					Conditions: []conditions.Condition{
+						staticConds.NewPolicyInvalid(
+							"spec.targetRef.sectionName: Invalid value: \"tcp\": the listener has the TCP protocol; " +
+								"only HTTP and HTTPS listeners are supported",
+						),
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakePolicyValidator{
+				ConflictsStub: func(_, _ policies.Policy) bool { return true },
+				MergeStub:     func(_, child policies.Policy) policies.Policy { return child },
+			}
+
+			gateway := &Gateway{
+				Source: &v1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway"}},
+				Listeners: []*Listener{
+					{Name: "http", Source: v1.Listener{Name: "http", Protocol: v1.HTTPProtocolType}, Valid: true},
+					{Name: "https", Source: v1.Listener{Name: "https", Protocol: v1.HTTPSProtocolType}, Valid: true},
+					{Name: "tcp", Source: v1.Listener{Name: "tcp", Protocol: v1.TCPProtocolType}, Valid: true},
+				},
+			}
+
+			pols := make(map[PolicyKey]policies.Policy, len(test.policies))
+			for _, p := range test.policies {
+				pols[createKey(p.Name)] = p
+			}
+
+			result := processPolicies(
+				pols,
+				validator,
+				gateway,
+				nil,
+				nil,
+				newSecretResolver(nil),
+				newReferenceGrantResolver(nil),
+				nil,
+				nil,
+				&policies.GlobalSettings{},
+			)
+
+			g.Expect(result).To(HaveLen(len(test.expPolicies)))
+			for name, expPolicy := range test.expPolicies {
+				g.Expect(helpers.Diff(expPolicy, result[createKey(name)])).To(BeEmpty(), name)
+			}
+
+			g.Expect(gateway.EffectivePolicies).To(Equal(test.expGatewayEffective))
+			for _, l := range gateway.Listeners {
+				g.Expect(l.EffectivePolicies).To(Equal(test.expListenerPolicies[l.Name]), l.Name)
+			}
+		})
+	}
+}
+
+func TestValidatePolicyRefs(t *testing.T) {
+	htpasswdSecret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "htpasswd"},
+		Data:       map[string][]byte{HtpasswdSecretKey: []byte("user:password")},
+		Type:       SecretTypeHtpasswd,
+	}
+	otherNsSecret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "htpasswd"},
+		Data:       map[string][]byte{HtpasswdSecretKey: []byte("user:password")},
+		Type:       SecretTypeHtpasswd,
+	}
+	grantedNsSecret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "granted", Name: "htpasswd"},
+		Data:       map[string][]byte{HtpasswdSecretKey: []byte("user:password")},
+		Type:       SecretTypeHtpasswd,
+	}
+
+	secrets := map[types.NamespacedName]*apiv1.Secret{
+		client.ObjectKeyFromObject(htpasswdSecret):  htpasswdSecret,
+		client.ObjectKeyFromObject(otherNsSecret):   otherNsSecret,
+		client.ObjectKeyFromObject(grantedNsSecret): grantedNsSecret,
+	}
+
+	refGrants := map[types.NamespacedName]*v1beta1.ReferenceGrant{
+		{Namespace: "granted", Name: "grant"}: {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "granted", Name: "grant"},
+			Spec: v1beta1.ReferenceGrantSpec{
+				From: []v1beta1.ReferenceGrantFrom{
+					{
+						Group:     ngfAPI.GroupName,
+						Kind:      "BasicAuthPolicy",
+						Namespace: "test",
+					},
+				},
+				To: []v1beta1.ReferenceGrantTo{
+					{
+						Kind: "Secret",
+					},
+				},
+			},
+		},
+	}
+
+	pagesConfigMap := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "pages"},
+		Data:       map[string]string{"404.html": "<h1>Not Found</h1>"},
+		BinaryData: map[string][]byte{"500.html": []byte("<h1>Error</h1>")},
+	}
+
+	configMaps := map[types.NamespacedName]*apiv1.ConfigMap{
+		client.ObjectKeyFromObject(pagesConfigMap): pagesConfigMap,
+	}
+
+	createErrorPagePolicy := func(contents ...ngfAPI.ErrorPageContent) *ngfAPI.ErrorPagePolicy {
+		pages := []ngfAPI.ErrorPage{
+			{BackendRef: &v1.BackendObjectReference{Name: "not-validated"}},
+		}
+		for _, content := range contents {
+			pages = append(pages, ngfAPI.ErrorPage{Content: &content})
+		}
+
+		return &ngfAPI.ErrorPagePolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "pages"},
+			Spec:       ngfAPI.ErrorPagePolicySpec{Pages: pages},
+		}
+	}
+
+	createBasicAuthPolicy := func(secretNs *string, secretName string) *ngfAPI.BasicAuthPolicy {
+		return &ngfAPI.BasicAuthPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "auth"},
+			Spec: ngfAPI.BasicAuthPolicySpec{
+				SecretRef: v1.SecretObjectReference{
+					Namespace: (*v1.Namespace)(secretNs),
+					Name:      v1.ObjectName(secretName),
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		policy   policies.Policy
+		name     string
+		kind     string
+		expConds []conditions.Condition
+	}{
+		{
+			name:   "policy without references",
+			kind:   "ClientSettingsPolicy",
+			policy: &ngfAPI.ClientSettingsPolicy{},
+		},
+		{
+			name:   "secret in the namespace of the policy",
+			kind:   "BasicAuthPolicy",
+			policy: createBasicAuthPolicy(nil, "htpasswd"),
+		},
+		{
+			name:   "secret in another namespace allowed by a ReferenceGrant",
+			kind:   "BasicAuthPolicy",
+			policy: createBasicAuthPolicy(helpers.GetPointer("granted"), "htpasswd"),
+		},
+		{
+			name:   "secret in another namespace not allowed by a ReferenceGrant",
+			kind:   "BasicAuthPolicy",
+			policy: createBasicAuthPolicy(helpers.GetPointer("other"), "htpasswd"),
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid("Secret ref to secret other/htpasswd not permitted by any ReferenceGrant"),
+			},
+		},
+		{
+			name:   "secret does not exist",
+			kind:   "BasicAuthPolicy",
+			policy: createBasicAuthPolicy(nil, "not-exist"),
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"spec.secretRef: Invalid value: test/not-exist: secret does not exist",
+				),
+			},
+		},
+		{
+			name: "error page contents in data and binaryData",
+			kind: "ErrorPagePolicy",
+			policy: createErrorPagePolicy(
+				ngfAPI.ErrorPageContent{ConfigMapName: "pages", Key: "404.html"},
+				ngfAPI.ErrorPageContent{ConfigMapName: "pages", Key: "500.html"},
+			),
+		},
+		{
+			name: "error page ConfigMap and key do not exist",
+			kind: "ErrorPagePolicy",
+			policy: createErrorPagePolicy(
+				ngfAPI.ErrorPageContent{ConfigMapName: "not-exist", Key: "404.html"},
+				ngfAPI.ErrorPageContent{ConfigMapName: "pages", Key: "not-exist"},
+			),
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.pages[1].content.configMapName: Not found: \"test/not-exist\", " +
+						"spec.pages[2].content.key: Invalid value: \"not-exist\": ConfigMap test/pages does not " +
+						"have the key]",
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			resources := policyResources{
+				secretResolver:   newSecretResolver(secrets),
+				refGrantResolver: newReferenceGrantResolver(refGrants),
+				configMaps:       configMaps,
+			}
+			gvk := schema.GroupVersionKind{Group: ngfAPI.GroupName, Version: "v1alpha1", Kind: test.kind}
+
+			conds := validatePolicyRefs(test.policy, gvk, resources)
+			g.Expect(conds).To(Equal(test.expConds))
+		})
+	}
+}
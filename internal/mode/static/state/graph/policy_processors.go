@@ -0,0 +1,171 @@
+package graph
+
+import (
+	"slices"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+)
+
+// policyResources holds the resources that the Policies can reference.
+type policyResources struct {
+	secretResolver   *secretResolver
+	refGrantResolver *referenceGrantResolver
+	services         map[types.NamespacedName]*apiv1.Service
+	configMaps       map[types.NamespacedName]*apiv1.ConfigMap
+}
+
+// A Policy kind that needs more than the generic processing of processPolicies registers a processor in
+// policyProcessors. A processor implements only the interfaces below that its kind needs.
+
+// policyRefsValidator validates the references of the Policies of a kind to other resources. The Policy validators
+// can't validate them, because the referenced resources are only known when the Graph is built.
+type policyRefsValidator interface {
+	// validateRefs returns the conditions of the Policy if its references make it invalid.
+	validateRefs(policy policies.Policy, resources policyResources) []conditions.Condition
+}
+
+// policyRefsResolver resolves the references of the valid Policies of a kind. Unlike an invalid reference,
+// an unresolved reference doesn't invalidate the Policy.
+type policyRefsResolver interface {
+	// resolveRefs resolves the references of the Policy and sets its ResolvedRefs condition.
+	resolveRefs(p *Policy, resources policyResources)
+}
+
+// policyTargetUpdater updates the targets of the accepted Policies of a kind with the resolved references of
+// the Policies.
+type policyTargetUpdater interface {
+	// updateTarget updates the Gateway or the Route targeted by the accepted Policy. Only one of them is not nil.
+	updateTarget(p *Policy, gateway *Gateway, route *L7Route)
+}
+
+// policyConditionsUpdater updates the conditions of the accepted Policies of a kind that depend on the other
+// Policies and the Routes. It is called after all the Policies are attached to their targets.
+type policyConditionsUpdater interface {
+	// updateConditions updates the conditions of the Policies of the group.
+	updateConditions(
+		group []*Policy,
+		groupKey policyGroupKey,
+		routes map[RouteKey]*L7Route,
+		routePolicies map[RouteKey]kindPolicies,
+	)
+}
+
+// policyProcessors holds the processors of the Policy kinds.
+var policyProcessors = map[schema.GroupKind]any{
+	{Group: ngfAPI.GroupName, Kind: "BasicAuthPolicy"}:      basicAuthPolicyProcessor{},
+	{Group: ngfAPI.GroupName, Kind: "ClientSettingsPolicy"}: clientSettingsPolicyProcessor{},
+	{Group: ngfAPI.GroupName, Kind: "ExternalAuthPolicy"}:   externalAuthPolicyProcessor{},
+	{Group: ngfAPI.GroupName, Kind: "ErrorPagePolicy"}:      errorPagePolicyProcessor{},
+	{Group: ngfAPI.GroupName, Kind: "RetryPolicy"}:          retryPolicyProcessor{},
+}
+
+// validatePolicyRefs validates the references of a Policy of the kind gvk with the processor of the kind.
+func validatePolicyRefs(
+	policy policies.Policy,
+	gvk schema.GroupVersionKind,
+	resources policyResources,
+) []conditions.Condition {
+	if v, ok := policyProcessors[gvk.GroupKind()].(policyRefsValidator); ok {
+		return v.validateRefs(policy, resources)
+	}
+
+	return nil
+}
+
+// resolvePolicyRefs resolves the references of a Policy of the kind gvk with the processor of the kind.
+func resolvePolicyRefs(p *Policy, gvk schema.GroupVersionKind, resources policyResources) {
+	if r, ok := policyProcessors[gvk.GroupKind()].(policyRefsResolver); ok {
+		r.resolveRefs(p, resources)
+	}
+}
+
+// updatePolicyTarget updates the Gateway or the Route targeted by a group of Policies of the kind gvk with
+// the accepted Policy of the group. If more than one Policy of the group is accepted, the oldest one is used.
+func updatePolicyTarget(group []*Policy, gvk schema.GroupVersionKind, gateway *Gateway, route *L7Route) {
+	u, ok := policyProcessors[gvk.GroupKind()].(policyTargetUpdater)
+	if !ok {
+		return
+	}
+
+	// resolvePolicyConflicts sorts the group from the oldest to the newest Policy
+	for _, p := range group {
+		if p.Valid {
+			u.updateTarget(p, gateway, route)
+			return
+		}
+	}
+}
+
+// updatePolicyConditions updates the conditions of a group of Policies with the processor of their kind.
+func updatePolicyConditions(
+	group []*Policy,
+	groupKey policyGroupKey,
+	routes map[RouteKey]*L7Route,
+	routePolicies map[RouteKey]kindPolicies,
+) {
+	if u, ok := policyProcessors[groupKey.gvk.GroupKind()].(policyConditionsUpdater); ok {
+		u.updateConditions(group, groupKey, routes, routePolicies)
+	}
+}
+
+// getRequestTimeoutRoutes returns the sorted names of the HTTPRoutes with a rule that sets a request timeout, among
+// the HTTPRoutes that the Policies of the group apply to. The Policies of a group that targets the Gateway apply to
+// the HTTPRoutes attached to the Gateway for which inherits returns true. inherits is called with the accepted
+// Policies of the same kind that target the HTTPRoute.
+func getRequestTimeoutRoutes(
+	groupKey policyGroupKey,
+	routes map[RouteKey]*L7Route,
+	routePolicies map[RouteKey]kindPolicies,
+	inherits func(attached []policies.Policy) bool,
+) []string {
+	var names []string
+
+	for routeKey, route := range routes {
+		if routeKey.RouteType != RouteTypeHTTP || !hasRequestTimeout(route) {
+			continue
+		}
+
+		switch groupKey.target.Kind {
+		case policies.KindGateway:
+			if !isAttachedToGateway(route.ParentRefs) || !inherits(routePolicies[routeKey][groupKey.gvk]) {
+				continue
+			}
+		default:
+			if routeKey.NamespacedName != groupKey.target.NsName {
+				continue
+			}
+		}
+
+		names = append(names, routeKey.NamespacedName.String())
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// hasRequestTimeout returns true if a valid rule of the Route sets a non-zero request timeout.
+func hasRequestTimeout(route *L7Route) bool {
+	if !route.Valid {
+		return false
+	}
+
+	for _, rule := range route.Spec.Rules {
+		if !rule.ValidMatches || !rule.ValidFilters || rule.Timeouts == nil {
+			continue
+		}
+
+		// the timeouts of a valid rule are valid
+		if request, _ := parseDuration(rule.Timeouts.Request); request != nil && *request != 0 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,141 @@
+package graph
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// policyTarget identifies the resource targeted by a policy.
+type policyTarget struct {
+	Group  v1.Group
+	Kind   v1.Kind
+	NsName types.NamespacedName
+	// SectionName is the name of the targeted listener of the Gateway. It is empty if the policy targets the whole
+	// resource.
+	SectionName v1.SectionName
+}
+
+// getPolicyTarget returns the target of a policy from the namespace policyNs.
+// It returns false if the target is not the Gateway, one of the HTTPRoutes of NGF or a Service referenced by them.
+// The target is identified by its kind and name. The sectionName of a policy that targets the Gateway must be the name
+// of one of its listeners.
+func getPolicyTarget(
+	ref v1alpha2.PolicyTargetReference,
+	sectionName *v1.SectionName,
+	policyNs string,
+	gateway *Gateway,
+	routes map[RouteKey]*L7Route,
+	services map[types.NamespacedName]*ReferencedService,
+) (policyTarget, bool) {
+	// The target must be in the same namespace as the policy.
+	if ref.Namespace != nil && string(*ref.Namespace) != policyNs {
+		return policyTarget{}, false
+	}
+
+	target := policyTarget{
+		Group:  ref.Group,
+		Kind:   ref.Kind,
+		NsName: types.NamespacedName{Namespace: policyNs, Name: string(ref.Name)},
+	}
+
+	if sectionName != nil {
+		target.SectionName = *sectionName
+	}
+
+	// The group of the target is not checked here, so that the Policy validation reports a wrong group in the status
+	// of the Policy.
+	switch ref.Kind {
+	case policies.KindGateway:
+		if gateway == nil || client.ObjectKeyFromObject(gateway.Source) != target.NsName {
+			return policyTarget{}, false
+		}
+
+		if target.SectionName != "" && findListener(gateway.Listeners, string(target.SectionName)) == nil {
+			return policyTarget{}, false
+		}
+	case policies.KindHTTPRoute:
+		if _, exists := routes[RouteKey{NamespacedName: target.NsName, RouteType: RouteTypeHTTP}]; !exists {
+			return policyTarget{}, false
+		}
+	case policies.KindService:
+		if _, exists := services[target.NsName]; !exists {
+			return policyTarget{}, false
+		}
+	default:
+		return policyTarget{}, false
+	}
+
+	return target, true
+}
+
+// createPolicyAncestor creates the reference to the target of a policy, which is reported in the ancestor status
+// of the policy.
+func createPolicyAncestor(target policyTarget) v1.ParentReference {
+	ancestor := v1.ParentReference{
+		Group:     helpers.GetPointer(target.Group),
+		Kind:      helpers.GetPointer(target.Kind),
+		Namespace: helpers.GetPointer(v1.Namespace(target.NsName.Namespace)),
+		Name:      v1.ObjectName(target.NsName.Name),
+	}
+
+	if target.SectionName != "" {
+		ancestor.SectionName = helpers.GetPointer(target.SectionName)
+	}
+
+	return ancestor
+}
+
+// getPolicySectionName returns the sectionName of the target of a policy. It is nil if the policy kind can't target
+// a section of a resource.
+func getPolicySectionName(policy policies.Policy) *v1.SectionName {
+	if sp, ok := policy.(policies.SectionPolicy); ok {
+		return sp.GetSectionName()
+	}
+
+	return nil
+}
+
+// validatePolicyTargetListener returns the conditions of a policy that targets a listener of the Gateway which
+// is not an HTTP or HTTPS listener. The policies only apply to the HTTP traffic.
+func validatePolicyTargetListener(target policyTarget, gateway *Gateway) []conditions.Condition {
+	if target.Kind != policies.KindGateway || target.SectionName == "" {
+		return nil
+	}
+
+	l := findListener(gateway.Listeners, string(target.SectionName))
+
+	switch l.Source.Protocol {
+	case v1.HTTPProtocolType, v1.HTTPSProtocolType:
+		return nil
+	default:
+		path := field.NewPath("spec").Child("targetRef").Child("sectionName")
+		err := field.Invalid(
+			path,
+			target.SectionName,
+			fmt.Sprintf("the listener has the %s protocol; only HTTP and HTTPS listeners are supported", l.Source.Protocol),
+		)
+
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+}
+
+// findListener returns the listener with the name, or nil if the Gateway doesn't have it.
+func findListener(listeners []*Listener, name string) *Listener {
+	for _, l := range listeners {
+		if l.Name == name {
+			return l
+		}
+	}
+
+	return nil
+}
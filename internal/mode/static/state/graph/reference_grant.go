@@ -1,9 +1,13 @@
 package graph
 
 import (
+	"fmt"
+
 	"k8s.io/apimachinery/pkg/types"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 	v1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 )
 
 // referenceGrantResolver resolves references from one resource to another.
@@ -71,6 +75,71 @@ func fromHTTPRoute(namespace string) fromResource {
 	}
 }
 
+func fromGRPCRoute(namespace string) fromResource {
+	return fromResource{
+		group:     v1.GroupName,
+		kind:      "GRPCRoute",
+		namespace: namespace,
+	}
+}
+
+func fromTLSRoute(namespace string) fromResource {
+	return fromResource{
+		group:     v1.GroupName,
+		kind:      "TLSRoute",
+		namespace: namespace,
+	}
+}
+
+func fromTCPRoute(namespace string) fromResource {
+	return fromResource{
+		group:     v1.GroupName,
+		kind:      "TCPRoute",
+		namespace: namespace,
+	}
+}
+
+func fromUDPRoute(namespace string) fromResource {
+	return fromResource{
+		group:     v1.GroupName,
+		kind:      "UDPRoute",
+		namespace: namespace,
+	}
+}
+
+func fromBasicAuthPolicy(namespace string) fromResource {
+	return fromResource{
+		group:     ngfAPI.GroupName,
+		kind:      "BasicAuthPolicy",
+		namespace: namespace,
+	}
+}
+
+func fromExternalAuthPolicy(namespace string) fromResource {
+	return fromResource{
+		group:     ngfAPI.GroupName,
+		kind:      "ExternalAuthPolicy",
+		namespace: namespace,
+	}
+}
+
+func fromRoute(routeType RouteType, namespace string) fromResource {
+	switch routeType {
+	case RouteTypeHTTP:
+		return fromHTTPRoute(namespace)
+	case RouteTypeGRPC:
+		return fromGRPCRoute(namespace)
+	case RouteTypeTLS:
+		return fromTLSRoute(namespace)
+	case RouteTypeTCP:
+		return fromTCPRoute(namespace)
+	case RouteTypeUDP:
+		return fromUDPRoute(namespace)
+	default:
+		panic(fmt.Sprintf("unsupported route type %q", routeType))
+	}
+}
+
 // newReferenceGrantResolver creates a new referenceGrantResolver.
 func newReferenceGrantResolver(refGrants map[types.NamespacedName]*v1beta1.ReferenceGrant) *referenceGrantResolver {
 	allowed := make(map[allowedReference]struct{})
@@ -136,3 +205,11 @@ func (r *referenceGrantResolver) refAllowed(to toResource, from fromResource) bo
 
 	return false
 }
+
+func fromErrorPagePolicy(namespace string) fromResource {
+	return fromResource{
+		group:     ngfAPI.GroupName,
+		kind:      "ErrorPagePolicy",
+		namespace: namespace,
+	}
+}
@@ -0,0 +1,54 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// retryPolicyProcessor reports the RetryPolicies whose total timeout is overridden by the request timeouts of
+// the HTTPRoutes.
+type retryPolicyProcessor struct{}
+
+// updateConditions sets the Overridden condition on the accepted RetryPolicy of the group if the request timeout
+// of an HTTPRoute rule takes precedence over its total timeout in the locations of the rule.
+// The total timeout of a RetryPolicy that targets the Gateway applies to the HTTPRoutes attached to the Gateway,
+// except for the HTTPRoutes whose own RetryPolicy sets the total timeout.
+func (retryPolicyProcessor) updateConditions(
+	group []*Policy,
+	groupKey policyGroupKey,
+	routes map[RouteKey]*L7Route,
+	routePolicies map[RouteKey]kindPolicies,
+) {
+	for _, p := range group {
+		rp, ok := p.Source.(*ngfAPI.RetryPolicy)
+		if !ok || !p.Valid || rp.Spec.TotalTimeout == nil {
+			continue
+		}
+
+		overriddenBy := getRequestTimeoutRoutes(groupKey, routes, routePolicies, func(attached []policies.Policy) bool {
+			return !setsRetryTotalTimeout(attached)
+		})
+		if len(overriddenBy) == 0 {
+			continue
+		}
+
+		p.Conditions = append(p.Conditions, staticConds.NewPolicyOverriddenByRequestTimeout(fmt.Sprintf(
+			"The request timeout of the rules of HTTPRoutes %s takes precedence over spec.totalTimeout",
+			strings.Join(overriddenBy, ", "),
+		)))
+	}
+}
+
+func setsRetryTotalTimeout(accepted []policies.Policy) bool {
+	for _, p := range accepted {
+		if rp, ok := p.(*ngfAPI.RetryPolicy); ok && rp.Spec.TotalTimeout != nil {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,993 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+const wildcardHostname = "~^"
+
+// ParentRef describes a reference to a parent in a Route.
+type ParentRef struct {
+	// Attachment is the attachment status of the ParentRef. It could be nil. In that case, NGF didn't attempt to
+	// attach because of problems with the Route.
+	Attachment *ParentRefAttachmentStatus
+	// SectionName is the name of a section within the target Gateway.
+	SectionName *v1.SectionName
+	// Port is the network port this Route targets.
+	Port *v1.PortNumber
+	// Gateway is the NamespacedName of the referenced Gateway
+	Gateway types.NamespacedName
+	// Idx is the index of the corresponding ParentReference in the Route.
+	Idx int
+}
+
+// ParentRefAttachmentStatus describes the attachment status of a ParentRef.
+type ParentRefAttachmentStatus struct {
+	// AcceptedHostnames is an intersection between the hostnames supported by an attached Listener
+	// and the hostnames from this Route. Key is listener name, value is list of hostnames.
+	AcceptedHostnames map[string][]string
+	// FailedCondition is the condition that describes why the ParentRef is not attached to the Gateway. It is set
+	// when Attached is false.
+	FailedCondition conditions.Condition
+	// Attached indicates if the ParentRef is attached to the Gateway.
+	Attached bool
+}
+
+// RouteType is the type of the Route.
+type RouteType string
+
+const (
+	// RouteTypeHTTP indicates that the RouteType of the L7Route is HTTP.
+	RouteTypeHTTP RouteType = "http"
+	// RouteTypeGRPC indicates that the RouteType of the L7Route is gRPC.
+	RouteTypeGRPC RouteType = "grpc"
+	// RouteTypeTLS indicates that the RouteType of the L4Route is TLS.
+	RouteTypeTLS RouteType = "tls"
+	// RouteTypeTCP indicates that the RouteType of the L4Route is TCP.
+	RouteTypeTCP RouteType = "tcp"
+	// RouteTypeUDP indicates that the RouteType of the L4Route is UDP.
+	RouteTypeUDP RouteType = "udp"
+)
+
+// RouteKey is the unique identifier for a L7Route or a L4Route.
+type RouteKey struct {
+	// NamespacedName is the NamespacedName of the Route.
+	NamespacedName types.NamespacedName
+	// RouteType is the type of the Route.
+	RouteType RouteType
+}
+
+// CreateRouteKey returns a RouteKey for the provided Route resource.
+// It panics if the type of the resource is not a supported Route.
+func CreateRouteKey(obj client.Object) RouteKey {
+	var routeType RouteType
+
+	switch obj.(type) {
+	case *v1.HTTPRoute:
+		routeType = RouteTypeHTTP
+	case *v1alpha2.GRPCRoute:
+		routeType = RouteTypeGRPC
+	case *v1alpha2.TLSRoute:
+		routeType = RouteTypeTLS
+	case *v1alpha2.TCPRoute:
+		routeType = RouteTypeTCP
+	case *v1alpha2.UDPRoute:
+		routeType = RouteTypeUDP
+	default:
+		panic(fmt.Sprintf("unsupported route type %T", obj))
+	}
+
+	return RouteKey{
+		NamespacedName: client.ObjectKeyFromObject(obj),
+		RouteType:      routeType,
+	}
+}
+
+// L7Route is the generic type for the layer 7 routes: HTTPRoute and GRPCRoute.
+type L7Route struct {
+	// Source is the source Gateway API object of the Route.
+	Source client.Object
+	// RouteType is the type of the Route.
+	RouteType RouteType
+	// Spec is the L7RouteSpec of the Route.
+	Spec L7RouteSpec
+	// ParentRefs includes ParentRefs with NGF Gateways only.
+	ParentRefs []ParentRef
+	// Conditions include Conditions for the Route.
+	Conditions []conditions.Condition
+	// EffectivePolicies holds the effective Policies of the Route, one per kind of the valid Policies that target
+	// the Route, sorted by their kinds. An effective Policy is the result of merging the valid Policies of its kind
+	// that target the Route with the effective Policy of the same kind of the Gateway.
+	// The effective Policies of the other kinds of the Gateway apply to the Route through the Gateway.
+	EffectivePolicies []policies.Policy
+	// ExternalAuthBackendRef is the BackendRef of the authorization service of the effective ExternalAuthPolicy of
+	// the Route. It is nil if no ExternalAuthPolicy applies to the Route.
+	ExternalAuthBackendRef *BackendRef
+	// ErrorPages are the error pages of the effective ErrorPagePolicy of the Route. They are nil if no
+	// ErrorPagePolicy targets the Route.
+	ErrorPages []ErrorPage
+	// Valid tells if the Route is valid.
+	// If it is invalid, NGF should not generate any configuration for it.
+	Valid bool
+	// Attachable tells if the Route can be attached to any of the Gateways.
+	// Route can be invalid but still attachable.
+	Attachable bool
+}
+
+// L7RouteSpec is the common spec of the layer 7 routes.
+type L7RouteSpec struct {
+	// Hostnames defines a set of hostnames used to select a Route used to process the request.
+	Hostnames []v1.Hostname
+	// Rules include Rules for the Route. Each Rules[i] corresponds to the ith rule of the source Route.
+	// If the Route is invalid, this field is nil.
+	Rules []RouteRule
+}
+
+// RouteRule represents a rule of a Route.
+type RouteRule struct {
+	// Matches define the predicate used to match requests to a given action.
+	// For GRPCRoutes, the matches are converted to the equivalent HTTPRouteMatches.
+	Matches []v1.HTTPRouteMatch
+	// Filters define processing steps that must be completed during the request or response lifecycle.
+	// For GRPCRoutes, the filters are converted to the equivalent HTTPRouteFilters.
+	Filters []v1.HTTPRouteFilter
+	// RouteBackendRefs are the backendRefs of the rule as they are defined in the source Route.
+	RouteBackendRefs []RouteBackendRef
+	// BackendRefs is a list of BackendRefs for the rule.
+	BackendRefs []BackendRef
+	// Timeouts are the timeouts of the rule. Only HTTPRoutes support timeouts.
+	Timeouts *v1.HTTPRouteTimeouts
+	// MirrorBackendRef is the BackendRef of the first RequestMirror filter of the rule.
+	// It is nil if the rule doesn't have a RequestMirror filter.
+	MirrorBackendRef *BackendRef
+	// ValidMatches indicates whether the matches of the rule are valid.
+	// If the matches are invalid, NGF should not generate any configuration for the rule.
+	ValidMatches bool
+	// ValidFilters indicates whether the filters of the rule are valid.
+	// If the filters are invalid, the data-plane should return 500 error provided that the matches are valid.
+	ValidFilters bool
+}
+
+// RouteBackendRef is a wrapper for v1.BackendRef and any backendRef filters from the HTTPRoute or GRPCRoute.
+type RouteBackendRef struct {
+	v1.BackendRef
+	// Filters are the filters of the backendRef.
+	// For GRPCRoutes, the filters are converted to the equivalent HTTPRouteFilters.
+	Filters []v1.HTTPRouteFilter
+}
+
+// L4Route is the generic type for the layer 4 routes: TLSRoute, TCPRoute and UDPRoute.
+type L4Route struct {
+	// Source is the source Gateway API object of the Route.
+	Source client.Object
+	// RouteType is the type of the Route.
+	RouteType RouteType
+	// Spec is the L4RouteSpec of the Route.
+	Spec L4RouteSpec
+	// ParentRefs includes ParentRefs with NGF Gateways only.
+	ParentRefs []ParentRef
+	// Conditions include Conditions for the Route.
+	Conditions []conditions.Condition
+	// Valid tells if the Route is valid.
+	// If it is invalid, NGF should not generate any configuration for it.
+	Valid bool
+	// Attachable tells if the Route can be attached to any of the Gateways.
+	// Route can be invalid but still attachable.
+	Attachable bool
+}
+
+// L4RouteSpec is the common spec of the layer 4 routes.
+type L4RouteSpec struct {
+	// Hostnames defines a set of hostnames used to select a Route used to process the connection.
+	// Only applicable for TLSRoutes, where the hostnames are matched against the SNI of the connection.
+	Hostnames []v1.Hostname
+	// RouteBackendRefs are the backendRefs of the Route as they are defined in the source Route.
+	RouteBackendRefs []v1.BackendRef
+	// BackendRefs is a list of BackendRefs of the Route. It is only populated if the Route is valid.
+	BackendRefs []BackendRef
+}
+
+// buildL4RoutesForGateways builds routes from TLSRoutes, TCPRoutes and UDPRoutes that reference any of the specified
+// Gateways.
+func buildL4RoutesForGateways(
+	tlsRoutes map[types.NamespacedName]*v1alpha2.TLSRoute,
+	tcpRoutes map[types.NamespacedName]*v1alpha2.TCPRoute,
+	udpRoutes map[types.NamespacedName]*v1alpha2.UDPRoute,
+	gatewayNsNames []types.NamespacedName,
+) map[RouteKey]*L4Route {
+	if len(gatewayNsNames) == 0 {
+		return nil
+	}
+
+	routes := make(map[RouteKey]*L4Route)
+
+	for _, tlsRoute := range tlsRoutes {
+		r := buildTLSRoute(tlsRoute, gatewayNsNames)
+		if r != nil {
+			routes[CreateRouteKey(tlsRoute)] = r
+		}
+	}
+
+	for _, tcpRoute := range tcpRoutes {
+		r := buildTCPRoute(tcpRoute, gatewayNsNames)
+		if r != nil {
+			routes[CreateRouteKey(tcpRoute)] = r
+		}
+	}
+
+	for _, udpRoute := range udpRoutes {
+		r := buildUDPRoute(udpRoute, gatewayNsNames)
+		if r != nil {
+			routes[CreateRouteKey(udpRoute)] = r
+		}
+	}
+
+	return routes
+}
+
+// buildRoutesForGateways builds routes from HTTPRoutes and GRPCRoutes that reference any of the specified Gateways.
+func buildRoutesForGateways(
+	validator validation.HTTPFieldsValidator,
+	httpRoutes map[types.NamespacedName]*v1.HTTPRoute,
+	grpcRoutes map[types.NamespacedName]*v1alpha2.GRPCRoute,
+	gatewayNsNames []types.NamespacedName,
+) map[RouteKey]*L7Route {
+	if len(gatewayNsNames) == 0 {
+		return nil
+	}
+
+	routes := make(map[RouteKey]*L7Route)
+
+	for _, ghr := range httpRoutes {
+		r := buildHTTPRoute(validator, ghr, gatewayNsNames)
+		if r != nil {
+			routes[CreateRouteKey(ghr)] = r
+		}
+	}
+
+	for _, grpcRoute := range grpcRoutes {
+		r := buildGRPCRoute(validator, grpcRoute, gatewayNsNames)
+		if r != nil {
+			routes[CreateRouteKey(grpcRoute)] = r
+		}
+	}
+
+	return routes
+}
+
+func buildSectionNameRefs(
+	parentRefs []v1.ParentReference,
+	routeNamespace string,
+	gatewayNsNames []types.NamespacedName,
+) ([]ParentRef, error) {
+	sectionNameRefs := make([]ParentRef, 0, len(parentRefs))
+
+	type key struct {
+		gwNsName    types.NamespacedName
+		sectionName string
+	}
+	uniqueSectionsPerGateway := make(map[key]struct{})
+
+	for i, p := range parentRefs {
+		gw, found := findGatewayForParentRef(p, routeNamespace, gatewayNsNames)
+		if !found {
+			continue
+		}
+
+		var sectionName string
+		if p.SectionName != nil {
+			sectionName = string(*p.SectionName)
+		}
+
+		k := key{
+			gwNsName:    gw,
+			sectionName: sectionName,
+		}
+
+		if _, exist := uniqueSectionsPerGateway[k]; exist {
+			return nil, fmt.Errorf("duplicate section name %q for Gateway %s", sectionName, gw.String())
+		}
+		uniqueSectionsPerGateway[k] = struct{}{}
+
+		sectionNameRefs = append(sectionNameRefs, ParentRef{
+			Idx:         i,
+			Gateway:     gw,
+			SectionName: p.SectionName,
+			Port:        p.Port,
+		})
+	}
+
+	return sectionNameRefs, nil
+}
+
+func findGatewayForParentRef(
+	ref v1.ParentReference,
+	routeNamespace string,
+	gatewayNsNames []types.NamespacedName,
+) (gwNsName types.NamespacedName, found bool) {
+	if ref.Kind != nil && *ref.Kind != "Gateway" {
+		return types.NamespacedName{}, false
+	}
+	if ref.Group != nil && *ref.Group != v1.GroupName {
+		return types.NamespacedName{}, false
+	}
+
+	// if the namespace is missing, assume the namespace of the Route
+	ns := routeNamespace
+	if ref.Namespace != nil {
+		ns = string(*ref.Namespace)
+	}
+
+	for _, gw := range gatewayNsNames {
+		if gw.Namespace == ns && gw.Name == string(ref.Name) {
+			return gw, true
+		}
+	}
+
+	return types.NamespacedName{}, false
+}
+
+// validateRouteRules validates the matches and filters of the rules of a Route and populates its Rules, Valid and
+// Conditions fields accordingly. The route must be valid and have its Spec.Rules populated with the
+// matches and filters of each rule.
+func validateRouteRules(
+	r *L7Route,
+	validateRule func(idx int, rulePath *field.Path) (matchesErrs, filtersErrs field.ErrorList),
+) {
+	atLeastOneValid := false
+	var allRulesErrs field.ErrorList
+
+	for i := range r.Spec.Rules {
+		rulePath := field.NewPath("spec").Child("rules").Index(i)
+
+		// rule backendRefs are validated separately because of their special requirements
+		matchesErrs, filtersErrs := validateRule(i, rulePath)
+
+		var allErrs field.ErrorList
+		allErrs = append(allErrs, matchesErrs...)
+		allErrs = append(allErrs, filtersErrs...)
+		allRulesErrs = append(allRulesErrs, allErrs...)
+
+		if len(allErrs) == 0 {
+			atLeastOneValid = true
+		}
+
+		r.Spec.Rules[i].ValidMatches = len(matchesErrs) == 0
+		r.Spec.Rules[i].ValidFilters = len(filtersErrs) == 0
+	}
+
+	if len(allRulesErrs) > 0 {
+		msg := allRulesErrs.ToAggregate().Error()
+
+		if atLeastOneValid {
+			r.Conditions = append(r.Conditions, staticConds.NewRoutePartiallyInvalid(msg))
+		} else {
+			msg = "All rules are invalid: " + msg
+			r.Conditions = append(r.Conditions, staticConds.NewRouteUnsupportedValue(msg))
+
+			r.Valid = false
+		}
+	}
+}
+
+func bindRoutesToListeners(
+	routes map[RouteKey]*L7Route,
+	gw *Gateway,
+	namespaces map[types.NamespacedName]*apiv1.Namespace,
+) {
+	if gw == nil {
+		return
+	}
+
+	for _, r := range routes {
+		bindL7RouteToListeners(r, gw, namespaces)
+	}
+}
+
+func bindL4RoutesToListeners(
+	routes map[RouteKey]*L4Route,
+	gw *Gateway,
+	namespaces map[types.NamespacedName]*apiv1.Namespace,
+) {
+	if gw == nil {
+		return
+	}
+
+	for _, r := range routes {
+		bindL4RouteToListeners(r, gw, namespaces)
+	}
+}
+
+// attachableRoute holds the information about a Route that is required to attach it to the Listeners.
+type attachableRoute struct {
+	// attach adds the Route to the Listener.
+	attach func(l *Listener)
+	// namespace is the namespace of the Route.
+	namespace string
+	// routeType is the type of the Route.
+	routeType RouteType
+	// hostnames are the hostnames of the Route.
+	hostnames []v1.Hostname
+}
+
+func bindL7RouteToListeners(r *L7Route, gw *Gateway, namespaces map[types.NamespacedName]*apiv1.Namespace) {
+	if !r.Attachable {
+		return
+	}
+
+	route := attachableRoute{
+		attach: func(l *Listener) {
+			l.Routes[CreateRouteKey(r.Source)] = r
+		},
+		namespace: r.Source.GetNamespace(),
+		routeType: r.RouteType,
+		hostnames: r.Spec.Hostnames,
+	}
+
+	r.Conditions = append(r.Conditions, bindParentRefsToListeners(r.ParentRefs, route, gw, namespaces)...)
+}
+
+func bindL4RouteToListeners(r *L4Route, gw *Gateway, namespaces map[types.NamespacedName]*apiv1.Namespace) {
+	if !r.Attachable {
+		return
+	}
+
+	route := attachableRoute{
+		attach: func(l *Listener) {
+			l.L4Routes[CreateRouteKey(r.Source)] = r
+		},
+		namespace: r.Source.GetNamespace(),
+		routeType: r.RouteType,
+		hostnames: r.Spec.Hostnames,
+	}
+
+	r.Conditions = append(r.Conditions, bindParentRefsToListeners(r.ParentRefs, route, gw, namespaces)...)
+}
+
+// bindParentRefsToListeners tries to attach the route to the listeners of the Gateway for each of the parentRefs.
+// It populates the Attachment of each parentRef and returns the conditions that must be added to the route.
+func bindParentRefsToListeners(
+	parentRefs []ParentRef,
+	route attachableRoute,
+	gw *Gateway,
+	namespaces map[types.NamespacedName]*apiv1.Namespace,
+) []conditions.Condition {
+	var conds []conditions.Condition
+
+	for i := 0; i < len(parentRefs); i++ {
+		attachment := &ParentRefAttachmentStatus{
+			AcceptedHostnames: make(map[string][]string),
+		}
+		ref := &parentRefs[i]
+		ref.Attachment = attachment
+
+		path := field.NewPath("spec").Child("parentRefs").Index(ref.Idx)
+
+		attachableListeners, listenerExists := findAttachableListeners(
+			getSectionName(ref.SectionName),
+			gw.Listeners,
+		)
+
+		// Case 1: Attachment is not possible because the specified SectionName does not match any Listeners in the
+		// Gateway.
+		if !listenerExists {
+			attachment.FailedCondition = staticConds.NewRouteNoMatchingParent()
+			continue
+		}
+
+		// Case 2: Attachment is not possible due to unsupported configuration
+
+		if ref.Port != nil {
+			valErr := field.Forbidden(path.Child("port"), "cannot be set")
+			attachment.FailedCondition = staticConds.NewRouteUnsupportedValue(valErr.Error())
+			continue
+		}
+
+		// Case 3: the parentRef references an ignored Gateway resource.
+
+		referencesWinningGw := ref.Gateway.Namespace == gw.Source.Namespace && ref.Gateway.Name == gw.Source.Name
+
+		if !referencesWinningGw {
+			attachment.FailedCondition = staticConds.NewTODO("Gateway is ignored")
+			continue
+		}
+
+		// Case 4: Attachment is not possible because Gateway is invalid
+
+		if !gw.Valid {
+			attachment.FailedCondition = staticConds.NewRouteInvalidGateway()
+			continue
+		}
+
+		// Case 5 - winning Gateway
+
+		// Try to attach Route to all matching listeners
+
+		cond, attached := tryToAttachRouteToListeners(ref.Attachment, attachableListeners, route, gw, namespaces)
+		if !attached {
+			attachment.FailedCondition = cond
+			continue
+		}
+		if cond != (conditions.Condition{}) {
+			conds = append(conds, cond)
+		}
+
+		attachment.Attached = true
+	}
+
+	return conds
+}
+
+// tryToAttachRouteToListeners tries to attach the route to the listeners that match the parentRef and the hostnames.
+// There are two cases:
+// (1) If it succeeds in attaching at least one listener it will return true. The returned condition will be empty if
+// at least one of the listeners is valid. Otherwise, it will return the failure condition.
+// (2) If it fails to attach the route, it will return false and the failure condition.
+func tryToAttachRouteToListeners(
+	refStatus *ParentRefAttachmentStatus,
+	attachableListeners []*Listener,
+	route attachableRoute,
+	gw *Gateway,
+	namespaces map[types.NamespacedName]*apiv1.Namespace,
+) (conditions.Condition, bool) {
+	if len(attachableListeners) == 0 {
+		return staticConds.NewRouteInvalidListener(), false
+	}
+
+	bind := func(l *Listener) (allowed, attached bool) {
+		if !routeAllowedByListener(l, route.namespace, gw.Source.Namespace, namespaces) {
+			return false, false
+		}
+
+		if !isRouteTypeAllowedByListener(l, route.routeType) {
+			return false, false
+		}
+
+		hostnames := findAcceptedHostnames(l.Source.Hostname, route.hostnames)
+		if len(hostnames) == 0 {
+			return true, false
+		}
+
+		refStatus.AcceptedHostnames[string(l.Source.Name)] = hostnames
+		route.attach(l)
+
+		return true, true
+	}
+
+	var attachedToAtLeastOneValidListener bool
+
+	var allowed, attached bool
+	for _, l := range attachableListeners {
+		routeAllowed, routeAttached := bind(l)
+		allowed = allowed || routeAllowed
+		attached = attached || routeAttached
+		attachedToAtLeastOneValidListener = attachedToAtLeastOneValidListener || (routeAttached && l.Valid)
+	}
+
+	if !attached {
+		if !allowed {
+			return staticConds.NewRouteNotAllowedByListeners(), false
+		}
+		return staticConds.NewRouteNoMatchingListenerHostname(), false
+	}
+
+	if !attachedToAtLeastOneValidListener {
+		return staticConds.NewRouteInvalidListener(), true
+	}
+
+	return conditions.Condition{}, true
+}
+
+// findAttachableListeners returns a list of attachable listeners and whether the listener exists for a non-empty
+// sectionName.
+func findAttachableListeners(sectionName string, listeners []*Listener) ([]*Listener, bool) {
+	if sectionName != "" {
+		for _, l := range listeners {
+			if l.Name == sectionName {
+				if l.Attachable {
+					return []*Listener{l}, true
+				}
+				return nil, true
+			}
+		}
+		return nil, false
+	}
+
+	attachableListeners := make([]*Listener, 0, len(listeners))
+	for _, l := range listeners {
+		if !l.Attachable {
+			continue
+		}
+
+		attachableListeners = append(attachableListeners, l)
+	}
+
+	return attachableListeners, true
+}
+
+func findAcceptedHostnames(listenerHostname *v1.Hostname, routeHostnames []v1.Hostname) []string {
+	hostname := getHostname(listenerHostname)
+
+	if len(routeHostnames) == 0 {
+		if hostname == "" {
+			return []string{wildcardHostname}
+		}
+		return []string{hostname}
+	}
+
+	var result []string
+
+	for _, h := range routeHostnames {
+		routeHost := string(h)
+		if match(hostname, routeHost) {
+			result = append(result, GetMoreSpecificHostname(hostname, routeHost))
+		}
+	}
+
+	return result
+}
+
+func match(listenerHost, routeHost string) bool {
+	if listenerHost == "" {
+		return true
+	}
+
+	if routeHost == listenerHost {
+		return true
+	}
+
+	wildcardMatch := func(host1, host2 string) bool {
+		return strings.HasPrefix(host1, "*.") && strings.HasSuffix(host2, strings.TrimPrefix(host1, "*"))
+	}
+
+	// check if listenerHost is a wildcard and routeHost matches
+	if wildcardMatch(listenerHost, routeHost) {
+		return true
+	}
+
+	// check if routeHost is a wildcard and listener matchess
+	return wildcardMatch(routeHost, listenerHost)
+}
+
+// GetMoreSpecificHostname returns the more specific hostname between the two inputs.
+//
+// This function assumes that the two hostnames match each other, either:
+// - Exactly
+// - One as a substring of the other
+func GetMoreSpecificHostname(hostname1, hostname2 string) string {
+	if hostname1 == hostname2 {
+		return hostname1
+	}
+	if hostname1 == "" {
+		return hostname2
+	}
+	if hostname2 == "" {
+		return hostname1
+	}
+
+	// Compare if wildcards are present
+	if strings.HasPrefix(hostname1, "*.") {
+		if strings.HasPrefix(hostname2, "*.") {
+			subdomains1 := strings.Split(hostname1, ".")
+			subdomains2 := strings.Split(hostname2, ".")
+
+			// Compare number of subdomains
+			if len(subdomains1) > len(subdomains2) {
+				return hostname1
+			}
+
+			return hostname2
+		}
+
+		return hostname2
+	}
+	if strings.HasPrefix(hostname2, "*.") {
+		return hostname1
+	}
+
+	return ""
+}
+
+func routeAllowedByListener(
+	listener *Listener,
+	routeNS,
+	gwNS string,
+	namespaces map[types.NamespacedName]*apiv1.Namespace,
+) bool {
+	if listener.Source.AllowedRoutes != nil {
+		switch *listener.Source.AllowedRoutes.Namespaces.From {
+		case v1.NamespacesFromAll:
+			return true
+		case v1.NamespacesFromSame:
+			return routeNS == gwNS
+		case v1.NamespacesFromSelector:
+			if listener.AllowedRouteLabelSelector == nil {
+				return false
+			}
+
+			ns, exists := namespaces[types.NamespacedName{Name: routeNS}]
+			if !exists {
+				panic(fmt.Errorf("route namespace %q not found in map", routeNS))
+			}
+			return listener.AllowedRouteLabelSelector.Matches(labels.Set(ns.Labels))
+		}
+	}
+	return true
+}
+
+// isRouteTypeAllowedByListener checks if the listener supports the kind of the route.
+func isRouteTypeAllowedByListener(listener *Listener, routeType RouteType) bool {
+	kind := convertRouteTypeToKind(routeType)
+
+	for _, supportedKind := range listener.SupportedKinds {
+		if supportedKind.Kind == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+func convertRouteTypeToKind(routeType RouteType) v1.Kind {
+	switch routeType {
+	case RouteTypeHTTP:
+		return policies.KindHTTPRoute
+	case RouteTypeGRPC:
+		return kindGRPCRoute
+	case RouteTypeTLS:
+		return kindTLSRoute
+	case RouteTypeTCP:
+		return kindTCPRoute
+	case RouteTypeUDP:
+		return kindUDPRoute
+	default:
+		panic(fmt.Sprintf("unsupported route type %q", routeType))
+	}
+}
+
+func getHostname(h *v1.Hostname) string {
+	if h == nil {
+		return ""
+	}
+	return string(*h)
+}
+
+func getSectionName(s *v1.SectionName) string {
+	if s == nil {
+		return ""
+	}
+	return string(*s)
+}
+
+func validateHostnames(hostnames []v1.Hostname, path *field.Path) error {
+	var allErrs field.ErrorList
+
+	for i := range hostnames {
+		if err := validateHostname(string(hostnames[i])); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Index(i), hostnames[i], err.Error()))
+			continue
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func validateHeaderMatch(
+	validator validation.HTTPFieldsValidator,
+	headerType *v1.HeaderMatchType,
+	headerName, headerValue string,
+	headerPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	validateValue := validator.ValidateHeaderValueInMatch
+
+	if headerType == nil {
+		allErrs = append(allErrs, field.Required(headerPath.Child("type"), "cannot be empty"))
+	} else {
+		switch *headerType {
+		case v1.HeaderMatchExact:
+		case v1.HeaderMatchRegularExpression:
+			validateValue = validator.ValidateHeaderValueRegexInMatch
+		default:
+			valErr := field.NotSupported(
+				headerPath.Child("type"),
+				*headerType,
+				[]string{string(v1.HeaderMatchExact), string(v1.HeaderMatchRegularExpression)},
+			)
+			allErrs = append(allErrs, valErr)
+		}
+	}
+
+	if err := validator.ValidateHeaderNameInMatch(headerName); err != nil {
+		valErr := field.Invalid(headerPath.Child("name"), headerName, err.Error())
+		allErrs = append(allErrs, valErr)
+	}
+
+	if err := validateValue(headerValue); err != nil {
+		valErr := field.Invalid(headerPath.Child("value"), headerValue, err.Error())
+		allErrs = append(allErrs, valErr)
+	}
+
+	return allErrs
+}
+
+func validateFilterHeaderModifier(
+	validator validation.HTTPFieldsValidator,
+	headerModifier *v1.HTTPHeaderFilter,
+	headerModifierPath *field.Path,
+) field.ErrorList {
+	if headerModifier == nil {
+		return field.ErrorList{field.Required(headerModifierPath, "requestHeaderModifier cannot be nil")}
+	}
+
+	return validateFilterHeaderModifierFields(
+		validator.ValidateRequestHeaderName,
+		validator.ValidateRequestHeaderValue,
+		headerModifier,
+		headerModifierPath,
+	)
+}
+
+func validateFilterResponseHeaderModifier(
+	validator validation.HTTPFieldsValidator,
+	headerModifier *v1.HTTPHeaderFilter,
+	headerModifierPath *field.Path,
+) field.ErrorList {
+	if headerModifier == nil {
+		return field.ErrorList{field.Required(headerModifierPath, "responseHeaderModifier cannot be nil")}
+	}
+
+	return validateFilterHeaderModifierFields(
+		validator.ValidateResponseHeaderName,
+		validator.ValidateResponseHeaderValue,
+		headerModifier,
+		headerModifierPath,
+	)
+}
+
+// validateBackendRefFilters validates the filters of the backendRefs of a rule.
+// Only the header modifier filters are supported for backendRefs.
+func validateBackendRefFilters(
+	validator validation.HTTPFieldsValidator,
+	refs []RouteBackendRef,
+	refsPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, ref := range refs {
+		for j, filter := range ref.Filters {
+			filterPath := refsPath.Index(i).Child("filters").Index(j)
+
+			switch filter.Type {
+			case v1.HTTPRouteFilterRequestHeaderModifier:
+				allErrs = append(allErrs, validateFilterHeaderModifier(
+					validator,
+					filter.RequestHeaderModifier,
+					filterPath.Child("requestHeaderModifier"),
+				)...)
+			case v1.HTTPRouteFilterResponseHeaderModifier:
+				allErrs = append(allErrs, validateFilterResponseHeaderModifier(
+					validator,
+					filter.ResponseHeaderModifier,
+					filterPath.Child("responseHeaderModifier"),
+				)...)
+			default:
+				valErr := field.NotSupported(
+					filterPath.Child("type"),
+					filter.Type,
+					[]string{
+						string(v1.HTTPRouteFilterRequestHeaderModifier),
+						string(v1.HTTPRouteFilterResponseHeaderModifier),
+					},
+				)
+				allErrs = append(allErrs, valErr)
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateFilterHeaderModifierFields(
+	validateName func(name string) error,
+	validateValue func(value string) error,
+	headerModifier *v1.HTTPHeaderFilter,
+	headerModifierPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	// Ensure that the header names are case-insensitive unique
+	allErrs = append(allErrs, validateRequestHeadersCaseInsensitiveUnique(
+		headerModifier.Add,
+		headerModifierPath.Child("add"))...,
+	)
+	allErrs = append(allErrs, validateRequestHeadersCaseInsensitiveUnique(
+		headerModifier.Set,
+		headerModifierPath.Child("set"))...,
+	)
+	allErrs = append(allErrs, validateRequestHeaderStringCaseInsensitiveUnique(
+		headerModifier.Remove,
+		headerModifierPath.Child("remove"))...,
+	)
+
+	for _, h := range headerModifier.Add {
+		if err := validateName(string(h.Name)); err != nil {
+			valErr := field.Invalid(headerModifierPath.Child("add"), h, err.Error())
+			allErrs = append(allErrs, valErr)
+		}
+		if err := validateValue(h.Value); err != nil {
+			valErr := field.Invalid(headerModifierPath.Child("add"), h, err.Error())
+			allErrs = append(allErrs, valErr)
+		}
+	}
+	for _, h := range headerModifier.Set {
+		if err := validateName(string(h.Name)); err != nil {
+			valErr := field.Invalid(headerModifierPath.Child("set"), h, err.Error())
+			allErrs = append(allErrs, valErr)
+		}
+		if err := validateValue(h.Value); err != nil {
+			valErr := field.Invalid(headerModifierPath.Child("set"), h, err.Error())
+			allErrs = append(allErrs, valErr)
+		}
+	}
+	for _, h := range headerModifier.Remove {
+		if err := validateName(h); err != nil {
+			valErr := field.Invalid(headerModifierPath.Child("remove"), h, err.Error())
+			allErrs = append(allErrs, valErr)
+		}
+	}
+
+	return allErrs
+}
+
+func validateRequestHeadersCaseInsensitiveUnique(
+	headers []v1.HTTPHeader,
+	path *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	seen := make(map[string]struct{})
+
+	for _, h := range headers {
+		name := strings.ToLower(string(h.Name))
+		if _, exists := seen[name]; exists {
+			valErr := field.Invalid(path, h, "header name is not unique")
+			allErrs = append(allErrs, valErr)
+		}
+		seen[name] = struct{}{}
+	}
+
+	return allErrs
+}
+
+func validateRequestHeaderStringCaseInsensitiveUnique(headers []string, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	seen := make(map[string]struct{})
+
+	for _, h := range headers {
+		name := strings.ToLower(h)
+		if _, exists := seen[name]; exists {
+			valErr := field.Invalid(path, h, "header name is not unique")
+			allErrs = append(allErrs, valErr)
+		}
+		seen[name] = struct{}{}
+	}
+
+	return allErrs
+}
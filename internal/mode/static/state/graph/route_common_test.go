@@ -0,0 +1,1527 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func TestBuildSectionNameRefs(t *testing.T) {
+	const routeNamespace = "test"
+
+	gwNsName1 := types.NamespacedName{Namespace: routeNamespace, Name: "gateway-1"}
+	gwNsName2 := types.NamespacedName{Namespace: routeNamespace, Name: "gateway-2"}
+
+	parentRefs := []gatewayv1.ParentReference{
+		{
+			Name:        gatewayv1.ObjectName(gwNsName1.Name),
+			SectionName: helpers.GetPointer[gatewayv1.SectionName]("one"),
+		},
+		{
+			Name:        gatewayv1.ObjectName("some-other-gateway"),
+			SectionName: helpers.GetPointer[gatewayv1.SectionName]("two"),
+		},
+		{
+			Name:        gatewayv1.ObjectName(gwNsName2.Name),
+			SectionName: helpers.GetPointer[gatewayv1.SectionName]("three"),
+		},
+		{
+			Name:        gatewayv1.ObjectName(gwNsName1.Name),
+			SectionName: helpers.GetPointer[gatewayv1.SectionName]("same-name"),
+		},
+		{
+			Name:        gatewayv1.ObjectName(gwNsName2.Name),
+			SectionName: helpers.GetPointer[gatewayv1.SectionName]("same-name"),
+		},
+		{
+			Name:        gatewayv1.ObjectName("some-other-gateway"),
+			SectionName: helpers.GetPointer[gatewayv1.SectionName]("same-name"),
+		},
+	}
+
+	gwNsNames := []types.NamespacedName{gwNsName1, gwNsName2}
+
+	expected := []ParentRef{
+		{
+			Idx:         0,
+			Gateway:     gwNsName1,
+			SectionName: parentRefs[0].SectionName,
+		},
+		{
+			Idx:         2,
+			Gateway:     gwNsName2,
+			SectionName: parentRefs[2].SectionName,
+		},
+		{
+			Idx:         3,
+			Gateway:     gwNsName1,
+			SectionName: parentRefs[3].SectionName,
+		},
+		{
+			Idx:         4,
+			Gateway:     gwNsName2,
+			SectionName: parentRefs[4].SectionName,
+		},
+	}
+
+	tests := []struct {
+		expectedError error
+		name          string
+		parentRefs    []gatewayv1.ParentReference
+		expectedRefs  []ParentRef
+	}{
+		{
+			name:          "normal case",
+			parentRefs:    parentRefs,
+			expectedRefs:  expected,
+			expectedError: nil,
+		},
+		{
+			parentRefs: []gatewayv1.ParentReference{
+				{
+					Name:        gatewayv1.ObjectName(gwNsName1.Name),
+					SectionName: helpers.GetPointer[gatewayv1.SectionName]("http"),
+				},
+				{
+					Name:        gatewayv1.ObjectName(gwNsName1.Name),
+					SectionName: helpers.GetPointer[gatewayv1.SectionName]("http"),
+				},
+			},
+			name:          "duplicate sectionNames",
+			expectedError: errors.New("duplicate section name \"http\" for Gateway test/gateway-1"),
+		},
+		{
+			parentRefs: []gatewayv1.ParentReference{
+				{
+					Name:        gatewayv1.ObjectName(gwNsName1.Name),
+					SectionName: nil,
+				},
+				{
+					Name:        gatewayv1.ObjectName(gwNsName1.Name),
+					SectionName: nil,
+				},
+			},
+			name:          "nil sectionNames",
+			expectedError: errors.New("duplicate section name \"\" for Gateway test/gateway-1"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result, err := buildSectionNameRefs(test.parentRefs, routeNamespace, gwNsNames)
+			g.Expect(result).To(Equal(test.expectedRefs))
+			if test.expectedError != nil {
+				g.Expect(err).To(Equal(test.expectedError))
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestFindGatewayForParentRef(t *testing.T) {
+	gwNsName1 := types.NamespacedName{Namespace: "test-1", Name: "gateway-1"}
+	gwNsName2 := types.NamespacedName{Namespace: "test-2", Name: "gateway-2"}
+
+	tests := []struct {
+		ref              gatewayv1.ParentReference
+		expectedGwNsName types.NamespacedName
+		name             string
+		expectedFound    bool
+	}{
+		{
+			ref: gatewayv1.ParentReference{
+				Namespace: helpers.GetPointer(gatewayv1.Namespace(gwNsName1.Namespace)),
+				Name:      gatewayv1.ObjectName(gwNsName1.Name),
+			},
+			expectedFound:    true,
+			expectedGwNsName: gwNsName1,
+			name:             "found",
+		},
+		{
+			ref: gatewayv1.ParentReference{
+				Group:     helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName),
+				Kind:      helpers.GetPointer[gatewayv1.Kind]("Gateway"),
+				Namespace: helpers.GetPointer(gatewayv1.Namespace(gwNsName1.Namespace)),
+				Name:      gatewayv1.ObjectName(gwNsName1.Name),
+			},
+			expectedFound:    true,
+			expectedGwNsName: gwNsName1,
+			name:             "found with explicit group and kind",
+		},
+		{
+			ref: gatewayv1.ParentReference{
+				Name: gatewayv1.ObjectName(gwNsName2.Name),
+			},
+			expectedFound:    true,
+			expectedGwNsName: gwNsName2,
+			name:             "found with implicit namespace",
+		},
+		{
+			ref: gatewayv1.ParentReference{
+				Kind: helpers.GetPointer[gatewayv1.Kind]("NotGateway"),
+				Name: gatewayv1.ObjectName(gwNsName2.Name),
+			},
+			expectedFound:    false,
+			expectedGwNsName: types.NamespacedName{},
+			name:             "wrong kind",
+		},
+		{
+			ref: gatewayv1.ParentReference{
+				Group: helpers.GetPointer[gatewayv1.Group]("wrong-group"),
+				Name:  gatewayv1.ObjectName(gwNsName2.Name),
+			},
+			expectedFound:    false,
+			expectedGwNsName: types.NamespacedName{},
+			name:             "wrong group",
+		},
+		{
+			ref: gatewayv1.ParentReference{
+				Namespace: helpers.GetPointer(gatewayv1.Namespace(gwNsName1.Namespace)),
+				Name:      "some-gateway",
+			},
+			expectedFound:    false,
+			expectedGwNsName: types.NamespacedName{},
+			name:             "not found",
+		},
+	}
+
+	routeNamespace := "test-2"
+
+	gwNsNames := []types.NamespacedName{
+		gwNsName1,
+		gwNsName2,
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			gw, found := findGatewayForParentRef(test.ref, routeNamespace, gwNsNames)
+			g.Expect(found).To(Equal(test.expectedFound))
+			g.Expect(gw).To(Equal(test.expectedGwNsName))
+		})
+	}
+}
+
+func TestBindL7RouteToListeners(t *testing.T) {
+	// we create a new listener each time because the function under test can modify it
+	createListener := func(name string) *Listener {
+		return &Listener{
+			Name: name,
+			Source: gatewayv1.Listener{
+				Name:     gatewayv1.SectionName(name),
+				Hostname: (*gatewayv1.Hostname)(helpers.GetPointer("foo.example.com")),
+			},
+			Valid:      true,
+			Attachable: true,
+			Routes:     map[RouteKey]*L7Route{},
+			SupportedKinds: []gatewayv1.RouteGroupKind{
+				{Kind: "HTTPRoute"},
+			},
+		}
+	}
+	createModifiedListener := func(name string, m func(*Listener)) *Listener {
+		l := createListener(name)
+		m(l)
+		return l
+	}
+
+	gw := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "gateway",
+		},
+	}
+	gwDiffNamespace := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "diff-namespace",
+			Name:      "gateway",
+		},
+	}
+
+	createHTTPRouteWithSectionNameAndPort := func(
+		sectionName *gatewayv1.SectionName,
+		port *gatewayv1.PortNumber,
+	) *gatewayv1.HTTPRoute {
+		return &gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "hr",
+			},
+			Spec: gatewayv1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{
+					ParentRefs: []gatewayv1.ParentReference{
+						{
+							Name:        gatewayv1.ObjectName(gw.Name),
+							SectionName: sectionName,
+							Port:        port,
+						},
+					},
+				},
+				Hostnames: []gatewayv1.Hostname{
+					"foo.example.com",
+				},
+			},
+		}
+	}
+
+	gr := &v1alpha2.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "gr",
+		},
+		Spec: v1alpha2.GRPCRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{
+					{
+						Name:        gatewayv1.ObjectName(gw.Name),
+						SectionName: helpers.GetPointer[gatewayv1.SectionName]("listener-80-1"),
+					},
+				},
+			},
+			Hostnames: []gatewayv1.Hostname{
+				"foo.example.com",
+			},
+		},
+	}
+
+	grpcRoute := &L7Route{
+		RouteType:  RouteTypeGRPC,
+		Source:     gr,
+		Spec:       L7RouteSpec{Hostnames: gr.Spec.Hostnames},
+		Valid:      true,
+		Attachable: true,
+		ParentRefs: []ParentRef{
+			{
+				Idx:         0,
+				Gateway:     client.ObjectKeyFromObject(gw),
+				SectionName: gr.Spec.ParentRefs[0].SectionName,
+			},
+		},
+	}
+
+	hr := createHTTPRouteWithSectionNameAndPort(helpers.GetPointer[gatewayv1.SectionName]("listener-80-1"), nil)
+	hrWithNilSectionName := createHTTPRouteWithSectionNameAndPort(nil, nil)
+	hrWithEmptySectionName := createHTTPRouteWithSectionNameAndPort(helpers.GetPointer[gatewayv1.SectionName](""), nil)
+	hrWithPort := createHTTPRouteWithSectionNameAndPort(
+		helpers.GetPointer[gatewayv1.SectionName]("listener-80-1"),
+		helpers.GetPointer[gatewayv1.PortNumber](80),
+	)
+	hrWithNonExistingListener := createHTTPRouteWithSectionNameAndPort(
+		helpers.GetPointer[gatewayv1.SectionName]("listener-80-2"),
+		nil,
+	)
+
+	var normalRoute *L7Route
+	createNormalRoute := func(gateway *gatewayv1.Gateway) *L7Route {
+		normalRoute = &L7Route{
+			RouteType:  RouteTypeHTTP,
+			Source:     hr,
+			Spec:       L7RouteSpec{Hostnames: hr.Spec.Hostnames},
+			Valid:      true,
+			Attachable: true,
+			ParentRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gateway),
+					SectionName: hr.Spec.ParentRefs[0].SectionName,
+				},
+			},
+		}
+		return normalRoute
+	}
+	getLastNormalRoute := func() *L7Route {
+		return normalRoute
+	}
+
+	invalidAttachableRoute1 := &L7Route{
+		RouteType:  RouteTypeHTTP,
+		Source:     hr,
+		Spec:       L7RouteSpec{Hostnames: hr.Spec.Hostnames},
+		Valid:      false,
+		Attachable: true,
+		ParentRefs: []ParentRef{
+			{
+				Idx:         0,
+				Gateway:     client.ObjectKeyFromObject(gw),
+				SectionName: hr.Spec.ParentRefs[0].SectionName,
+			},
+		},
+	}
+	invalidAttachableRoute2 := &L7Route{
+		RouteType:  RouteTypeHTTP,
+		Source:     hr,
+		Spec:       L7RouteSpec{Hostnames: hr.Spec.Hostnames},
+		Valid:      false,
+		Attachable: true,
+		ParentRefs: []ParentRef{
+			{
+				Idx:         0,
+				Gateway:     client.ObjectKeyFromObject(gw),
+				SectionName: hr.Spec.ParentRefs[0].SectionName,
+			},
+		},
+	}
+
+	routeWithMissingSectionName := &L7Route{
+		RouteType:  RouteTypeHTTP,
+		Source:     hrWithNilSectionName,
+		Spec:       L7RouteSpec{Hostnames: hrWithNilSectionName.Spec.Hostnames},
+		Valid:      true,
+		Attachable: true,
+		ParentRefs: []ParentRef{
+			{
+				Idx:         0,
+				Gateway:     client.ObjectKeyFromObject(gw),
+				SectionName: hrWithNilSectionName.Spec.ParentRefs[0].SectionName,
+			},
+		},
+	}
+	routeWithEmptySectionName := &L7Route{
+		RouteType:  RouteTypeHTTP,
+		Source:     hrWithEmptySectionName,
+		Spec:       L7RouteSpec{Hostnames: hrWithEmptySectionName.Spec.Hostnames},
+		Valid:      true,
+		Attachable: true,
+		ParentRefs: []ParentRef{
+			{
+				Idx:         0,
+				Gateway:     client.ObjectKeyFromObject(gw),
+				SectionName: hrWithEmptySectionName.Spec.ParentRefs[0].SectionName,
+			},
+		},
+	}
+	routeWithNonExistingListener := &L7Route{
+		RouteType:  RouteTypeHTTP,
+		Source:     hrWithNonExistingListener,
+		Spec:       L7RouteSpec{Hostnames: hrWithNonExistingListener.Spec.Hostnames},
+		Valid:      true,
+		Attachable: true,
+		ParentRefs: []ParentRef{
+			{
+				Idx:         0,
+				Gateway:     client.ObjectKeyFromObject(gw),
+				SectionName: hrWithNonExistingListener.Spec.ParentRefs[0].SectionName,
+			},
+		},
+	}
+	routeWithPort := &L7Route{
+		RouteType:  RouteTypeHTTP,
+		Source:     hrWithPort,
+		Spec:       L7RouteSpec{Hostnames: hrWithPort.Spec.Hostnames},
+		Valid:      true,
+		Attachable: true,
+		ParentRefs: []ParentRef{
+			{
+				Idx:         0,
+				Gateway:     client.ObjectKeyFromObject(gw),
+				SectionName: hrWithPort.Spec.ParentRefs[0].SectionName,
+				Port:        hrWithPort.Spec.ParentRefs[0].Port,
+			},
+		},
+	}
+	ignoredGwNsName := types.NamespacedName{Namespace: "test", Name: "ignored-gateway"}
+	routeWithIgnoredGateway := &L7Route{
+		RouteType:  RouteTypeHTTP,
+		Source:     hr,
+		Spec:       L7RouteSpec{Hostnames: hr.Spec.Hostnames},
+		Valid:      true,
+		Attachable: true,
+		ParentRefs: []ParentRef{
+			{
+				Idx:         0,
+				Gateway:     ignoredGwNsName,
+				SectionName: hr.Spec.ParentRefs[0].SectionName,
+			},
+		},
+	}
+	invalidRoute := &L7Route{
+		Valid: false,
+		ParentRefs: []ParentRef{
+			{
+				Idx:     0,
+				Gateway: client.ObjectKeyFromObject(gw),
+			},
+		},
+	}
+
+	invalidNotAttachableListener := createModifiedListener("listener-80-1", func(l *Listener) {
+		l.Valid = false
+		l.Attachable = false
+	})
+	nonMatchingHostnameListener := createModifiedListener("listener-80-1", func(l *Listener) {
+		l.Source.Hostname = helpers.GetPointer[gatewayv1.Hostname]("bar.example.com")
+	})
+
+	tests := []struct {
+		route                    *L7Route
+		gateway                  *Gateway
+		expectedGatewayListeners []*Listener
+		name                     string
+		expectedSectionNameRefs  []ParentRef
+		expectedConditions       []conditions.Condition
+	}{
+		{
+			route: createNormalRoute(gw),
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createListener("listener-80-1"),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gw),
+					SectionName: hr.Spec.ParentRefs[0].SectionName,
+					Attachment: &ParentRefAttachmentStatus{
+						Attached: true,
+						AcceptedHostnames: map[string][]string{
+							"listener-80-1": {"foo.example.com"},
+						},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createModifiedListener("listener-80-1", func(l *Listener) {
+					l.Routes = map[RouteKey]*L7Route{
+						CreateRouteKey(hr): getLastNormalRoute(),
+					}
+				}),
+			},
+			name: "normal case",
+		},
+		{
+			route: routeWithMissingSectionName,
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createListener("listener-80-1"),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gw),
+					SectionName: hrWithNilSectionName.Spec.ParentRefs[0].SectionName,
+					Attachment: &ParentRefAttachmentStatus{
+						Attached: true,
+						AcceptedHostnames: map[string][]string{
+							"listener-80-1": {"foo.example.com"},
+						},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createModifiedListener("listener-80-1", func(l *Listener) {
+					l.Routes = map[RouteKey]*L7Route{
+						CreateRouteKey(hr): routeWithMissingSectionName,
+					}
+				}),
+			},
+			name: "section name is nil",
+		},
+		{
+			route: routeWithEmptySectionName,
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createListener("listener-80"),
+					createListener("listener-8080"),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gw),
+					SectionName: hrWithEmptySectionName.Spec.ParentRefs[0].SectionName,
+					Attachment: &ParentRefAttachmentStatus{
+						Attached: true,
+						AcceptedHostnames: map[string][]string{
+							"listener-80":   {"foo.example.com"},
+							"listener-8080": {"foo.example.com"},
+						},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createModifiedListener("listener-80", func(l *Listener) {
+					l.Routes = map[RouteKey]*L7Route{
+						CreateRouteKey(hr): routeWithEmptySectionName,
+					}
+				}),
+				createModifiedListener("listener-8080", func(l *Listener) {
+					l.Routes = map[RouteKey]*L7Route{
+						CreateRouteKey(hr): routeWithEmptySectionName,
+					}
+				}),
+			},
+			name: "section name is empty; bind to multiple listeners",
+		},
+		{
+			route: routeWithEmptySectionName,
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					invalidNotAttachableListener,
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gw),
+					SectionName: hrWithEmptySectionName.Spec.ParentRefs[0].SectionName,
+					Attachment: &ParentRefAttachmentStatus{
+						Attached:          false,
+						FailedCondition:   staticConds.NewRouteInvalidListener(),
+						AcceptedHostnames: map[string][]string{},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				invalidNotAttachableListener,
+			},
+			name: "empty section name with no valid and attachable listeners",
+		},
+		{
+			route: routeWithPort,
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createListener("listener-80-1"),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gw),
+					SectionName: hrWithPort.Spec.ParentRefs[0].SectionName,
+					Port:        hrWithPort.Spec.ParentRefs[0].Port,
+					Attachment: &ParentRefAttachmentStatus{
+						Attached: false,
+						FailedCondition: staticConds.NewRouteUnsupportedValue(
+							`spec.parentRefs[0].port: Forbidden: cannot be set`,
+						),
+						AcceptedHostnames: map[string][]string{},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createListener("listener-80-1"),
+			},
+			name: "port is configured",
+		},
+		{
+			route: routeWithNonExistingListener,
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createListener("listener-80-1"),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gw),
+					SectionName: hrWithNonExistingListener.Spec.ParentRefs[0].SectionName,
+					Attachment: &ParentRefAttachmentStatus{
+						Attached:          false,
+						FailedCondition:   staticConds.NewRouteNoMatchingParent(),
+						AcceptedHostnames: map[string][]string{},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createListener("listener-80-1"),
+			},
+			name: "listener doesn't exist",
+		},
+		{
+			route: createNormalRoute(gw),
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					invalidNotAttachableListener,
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gw),
+					SectionName: hr.Spec.ParentRefs[0].SectionName,
+					Attachment: &ParentRefAttachmentStatus{
+						Attached:          false,
+						FailedCondition:   staticConds.NewRouteInvalidListener(),
+						AcceptedHostnames: map[string][]string{},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				invalidNotAttachableListener,
+			},
+			name: "listener isn't valid and attachable",
+		},
+		{
+			route: createNormalRoute(gw),
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					nonMatchingHostnameListener,
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gw),
+					SectionName: hr.Spec.ParentRefs[0].SectionName,
+					Attachment: &ParentRefAttachmentStatus{
+						Attached:          false,
+						FailedCondition:   staticConds.NewRouteNoMatchingListenerHostname(),
+						AcceptedHostnames: map[string][]string{},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				nonMatchingHostnameListener,
+			},
+			name: "no matching listener hostname",
+		},
+		{
+			route: routeWithIgnoredGateway,
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createListener("listener-80-1"),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     ignoredGwNsName,
+					SectionName: hr.Spec.ParentRefs[0].SectionName,
+					Attachment: &ParentRefAttachmentStatus{
+						Attached:          false,
+						FailedCondition:   staticConds.NewTODO("Gateway is ignored"),
+						AcceptedHostnames: map[string][]string{},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createListener("listener-80-1"),
+			},
+			name: "gateway is ignored",
+		},
+		{
+			route: invalidRoute,
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createListener("listener-80-1"),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:        0,
+					Gateway:    client.ObjectKeyFromObject(gw),
+					Attachment: nil,
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createListener("listener-80-1"),
+			},
+			name: "route isn't valid",
+		},
+		{
+			route: createNormalRoute(gw),
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  false,
+				Listeners: []*Listener{
+					createListener("listener-80-1"),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gw),
+					SectionName: hr.Spec.ParentRefs[0].SectionName,
+					Attachment: &ParentRefAttachmentStatus{
+						Attached:          false,
+						FailedCondition:   staticConds.NewRouteInvalidGateway(),
+						AcceptedHostnames: map[string][]string{},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createListener("listener-80-1"),
+			},
+			name: "invalid gateway",
+		},
+		{
+			route: createNormalRoute(gw),
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createModifiedListener("listener-80-1", func(l *Listener) {
+						l.Valid = false
+					}),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gw),
+					SectionName: hr.Spec.ParentRefs[0].SectionName,
+					Attachment: &ParentRefAttachmentStatus{
+						Attached: true,
+						AcceptedHostnames: map[string][]string{
+							"listener-80-1": {"foo.example.com"},
+						},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createModifiedListener("listener-80-1", func(l *Listener) {
+					l.Valid = false
+					l.Routes = map[RouteKey]*L7Route{
+						CreateRouteKey(hr): getLastNormalRoute(),
+					}
+				}),
+			},
+			expectedConditions: []conditions.Condition{staticConds.NewRouteInvalidListener()},
+			name:               "invalid attachable listener",
+		},
+		{
+			route: invalidAttachableRoute1,
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createListener("listener-80-1"),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gw),
+					SectionName: hr.Spec.ParentRefs[0].SectionName,
+					Attachment: &ParentRefAttachmentStatus{
+						Attached: true,
+						AcceptedHostnames: map[string][]string{
+							"listener-80-1": {"foo.example.com"},
+						},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createModifiedListener("listener-80-1", func(l *Listener) {
+					l.Routes = map[RouteKey]*L7Route{
+						CreateRouteKey(hr): invalidAttachableRoute1,
+					}
+				}),
+			},
+			name: "invalid attachable route",
+		},
+		{
+			route: invalidAttachableRoute2,
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createModifiedListener("listener-80-1", func(l *Listener) {
+						l.Valid = false
+					}),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gw),
+					SectionName: hr.Spec.ParentRefs[0].SectionName,
+					Attachment: &ParentRefAttachmentStatus{
+						Attached: true,
+						AcceptedHostnames: map[string][]string{
+							"listener-80-1": {"foo.example.com"},
+						},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createModifiedListener("listener-80-1", func(l *Listener) {
+					l.Valid = false
+					l.Routes = map[RouteKey]*L7Route{
+						CreateRouteKey(hr): invalidAttachableRoute2,
+					}
+				}),
+			},
+			expectedConditions: []conditions.Condition{staticConds.NewRouteInvalidListener()},
+			name:               "invalid attachable listener with invalid attachable route",
+		},
+		{
+			route: createNormalRoute(gw),
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createModifiedListener("listener-80-1", func(l *Listener) {
+						l.Source.AllowedRoutes = &gatewayv1.AllowedRoutes{
+							Namespaces: &gatewayv1.RouteNamespaces{
+								From: helpers.GetPointer(gatewayv1.NamespacesFromSelector),
+							},
+						}
+						allowedLabels := map[string]string{"app": "not-allowed"}
+						l.AllowedRouteLabelSelector = labels.SelectorFromSet(allowedLabels)
+					}),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gw),
+					SectionName: hr.Spec.ParentRefs[0].SectionName,
+					Attachment: &ParentRefAttachmentStatus{
+						Attached:          false,
+						FailedCondition:   staticConds.NewRouteNotAllowedByListeners(),
+						AcceptedHostnames: map[string][]string{},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createModifiedListener("listener-80-1", func(l *Listener) {
+					l.Source.AllowedRoutes = &gatewayv1.AllowedRoutes{
+						Namespaces: &gatewayv1.RouteNamespaces{
+							From: helpers.GetPointer(gatewayv1.NamespacesFromSelector),
+						},
+					}
+					allowedLabels := map[string]string{"app": "not-allowed"}
+					l.AllowedRouteLabelSelector = labels.SelectorFromSet(allowedLabels)
+				}),
+			},
+			name: "route not allowed via labels",
+		},
+		{
+			route: createNormalRoute(gw),
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createModifiedListener("listener-80-1", func(l *Listener) {
+						l.Source.AllowedRoutes = &gatewayv1.AllowedRoutes{
+							Namespaces: &gatewayv1.RouteNamespaces{
+								From: helpers.GetPointer(gatewayv1.NamespacesFromSelector),
+							},
+						}
+						allowedLabels := map[string]string{"app": "allowed"}
+						l.AllowedRouteLabelSelector = labels.SelectorFromSet(allowedLabels)
+					}),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gw),
+					SectionName: hr.Spec.ParentRefs[0].SectionName,
+					Attachment: &ParentRefAttachmentStatus{
+						Attached: true,
+						AcceptedHostnames: map[string][]string{
+							"listener-80-1": {"foo.example.com"},
+						},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createModifiedListener("listener-80-1", func(l *Listener) {
+					allowedLabels := map[string]string{"app": "allowed"}
+					l.AllowedRouteLabelSelector = labels.SelectorFromSet(allowedLabels)
+					l.Source.AllowedRoutes = &gatewayv1.AllowedRoutes{
+						Namespaces: &gatewayv1.RouteNamespaces{
+							From: helpers.GetPointer(gatewayv1.NamespacesFromSelector),
+						},
+					}
+					l.Routes = map[RouteKey]*L7Route{
+						CreateRouteKey(hr): getLastNormalRoute(),
+					}
+				}),
+			},
+			name: "route allowed via labels",
+		},
+		{
+			route: createNormalRoute(gwDiffNamespace),
+			gateway: &Gateway{
+				Source: gwDiffNamespace,
+				Valid:  true,
+				Listeners: []*Listener{
+					createModifiedListener("listener-80-1", func(l *Listener) {
+						l.Source.AllowedRoutes = &gatewayv1.AllowedRoutes{
+							Namespaces: &gatewayv1.RouteNamespaces{
+								From: helpers.GetPointer(gatewayv1.NamespacesFromSame),
+							},
+						}
+					}),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gwDiffNamespace),
+					SectionName: hr.Spec.ParentRefs[0].SectionName,
+					Attachment: &ParentRefAttachmentStatus{
+						Attached:          false,
+						FailedCondition:   staticConds.NewRouteNotAllowedByListeners(),
+						AcceptedHostnames: map[string][]string{},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createModifiedListener("listener-80-1", func(l *Listener) {
+					l.Source.AllowedRoutes = &gatewayv1.AllowedRoutes{
+						Namespaces: &gatewayv1.RouteNamespaces{
+							From: helpers.GetPointer(gatewayv1.NamespacesFromSame),
+						},
+					}
+				}),
+			},
+			name: "route not allowed via same namespace",
+		},
+		{
+			route: createNormalRoute(gw),
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createModifiedListener("listener-80-1", func(l *Listener) {
+						l.Source.AllowedRoutes = &gatewayv1.AllowedRoutes{
+							Namespaces: &gatewayv1.RouteNamespaces{
+								From: helpers.GetPointer(gatewayv1.NamespacesFromSame),
+							},
+						}
+					}),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gw),
+					SectionName: hr.Spec.ParentRefs[0].SectionName,
+					Attachment: &ParentRefAttachmentStatus{
+						Attached: true,
+						AcceptedHostnames: map[string][]string{
+							"listener-80-1": {"foo.example.com"},
+						},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createModifiedListener("listener-80-1", func(l *Listener) {
+					l.Source.AllowedRoutes = &gatewayv1.AllowedRoutes{
+						Namespaces: &gatewayv1.RouteNamespaces{
+							From: helpers.GetPointer(gatewayv1.NamespacesFromSame),
+						},
+					}
+					l.Routes = map[RouteKey]*L7Route{
+						CreateRouteKey(hr): getLastNormalRoute(),
+					}
+				}),
+			},
+			name: "route allowed via same namespace",
+		},
+		{
+			route: createNormalRoute(gwDiffNamespace),
+			gateway: &Gateway{
+				Source: gwDiffNamespace,
+				Valid:  true,
+				Listeners: []*Listener{
+					createModifiedListener("listener-80-1", func(l *Listener) {
+						l.Source.AllowedRoutes = &gatewayv1.AllowedRoutes{
+							Namespaces: &gatewayv1.RouteNamespaces{
+								From: helpers.GetPointer(gatewayv1.NamespacesFromAll),
+							},
+						}
+					}),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gwDiffNamespace),
+					SectionName: hr.Spec.ParentRefs[0].SectionName,
+					Attachment: &ParentRefAttachmentStatus{
+						Attached: true,
+						AcceptedHostnames: map[string][]string{
+							"listener-80-1": {"foo.example.com"},
+						},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createModifiedListener("listener-80-1", func(l *Listener) {
+					l.Source.AllowedRoutes = &gatewayv1.AllowedRoutes{
+						Namespaces: &gatewayv1.RouteNamespaces{
+							From: helpers.GetPointer(gatewayv1.NamespacesFromAll),
+						},
+					}
+					l.Routes = map[RouteKey]*L7Route{
+						CreateRouteKey(hr): getLastNormalRoute(),
+					}
+				}),
+			},
+			name: "route allowed via all namespaces",
+		},
+		{
+			route: grpcRoute,
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createListener("listener-80-1"),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gw),
+					SectionName: gr.Spec.ParentRefs[0].SectionName,
+					Attachment: &ParentRefAttachmentStatus{
+						Attached:          false,
+						FailedCondition:   staticConds.NewRouteNotAllowedByListeners(),
+						AcceptedHostnames: map[string][]string{},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createListener("listener-80-1"),
+			},
+			name: "route kind not allowed by listener",
+		},
+	}
+
+	namespaces := map[types.NamespacedName]*v1.Namespace{
+		{Name: "test"}: {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "test",
+				Labels: map[string]string{"app": "allowed"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			bindL7RouteToListeners(test.route, test.gateway, namespaces)
+
+			g.Expect(test.route.ParentRefs).To(Equal(test.expectedSectionNameRefs))
+			g.Expect(helpers.Diff(test.gateway.Listeners, test.expectedGatewayListeners)).To(BeEmpty())
+			g.Expect(helpers.Diff(test.route.Conditions, test.expectedConditions)).To(BeEmpty())
+		})
+	}
+}
+
+func TestBindL4RouteToListeners(t *testing.T) {
+	// we create a new listener each time because the function under test can modify it
+	createListener := func(name string, hostname gatewayv1.Hostname, kind gatewayv1.Kind) *Listener {
+		return &Listener{
+			Name: name,
+			Source: gatewayv1.Listener{
+				Name:     gatewayv1.SectionName(name),
+				Hostname: &hostname,
+			},
+			Valid:      true,
+			Attachable: true,
+			Routes:     map[RouteKey]*L7Route{},
+			L4Routes:   map[RouteKey]*L4Route{},
+			SupportedKinds: []gatewayv1.RouteGroupKind{
+				{Kind: kind},
+			},
+		}
+	}
+
+	gw := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "gateway",
+		},
+	}
+
+	tr := &v1alpha2.TLSRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "tr",
+		},
+		Spec: v1alpha2.TLSRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{
+					{
+						Name: gatewayv1.ObjectName(gw.Name),
+					},
+				},
+			},
+			Hostnames: []gatewayv1.Hostname{
+				"app.example.com",
+			},
+		},
+	}
+
+	createRoute := func() *L4Route {
+		return &L4Route{
+			RouteType:  RouteTypeTLS,
+			Source:     tr,
+			Spec:       L4RouteSpec{Hostnames: tr.Spec.Hostnames},
+			Valid:      true,
+			Attachable: true,
+			ParentRefs: []ParentRef{
+				{
+					Idx:     0,
+					Gateway: client.ObjectKeyFromObject(gw),
+				},
+			},
+		}
+	}
+
+	tcr := &v1alpha2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "tcr",
+		},
+		Spec: v1alpha2.TCPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{
+					{
+						Name: gatewayv1.ObjectName(gw.Name),
+					},
+				},
+			},
+		},
+	}
+
+	routeAttached := createRoute()
+	routeNotAllowed := createRoute()
+	routeNoMatchingHostname := createRoute()
+
+	tcpRouteAttached := &L4Route{
+		RouteType:  RouteTypeTCP,
+		Source:     tcr,
+		Valid:      true,
+		Attachable: true,
+		ParentRefs: []ParentRef{
+			{
+				Idx:     0,
+				Gateway: client.ObjectKeyFromObject(gw),
+			},
+		},
+	}
+
+	expListenerWithRoute := createListener("tls-listener", "*.example.com", kindTLSRoute)
+	expListenerWithRoute.L4Routes[CreateRouteKey(tr)] = routeAttached
+
+	expTCPListenerWithRoute := createListener("tcp-listener", "", kindTCPRoute)
+	expTCPListenerWithRoute.L4Routes[CreateRouteKey(tcr)] = tcpRouteAttached
+
+	tests := []struct {
+		route                    *L4Route
+		gateway                  *Gateway
+		name                     string
+		expectedSectionNameRefs  []ParentRef
+		expectedGatewayListeners []*Listener
+	}{
+		{
+			route: routeAttached,
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createListener("tls-listener", "*.example.com", kindTLSRoute),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:     0,
+					Gateway: client.ObjectKeyFromObject(gw),
+					Attachment: &ParentRefAttachmentStatus{
+						Attached: true,
+						AcceptedHostnames: map[string][]string{
+							"tls-listener": {"app.example.com"},
+						},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{expListenerWithRoute},
+			name:                     "attached to TLS listener",
+		},
+		{
+			route: routeNotAllowed,
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createListener("http-listener", "*.example.com", policies.KindHTTPRoute),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:     0,
+					Gateway: client.ObjectKeyFromObject(gw),
+					Attachment: &ParentRefAttachmentStatus{
+						Attached:          false,
+						FailedCondition:   staticConds.NewRouteNotAllowedByListeners(),
+						AcceptedHostnames: map[string][]string{},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createListener("http-listener", "*.example.com", policies.KindHTTPRoute),
+			},
+			name: "route kind not allowed by listener",
+		},
+		{
+			route: routeNoMatchingHostname,
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createListener("tls-listener", "foo.example.com", kindTLSRoute),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:     0,
+					Gateway: client.ObjectKeyFromObject(gw),
+					Attachment: &ParentRefAttachmentStatus{
+						Attached:          false,
+						FailedCondition:   staticConds.NewRouteNoMatchingListenerHostname(),
+						AcceptedHostnames: map[string][]string{},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createListener("tls-listener", "foo.example.com", kindTLSRoute),
+			},
+			name: "no matching listener hostname",
+		},
+		{
+			route: tcpRouteAttached,
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createListener("tcp-listener", "", kindTCPRoute),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:     0,
+					Gateway: client.ObjectKeyFromObject(gw),
+					Attachment: &ParentRefAttachmentStatus{
+						Attached: true,
+						AcceptedHostnames: map[string][]string{
+							"tcp-listener": {wildcardHostname},
+						},
+					},
+				},
+			},
+			expectedGatewayListeners: []*Listener{expTCPListenerWithRoute},
+			name:                     "attached to TCP listener",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			bindL4RouteToListeners(test.route, test.gateway, nil)
+
+			g.Expect(test.route.ParentRefs).To(Equal(test.expectedSectionNameRefs))
+			g.Expect(helpers.Diff(test.gateway.Listeners, test.expectedGatewayListeners)).To(BeEmpty())
+			g.Expect(test.route.Conditions).To(BeEmpty())
+		})
+	}
+}
+
+func TestFindAcceptedHostnames(t *testing.T) {
+	var listenerHostnameFoo gatewayv1.Hostname = "foo.example.com"
+	var listenerHostnameCafe gatewayv1.Hostname = "cafe.example.com"
+	var listenerHostnameWildcard gatewayv1.Hostname = "*.example.com"
+	routeHostnames := []gatewayv1.Hostname{"foo.example.com", "bar.example.com"}
+
+	tests := []struct {
+		listenerHostname *gatewayv1.Hostname
+		msg              string
+		routeHostnames   []gatewayv1.Hostname
+		expected         []string
+	}{
+		{
+			listenerHostname: &listenerHostnameFoo,
+			routeHostnames:   routeHostnames,
+			expected:         []string{"foo.example.com"},
+			msg:              "one match",
+		},
+		{
+			listenerHostname: &listenerHostnameCafe,
+			routeHostnames:   routeHostnames,
+			expected:         nil,
+			msg:              "no match",
+		},
+		{
+			listenerHostname: nil,
+			routeHostnames:   routeHostnames,
+			expected:         []string{"foo.example.com", "bar.example.com"},
+			msg:              "nil listener hostname",
+		},
+		{
+			listenerHostname: &listenerHostnameFoo,
+			routeHostnames:   nil,
+			expected:         []string{"foo.example.com"},
+			msg:              "route has empty hostnames",
+		},
+		{
+			listenerHostname: nil,
+			routeHostnames:   nil,
+			expected:         []string{wildcardHostname},
+			msg:              "both listener and route have empty hostnames",
+		},
+		{
+			listenerHostname: &listenerHostnameWildcard,
+			routeHostnames:   routeHostnames,
+			expected:         []string{"foo.example.com", "bar.example.com"},
+			msg:              "listener wildcard hostname",
+		},
+		{
+			listenerHostname: &listenerHostnameFoo,
+			routeHostnames:   []gatewayv1.Hostname{"*.example.com"},
+			expected:         []string{"foo.example.com"},
+			msg:              "route wildcard hostname; specific listener hostname",
+		},
+		{
+			listenerHostname: &listenerHostnameWildcard,
+			routeHostnames:   nil,
+			expected:         []string{"*.example.com"},
+			msg:              "listener wildcard hostname; nil route hostname",
+		},
+		{
+			listenerHostname: nil,
+			routeHostnames:   []gatewayv1.Hostname{"*.example.com"},
+			expected:         []string{"*.example.com"},
+			msg:              "route wildcard hostname; nil listener hostname",
+		},
+		{
+			listenerHostname: &listenerHostnameWildcard,
+			routeHostnames:   []gatewayv1.Hostname{"*.bar.example.com"},
+			expected:         []string{"*.bar.example.com"},
+			msg:              "route and listener wildcard hostnames",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewWithT(t)
+			result := findAcceptedHostnames(test.listenerHostname, test.routeHostnames)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestGetHostname(t *testing.T) {
+	var emptyHostname gatewayv1.Hostname
+	var hostname gatewayv1.Hostname = "example.com"
+
+	tests := []struct {
+		h        *gatewayv1.Hostname
+		expected string
+		msg      string
+	}{
+		{
+			h:        nil,
+			expected: "",
+			msg:      "nil hostname",
+		},
+		{
+			h:        &emptyHostname,
+			expected: "",
+			msg:      "empty hostname",
+		},
+		{
+			h:        &hostname,
+			expected: string(hostname),
+			msg:      "normal hostname",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewWithT(t)
+			result := getHostname(test.h)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestValidateHostnames(t *testing.T) {
+	const validHostname = "example.com"
+
+	tests := []struct {
+		name      string
+		hostnames []gatewayv1.Hostname
+		expectErr bool
+	}{
+		{
+			hostnames: []gatewayv1.Hostname{
+				validHostname,
+				"example.org",
+				"foo.example.net",
+			},
+			expectErr: false,
+			name:      "multiple valid",
+		},
+		{
+			hostnames: []gatewayv1.Hostname{
+				validHostname,
+				"",
+			},
+			expectErr: true,
+			name:      "valid and invalid",
+		},
+	}
+
+	path := field.NewPath("test")
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := validateHostnames(test.hostnames, path)
+
+			if test.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
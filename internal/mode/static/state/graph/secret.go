@@ -15,10 +15,18 @@ type Secret struct {
 	Source *apiv1.Secret
 }
 
+const (
+	// SecretTypeHtpasswd is the type of the Secrets that hold users and passwords in the htpasswd format.
+	SecretTypeHtpasswd apiv1.SecretType = "nginx.org/htpasswd"
+	// HtpasswdSecretKey is the key of the htpasswd data in a Secret of the SecretTypeHtpasswd type.
+	HtpasswdSecretKey = "htpasswd"
+)
+
 type secretEntry struct {
 	Secret
-	// err holds the corresponding error if the Secret is invalid or does not exist.
-	err error
+	// errs holds the validation error of the Secret for each type it was resolved as.
+	// The error is nil if the Secret is valid for the type.
+	errs map[apiv1.SecretType]error
 }
 
 // secretResolver wraps the cluster Secrets so that they can be resolved (includes validation). All resolved
@@ -35,35 +43,52 @@ func newSecretResolver(secrets map[types.NamespacedName]*apiv1.Secret) *secretRe
 	}
 }
 
-func (r *secretResolver) resolve(nsname types.NamespacedName) error {
-	if s, resolved := r.resolvedSecrets[nsname]; resolved {
-		return s.err
+// resolve resolves the Secret and validates that it is a valid Secret of the secretType.
+func (r *secretResolver) resolve(nsname types.NamespacedName, secretType apiv1.SecretType) error {
+	entry, resolved := r.resolvedSecrets[nsname]
+	if !resolved {
+		entry = &secretEntry{
+			Secret: Secret{
+				Source: r.clusterSecrets[nsname],
+			},
+			errs: make(map[apiv1.SecretType]error),
+		}
+		r.resolvedSecrets[nsname] = entry
+	}
+
+	if err, validated := entry.errs[secretType]; validated {
+		return err
 	}
 
-	secret, exist := r.clusterSecrets[nsname]
+	validationErr := validateSecret(entry.Source, secretType)
+	entry.errs[secretType] = validationErr
 
-	var validationErr error
+	return validationErr
+}
 
-	if !exist {
-		validationErr = errors.New("secret does not exist")
-	} else if secret.Type != apiv1.SecretTypeTLS {
-		validationErr = fmt.Errorf("secret type must be %q not %q", apiv1.SecretTypeTLS, secret.Type)
-	} else {
+func validateSecret(secret *apiv1.Secret, secretType apiv1.SecretType) error {
+	if secret == nil {
+		return errors.New("secret does not exist")
+	}
+
+	if secret.Type != secretType {
+		return fmt.Errorf("secret type must be %q not %q", secretType, secret.Type)
+	}
+
+	switch secretType {
+	case apiv1.SecretTypeTLS:
 		// A TLS Secret is guaranteed to have these data fields.
 		_, err := tls.X509KeyPair(secret.Data[apiv1.TLSCertKey], secret.Data[apiv1.TLSPrivateKeyKey])
 		if err != nil {
-			validationErr = fmt.Errorf("TLS secret is invalid: %w", err)
+			return fmt.Errorf("TLS secret is invalid: %w", err)
+		}
+	case SecretTypeHtpasswd:
+		if len(secret.Data[HtpasswdSecretKey]) == 0 {
+			return fmt.Errorf("htpasswd secret must have non-empty %q data", HtpasswdSecretKey)
 		}
 	}
 
-	r.resolvedSecrets[nsname] = &secretEntry{
-		Secret: Secret{
-			Source: secret,
-		},
-		err: validationErr,
-	}
-
-	return validationErr
+	return nil
 }
 
 func (r *secretResolver) getResolvedSecrets() map[types.NamespacedName]*Secret {
@@ -128,6 +128,28 @@ func TestSecretResolver(t *testing.T) {
 			Type: apiv1.SecretTypeTLS,
 		}
 
+		validHtpasswdSecret = &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "htpasswd",
+			},
+			Data: map[string][]byte{
+				HtpasswdSecretKey: []byte("user:$apr1$YwsbMPqt$JBeDWSQqVTBiRkYPnpzry1"),
+			},
+			Type: SecretTypeHtpasswd,
+		}
+
+		invalidHtpasswdSecret = &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "invalid-htpasswd",
+			},
+			Data: map[string][]byte{
+				"auth": []byte("user:$apr1$YwsbMPqt$JBeDWSQqVTBiRkYPnpzry1"),
+			},
+			Type: SecretTypeHtpasswd,
+		}
+
 		secretNotExistNsName = types.NamespacedName{
 			Namespace: "test",
 			Name:      "not-exist",
@@ -136,16 +158,19 @@ func TestSecretResolver(t *testing.T) {
 
 	resolver := newSecretResolver(
 		map[types.NamespacedName]*apiv1.Secret{
-			client.ObjectKeyFromObject(validSecret1):      validSecret1,
-			client.ObjectKeyFromObject(validSecret2):      validSecret2, // we're not going to resolve it
-			client.ObjectKeyFromObject(invalidSecretType): invalidSecretType,
-			client.ObjectKeyFromObject(invalidSecretCert): invalidSecretCert,
-			client.ObjectKeyFromObject(invalidSecretKey):  invalidSecretKey,
+			client.ObjectKeyFromObject(validSecret1):          validSecret1,
+			client.ObjectKeyFromObject(validSecret2):          validSecret2, // we're not going to resolve it
+			client.ObjectKeyFromObject(invalidSecretType):     invalidSecretType,
+			client.ObjectKeyFromObject(invalidSecretCert):     invalidSecretCert,
+			client.ObjectKeyFromObject(invalidSecretKey):      invalidSecretKey,
+			client.ObjectKeyFromObject(validHtpasswdSecret):   validHtpasswdSecret,
+			client.ObjectKeyFromObject(invalidHtpasswdSecret): invalidHtpasswdSecret,
 		})
 
 	tests := []struct {
 		name           string
 		nsname         types.NamespacedName
+		secretType     apiv1.SecretType
 		expectedErrMsg string
 	}{
 		{
@@ -181,6 +206,27 @@ func TestSecretResolver(t *testing.T) {
 			nsname:         client.ObjectKeyFromObject(invalidSecretKey),
 			expectedErrMsg: "TLS secret is invalid: tls: failed to parse private key",
 		},
+		{
+			name:       "valid htpasswd secret",
+			nsname:     client.ObjectKeyFromObject(validHtpasswdSecret),
+			secretType: SecretTypeHtpasswd,
+		},
+		{
+			name:           "invalid htpasswd secret",
+			nsname:         client.ObjectKeyFromObject(invalidHtpasswdSecret),
+			secretType:     SecretTypeHtpasswd,
+			expectedErrMsg: `htpasswd secret must have non-empty "htpasswd" data`,
+		},
+		{
+			name:           "valid TLS secret resolved as htpasswd secret",
+			nsname:         client.ObjectKeyFromObject(validSecret1),
+			secretType:     SecretTypeHtpasswd,
+			expectedErrMsg: `secret type must be "nginx.org/htpasswd" not "kubernetes.io/tls"`,
+		},
+		{
+			name:   "valid TLS secret resolved as TLS secret after htpasswd secret",
+			nsname: client.ObjectKeyFromObject(validSecret1),
+		},
 	}
 
 	// Not running tests with t.Run(...) because the last one (getResolvedSecrets) depends on the execution of
@@ -189,7 +235,12 @@ func TestSecretResolver(t *testing.T) {
 	g := NewWithT(t)
 
 	for _, test := range tests {
-		err := resolver.resolve(test.nsname)
+		secretType := test.secretType
+		if secretType == "" {
+			secretType = apiv1.SecretTypeTLS
+		}
+
+		err := resolver.resolve(test.nsname, secretType)
 		if test.expectedErrMsg == "" {
 			g.Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("case %q", test.name))
 		} else {
@@ -210,6 +261,12 @@ func TestSecretResolver(t *testing.T) {
 		client.ObjectKeyFromObject(invalidSecretKey): {
 			Source: invalidSecretKey,
 		},
+		client.ObjectKeyFromObject(validHtpasswdSecret): {
+			Source: validHtpasswdSecret,
+		},
+		client.ObjectKeyFromObject(invalidHtpasswdSecret): {
+			Source: invalidHtpasswdSecret,
+		},
 		secretNotExistNsName: {
 			Source: nil,
 		},
@@ -2,39 +2,88 @@ package graph
 
 import (
 	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
 )
 
+// ReferencedService represents a Service referenced by a Route, by an ExternalAuthPolicy of a Route or by
+// an ErrorPagePolicy of the Gateway or a Route.
+type ReferencedService struct {
+	// EffectivePolicies holds the effective NGF Policies of the Service, one per Policy kind.
+	EffectivePolicies []policies.Policy
+}
+
 func buildReferencedServices(
-	routes map[types.NamespacedName]*Route,
-) map[types.NamespacedName]struct{} {
-	svcNames := make(map[types.NamespacedName]struct{})
+	routes map[RouteKey]*L7Route,
+	l4Routes map[RouteKey]*L4Route,
+	pols map[PolicyKey]policies.Policy,
+	gw *Gateway,
+) map[types.NamespacedName]*ReferencedService {
+	svcNames := make(map[types.NamespacedName]*ReferencedService)
 
 	// routes all have populated ParentRefs from when they were created.
 	//
-	// Get all the service names referenced from all the HTTPRoutes.
+	// Get all the service names referenced from all the Routes.
 	for _, route := range routes {
 		if !route.Valid {
 			continue
 		}
 
 		// If none of the ParentRefs are attached to the Gateway, we want to skip the route.
-		attached := false
-		for _, ref := range route.ParentRefs {
-			if ref.Attachment.Attached {
-				attached = true
-				break
-			}
-		}
-		if !attached {
+		if !isAttachedToGateway(route.ParentRefs) {
 			continue
 		}
 
-		for _, rule := range route.Rules {
+		for _, rule := range route.Spec.Rules {
 			for _, ref := range rule.BackendRefs {
 				// Processes both valid and invalid BackendRefs as invalid ones still have referenced services
 				// we may want to track.
 				if ref.SvcNsName != (types.NamespacedName{}) {
-					svcNames[ref.SvcNsName] = struct{}{}
+					svcNames[ref.SvcNsName] = &ReferencedService{}
+				}
+			}
+
+			if ref := rule.MirrorBackendRef; ref != nil && ref.SvcNsName != (types.NamespacedName{}) {
+				svcNames[ref.SvcNsName] = &ReferencedService{}
+			}
+		}
+	}
+
+	for _, route := range l4Routes {
+		if !route.Valid || !isAttachedToGateway(route.ParentRefs) {
+			continue
+		}
+
+		for _, ref := range route.Spec.BackendRefs {
+			// Processes both valid and invalid BackendRefs as invalid ones still have referenced services
+			// we may want to track.
+			if ref.SvcNsName != (types.NamespacedName{}) {
+				svcNames[ref.SvcNsName] = &ReferencedService{}
+			}
+		}
+	}
+
+	// The authorization services of the ExternalAuthPolicies are referenced by the Routes that the Policies target.
+	// The error page services of the ErrorPagePolicies are referenced by the Gateway or the Routes that the Policies
+	// target.
+	for _, policy := range pols {
+		switch p := policy.(type) {
+		case *ngfAPI.ExternalAuthPolicy:
+			if !isPolicyRouteAttached(p, routes) {
+				continue
+			}
+
+			// Like with the invalid BackendRefs, the Services of the invalid Policies are tracked too.
+			svcNames[GetExternalAuthServiceNsName(p)] = &ReferencedService{}
+		case *ngfAPI.ErrorPagePolicy:
+			if !isPolicyGatewayTarget(p, gw) && !isPolicyRouteAttached(p, routes) {
+				continue
+			}
+
+			for _, page := range p.Spec.Pages {
+				if page.BackendRef != nil {
+					svcNames[GetErrorPageServiceNsName(p, *page.BackendRef)] = &ReferencedService{}
 				}
 			}
 		}
@@ -45,3 +94,40 @@ func buildReferencedServices(
 	}
 	return svcNames
 }
+
+// isPolicyRouteAttached returns true if the Policy targets an HTTPRoute that is valid and attached to the Gateway.
+func isPolicyRouteAttached(policy policies.Policy, routes map[RouteKey]*L7Route) bool {
+	ref := policy.GetTargetRef()
+	if ref.Kind != policies.KindHTTPRoute {
+		return false
+	}
+
+	routeKey := RouteKey{
+		NamespacedName: types.NamespacedName{Namespace: policy.GetNamespace(), Name: string(ref.Name)},
+		RouteType:      RouteTypeHTTP,
+	}
+
+	route, exists := routes[routeKey]
+
+	return exists && route.Valid && isAttachedToGateway(route.ParentRefs)
+}
+
+// isPolicyGatewayTarget returns true if the Policy targets the Gateway.
+func isPolicyGatewayTarget(policy policies.Policy, gw *Gateway) bool {
+	ref := policy.GetTargetRef()
+
+	return gw != nil &&
+		ref.Kind == policies.KindGateway &&
+		policy.GetNamespace() == gw.Source.Namespace &&
+		string(ref.Name) == gw.Source.Name
+}
+
+func isAttachedToGateway(parentRefs []ParentRef) bool {
+	for _, ref := range parentRefs {
+		if ref.Attachment.Attached {
+			return true
+		}
+	}
+
+	return false
+}
@@ -4,11 +4,18 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
 )
 
 func TestBuildReferencedServices(t *testing.T) {
-	normalRoute := &Route{
+	normalRoute := &L7Route{
 		ParentRefs: []ParentRef{
 			{
 				Attachment: &ParentRefAttachmentStatus{
@@ -17,21 +24,23 @@ func TestBuildReferencedServices(t *testing.T) {
 			},
 		},
 		Valid: true,
-		Rules: []Rule{
-			{
-				BackendRefs: []BackendRef{
-					{
-						SvcNsName: types.NamespacedName{Namespace: "banana-ns", Name: "service"},
-						Weight:    1,
+		Spec: L7RouteSpec{
+			Rules: []RouteRule{
+				{
+					BackendRefs: []BackendRef{
+						{
+							SvcNsName: types.NamespacedName{Namespace: "banana-ns", Name: "service"},
+							Weight:    1,
+						},
 					},
+					ValidMatches: true,
+					ValidFilters: true,
 				},
-				ValidMatches: true,
-				ValidFilters: true,
 			},
 		},
 	}
 
-	validRouteTwoServicesOneRule := &Route{
+	validRouteTwoServicesOneRule := &L7Route{
 		ParentRefs: []ParentRef{
 			{
 				Attachment: &ParentRefAttachmentStatus{
@@ -40,25 +49,27 @@ func TestBuildReferencedServices(t *testing.T) {
 			},
 		},
 		Valid: true,
-		Rules: []Rule{
-			{
-				BackendRefs: []BackendRef{
-					{
-						SvcNsName: types.NamespacedName{Namespace: "service-ns", Name: "service"},
-						Weight:    1,
-					},
-					{
-						SvcNsName: types.NamespacedName{Namespace: "service-ns2", Name: "service2"},
-						Weight:    1,
+		Spec: L7RouteSpec{
+			Rules: []RouteRule{
+				{
+					BackendRefs: []BackendRef{
+						{
+							SvcNsName: types.NamespacedName{Namespace: "service-ns", Name: "service"},
+							Weight:    1,
+						},
+						{
+							SvcNsName: types.NamespacedName{Namespace: "service-ns2", Name: "service2"},
+							Weight:    1,
+						},
 					},
+					ValidMatches: true,
+					ValidFilters: true,
 				},
-				ValidMatches: true,
-				ValidFilters: true,
 			},
 		},
 	}
 
-	validRouteTwoServicesTwoRules := &Route{
+	validRouteTwoServicesTwoRules := &L7Route{
 		ParentRefs: []ParentRef{
 			{
 				Attachment: &ParentRefAttachmentStatus{
@@ -67,31 +78,33 @@ func TestBuildReferencedServices(t *testing.T) {
 			},
 		},
 		Valid: true,
-		Rules: []Rule{
-			{
-				BackendRefs: []BackendRef{
-					{
-						SvcNsName: types.NamespacedName{Namespace: "service-ns", Name: "service"},
-						Weight:    1,
+		Spec: L7RouteSpec{
+			Rules: []RouteRule{
+				{
+					BackendRefs: []BackendRef{
+						{
+							SvcNsName: types.NamespacedName{Namespace: "service-ns", Name: "service"},
+							Weight:    1,
+						},
 					},
+					ValidMatches: true,
+					ValidFilters: true,
 				},
-				ValidMatches: true,
-				ValidFilters: true,
-			},
-			{
-				BackendRefs: []BackendRef{
-					{
-						SvcNsName: types.NamespacedName{Namespace: "service-ns2", Name: "service2"},
-						Weight:    1,
+				{
+					BackendRefs: []BackendRef{
+						{
+							SvcNsName: types.NamespacedName{Namespace: "service-ns2", Name: "service2"},
+							Weight:    1,
+						},
 					},
+					ValidMatches: true,
+					ValidFilters: true,
 				},
-				ValidMatches: true,
-				ValidFilters: true,
 			},
 		},
 	}
 
-	invalidRoute := &Route{
+	invalidRoute := &L7Route{
 		ParentRefs: []ParentRef{
 			{
 				Attachment: &ParentRefAttachmentStatus{
@@ -100,21 +113,23 @@ func TestBuildReferencedServices(t *testing.T) {
 			},
 		},
 		Valid: false,
-		Rules: []Rule{
-			{
-				BackendRefs: []BackendRef{
-					{
-						SvcNsName: types.NamespacedName{Namespace: "service-ns", Name: "service"},
-						Weight:    1,
+		Spec: L7RouteSpec{
+			Rules: []RouteRule{
+				{
+					BackendRefs: []BackendRef{
+						{
+							SvcNsName: types.NamespacedName{Namespace: "service-ns", Name: "service"},
+							Weight:    1,
+						},
 					},
+					ValidMatches: true,
+					ValidFilters: true,
 				},
-				ValidMatches: true,
-				ValidFilters: true,
 			},
 		},
 	}
 
-	unattachedRoute := &Route{
+	unattachedRoute := &L7Route{
 		ParentRefs: []ParentRef{
 			{
 				Attachment: &ParentRefAttachmentStatus{
@@ -123,21 +138,23 @@ func TestBuildReferencedServices(t *testing.T) {
 			},
 		},
 		Valid: true,
-		Rules: []Rule{
-			{
-				BackendRefs: []BackendRef{
-					{
-						SvcNsName: types.NamespacedName{Namespace: "service-ns", Name: "service"},
-						Weight:    1,
+		Spec: L7RouteSpec{
+			Rules: []RouteRule{
+				{
+					BackendRefs: []BackendRef{
+						{
+							SvcNsName: types.NamespacedName{Namespace: "service-ns", Name: "service"},
+							Weight:    1,
+						},
 					},
+					ValidMatches: true,
+					ValidFilters: true,
 				},
-				ValidMatches: true,
-				ValidFilters: true,
 			},
 		},
 	}
 
-	attachedRouteWithManyParentRefs := &Route{
+	attachedRouteWithManyParentRefs := &L7Route{
 		ParentRefs: []ParentRef{
 			{
 				Attachment: &ParentRefAttachmentStatus{
@@ -156,20 +173,75 @@ func TestBuildReferencedServices(t *testing.T) {
 			},
 		},
 		Valid: true,
-		Rules: []Rule{
+		Spec: L7RouteSpec{
+			Rules: []RouteRule{
+				{
+					BackendRefs: []BackendRef{
+						{
+							SvcNsName: types.NamespacedName{Namespace: "service-ns", Name: "service"},
+							Weight:    1,
+						},
+					},
+					ValidMatches: true,
+					ValidFilters: true,
+				},
+			},
+		},
+	}
+	validRouteNoServiceNsName := &L7Route{
+		ParentRefs: []ParentRef{
 			{
-				BackendRefs: []BackendRef{
-					{
-						SvcNsName: types.NamespacedName{Namespace: "service-ns", Name: "service"},
+				Attachment: &ParentRefAttachmentStatus{
+					Attached: true,
+				},
+			},
+		},
+		Valid: true,
+		Spec: L7RouteSpec{
+			Rules: []RouteRule{
+				{
+					BackendRefs: []BackendRef{
+						{
+							Weight: 1,
+						},
+					},
+					ValidMatches: true,
+					ValidFilters: true,
+				},
+			},
+		},
+	}
+
+	validRouteWithMirror := &L7Route{
+		ParentRefs: []ParentRef{
+			{
+				Attachment: &ParentRefAttachmentStatus{
+					Attached: true,
+				},
+			},
+		},
+		Valid: true,
+		Spec: L7RouteSpec{
+			Rules: []RouteRule{
+				{
+					BackendRefs: []BackendRef{
+						{
+							SvcNsName: types.NamespacedName{Namespace: "banana-ns", Name: "service"},
+							Weight:    1,
+						},
+					},
+					MirrorBackendRef: &BackendRef{
+						SvcNsName: types.NamespacedName{Namespace: "banana-ns", Name: "mirror-service"},
 						Weight:    1,
 					},
+					ValidMatches: true,
+					ValidFilters: true,
 				},
-				ValidMatches: true,
-				ValidFilters: true,
 			},
 		},
 	}
-	validRouteNoServiceNsName := &Route{
+
+	validL4Route := &L4Route{
 		ParentRefs: []ParentRef{
 			{
 				Attachment: &ParentRefAttachmentStatus{
@@ -178,71 +250,171 @@ func TestBuildReferencedServices(t *testing.T) {
 			},
 		},
 		Valid: true,
-		Rules: []Rule{
+		Spec: L4RouteSpec{
+			BackendRefs: []BackendRef{
+				{
+					SvcNsName: types.NamespacedName{Namespace: "tls-ns", Name: "tls-service"},
+				},
+			},
+		},
+	}
+
+	invalidL4Route := &L4Route{
+		ParentRefs: []ParentRef{
 			{
-				BackendRefs: []BackendRef{
-					{
-						Weight: 1,
-					},
+				Attachment: &ParentRefAttachmentStatus{
+					Attached: true,
+				},
+			},
+		},
+		Valid: false,
+	}
+
+	unattachedL4Route := &L4Route{
+		ParentRefs: []ParentRef{
+			{
+				Attachment: &ParentRefAttachmentStatus{
+					Attached: false,
+				},
+			},
+		},
+		Valid: true,
+		Spec: L4RouteSpec{
+			BackendRefs: []BackendRef{
+				{
+					SvcNsName: types.NamespacedName{Namespace: "tls-ns", Name: "unattached-service"},
 				},
-				ValidMatches: true,
-				ValidFilters: true,
 			},
 		},
 	}
 
+	createExternalAuthPolicy := func(name, route string, backendRef v1.BackendObjectReference) policies.Policy {
+		return &ngfAPI.ExternalAuthPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "banana-ns", Name: name},
+			Spec: ngfAPI.ExternalAuthPolicySpec{
+				TargetRef: v1alpha2.PolicyTargetReference{
+					Group: v1.GroupName,
+					Kind:  policies.KindHTTPRoute,
+					Name:  v1.ObjectName(route),
+				},
+				BackendRef: backendRef,
+			},
+		}
+	}
+
+	externalAuthPolicies := map[PolicyKey]policies.Policy{
+		{NsName: types.NamespacedName{Namespace: "banana-ns", Name: "auth"}}: createExternalAuthPolicy(
+			"auth",
+			"normal-route",
+			v1.BackendObjectReference{Name: "authz"},
+		),
+		{NsName: types.NamespacedName{Namespace: "banana-ns", Name: "auth-other-ns"}}: createExternalAuthPolicy(
+			"auth-other-ns",
+			"normal-route",
+			v1.BackendObjectReference{Name: "authz", Namespace: helpers.GetPointer[v1.Namespace]("authz-ns")},
+		),
+		{NsName: types.NamespacedName{Namespace: "banana-ns", Name: "auth-no-route"}}: createExternalAuthPolicy(
+			"auth-no-route",
+			"not-exist",
+			v1.BackendObjectReference{Name: "unused"},
+		),
+		{NsName: types.NamespacedName{Namespace: "banana-ns", Name: "other"}}: &ngfAPI.ClientSettingsPolicy{},
+	}
+
+	createErrorPagePolicy := func(name string, kind v1.Kind, target string) policies.Policy {
+		return &ngfAPI.ErrorPagePolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "banana-ns", Name: name},
+			Spec: ngfAPI.ErrorPagePolicySpec{
+				TargetRef: v1alpha2.PolicyTargetReference{
+					Group: v1.GroupName,
+					Kind:  kind,
+					Name:  v1.ObjectName(target),
+				},
+				Pages: []ngfAPI.ErrorPage{
+					{BackendRef: &v1.BackendObjectReference{Name: v1.ObjectName(name + "-errors")}},
+					{Content: &ngfAPI.ErrorPageContent{ConfigMapName: "pages", Key: "404.html"}},
+				},
+			},
+		}
+	}
+
+	errorPagePolicies := map[PolicyKey]policies.Policy{
+		{NsName: types.NamespacedName{Namespace: "banana-ns", Name: "gw"}}: createErrorPagePolicy(
+			"gw",
+			policies.KindGateway,
+			"gateway",
+		),
+		{NsName: types.NamespacedName{Namespace: "banana-ns", Name: "route"}}: createErrorPagePolicy(
+			"route",
+			policies.KindHTTPRoute,
+			"normal-route",
+		),
+		{NsName: types.NamespacedName{Namespace: "banana-ns", Name: "other-gw"}}: createErrorPagePolicy(
+			"other-gw",
+			policies.KindGateway,
+			"other-gateway",
+		),
+	}
+
+	gateway := &Gateway{
+		Source: &v1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "banana-ns", Name: "gateway"}},
+	}
+
 	tests := []struct {
-		routes map[types.NamespacedName]*Route
-		exp    map[types.NamespacedName]struct{}
-		name   string
+		routes   map[RouteKey]*L7Route
+		l4Routes map[RouteKey]*L4Route
+		policies map[PolicyKey]policies.Policy
+		exp      map[types.NamespacedName]*ReferencedService
+		gw       *Gateway
+		name     string
 	}{
 		{
 			name: "normal route",
-			routes: map[types.NamespacedName]*Route{
-				{Name: "normal-route"}: normalRoute,
+			routes: map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Name: "normal-route"}}: normalRoute,
 			},
-			exp: map[types.NamespacedName]struct{}{
+			exp: map[types.NamespacedName]*ReferencedService{
 				{Namespace: "banana-ns", Name: "service"}: {},
 			},
 		},
 		{
 			name: "route with two services in one Rule",
-			routes: map[types.NamespacedName]*Route{
-				{Name: "two-svc-one-rule"}: validRouteTwoServicesOneRule,
+			routes: map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Name: "two-svc-one-rule"}}: validRouteTwoServicesOneRule,
 			},
-			exp: map[types.NamespacedName]struct{}{
+			exp: map[types.NamespacedName]*ReferencedService{
 				{Namespace: "service-ns", Name: "service"}:   {},
 				{Namespace: "service-ns2", Name: "service2"}: {},
 			},
 		},
 		{
 			name: "route with one service per rule",
-			routes: map[types.NamespacedName]*Route{
-				{Name: "one-svc-per-rule"}: validRouteTwoServicesTwoRules,
+			routes: map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Name: "one-svc-per-rule"}}: validRouteTwoServicesTwoRules,
 			},
-			exp: map[types.NamespacedName]struct{}{
+			exp: map[types.NamespacedName]*ReferencedService{
 				{Namespace: "service-ns", Name: "service"}:   {},
 				{Namespace: "service-ns2", Name: "service2"}: {},
 			},
 		},
 		{
 			name: "two valid routes with same services",
-			routes: map[types.NamespacedName]*Route{
-				{Name: "one-svc-per-rule"}: validRouteTwoServicesTwoRules,
-				{Name: "two-svc-one-rule"}: validRouteTwoServicesOneRule,
+			routes: map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Name: "one-svc-per-rule"}}: validRouteTwoServicesTwoRules,
+				{NamespacedName: types.NamespacedName{Name: "two-svc-one-rule"}}: validRouteTwoServicesOneRule,
 			},
-			exp: map[types.NamespacedName]struct{}{
+			exp: map[types.NamespacedName]*ReferencedService{
 				{Namespace: "service-ns", Name: "service"}:   {},
 				{Namespace: "service-ns2", Name: "service2"}: {},
 			},
 		},
 		{
 			name: "two valid routes with different services",
-			routes: map[types.NamespacedName]*Route{
-				{Name: "one-svc-per-rule"}: validRouteTwoServicesTwoRules,
-				{Name: "normal-route"}:     normalRoute,
+			routes: map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Name: "one-svc-per-rule"}}: validRouteTwoServicesTwoRules,
+				{NamespacedName: types.NamespacedName{Name: "normal-route"}}:     normalRoute,
 			},
-			exp: map[types.NamespacedName]struct{}{
+			exp: map[types.NamespacedName]*ReferencedService{
 				{Namespace: "service-ns", Name: "service"}:   {},
 				{Namespace: "service-ns2", Name: "service2"}: {},
 				{Namespace: "banana-ns", Name: "service"}:    {},
@@ -250,50 +422,161 @@ func TestBuildReferencedServices(t *testing.T) {
 		},
 		{
 			name: "invalid route",
-			routes: map[types.NamespacedName]*Route{
-				{Name: "invalid-route"}: invalidRoute,
+			routes: map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Name: "invalid-route"}}: invalidRoute,
 			},
 			exp: nil,
 		},
 		{
 			name: "unattached route",
-			routes: map[types.NamespacedName]*Route{
-				{Name: "unattached-route"}: unattachedRoute,
+			routes: map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Name: "unattached-route"}}: unattachedRoute,
 			},
 			exp: nil,
 		},
 		{
 			name: "combination of valid and invalid routes",
-			routes: map[types.NamespacedName]*Route{
-				{Name: "normal-route"}:  normalRoute,
-				{Name: "invalid-route"}: invalidRoute,
+			routes: map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Name: "normal-route"}}:  normalRoute,
+				{NamespacedName: types.NamespacedName{Name: "invalid-route"}}: invalidRoute,
 			},
-			exp: map[types.NamespacedName]struct{}{
+			exp: map[types.NamespacedName]*ReferencedService{
 				{Namespace: "banana-ns", Name: "service"}: {},
 			},
 		},
 		{
 			name: "route with many parentRefs and one is attached",
-			routes: map[types.NamespacedName]*Route{
-				{Name: "multiple-parent-ref-route"}: attachedRouteWithManyParentRefs,
+			routes: map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Name: "multiple-parent-ref-route"}}: attachedRouteWithManyParentRefs,
 			},
-			exp: map[types.NamespacedName]struct{}{
+			exp: map[types.NamespacedName]*ReferencedService{
 				{Namespace: "service-ns", Name: "service"}: {},
 			},
 		},
 		{
 			name: "valid route no service nsname",
-			routes: map[types.NamespacedName]*Route{
-				{Name: "no-service-nsname"}: validRouteNoServiceNsName,
+			routes: map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Name: "no-service-nsname"}}: validRouteNoServiceNsName,
 			},
 			exp: nil,
 		},
+		{
+			name: "route with mirror",
+			routes: map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Name: "mirror-route"}}: validRouteWithMirror,
+			},
+			exp: map[types.NamespacedName]*ReferencedService{
+				{Namespace: "banana-ns", Name: "service"}:        {},
+				{Namespace: "banana-ns", Name: "mirror-service"}: {},
+			},
+		},
 	}
 
+	policyTests := []struct {
+		routes   map[RouteKey]*L7Route
+		l4Routes map[RouteKey]*L4Route
+		policies map[PolicyKey]policies.Policy
+		exp      map[types.NamespacedName]*ReferencedService
+		gw       *Gateway
+		name     string
+	}{
+		{
+			name: "route with external auth policies",
+			routes: map[RouteKey]*L7Route{
+				{
+					NamespacedName: types.NamespacedName{Namespace: "banana-ns", Name: "normal-route"},
+					RouteType:      RouteTypeHTTP,
+				}: normalRoute,
+			},
+			policies: externalAuthPolicies,
+			exp: map[types.NamespacedName]*ReferencedService{
+				{Namespace: "banana-ns", Name: "service"}: {},
+				{Namespace: "banana-ns", Name: "authz"}:   {},
+				{Namespace: "authz-ns", Name: "authz"}:    {},
+			},
+		},
+		{
+			name: "invalid route with external auth policy",
+			routes: map[RouteKey]*L7Route{
+				{
+					NamespacedName: types.NamespacedName{Namespace: "banana-ns", Name: "normal-route"},
+					RouteType:      RouteTypeHTTP,
+				}: invalidRoute,
+			},
+			policies: externalAuthPolicies,
+			exp:      nil,
+		},
+		{
+			name: "gateway and route with error page policies",
+			routes: map[RouteKey]*L7Route{
+				{
+					NamespacedName: types.NamespacedName{Namespace: "banana-ns", Name: "normal-route"},
+					RouteType:      RouteTypeHTTP,
+				}: normalRoute,
+			},
+			policies: errorPagePolicies,
+			gw:       gateway,
+			exp: map[types.NamespacedName]*ReferencedService{
+				{Namespace: "banana-ns", Name: "service"}:      {},
+				{Namespace: "banana-ns", Name: "gw-errors"}:    {},
+				{Namespace: "banana-ns", Name: "route-errors"}: {},
+			},
+		},
+		{
+			name:     "error page policies without gateway and routes",
+			policies: errorPagePolicies,
+			exp:      nil,
+		},
+	}
+
+	tests = append(tests, policyTests...)
+
+	l4Tests := []struct {
+		routes   map[RouteKey]*L7Route
+		l4Routes map[RouteKey]*L4Route
+		policies map[PolicyKey]policies.Policy
+		exp      map[types.NamespacedName]*ReferencedService
+		gw       *Gateway
+		name     string
+	}{
+		{
+			name: "valid l4 route",
+			l4Routes: map[RouteKey]*L4Route{
+				{NamespacedName: types.NamespacedName{Name: "valid-l4-route"}}: validL4Route,
+			},
+			exp: map[types.NamespacedName]*ReferencedService{
+				{Namespace: "tls-ns", Name: "tls-service"}: {},
+			},
+		},
+		{
+			name: "invalid and unattached l4 routes",
+			l4Routes: map[RouteKey]*L4Route{
+				{NamespacedName: types.NamespacedName{Name: "invalid-l4-route"}}:    invalidL4Route,
+				{NamespacedName: types.NamespacedName{Name: "unattached-l4-route"}}: unattachedL4Route,
+			},
+			exp: nil,
+		},
+		{
+			name: "l7 and l4 routes",
+			routes: map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Name: "normal-route"}}: normalRoute,
+			},
+			l4Routes: map[RouteKey]*L4Route{
+				{NamespacedName: types.NamespacedName{Name: "valid-l4-route"}}: validL4Route,
+			},
+			exp: map[types.NamespacedName]*ReferencedService{
+				{Namespace: "banana-ns", Name: "service"}:  {},
+				{Namespace: "tls-ns", Name: "tls-service"}: {},
+			},
+		},
+	}
+
+	tests = append(tests, l4Tests...)
+
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
-			g.Expect(buildReferencedServices(test.routes)).To(Equal(test.exp))
+			g.Expect(buildReferencedServices(test.routes, test.l4Routes, test.policies, test.gw)).To(Equal(test.exp))
 		})
 	}
 }
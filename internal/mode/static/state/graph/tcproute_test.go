@@ -0,0 +1,173 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func createTCPRoute(
+	name string,
+	refName string,
+	rules []v1alpha2.TCPRouteRule,
+) *v1alpha2.TCPRoute {
+	return &v1alpha2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: v1alpha2.TCPRouteSpec{
+			CommonRouteSpec: v1.CommonRouteSpec{
+				ParentRefs: []v1.ParentReference{
+					{
+						Namespace:   helpers.GetPointer[v1.Namespace]("test"),
+						Name:        v1.ObjectName(refName),
+						SectionName: helpers.GetPointer[v1.SectionName]("tcp-listener"),
+					},
+				},
+			},
+			Rules: rules,
+		},
+	}
+}
+
+func TestBuildTCPRoute(t *testing.T) {
+	gatewayNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	createBackendRef := func(name string, weight int32) v1.BackendRef {
+		return v1.BackendRef{
+			BackendObjectReference: v1.BackendObjectReference{
+				Name: v1.ObjectName(name),
+				Port: helpers.GetPointer[v1.PortNumber](5432),
+			},
+			Weight: helpers.GetPointer(weight),
+		}
+	}
+
+	backendRefs := []v1.BackendRef{createBackendRef("service1", 80), createBackendRef("service2", 20)}
+
+	validRule := v1alpha2.TCPRouteRule{BackendRefs: backendRefs}
+
+	tcrValid := createTCPRoute("tcr", gatewayNsName.Name, []v1alpha2.TCPRouteRule{validRule})
+
+	tcrNoRules := createTCPRoute("tcr", gatewayNsName.Name, nil)
+
+	tcrTooManyRules := createTCPRoute("tcr", gatewayNsName.Name, []v1alpha2.TCPRouteRule{validRule, validRule})
+
+	tcrNoBackendRefs := createTCPRoute("tcr", gatewayNsName.Name, []v1alpha2.TCPRouteRule{{}})
+
+	tcrNotNGF := createTCPRoute("tcr", "some-gateway", []v1alpha2.TCPRouteRule{validRule})
+
+	tcrDuplicateSectionName := createTCPRoute("tcr", gatewayNsName.Name, []v1alpha2.TCPRouteRule{validRule})
+	tcrDuplicateSectionName.Spec.ParentRefs = append(
+		tcrDuplicateSectionName.Spec.ParentRefs,
+		tcrDuplicateSectionName.Spec.ParentRefs[0],
+	)
+
+	createParentRefs := func(tcr *v1alpha2.TCPRoute) []ParentRef {
+		return []ParentRef{
+			{
+				Idx:         0,
+				Gateway:     gatewayNsName,
+				SectionName: tcr.Spec.ParentRefs[0].SectionName,
+			},
+		}
+	}
+
+	tests := []struct {
+		tcr      *v1alpha2.TCPRoute
+		expected *L4Route
+		name     string
+	}{
+		{
+			tcr: tcrValid,
+			expected: &L4Route{
+				RouteType:  RouteTypeTCP,
+				Source:     tcrValid,
+				ParentRefs: createParentRefs(tcrValid),
+				Valid:      true,
+				Attachable: true,
+				Spec: L4RouteSpec{
+					RouteBackendRefs: backendRefs,
+				},
+			},
+			name: "valid",
+		},
+		{
+			tcr: tcrNoRules,
+			expected: &L4Route{
+				RouteType:  RouteTypeTCP,
+				Source:     tcrNoRules,
+				ParentRefs: createParentRefs(tcrNoRules),
+				Valid:      false,
+				Attachable: true,
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue(`spec.rules: Required value: one rule must be defined`),
+				},
+			},
+			name: "no rules",
+		},
+		{
+			tcr: tcrTooManyRules,
+			expected: &L4Route{
+				RouteType:  RouteTypeTCP,
+				Source:     tcrTooManyRules,
+				ParentRefs: createParentRefs(tcrTooManyRules),
+				Valid:      false,
+				Attachable: true,
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue(`spec.rules: Too many: 2: must have at most 1 items`),
+				},
+			},
+			name: "too many rules",
+		},
+		{
+			tcr: tcrNoBackendRefs,
+			expected: &L4Route{
+				RouteType:  RouteTypeTCP,
+				Source:     tcrNoBackendRefs,
+				ParentRefs: createParentRefs(tcrNoBackendRefs),
+				Valid:      false,
+				Attachable: true,
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue(
+						`spec.rules[0].backendRefs: Required value: at least one backendRef must be defined`,
+					),
+				},
+			},
+			name: "no backendRefs",
+		},
+		{
+			tcr:      tcrNotNGF,
+			expected: nil,
+			name:     "not NGF route",
+		},
+		{
+			tcr: tcrDuplicateSectionName,
+			expected: &L4Route{
+				RouteType: RouteTypeTCP,
+				Source:    tcrDuplicateSectionName,
+			},
+			name: "invalid route with duplicate sectionName",
+		},
+	}
+
+	gatewayNsNames := []types.NamespacedName{gatewayNsName}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			route := buildTCPRoute(test.tcr, gatewayNsNames)
+			g.Expect(helpers.Diff(test.expected, route)).To(BeEmpty())
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package graph
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func buildTLSRoute(
+	gtr *v1alpha2.TLSRoute,
+	gatewayNsNames []types.NamespacedName,
+) *L4Route {
+	r := &L4Route{
+		Source:    gtr,
+		RouteType: RouteTypeTLS,
+	}
+	sectionNameRefs, err := buildSectionNameRefs(gtr.Spec.ParentRefs, gtr.Namespace, gatewayNsNames)
+	if err != nil {
+		r.Valid = false
+
+		return r
+	}
+	// route doesn't belong to any of the Gateways
+	if len(sectionNameRefs) == 0 {
+		return nil
+	}
+	r.ParentRefs = sectionNameRefs
+
+	if err := validateHostnames(
+		gtr.Spec.Hostnames,
+		field.NewPath("spec").Child("hostnames"),
+	); err != nil {
+		r.Valid = false
+		r.Conditions = append(r.Conditions, staticConds.NewRouteUnsupportedValue(err.Error()))
+
+		return r
+	}
+
+	r.Spec.Hostnames = gtr.Spec.Hostnames
+
+	r.Attachable = true
+
+	if err := validateTLSRouteRules(gtr.Spec.Rules); err != nil {
+		r.Valid = false
+		r.Conditions = append(r.Conditions, staticConds.NewRouteUnsupportedValue(err.Error()))
+
+		return r
+	}
+
+	r.Spec.RouteBackendRefs = gtr.Spec.Rules[0].BackendRefs
+
+	r.Valid = true
+
+	return r
+}
+
+// validateTLSRouteRules validates that the TLSRoute has exactly one rule with exactly one backendRef.
+// NGINX proxies a TLS passthrough connection to a single upstream, so multiple rules or backendRefs are not supported.
+func validateTLSRouteRules(rules []v1alpha2.TLSRouteRule) error {
+	rulesPath := field.NewPath("spec").Child("rules")
+
+	switch l := len(rules); {
+	case l == 0:
+		return field.Required(rulesPath, "one rule must be defined")
+	case l > 1:
+		return field.TooMany(rulesPath, l, 1)
+	}
+
+	refsPath := rulesPath.Index(0).Child("backendRefs")
+
+	switch l := len(rules[0].BackendRefs); {
+	case l == 0:
+		return field.Required(refsPath, "one backendRef must be defined")
+	case l > 1:
+		return field.TooMany(refsPath, l, 1)
+	}
+
+	return nil
+}
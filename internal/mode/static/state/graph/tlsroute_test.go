@@ -0,0 +1,366 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func createTLSRoute(
+	name string,
+	refName string,
+	hostname v1.Hostname,
+	rules []v1alpha2.TLSRouteRule,
+) *v1alpha2.TLSRoute {
+	return &v1alpha2.TLSRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: v1alpha2.TLSRouteSpec{
+			CommonRouteSpec: v1.CommonRouteSpec{
+				ParentRefs: []v1.ParentReference{
+					{
+						Namespace:   helpers.GetPointer[v1.Namespace]("test"),
+						Name:        v1.ObjectName(refName),
+						SectionName: helpers.GetPointer[v1.SectionName]("tls-listener"),
+					},
+				},
+			},
+			Hostnames: []v1.Hostname{hostname},
+			Rules:     rules,
+		},
+	}
+}
+
+func TestBuildL4RoutesForGateways(t *testing.T) {
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	backendRef := v1.BackendRef{
+		BackendObjectReference: v1.BackendObjectReference{
+			Name: "service1",
+			Port: helpers.GetPointer[v1.PortNumber](443),
+		},
+	}
+
+	tr := createTLSRoute(
+		"tr-1",
+		gwNsName.Name,
+		"app.example.com",
+		[]v1alpha2.TLSRouteRule{{BackendRefs: []v1.BackendRef{backendRef}}},
+	)
+
+	trWrongGateway := createTLSRoute(
+		"tr-2",
+		"some-gateway",
+		"app.example.com",
+		[]v1alpha2.TLSRouteRule{{BackendRefs: []v1.BackendRef{backendRef}}},
+	)
+
+	tlsRoutes := map[types.NamespacedName]*v1alpha2.TLSRoute{
+		client.ObjectKeyFromObject(tr):             tr,
+		client.ObjectKeyFromObject(trWrongGateway): trWrongGateway,
+	}
+
+	tcr := createTCPRoute(
+		"tcr-1",
+		gwNsName.Name,
+		[]v1alpha2.TCPRouteRule{{BackendRefs: []v1.BackendRef{backendRef}}},
+	)
+
+	tcpRoutes := map[types.NamespacedName]*v1alpha2.TCPRoute{
+		client.ObjectKeyFromObject(tcr): tcr,
+	}
+
+	ur := createUDPRoute(
+		"ur-1",
+		gwNsName.Name,
+		[]v1alpha2.UDPRouteRule{{BackendRefs: []v1.BackendRef{backendRef}}},
+	)
+
+	udpRoutes := map[types.NamespacedName]*v1alpha2.UDPRoute{
+		client.ObjectKeyFromObject(ur): ur,
+	}
+
+	tests := []struct {
+		expected  map[RouteKey]*L4Route
+		name      string
+		gwNsNames []types.NamespacedName
+	}{
+		{
+			gwNsNames: []types.NamespacedName{gwNsName},
+			expected: map[RouteKey]*L4Route{
+				CreateRouteKey(tr): {
+					RouteType: RouteTypeTLS,
+					Source:    tr,
+					ParentRefs: []ParentRef{
+						{
+							Idx:         0,
+							Gateway:     gwNsName,
+							SectionName: tr.Spec.ParentRefs[0].SectionName,
+						},
+					},
+					Valid:      true,
+					Attachable: true,
+					Spec: L4RouteSpec{
+						Hostnames:        tr.Spec.Hostnames,
+						RouteBackendRefs: []v1.BackendRef{backendRef},
+					},
+				},
+				CreateRouteKey(tcr): {
+					RouteType: RouteTypeTCP,
+					Source:    tcr,
+					ParentRefs: []ParentRef{
+						{
+							Idx:         0,
+							Gateway:     gwNsName,
+							SectionName: tcr.Spec.ParentRefs[0].SectionName,
+						},
+					},
+					Valid:      true,
+					Attachable: true,
+					Spec: L4RouteSpec{
+						RouteBackendRefs: []v1.BackendRef{backendRef},
+					},
+				},
+				CreateRouteKey(ur): {
+					RouteType: RouteTypeUDP,
+					Source:    ur,
+					ParentRefs: []ParentRef{
+						{
+							Idx:         0,
+							Gateway:     gwNsName,
+							SectionName: ur.Spec.ParentRefs[0].SectionName,
+						},
+					},
+					Valid:      true,
+					Attachable: true,
+					Spec: L4RouteSpec{
+						RouteBackendRefs: []v1.BackendRef{backendRef},
+					},
+				},
+			},
+			name: "normal case",
+		},
+		{
+			gwNsNames: []types.NamespacedName{},
+			expected:  nil,
+			name:      "no gateways",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			routes := buildL4RoutesForGateways(tlsRoutes, tcpRoutes, udpRoutes, test.gwNsNames)
+			g.Expect(helpers.Diff(test.expected, routes)).To(BeEmpty())
+		})
+	}
+}
+
+func TestBuildTLSRoute(t *testing.T) {
+	gatewayNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	backendRef := v1.BackendRef{
+		BackendObjectReference: v1.BackendObjectReference{
+			Name: "service1",
+			Port: helpers.GetPointer[v1.PortNumber](443),
+		},
+	}
+
+	validRule := v1alpha2.TLSRouteRule{BackendRefs: []v1.BackendRef{backendRef}}
+
+	trValid := createTLSRoute("tr", gatewayNsName.Name, "app.example.com", []v1alpha2.TLSRouteRule{validRule})
+
+	trInvalidHostname := createTLSRoute("tr", gatewayNsName.Name, "", []v1alpha2.TLSRouteRule{validRule})
+
+	trNoRules := createTLSRoute("tr", gatewayNsName.Name, "app.example.com", nil)
+
+	trTooManyRules := createTLSRoute(
+		"tr",
+		gatewayNsName.Name,
+		"app.example.com",
+		[]v1alpha2.TLSRouteRule{validRule, validRule},
+	)
+
+	trNoBackendRefs := createTLSRoute(
+		"tr",
+		gatewayNsName.Name,
+		"app.example.com",
+		[]v1alpha2.TLSRouteRule{{}},
+	)
+
+	trTooManyBackendRefs := createTLSRoute(
+		"tr",
+		gatewayNsName.Name,
+		"app.example.com",
+		[]v1alpha2.TLSRouteRule{{BackendRefs: []v1.BackendRef{backendRef, backendRef}}},
+	)
+
+	trNotNGF := createTLSRoute("tr", "some-gateway", "app.example.com", []v1alpha2.TLSRouteRule{validRule})
+
+	trDuplicateSectionName := createTLSRoute(
+		"tr",
+		gatewayNsName.Name,
+		"app.example.com",
+		[]v1alpha2.TLSRouteRule{validRule},
+	)
+	trDuplicateSectionName.Spec.ParentRefs = append(
+		trDuplicateSectionName.Spec.ParentRefs,
+		trDuplicateSectionName.Spec.ParentRefs[0],
+	)
+
+	createParentRefs := func(tr *v1alpha2.TLSRoute) []ParentRef {
+		return []ParentRef{
+			{
+				Idx:         0,
+				Gateway:     gatewayNsName,
+				SectionName: tr.Spec.ParentRefs[0].SectionName,
+			},
+		}
+	}
+
+	tests := []struct {
+		tr       *v1alpha2.TLSRoute
+		expected *L4Route
+		name     string
+	}{
+		{
+			tr: trValid,
+			expected: &L4Route{
+				RouteType:  RouteTypeTLS,
+				Source:     trValid,
+				ParentRefs: createParentRefs(trValid),
+				Valid:      true,
+				Attachable: true,
+				Spec: L4RouteSpec{
+					Hostnames:        trValid.Spec.Hostnames,
+					RouteBackendRefs: []v1.BackendRef{backendRef},
+				},
+			},
+			name: "valid",
+		},
+		{
+			tr: trInvalidHostname,
+			expected: &L4Route{
+				RouteType:  RouteTypeTLS,
+				Source:     trInvalidHostname,
+				ParentRefs: createParentRefs(trInvalidHostname),
+				Valid:      false,
+				Attachable: false,
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue(
+						`spec.hostnames[0]: Invalid value: "": cannot be empty string`,
+					),
+				},
+			},
+			name: "invalid hostname",
+		},
+		{
+			tr: trNoRules,
+			expected: &L4Route{
+				RouteType:  RouteTypeTLS,
+				Source:     trNoRules,
+				ParentRefs: createParentRefs(trNoRules),
+				Valid:      false,
+				Attachable: true,
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue(`spec.rules: Required value: one rule must be defined`),
+				},
+				Spec: L4RouteSpec{
+					Hostnames: trNoRules.Spec.Hostnames,
+				},
+			},
+			name: "no rules",
+		},
+		{
+			tr: trTooManyRules,
+			expected: &L4Route{
+				RouteType:  RouteTypeTLS,
+				Source:     trTooManyRules,
+				ParentRefs: createParentRefs(trTooManyRules),
+				Valid:      false,
+				Attachable: true,
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue(`spec.rules: Too many: 2: must have at most 1 items`),
+				},
+				Spec: L4RouteSpec{
+					Hostnames: trTooManyRules.Spec.Hostnames,
+				},
+			},
+			name: "too many rules",
+		},
+		{
+			tr: trNoBackendRefs,
+			expected: &L4Route{
+				RouteType:  RouteTypeTLS,
+				Source:     trNoBackendRefs,
+				ParentRefs: createParentRefs(trNoBackendRefs),
+				Valid:      false,
+				Attachable: true,
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue(
+						`spec.rules[0].backendRefs: Required value: one backendRef must be defined`,
+					),
+				},
+				Spec: L4RouteSpec{
+					Hostnames: trNoBackendRefs.Spec.Hostnames,
+				},
+			},
+			name: "no backendRefs",
+		},
+		{
+			tr: trTooManyBackendRefs,
+			expected: &L4Route{
+				RouteType:  RouteTypeTLS,
+				Source:     trTooManyBackendRefs,
+				ParentRefs: createParentRefs(trTooManyBackendRefs),
+				Valid:      false,
+				Attachable: true,
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue(
+						`spec.rules[0].backendRefs: Too many: 2: must have at most 1 items`,
+					),
+				},
+				Spec: L4RouteSpec{
+					Hostnames: trTooManyBackendRefs.Spec.Hostnames,
+				},
+			},
+			name: "too many backendRefs",
+		},
+		{
+			tr:       trNotNGF,
+			expected: nil,
+			name:     "not NGF route",
+		},
+		{
+			tr: trDuplicateSectionName,
+			expected: &L4Route{
+				RouteType: RouteTypeTLS,
+				Source:    trDuplicateSectionName,
+			},
+			name: "invalid route with duplicate sectionName",
+		},
+	}
+
+	gatewayNsNames := []types.NamespacedName{gatewayNsName}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			route := buildTLSRoute(test.tr, gatewayNsNames)
+			g.Expect(helpers.Diff(test.expected, route)).To(BeEmpty())
+		})
+	}
+}
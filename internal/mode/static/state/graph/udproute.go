@@ -0,0 +1,64 @@
+package graph
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func buildUDPRoute(
+	gtr *v1alpha2.UDPRoute,
+	gatewayNsNames []types.NamespacedName,
+) *L4Route {
+	r := &L4Route{
+		Source:    gtr,
+		RouteType: RouteTypeUDP,
+	}
+	sectionNameRefs, err := buildSectionNameRefs(gtr.Spec.ParentRefs, gtr.Namespace, gatewayNsNames)
+	if err != nil {
+		r.Valid = false
+
+		return r
+	}
+	// route doesn't belong to any of the Gateways
+	if len(sectionNameRefs) == 0 {
+		return nil
+	}
+	r.ParentRefs = sectionNameRefs
+
+	r.Attachable = true
+
+	if err := validateUDPRouteRules(gtr.Spec.Rules); err != nil {
+		r.Valid = false
+		r.Conditions = append(r.Conditions, staticConds.NewRouteUnsupportedValue(err.Error()))
+
+		return r
+	}
+
+	r.Spec.RouteBackendRefs = gtr.Spec.Rules[0].BackendRefs
+
+	r.Valid = true
+
+	return r
+}
+
+// validateUDPRouteRules validates that the UDPRoute has exactly one rule with at least one backendRef.
+// A UDP datagram can't be matched against multiple rules, so multiple rules are not supported.
+func validateUDPRouteRules(rules []v1alpha2.UDPRouteRule) error {
+	rulesPath := field.NewPath("spec").Child("rules")
+
+	switch l := len(rules); {
+	case l == 0:
+		return field.Required(rulesPath, "one rule must be defined")
+	case l > 1:
+		return field.TooMany(rulesPath, l, 1)
+	}
+
+	if len(rules[0].BackendRefs) == 0 {
+		return field.Required(rulesPath.Index(0).Child("backendRefs"), "at least one backendRef must be defined")
+	}
+
+	return nil
+}
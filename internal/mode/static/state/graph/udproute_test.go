@@ -0,0 +1,173 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func createUDPRoute(
+	name string,
+	refName string,
+	rules []v1alpha2.UDPRouteRule,
+) *v1alpha2.UDPRoute {
+	return &v1alpha2.UDPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: v1alpha2.UDPRouteSpec{
+			CommonRouteSpec: v1.CommonRouteSpec{
+				ParentRefs: []v1.ParentReference{
+					{
+						Namespace:   helpers.GetPointer[v1.Namespace]("test"),
+						Name:        v1.ObjectName(refName),
+						SectionName: helpers.GetPointer[v1.SectionName]("udp-listener"),
+					},
+				},
+			},
+			Rules: rules,
+		},
+	}
+}
+
+func TestBuildUDPRoute(t *testing.T) {
+	gatewayNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	createBackendRef := func(name string, weight int32) v1.BackendRef {
+		return v1.BackendRef{
+			BackendObjectReference: v1.BackendObjectReference{
+				Name: v1.ObjectName(name),
+				Port: helpers.GetPointer[v1.PortNumber](53),
+			},
+			Weight: helpers.GetPointer(weight),
+		}
+	}
+
+	backendRefs := []v1.BackendRef{createBackendRef("service1", 80), createBackendRef("service2", 20)}
+
+	validRule := v1alpha2.UDPRouteRule{BackendRefs: backendRefs}
+
+	urValid := createUDPRoute("ur", gatewayNsName.Name, []v1alpha2.UDPRouteRule{validRule})
+
+	urNoRules := createUDPRoute("ur", gatewayNsName.Name, nil)
+
+	urTooManyRules := createUDPRoute("ur", gatewayNsName.Name, []v1alpha2.UDPRouteRule{validRule, validRule})
+
+	urNoBackendRefs := createUDPRoute("ur", gatewayNsName.Name, []v1alpha2.UDPRouteRule{{}})
+
+	urNotNGF := createUDPRoute("ur", "some-gateway", []v1alpha2.UDPRouteRule{validRule})
+
+	urDuplicateSectionName := createUDPRoute("ur", gatewayNsName.Name, []v1alpha2.UDPRouteRule{validRule})
+	urDuplicateSectionName.Spec.ParentRefs = append(
+		urDuplicateSectionName.Spec.ParentRefs,
+		urDuplicateSectionName.Spec.ParentRefs[0],
+	)
+
+	createParentRefs := func(ur *v1alpha2.UDPRoute) []ParentRef {
+		return []ParentRef{
+			{
+				Idx:         0,
+				Gateway:     gatewayNsName,
+				SectionName: ur.Spec.ParentRefs[0].SectionName,
+			},
+		}
+	}
+
+	tests := []struct {
+		ur       *v1alpha2.UDPRoute
+		expected *L4Route
+		name     string
+	}{
+		{
+			ur: urValid,
+			expected: &L4Route{
+				RouteType:  RouteTypeUDP,
+				Source:     urValid,
+				ParentRefs: createParentRefs(urValid),
+				Valid:      true,
+				Attachable: true,
+				Spec: L4RouteSpec{
+					RouteBackendRefs: backendRefs,
+				},
+			},
+			name: "valid",
+		},
+		{
+			ur: urNoRules,
+			expected: &L4Route{
+				RouteType:  RouteTypeUDP,
+				Source:     urNoRules,
+				ParentRefs: createParentRefs(urNoRules),
+				Valid:      false,
+				Attachable: true,
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue(`spec.rules: Required value: one rule must be defined`),
+				},
+			},
+			name: "no rules",
+		},
+		{
+			ur: urTooManyRules,
+			expected: &L4Route{
+				RouteType:  RouteTypeUDP,
+				Source:     urTooManyRules,
+				ParentRefs: createParentRefs(urTooManyRules),
+				Valid:      false,
+				Attachable: true,
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue(`spec.rules: Too many: 2: must have at most 1 items`),
+				},
+			},
+			name: "too many rules",
+		},
+		{
+			ur: urNoBackendRefs,
+			expected: &L4Route{
+				RouteType:  RouteTypeUDP,
+				Source:     urNoBackendRefs,
+				ParentRefs: createParentRefs(urNoBackendRefs),
+				Valid:      false,
+				Attachable: true,
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue(
+						`spec.rules[0].backendRefs: Required value: at least one backendRef must be defined`,
+					),
+				},
+			},
+			name: "no backendRefs",
+		},
+		{
+			ur:       urNotNGF,
+			expected: nil,
+			name:     "not NGF route",
+		},
+		{
+			ur: urDuplicateSectionName,
+			expected: &L4Route{
+				RouteType: RouteTypeUDP,
+				Source:    urDuplicateSectionName,
+			},
+			name: "invalid route with duplicate sectionName",
+		},
+	}
+
+	gatewayNsNames := []types.NamespacedName{gatewayNsName}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			route := buildUDPRoute(test.ur, gatewayNsNames)
+			g.Expect(helpers.Diff(test.expected, route)).To(BeEmpty())
+		})
+	}
+}
@@ -7,6 +7,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
 )
 
 // Updater updates the cluster state.
@@ -55,6 +58,44 @@ func (m *objectStoreMapAdapter[T]) delete(nsname types.NamespacedName) {
 	delete(m.objects, nsname)
 }
 
+// ngfPolicyObjectStoreMapAdapter wraps the map of the NGF Policies, which holds Policies of multiple kinds,
+// so that the Policies of one kind can be used through objectStore interface.
+type ngfPolicyObjectStoreMapAdapter[T policies.Policy] struct {
+	objects map[graph.PolicyKey]policies.Policy
+	gvk     schema.GroupVersionKind
+}
+
+func newNGFPolicyObjectStoreMapAdapter[T policies.Policy](
+	objects map[graph.PolicyKey]policies.Policy,
+	gvk schema.GroupVersionKind,
+) *ngfPolicyObjectStoreMapAdapter[T] {
+	return &ngfPolicyObjectStoreMapAdapter[T]{
+		objects: objects,
+		gvk:     gvk,
+	}
+}
+
+func (m *ngfPolicyObjectStoreMapAdapter[T]) get(nsname types.NamespacedName) client.Object {
+	obj, exist := m.objects[graph.PolicyKey{NsName: nsname, GVK: m.gvk}]
+	if !exist {
+		return nil
+	}
+
+	return obj
+}
+
+func (m *ngfPolicyObjectStoreMapAdapter[T]) upsert(obj client.Object) {
+	t, ok := obj.(T)
+	if !ok {
+		panic(fmt.Errorf("obj type mismatch: got %T, expected %T", obj, t))
+	}
+	m.objects[graph.PolicyKey{NsName: client.ObjectKeyFromObject(obj), GVK: m.gvk}] = t
+}
+
+func (m *ngfPolicyObjectStoreMapAdapter[T]) delete(nsname types.NamespacedName) {
+	delete(m.objects, graph.PolicyKey{NsName: nsname, GVK: m.gvk})
+}
+
 type gvkList []schema.GroupVersionKind
 
 func (list gvkList) contains(gvk schema.GroupVersionKind) bool {
@@ -30,6 +30,17 @@ type FakeHTTPFieldsValidator struct {
 	validateHeaderValueInMatchReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ValidateHeaderValueRegexInMatchStub        func(string) error
+	validateHeaderValueRegexInMatchMutex       sync.RWMutex
+	validateHeaderValueRegexInMatchArgsForCall []struct {
+		arg1 string
+	}
+	validateHeaderValueRegexInMatchReturns struct {
+		result1 error
+	}
+	validateHeaderValueRegexInMatchReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ValidateHostnameStub        func(string) error
 	validateHostnameMutex       sync.RWMutex
 	validateHostnameArgsForCall []struct {
@@ -65,6 +76,17 @@ type FakeHTTPFieldsValidator struct {
 	validatePathInMatchReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ValidatePathRegexInMatchStub        func(string) error
+	validatePathRegexInMatchMutex       sync.RWMutex
+	validatePathRegexInMatchArgsForCall []struct {
+		arg1 string
+	}
+	validatePathRegexInMatchReturns struct {
+		result1 error
+	}
+	validatePathRegexInMatchReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ValidateQueryParamNameInMatchStub        func(string) error
 	validateQueryParamNameInMatchMutex       sync.RWMutex
 	validateQueryParamNameInMatchArgsForCall []struct {
@@ -87,6 +109,17 @@ type FakeHTTPFieldsValidator struct {
 	validateQueryParamValueInMatchReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ValidateQueryParamValueRegexInMatchStub        func(string) error
+	validateQueryParamValueRegexInMatchMutex       sync.RWMutex
+	validateQueryParamValueRegexInMatchArgsForCall []struct {
+		arg1 string
+	}
+	validateQueryParamValueRegexInMatchReturns struct {
+		result1 error
+	}
+	validateQueryParamValueRegexInMatchReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ValidateRedirectPortStub        func(int32) error
 	validateRedirectPortMutex       sync.RWMutex
 	validateRedirectPortArgsForCall []struct {
@@ -146,6 +179,28 @@ type FakeHTTPFieldsValidator struct {
 	validateRequestHeaderValueReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ValidateResponseHeaderNameStub        func(string) error
+	validateResponseHeaderNameMutex       sync.RWMutex
+	validateResponseHeaderNameArgsForCall []struct {
+		arg1 string
+	}
+	validateResponseHeaderNameReturns struct {
+		result1 error
+	}
+	validateResponseHeaderNameReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateResponseHeaderValueStub        func(string) error
+	validateResponseHeaderValueMutex       sync.RWMutex
+	validateResponseHeaderValueArgsForCall []struct {
+		arg1 string
+	}
+	validateResponseHeaderValueReturns struct {
+		result1 error
+	}
+	validateResponseHeaderValueReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ValidateRewritePathStub        func(string) error
 	validateRewritePathMutex       sync.RWMutex
 	validateRewritePathArgsForCall []struct {
@@ -283,6 +338,67 @@ func (fake *FakeHTTPFieldsValidator) ValidateHeaderValueInMatchReturnsOnCall(i i
 	}{result1}
 }
 
+func (fake *FakeHTTPFieldsValidator) ValidateHeaderValueRegexInMatch(arg1 string) error {
+	fake.validateHeaderValueRegexInMatchMutex.Lock()
+	ret, specificReturn := fake.validateHeaderValueRegexInMatchReturnsOnCall[len(fake.validateHeaderValueRegexInMatchArgsForCall)]
+	fake.validateHeaderValueRegexInMatchArgsForCall = append(fake.validateHeaderValueRegexInMatchArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateHeaderValueRegexInMatchStub
+	fakeReturns := fake.validateHeaderValueRegexInMatchReturns
+	fake.recordInvocation("ValidateHeaderValueRegexInMatch", []interface{}{arg1})
+	fake.validateHeaderValueRegexInMatchMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateHeaderValueRegexInMatchCallCount() int {
+	fake.validateHeaderValueRegexInMatchMutex.RLock()
+	defer fake.validateHeaderValueRegexInMatchMutex.RUnlock()
+	return len(fake.validateHeaderValueRegexInMatchArgsForCall)
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateHeaderValueRegexInMatchCalls(stub func(string) error) {
+	fake.validateHeaderValueRegexInMatchMutex.Lock()
+	defer fake.validateHeaderValueRegexInMatchMutex.Unlock()
+	fake.ValidateHeaderValueRegexInMatchStub = stub
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateHeaderValueRegexInMatchArgsForCall(i int) string {
+	fake.validateHeaderValueRegexInMatchMutex.RLock()
+	defer fake.validateHeaderValueRegexInMatchMutex.RUnlock()
+	argsForCall := fake.validateHeaderValueRegexInMatchArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateHeaderValueRegexInMatchReturns(result1 error) {
+	fake.validateHeaderValueRegexInMatchMutex.Lock()
+	defer fake.validateHeaderValueRegexInMatchMutex.Unlock()
+	fake.ValidateHeaderValueRegexInMatchStub = nil
+	fake.validateHeaderValueRegexInMatchReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateHeaderValueRegexInMatchReturnsOnCall(i int, result1 error) {
+	fake.validateHeaderValueRegexInMatchMutex.Lock()
+	defer fake.validateHeaderValueRegexInMatchMutex.Unlock()
+	fake.ValidateHeaderValueRegexInMatchStub = nil
+	if fake.validateHeaderValueRegexInMatchReturnsOnCall == nil {
+		fake.validateHeaderValueRegexInMatchReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateHeaderValueRegexInMatchReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHTTPFieldsValidator) ValidateHostname(arg1 string) error {
 	fake.validateHostnameMutex.Lock()
 	ret, specificReturn := fake.validateHostnameReturnsOnCall[len(fake.validateHostnameArgsForCall)]
@@ -469,6 +585,67 @@ func (fake *FakeHTTPFieldsValidator) ValidatePathInMatchReturnsOnCall(i int, res
 	}{result1}
 }
 
+func (fake *FakeHTTPFieldsValidator) ValidatePathRegexInMatch(arg1 string) error {
+	fake.validatePathRegexInMatchMutex.Lock()
+	ret, specificReturn := fake.validatePathRegexInMatchReturnsOnCall[len(fake.validatePathRegexInMatchArgsForCall)]
+	fake.validatePathRegexInMatchArgsForCall = append(fake.validatePathRegexInMatchArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidatePathRegexInMatchStub
+	fakeReturns := fake.validatePathRegexInMatchReturns
+	fake.recordInvocation("ValidatePathRegexInMatch", []interface{}{arg1})
+	fake.validatePathRegexInMatchMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidatePathRegexInMatchCallCount() int {
+	fake.validatePathRegexInMatchMutex.RLock()
+	defer fake.validatePathRegexInMatchMutex.RUnlock()
+	return len(fake.validatePathRegexInMatchArgsForCall)
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidatePathRegexInMatchCalls(stub func(string) error) {
+	fake.validatePathRegexInMatchMutex.Lock()
+	defer fake.validatePathRegexInMatchMutex.Unlock()
+	fake.ValidatePathRegexInMatchStub = stub
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidatePathRegexInMatchArgsForCall(i int) string {
+	fake.validatePathRegexInMatchMutex.RLock()
+	defer fake.validatePathRegexInMatchMutex.RUnlock()
+	argsForCall := fake.validatePathRegexInMatchArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidatePathRegexInMatchReturns(result1 error) {
+	fake.validatePathRegexInMatchMutex.Lock()
+	defer fake.validatePathRegexInMatchMutex.Unlock()
+	fake.ValidatePathRegexInMatchStub = nil
+	fake.validatePathRegexInMatchReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidatePathRegexInMatchReturnsOnCall(i int, result1 error) {
+	fake.validatePathRegexInMatchMutex.Lock()
+	defer fake.validatePathRegexInMatchMutex.Unlock()
+	fake.ValidatePathRegexInMatchStub = nil
+	if fake.validatePathRegexInMatchReturnsOnCall == nil {
+		fake.validatePathRegexInMatchReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validatePathRegexInMatchReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHTTPFieldsValidator) ValidateQueryParamNameInMatch(arg1 string) error {
 	fake.validateQueryParamNameInMatchMutex.Lock()
 	ret, specificReturn := fake.validateQueryParamNameInMatchReturnsOnCall[len(fake.validateQueryParamNameInMatchArgsForCall)]
@@ -591,6 +768,67 @@ func (fake *FakeHTTPFieldsValidator) ValidateQueryParamValueInMatchReturnsOnCall
 	}{result1}
 }
 
+func (fake *FakeHTTPFieldsValidator) ValidateQueryParamValueRegexInMatch(arg1 string) error {
+	fake.validateQueryParamValueRegexInMatchMutex.Lock()
+	ret, specificReturn := fake.validateQueryParamValueRegexInMatchReturnsOnCall[len(fake.validateQueryParamValueRegexInMatchArgsForCall)]
+	fake.validateQueryParamValueRegexInMatchArgsForCall = append(fake.validateQueryParamValueRegexInMatchArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateQueryParamValueRegexInMatchStub
+	fakeReturns := fake.validateQueryParamValueRegexInMatchReturns
+	fake.recordInvocation("ValidateQueryParamValueRegexInMatch", []interface{}{arg1})
+	fake.validateQueryParamValueRegexInMatchMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateQueryParamValueRegexInMatchCallCount() int {
+	fake.validateQueryParamValueRegexInMatchMutex.RLock()
+	defer fake.validateQueryParamValueRegexInMatchMutex.RUnlock()
+	return len(fake.validateQueryParamValueRegexInMatchArgsForCall)
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateQueryParamValueRegexInMatchCalls(stub func(string) error) {
+	fake.validateQueryParamValueRegexInMatchMutex.Lock()
+	defer fake.validateQueryParamValueRegexInMatchMutex.Unlock()
+	fake.ValidateQueryParamValueRegexInMatchStub = stub
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateQueryParamValueRegexInMatchArgsForCall(i int) string {
+	fake.validateQueryParamValueRegexInMatchMutex.RLock()
+	defer fake.validateQueryParamValueRegexInMatchMutex.RUnlock()
+	argsForCall := fake.validateQueryParamValueRegexInMatchArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateQueryParamValueRegexInMatchReturns(result1 error) {
+	fake.validateQueryParamValueRegexInMatchMutex.Lock()
+	defer fake.validateQueryParamValueRegexInMatchMutex.Unlock()
+	fake.ValidateQueryParamValueRegexInMatchStub = nil
+	fake.validateQueryParamValueRegexInMatchReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateQueryParamValueRegexInMatchReturnsOnCall(i int, result1 error) {
+	fake.validateQueryParamValueRegexInMatchMutex.Lock()
+	defer fake.validateQueryParamValueRegexInMatchMutex.Unlock()
+	fake.ValidateQueryParamValueRegexInMatchStub = nil
+	if fake.validateQueryParamValueRegexInMatchReturnsOnCall == nil {
+		fake.validateQueryParamValueRegexInMatchReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateQueryParamValueRegexInMatchReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHTTPFieldsValidator) ValidateRedirectPort(arg1 int32) error {
 	fake.validateRedirectPortMutex.Lock()
 	ret, specificReturn := fake.validateRedirectPortReturnsOnCall[len(fake.validateRedirectPortArgsForCall)]
@@ -902,6 +1140,128 @@ func (fake *FakeHTTPFieldsValidator) ValidateRequestHeaderValueReturnsOnCall(i i
 	}{result1}
 }
 
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderName(arg1 string) error {
+	fake.validateResponseHeaderNameMutex.Lock()
+	ret, specificReturn := fake.validateResponseHeaderNameReturnsOnCall[len(fake.validateResponseHeaderNameArgsForCall)]
+	fake.validateResponseHeaderNameArgsForCall = append(fake.validateResponseHeaderNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateResponseHeaderNameStub
+	fakeReturns := fake.validateResponseHeaderNameReturns
+	fake.recordInvocation("ValidateResponseHeaderName", []interface{}{arg1})
+	fake.validateResponseHeaderNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderNameCallCount() int {
+	fake.validateResponseHeaderNameMutex.RLock()
+	defer fake.validateResponseHeaderNameMutex.RUnlock()
+	return len(fake.validateResponseHeaderNameArgsForCall)
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderNameCalls(stub func(string) error) {
+	fake.validateResponseHeaderNameMutex.Lock()
+	defer fake.validateResponseHeaderNameMutex.Unlock()
+	fake.ValidateResponseHeaderNameStub = stub
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderNameArgsForCall(i int) string {
+	fake.validateResponseHeaderNameMutex.RLock()
+	defer fake.validateResponseHeaderNameMutex.RUnlock()
+	argsForCall := fake.validateResponseHeaderNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderNameReturns(result1 error) {
+	fake.validateResponseHeaderNameMutex.Lock()
+	defer fake.validateResponseHeaderNameMutex.Unlock()
+	fake.ValidateResponseHeaderNameStub = nil
+	fake.validateResponseHeaderNameReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderNameReturnsOnCall(i int, result1 error) {
+	fake.validateResponseHeaderNameMutex.Lock()
+	defer fake.validateResponseHeaderNameMutex.Unlock()
+	fake.ValidateResponseHeaderNameStub = nil
+	if fake.validateResponseHeaderNameReturnsOnCall == nil {
+		fake.validateResponseHeaderNameReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateResponseHeaderNameReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderValue(arg1 string) error {
+	fake.validateResponseHeaderValueMutex.Lock()
+	ret, specificReturn := fake.validateResponseHeaderValueReturnsOnCall[len(fake.validateResponseHeaderValueArgsForCall)]
+	fake.validateResponseHeaderValueArgsForCall = append(fake.validateResponseHeaderValueArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateResponseHeaderValueStub
+	fakeReturns := fake.validateResponseHeaderValueReturns
+	fake.recordInvocation("ValidateResponseHeaderValue", []interface{}{arg1})
+	fake.validateResponseHeaderValueMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderValueCallCount() int {
+	fake.validateResponseHeaderValueMutex.RLock()
+	defer fake.validateResponseHeaderValueMutex.RUnlock()
+	return len(fake.validateResponseHeaderValueArgsForCall)
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderValueCalls(stub func(string) error) {
+	fake.validateResponseHeaderValueMutex.Lock()
+	defer fake.validateResponseHeaderValueMutex.Unlock()
+	fake.ValidateResponseHeaderValueStub = stub
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderValueArgsForCall(i int) string {
+	fake.validateResponseHeaderValueMutex.RLock()
+	defer fake.validateResponseHeaderValueMutex.RUnlock()
+	argsForCall := fake.validateResponseHeaderValueArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderValueReturns(result1 error) {
+	fake.validateResponseHeaderValueMutex.Lock()
+	defer fake.validateResponseHeaderValueMutex.Unlock()
+	fake.ValidateResponseHeaderValueStub = nil
+	fake.validateResponseHeaderValueReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderValueReturnsOnCall(i int, result1 error) {
+	fake.validateResponseHeaderValueMutex.Lock()
+	defer fake.validateResponseHeaderValueMutex.Unlock()
+	fake.ValidateResponseHeaderValueStub = nil
+	if fake.validateResponseHeaderValueReturnsOnCall == nil {
+		fake.validateResponseHeaderValueReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateResponseHeaderValueReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHTTPFieldsValidator) ValidateRewritePath(arg1 string) error {
 	fake.validateRewritePathMutex.Lock()
 	ret, specificReturn := fake.validateRewritePathReturnsOnCall[len(fake.validateRewritePathArgsForCall)]
@@ -970,16 +1330,22 @@ func (fake *FakeHTTPFieldsValidator) Invocations() map[string][][]interface{} {
 	defer fake.validateHeaderNameInMatchMutex.RUnlock()
 	fake.validateHeaderValueInMatchMutex.RLock()
 	defer fake.validateHeaderValueInMatchMutex.RUnlock()
+	fake.validateHeaderValueRegexInMatchMutex.RLock()
+	defer fake.validateHeaderValueRegexInMatchMutex.RUnlock()
 	fake.validateHostnameMutex.RLock()
 	defer fake.validateHostnameMutex.RUnlock()
 	fake.validateMethodInMatchMutex.RLock()
 	defer fake.validateMethodInMatchMutex.RUnlock()
 	fake.validatePathInMatchMutex.RLock()
 	defer fake.validatePathInMatchMutex.RUnlock()
+	fake.validatePathRegexInMatchMutex.RLock()
+	defer fake.validatePathRegexInMatchMutex.RUnlock()
 	fake.validateQueryParamNameInMatchMutex.RLock()
 	defer fake.validateQueryParamNameInMatchMutex.RUnlock()
 	fake.validateQueryParamValueInMatchMutex.RLock()
 	defer fake.validateQueryParamValueInMatchMutex.RUnlock()
+	fake.validateQueryParamValueRegexInMatchMutex.RLock()
+	defer fake.validateQueryParamValueRegexInMatchMutex.RUnlock()
 	fake.validateRedirectPortMutex.RLock()
 	defer fake.validateRedirectPortMutex.RUnlock()
 	fake.validateRedirectSchemeMutex.RLock()
@@ -990,6 +1356,10 @@ func (fake *FakeHTTPFieldsValidator) Invocations() map[string][][]interface{} {
 	defer fake.validateRequestHeaderNameMutex.RUnlock()
 	fake.validateRequestHeaderValueMutex.RLock()
 	defer fake.validateRequestHeaderValueMutex.RUnlock()
+	fake.validateResponseHeaderNameMutex.RLock()
+	defer fake.validateResponseHeaderNameMutex.RUnlock()
+	fake.validateResponseHeaderValueMutex.RLock()
+	defer fake.validateResponseHeaderValueMutex.RUnlock()
 	fake.validateRewritePathMutex.RLock()
 	defer fake.validateRewritePathMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -1,11 +1,18 @@
 package validation
 
+import (
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
+)
+
 // Validators include validators for Gateway API resources from the perspective of a data-plane.
 // It is used for fields that propagate into the data plane configuration. For example, the path in a routing rule.
 // However, not all such fields are validated: NGF will not validate a field using Validators if it is confident that
 // the field is valid.
 type Validators struct {
 	HTTPFieldsValidator HTTPFieldsValidator
+	GenericValidator    GenericValidator
+	PolicyValidator     PolicyValidator
 }
 
 // HTTPFieldsValidator validates the HTTP-related fields of Gateway API resources from the perspective of
@@ -14,10 +21,13 @@ type Validators struct {
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . HTTPFieldsValidator
 type HTTPFieldsValidator interface {
 	ValidatePathInMatch(path string) error
+	ValidatePathRegexInMatch(regex string) error
 	ValidateHeaderNameInMatch(name string) error
 	ValidateHeaderValueInMatch(value string) error
+	ValidateHeaderValueRegexInMatch(regex string) error
 	ValidateQueryParamNameInMatch(name string) error
 	ValidateQueryParamValueInMatch(name string) error
+	ValidateQueryParamValueRegexInMatch(regex string) error
 	ValidateMethodInMatch(method string) (valid bool, supportedValues []string)
 	ValidateRedirectScheme(scheme string) (valid bool, supportedValues []string)
 	ValidateRedirectPort(port int32) error
@@ -26,4 +36,29 @@ type HTTPFieldsValidator interface {
 	ValidateRewritePath(path string) error
 	ValidateRequestHeaderName(name string) error
 	ValidateRequestHeaderValue(value string) error
+	ValidateResponseHeaderName(name string) error
+	ValidateResponseHeaderValue(value string) error
+}
+
+// GenericValidator validates the generic values of NGF API resources from the perspective of a data-plane.
+// Data-plane implementations must implement this interface.
+//
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . GenericValidator
+type GenericValidator interface {
+	ValidateEscapedStringNoVarExpansion(value string) error
+	ValidateServiceName(name string) error
+	ValidateNginxDuration(duration string) error
+	ValidateEndpoint(endpoint string) error
+}
+
+// PolicyValidator validates the NGF Policies and determines how the Policies of the same kind are combined.
+//
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . PolicyValidator
+type PolicyValidator interface {
+	// Validate validates an NGF Policy.
+	Validate(policy policies.Policy, globalSettings *policies.GlobalSettings) []conditions.Condition
+	// Conflicts returns true if the two Policies conflict.
+	Conflicts(a, b policies.Policy) bool
+	// Merge merges the parent and the child Policies of the same kind into their effective Policy.
+	Merge(parent, child policies.Policy) policies.Policy
 }
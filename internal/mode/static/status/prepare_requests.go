@@ -2,6 +2,7 @@ package status
 
 import (
 	"fmt"
+	"reflect"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -13,6 +14,7 @@ import (
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	frameworkStatus "github.com/nginxinc/nginx-gateway-fabric/internal/framework/status"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
 	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
 )
@@ -25,74 +27,155 @@ type NginxReloadResult struct {
 
 // PrepareRouteRequests prepares status UpdateRequests for the given Routes.
 func PrepareRouteRequests(
-	routes map[types.NamespacedName]*graph.Route,
+	l4routes map[graph.RouteKey]*graph.L4Route,
+	routes map[graph.RouteKey]*graph.L7Route,
 	transitionTime metav1.Time,
 	nginxReloadRes NginxReloadResult,
 	gatewayCtlrName string,
 ) []frameworkStatus.UpdateRequest {
-	reqs := make([]frameworkStatus.UpdateRequest, 0, len(routes))
-
-	for nsname, r := range routes {
-		parents := make([]v1.RouteParentStatus, 0, len(r.ParentRefs))
+	reqs := make([]frameworkStatus.UpdateRequest, 0, len(routes)+len(l4routes))
+
+	for routeKey, r := range l4routes {
+		routeStatus := prepareRouteStatus(
+			gatewayCtlrName,
+			r.ParentRefs,
+			r.Conditions,
+			nginxReloadRes,
+			transitionTime,
+			r.Source.GetGeneration(),
+		)
 
-		defaultConds := staticConds.NewDefaultRouteConditions()
+		var req frameworkStatus.UpdateRequest
 
-		for _, ref := range r.ParentRefs {
-			failedAttachmentCondCount := 0
-			if ref.Attachment != nil && !ref.Attachment.Attached {
-				failedAttachmentCondCount = 1
+		switch r.RouteType {
+		case graph.RouteTypeTLS:
+			req = frameworkStatus.UpdateRequest{
+				NsName:       routeKey.NamespacedName,
+				ResourceType: &v1alpha2.TLSRoute{},
+				Setter: newTLSRouteStatusSetter(
+					v1alpha2.TLSRouteStatus{RouteStatus: routeStatus},
+					gatewayCtlrName,
+				),
 			}
-			allConds := make([]conditions.Condition, 0, len(r.Conditions)+len(defaultConds)+failedAttachmentCondCount)
-
-			// We add defaultConds first, so that any additional conditions will override them, which is
-			// ensured by DeduplicateConditions.
-			allConds = append(allConds, defaultConds...)
-			allConds = append(allConds, r.Conditions...)
-			if failedAttachmentCondCount == 1 {
-				allConds = append(allConds, ref.Attachment.FailedCondition)
+		case graph.RouteTypeTCP:
+			req = frameworkStatus.UpdateRequest{
+				NsName:       routeKey.NamespacedName,
+				ResourceType: &v1alpha2.TCPRoute{},
+				Setter: newTCPRouteStatusSetter(
+					v1alpha2.TCPRouteStatus{RouteStatus: routeStatus},
+					gatewayCtlrName,
+				),
 			}
-
-			if nginxReloadRes.Error != nil {
-				allConds = append(
-					allConds,
-					staticConds.NewRouteGatewayNotProgrammed(staticConds.RouteMessageFailedNginxReload),
-				)
+		case graph.RouteTypeUDP:
+			req = frameworkStatus.UpdateRequest{
+				NsName:       routeKey.NamespacedName,
+				ResourceType: &v1alpha2.UDPRoute{},
+				Setter: newUDPRouteStatusSetter(
+					v1alpha2.UDPRouteStatus{RouteStatus: routeStatus},
+					gatewayCtlrName,
+				),
 			}
+		default:
+			panic(fmt.Sprintf("Unknown route type: %s", r.RouteType))
+		}
 
-			routeRef := r.Source.Spec.ParentRefs[ref.Idx]
+		reqs = append(reqs, req)
+	}
 
-			conds := conditions.DeduplicateConditions(allConds)
-			apiConds := conditions.ConvertConditions(conds, r.Source.Generation, transitionTime)
+	for routeKey, r := range routes {
+		routeStatus := prepareRouteStatus(
+			gatewayCtlrName,
+			r.ParentRefs,
+			r.Conditions,
+			nginxReloadRes,
+			transitionTime,
+			r.Source.GetGeneration(),
+		)
 
-			ps := v1.RouteParentStatus{
-				ParentRef: v1.ParentReference{
-					Namespace:   helpers.GetPointer(v1.Namespace(ref.Gateway.Namespace)),
-					Name:        v1.ObjectName(ref.Gateway.Name),
-					SectionName: routeRef.SectionName,
-				},
-				ControllerName: v1.GatewayController(gatewayCtlrName),
-				Conditions:     apiConds,
+		var req frameworkStatus.UpdateRequest
+
+		switch r.RouteType {
+		case graph.RouteTypeHTTP:
+			req = frameworkStatus.UpdateRequest{
+				NsName:       routeKey.NamespacedName,
+				ResourceType: &v1.HTTPRoute{},
+				Setter: newHTTPRouteStatusSetter(
+					v1.HTTPRouteStatus{RouteStatus: routeStatus},
+					gatewayCtlrName,
+				),
+			}
+		case graph.RouteTypeGRPC:
+			req = frameworkStatus.UpdateRequest{
+				NsName:       routeKey.NamespacedName,
+				ResourceType: &v1alpha2.GRPCRoute{},
+				Setter: newGRPCRouteStatusSetter(
+					v1alpha2.GRPCRouteStatus{RouteStatus: routeStatus},
+					gatewayCtlrName,
+				),
 			}
+		default:
+			panic(fmt.Sprintf("Unknown route type: %s", r.RouteType))
+		}
 
-			parents = append(parents, ps)
+		reqs = append(reqs, req)
+	}
+
+	return reqs
+}
+
+func prepareRouteStatus(
+	gatewayCtlrName string,
+	parentRefs []graph.ParentRef,
+	conds []conditions.Condition,
+	nginxReloadRes NginxReloadResult,
+	transitionTime metav1.Time,
+	srcGeneration int64,
+) v1.RouteStatus {
+	parents := make([]v1.RouteParentStatus, 0, len(parentRefs))
+
+	defaultConds := staticConds.NewDefaultRouteConditions()
+
+	for _, ref := range parentRefs {
+		failedAttachmentCondCount := 0
+		if ref.Attachment != nil && !ref.Attachment.Attached {
+			failedAttachmentCondCount = 1
 		}
+		allConds := make([]conditions.Condition, 0, len(conds)+len(defaultConds)+failedAttachmentCondCount)
 
-		status := v1.HTTPRouteStatus{
-			RouteStatus: v1.RouteStatus{
-				Parents: parents,
-			},
+		// We add defaultConds first, so that any additional conditions will override them, which is
+		// ensured by DeduplicateConditions.
+		allConds = append(allConds, defaultConds...)
+		allConds = append(allConds, conds...)
+		if failedAttachmentCondCount == 1 {
+			allConds = append(allConds, ref.Attachment.FailedCondition)
 		}
 
-		req := frameworkStatus.UpdateRequest{
-			NsName:       nsname,
-			ResourceType: &v1.HTTPRoute{},
-			Setter:       newHTTPRouteStatusSetter(status, gatewayCtlrName),
+		if nginxReloadRes.Error != nil {
+			allConds = append(
+				allConds,
+				staticConds.NewRouteGatewayNotProgrammed(staticConds.RouteMessageFailedNginxReload),
+			)
 		}
 
-		reqs = append(reqs, req)
+		dedupedConds := conditions.DeduplicateConditions(allConds)
+		apiConds := conditions.ConvertConditions(dedupedConds, srcGeneration, transitionTime)
+
+		ps := v1.RouteParentStatus{
+			ParentRef: v1.ParentReference{
+				Namespace:   helpers.GetPointer(v1.Namespace(ref.Gateway.Namespace)),
+				Name:        v1.ObjectName(ref.Gateway.Name),
+				SectionName: ref.SectionName,
+			},
+			ControllerName: v1.GatewayController(gatewayCtlrName),
+			Conditions:     apiConds,
+		}
+
+		parents = append(parents, ps)
 	}
 
-	return reqs
+	return v1.RouteStatus{
+		Parents: parents,
+	}
 }
 
 // PrepareGatewayClassRequests prepares status UpdateRequests for the given GatewayClasses.
@@ -226,7 +309,7 @@ func prepareGatewayRequest(
 		listenerStatuses = append(listenerStatuses, v1.ListenerStatus{
 			Name:           v1.SectionName(l.Name),
 			SupportedKinds: l.SupportedKinds,
-			AttachedRoutes: int32(len(l.Routes)),
+			AttachedRoutes: int32(len(l.Routes) + len(l.L4Routes)),
 			Conditions:     apiConds,
 		})
 	}
@@ -300,6 +383,43 @@ func PrepareBackendTLSPolicyRequests(
 	return reqs
 }
 
+// PrepareNGFPolicyRequests prepares status UpdateRequests for the given NGF Policies.
+func PrepareNGFPolicyRequests(
+	policies map[graph.PolicyKey]*graph.Policy,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+) []frameworkStatus.UpdateRequest {
+	reqs := make([]frameworkStatus.UpdateRequest, 0, len(policies))
+
+	for key, pol := range policies {
+		conds := conditions.DeduplicateConditions(pol.Conditions)
+		apiConds := conditions.ConvertConditions(conds, pol.Source.GetGeneration(), transitionTime)
+
+		status := v1alpha2.PolicyStatus{
+			Ancestors: []v1alpha2.PolicyAncestorStatus{
+				{
+					AncestorRef:    pol.Ancestor,
+					ControllerName: v1alpha2.GatewayController(gatewayCtlrName),
+					Conditions:     apiConds,
+				},
+			},
+		}
+
+		reqs = append(reqs, frameworkStatus.UpdateRequest{
+			NsName:       key.NsName,
+			ResourceType: newEmptyPolicy(pol.Source),
+			Setter:       newNGFPolicyStatusSetter(status, gatewayCtlrName),
+		})
+	}
+
+	return reqs
+}
+
+// newEmptyPolicy returns an empty Policy of the same type as the given Policy.
+func newEmptyPolicy(policy policies.Policy) policies.Policy {
+	return reflect.New(reflect.TypeOf(policy).Elem()).Interface().(policies.Policy)
+}
+
 // ControlPlaneUpdateResult describes the result of a control plane update.
 type ControlPlaneUpdateResult struct {
 	// Error is the error that occurred during the update.
@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -20,11 +21,12 @@ import (
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	statusFramework "github.com/nginxinc/nginx-gateway-fabric/internal/framework/status"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
 	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
 )
 
-func createK8sClientFor(resourceType client.Object) client.Client {
+func createK8sClientFor(resourceTypes ...client.Object) client.Client {
 	scheme := runtime.NewScheme()
 
 	// for simplicity, we add all used schemes here
@@ -35,7 +37,7 @@ func createK8sClientFor(resourceType client.Object) client.Client {
 	k8sClient := fake.NewClientBuilder().
 		WithScheme(scheme).
 		WithStatusSubresource(
-			resourceType,
+			resourceTypes...,
 		).
 		Build()
 
@@ -56,9 +58,10 @@ func TestBuildRouteStatuses(t *testing.T) {
 		Status: metav1.ConditionTrue,
 	}
 
-	routes := map[types.NamespacedName]*graph.Route{
-		{Namespace: "test", Name: "hr-valid"}: {
-			Valid: true,
+	routes := map[graph.RouteKey]*graph.L7Route{
+		{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-valid"}, RouteType: graph.RouteTypeHTTP}: {
+			RouteType: graph.RouteTypeHTTP,
+			Valid:     true,
 			Source: &v1.HTTPRoute{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace:  "test",
@@ -80,15 +83,17 @@ func TestBuildRouteStatuses(t *testing.T) {
 			},
 			ParentRefs: []graph.ParentRef{
 				{
-					Idx:     0,
-					Gateway: gwNsName,
+					Idx:         0,
+					Gateway:     gwNsName,
+					SectionName: helpers.GetPointer[v1.SectionName]("listener-80-1"),
 					Attachment: &graph.ParentRefAttachmentStatus{
 						Attached: true,
 					},
 				},
 				{
-					Idx:     1,
-					Gateway: gwNsName,
+					Idx:         1,
+					Gateway:     gwNsName,
+					SectionName: helpers.GetPointer[v1.SectionName]("listener-80-2"),
 					Attachment: &graph.ParentRefAttachmentStatus{
 						Attached:        false,
 						FailedCondition: invalidAttachmentCondition,
@@ -96,7 +101,8 @@ func TestBuildRouteStatuses(t *testing.T) {
 				},
 			},
 		},
-		{Namespace: "test", Name: "hr-invalid"}: {
+		{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-invalid"}, RouteType: graph.RouteTypeHTTP}: {
+			RouteType:  graph.RouteTypeHTTP,
 			Valid:      false,
 			Conditions: []conditions.Condition{invalidRouteCondition},
 			Source: &v1.HTTPRoute{
@@ -117,9 +123,10 @@ func TestBuildRouteStatuses(t *testing.T) {
 			},
 			ParentRefs: []graph.ParentRef{
 				{
-					Idx:        0,
-					Gateway:    gwNsName,
-					Attachment: nil,
+					Idx:         0,
+					Gateway:     gwNsName,
+					SectionName: helpers.GetPointer[v1.SectionName]("listener-80-1"),
+					Attachment:  nil,
 				},
 			},
 		},
@@ -243,7 +250,7 @@ func TestBuildRouteStatuses(t *testing.T) {
 
 	updater := statusFramework.NewUpdater(k8sClient, zap.New())
 
-	reqs := PrepareRouteRequests(routes, transitionTime, NginxReloadResult{}, gatewayCtlrName)
+	reqs := PrepareRouteRequests(nil, routes, transitionTime, NginxReloadResult{}, gatewayCtlrName)
 
 	updater.Update(context.Background(), reqs...)
 
@@ -264,9 +271,10 @@ func TestBuildRouteStatusesNginxErr(t *testing.T) {
 	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
 	routeNsName := types.NamespacedName{Namespace: "test", Name: "hr-valid"}
 
-	routes := map[types.NamespacedName]*graph.Route{
-		routeNsName: {
-			Valid: true,
+	routes := map[graph.RouteKey]*graph.L7Route{
+		{NamespacedName: routeNsName, RouteType: graph.RouteTypeHTTP}: {
+			RouteType: graph.RouteTypeHTTP,
+			Valid:     true,
 			Source: &v1.HTTPRoute{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace:  routeNsName.Namespace,
@@ -288,8 +296,9 @@ func TestBuildRouteStatusesNginxErr(t *testing.T) {
 			},
 			ParentRefs: []graph.ParentRef{
 				{
-					Idx:     0,
-					Gateway: gwNsName,
+					Idx:         0,
+					Gateway:     gwNsName,
+					SectionName: helpers.GetPointer[v1.SectionName]("listener-80-1"),
 					Attachment: &graph.ParentRefAttachmentStatus{
 						Attached: true,
 					},
@@ -345,6 +354,7 @@ func TestBuildRouteStatusesNginxErr(t *testing.T) {
 	updater := statusFramework.NewUpdater(k8sClient, zap.New())
 
 	reqs := PrepareRouteRequests(
+		nil,
 		routes,
 		transitionTime,
 		NginxReloadResult{Error: errors.New("test error")},
@@ -362,6 +372,398 @@ func TestBuildRouteStatusesNginxErr(t *testing.T) {
 	g.Expect(helpers.Diff(expectedStatus, hr.Status)).To(BeEmpty())
 }
 
+func TestBuildGRPCRouteStatuses(t *testing.T) {
+	const gatewayCtlrName = "controller"
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	routeNsName := types.NamespacedName{Namespace: "test", Name: "gr-valid"}
+
+	routes := map[graph.RouteKey]*graph.L7Route{
+		{NamespacedName: routeNsName, RouteType: graph.RouteTypeGRPC}: {
+			RouteType: graph.RouteTypeGRPC,
+			Valid:     true,
+			Source: &v1alpha2.GRPCRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  routeNsName.Namespace,
+					Name:       routeNsName.Name,
+					Generation: 3,
+				},
+				Spec: v1alpha2.GRPCRouteSpec{
+					CommonRouteSpec: v1.CommonRouteSpec{
+						ParentRefs: []v1.ParentReference{
+							{
+								SectionName: helpers.GetPointer[v1.SectionName]("listener-80-1"),
+							},
+						},
+					},
+				},
+			},
+			ParentRefs: []graph.ParentRef{
+				{
+					Idx:         0,
+					Gateway:     gwNsName,
+					SectionName: helpers.GetPointer[v1.SectionName]("listener-80-1"),
+					Attachment: &graph.ParentRefAttachmentStatus{
+						Attached: true,
+					},
+				},
+			},
+		},
+	}
+
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+
+	expectedStatus := v1alpha2.GRPCRouteStatus{
+		RouteStatus: v1.RouteStatus{
+			Parents: []v1.RouteParentStatus{
+				{
+					ParentRef: v1.ParentReference{
+						Namespace:   helpers.GetPointer(v1.Namespace(gwNsName.Namespace)),
+						Name:        v1.ObjectName(gwNsName.Name),
+						SectionName: helpers.GetPointer[v1.SectionName]("listener-80-1"),
+					},
+					ControllerName: gatewayCtlrName,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1.RouteConditionAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 3,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.RouteReasonAccepted),
+							Message:            "The route is accepted",
+						},
+						{
+							Type:               string(v1.RouteConditionResolvedRefs),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 3,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.RouteReasonResolvedRefs),
+							Message:            "All references are resolved",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	k8sClient := createK8sClientFor(&v1alpha2.GRPCRoute{})
+
+	for _, r := range routes {
+		err := k8sClient.Create(context.Background(), r.Source)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	updater := statusFramework.NewUpdater(k8sClient, zap.New())
+
+	reqs := PrepareRouteRequests(nil, routes, transitionTime, NginxReloadResult{}, gatewayCtlrName)
+
+	g.Expect(reqs).To(HaveLen(1))
+
+	updater.Update(context.Background(), reqs...)
+
+	var gr v1alpha2.GRPCRoute
+
+	err := k8sClient.Get(context.Background(), routeNsName, &gr)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(helpers.Diff(expectedStatus, gr.Status)).To(BeEmpty())
+}
+
+func TestBuildTLSRouteStatuses(t *testing.T) {
+	const gatewayCtlrName = "controller"
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	routeNsName := types.NamespacedName{Namespace: "test", Name: "tr-valid"}
+
+	routes := map[graph.RouteKey]*graph.L4Route{
+		{NamespacedName: routeNsName, RouteType: graph.RouteTypeTLS}: {
+			RouteType: graph.RouteTypeTLS,
+			Valid:     true,
+			Source: &v1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  routeNsName.Namespace,
+					Name:       routeNsName.Name,
+					Generation: 3,
+				},
+				Spec: v1alpha2.TLSRouteSpec{
+					CommonRouteSpec: v1.CommonRouteSpec{
+						ParentRefs: []v1.ParentReference{
+							{
+								SectionName: helpers.GetPointer[v1.SectionName]("listener-443-1"),
+							},
+						},
+					},
+				},
+			},
+			ParentRefs: []graph.ParentRef{
+				{
+					Idx:         0,
+					Gateway:     gwNsName,
+					SectionName: helpers.GetPointer[v1.SectionName]("listener-443-1"),
+					Attachment: &graph.ParentRefAttachmentStatus{
+						Attached: true,
+					},
+				},
+			},
+		},
+	}
+
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+
+	expectedStatus := v1alpha2.TLSRouteStatus{
+		RouteStatus: v1.RouteStatus{
+			Parents: []v1.RouteParentStatus{
+				{
+					ParentRef: v1.ParentReference{
+						Namespace:   helpers.GetPointer(v1.Namespace(gwNsName.Namespace)),
+						Name:        v1.ObjectName(gwNsName.Name),
+						SectionName: helpers.GetPointer[v1.SectionName]("listener-443-1"),
+					},
+					ControllerName: gatewayCtlrName,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1.RouteConditionAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 3,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.RouteReasonAccepted),
+							Message:            "The route is accepted",
+						},
+						{
+							Type:               string(v1.RouteConditionResolvedRefs),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 3,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.RouteReasonResolvedRefs),
+							Message:            "All references are resolved",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	k8sClient := createK8sClientFor(&v1alpha2.TLSRoute{})
+
+	for _, r := range routes {
+		err := k8sClient.Create(context.Background(), r.Source)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	updater := statusFramework.NewUpdater(k8sClient, zap.New())
+
+	reqs := PrepareRouteRequests(routes, nil, transitionTime, NginxReloadResult{}, gatewayCtlrName)
+
+	g.Expect(reqs).To(HaveLen(1))
+
+	updater.Update(context.Background(), reqs...)
+
+	var tr v1alpha2.TLSRoute
+
+	err := k8sClient.Get(context.Background(), routeNsName, &tr)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(helpers.Diff(expectedStatus, tr.Status)).To(BeEmpty())
+}
+
+func TestBuildTCPRouteStatuses(t *testing.T) {
+	const gatewayCtlrName = "controller"
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	routeNsName := types.NamespacedName{Namespace: "test", Name: "tcr-valid"}
+
+	routes := map[graph.RouteKey]*graph.L4Route{
+		{NamespacedName: routeNsName, RouteType: graph.RouteTypeTCP}: {
+			RouteType: graph.RouteTypeTCP,
+			Valid:     true,
+			Source: &v1alpha2.TCPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  routeNsName.Namespace,
+					Name:       routeNsName.Name,
+					Generation: 3,
+				},
+				Spec: v1alpha2.TCPRouteSpec{
+					CommonRouteSpec: v1.CommonRouteSpec{
+						ParentRefs: []v1.ParentReference{
+							{
+								SectionName: helpers.GetPointer[v1.SectionName]("listener-5432-1"),
+							},
+						},
+					},
+				},
+			},
+			ParentRefs: []graph.ParentRef{
+				{
+					Idx:         0,
+					Gateway:     gwNsName,
+					SectionName: helpers.GetPointer[v1.SectionName]("listener-5432-1"),
+					Attachment: &graph.ParentRefAttachmentStatus{
+						Attached: true,
+					},
+				},
+			},
+		},
+	}
+
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+
+	expectedStatus := v1alpha2.TCPRouteStatus{
+		RouteStatus: v1.RouteStatus{
+			Parents: []v1.RouteParentStatus{
+				{
+					ParentRef: v1.ParentReference{
+						Namespace:   helpers.GetPointer(v1.Namespace(gwNsName.Namespace)),
+						Name:        v1.ObjectName(gwNsName.Name),
+						SectionName: helpers.GetPointer[v1.SectionName]("listener-5432-1"),
+					},
+					ControllerName: gatewayCtlrName,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1.RouteConditionAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 3,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.RouteReasonAccepted),
+							Message:            "The route is accepted",
+						},
+						{
+							Type:               string(v1.RouteConditionResolvedRefs),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 3,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.RouteReasonResolvedRefs),
+							Message:            "All references are resolved",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	k8sClient := createK8sClientFor(&v1alpha2.TCPRoute{})
+
+	for _, r := range routes {
+		err := k8sClient.Create(context.Background(), r.Source)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	updater := statusFramework.NewUpdater(k8sClient, zap.New())
+
+	reqs := PrepareRouteRequests(routes, nil, transitionTime, NginxReloadResult{}, gatewayCtlrName)
+
+	g.Expect(reqs).To(HaveLen(1))
+
+	updater.Update(context.Background(), reqs...)
+
+	var tcr v1alpha2.TCPRoute
+
+	err := k8sClient.Get(context.Background(), routeNsName, &tcr)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(helpers.Diff(expectedStatus, tcr.Status)).To(BeEmpty())
+}
+
+func TestBuildUDPRouteStatuses(t *testing.T) {
+	const gatewayCtlrName = "controller"
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	routeNsName := types.NamespacedName{Namespace: "test", Name: "ur-valid"}
+
+	routes := map[graph.RouteKey]*graph.L4Route{
+		{NamespacedName: routeNsName, RouteType: graph.RouteTypeUDP}: {
+			RouteType: graph.RouteTypeUDP,
+			Valid:     true,
+			Source: &v1alpha2.UDPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  routeNsName.Namespace,
+					Name:       routeNsName.Name,
+					Generation: 3,
+				},
+				Spec: v1alpha2.UDPRouteSpec{
+					CommonRouteSpec: v1.CommonRouteSpec{
+						ParentRefs: []v1.ParentReference{
+							{
+								SectionName: helpers.GetPointer[v1.SectionName]("listener-53-1"),
+							},
+						},
+					},
+				},
+			},
+			ParentRefs: []graph.ParentRef{
+				{
+					Idx:         0,
+					Gateway:     gwNsName,
+					SectionName: helpers.GetPointer[v1.SectionName]("listener-53-1"),
+					Attachment: &graph.ParentRefAttachmentStatus{
+						Attached: true,
+					},
+				},
+			},
+		},
+	}
+
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+
+	expectedStatus := v1alpha2.UDPRouteStatus{
+		RouteStatus: v1.RouteStatus{
+			Parents: []v1.RouteParentStatus{
+				{
+					ParentRef: v1.ParentReference{
+						Namespace:   helpers.GetPointer(v1.Namespace(gwNsName.Namespace)),
+						Name:        v1.ObjectName(gwNsName.Name),
+						SectionName: helpers.GetPointer[v1.SectionName]("listener-53-1"),
+					},
+					ControllerName: gatewayCtlrName,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1.RouteConditionAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 3,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.RouteReasonAccepted),
+							Message:            "The route is accepted",
+						},
+						{
+							Type:               string(v1.RouteConditionResolvedRefs),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 3,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.RouteReasonResolvedRefs),
+							Message:            "All references are resolved",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	k8sClient := createK8sClientFor(&v1alpha2.UDPRoute{})
+
+	for _, r := range routes {
+		err := k8sClient.Create(context.Background(), r.Source)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	updater := statusFramework.NewUpdater(k8sClient, zap.New())
+
+	reqs := PrepareRouteRequests(routes, nil, transitionTime, NginxReloadResult{}, gatewayCtlrName)
+
+	g.Expect(reqs).To(HaveLen(1))
+
+	updater.Update(context.Background(), reqs...)
+
+	var ur v1alpha2.UDPRoute
+
+	err := k8sClient.Get(context.Background(), routeNsName, &ur)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(helpers.Diff(expectedStatus, ur.Status)).To(BeEmpty())
+}
+
 func TestBuildGatewayClassStatuses(t *testing.T) {
 	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
 
@@ -627,15 +1029,22 @@ func TestBuildGatewayStatuses(t *testing.T) {
 					{
 						Name:  "listener-valid-1",
 						Valid: true,
-						Routes: map[types.NamespacedName]*graph.Route{
-							{Namespace: "test", Name: "hr-1"}: {},
+						Routes: map[graph.RouteKey]*graph.L7Route{
+							{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-1"}}: {},
 						},
 					},
 					{
 						Name:  "listener-valid-2",
 						Valid: true,
-						Routes: map[types.NamespacedName]*graph.Route{
-							{Namespace: "test", Name: "hr-1"}: {},
+						Routes: map[graph.RouteKey]*graph.L7Route{
+							{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-1"}}: {},
+						},
+					},
+					{
+						Name:  "listener-valid-3",
+						Valid: true,
+						L4Routes: map[graph.RouteKey]*graph.L4Route{
+							{NamespacedName: types.NamespacedName{Namespace: "test", Name: "tr-1"}}: {},
 						},
 					},
 				},
@@ -673,6 +1082,11 @@ func TestBuildGatewayStatuses(t *testing.T) {
 							AttachedRoutes: 1,
 							Conditions:     validListenerConditions,
 						},
+						{
+							Name:           "listener-valid-3",
+							AttachedRoutes: 1,
+							Conditions:     validListenerConditions,
+						},
 					},
 				},
 			},
@@ -685,8 +1099,8 @@ func TestBuildGatewayStatuses(t *testing.T) {
 					{
 						Name:  "listener-valid",
 						Valid: true,
-						Routes: map[types.NamespacedName]*graph.Route{
-							{Namespace: "test", Name: "hr-1"}: {},
+						Routes: map[graph.RouteKey]*graph.L7Route{
+							{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-1"}}: {},
 						},
 					},
 					{
@@ -879,8 +1293,8 @@ func TestBuildGatewayStatuses(t *testing.T) {
 					{
 						Name:  "listener-valid",
 						Valid: true,
-						Routes: map[types.NamespacedName]*graph.Route{
-							{Namespace: "test", Name: "hr-1"}: {},
+						Routes: map[graph.RouteKey]*graph.L7Route{
+							{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-1"}}: {},
 						},
 					},
 				},
@@ -1196,6 +1610,169 @@ func TestBuildBackendTLSPolicyStatuses(t *testing.T) {
 	}
 }
 
+func TestBuildNGFPolicyStatuses(t *testing.T) {
+	const gatewayCtlrName = "controller"
+
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+
+	gwAncestor := v1.ParentReference{
+		Group:     helpers.GetPointer[v1.Group](v1.GroupName),
+		Kind:      helpers.GetPointer[v1.Kind]("Gateway"),
+		Namespace: helpers.GetPointer[v1.Namespace]("test"),
+		Name:      "gateway",
+	}
+
+	hrAncestor := v1.ParentReference{
+		Group:     helpers.GetPointer[v1.Group](v1.GroupName),
+		Kind:      helpers.GetPointer[v1.Kind]("HTTPRoute"),
+		Namespace: helpers.GetPointer[v1.Namespace]("test"),
+		Name:      "hr",
+	}
+
+	cspGVK := schema.GroupVersionKind{Group: ngfAPI.GroupName, Version: "v1alpha1", Kind: "ClientSettingsPolicy"}
+	opGVK := schema.GroupVersionKind{Group: ngfAPI.GroupName, Version: "v1alpha1", Kind: "ObservabilityPolicy"}
+
+	objectMeta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Namespace:  "test",
+			Name:       name,
+			Generation: 1,
+		}
+	}
+
+	getPolicyKey := func(name string, gvk schema.GroupVersionKind) graph.PolicyKey {
+		return graph.PolicyKey{
+			NsName: types.NamespacedName{Namespace: "test", Name: name},
+			GVK:    gvk,
+		}
+	}
+
+	tests := []struct {
+		policies     map[graph.PolicyKey]*graph.Policy
+		expected     map[graph.PolicyKey]v1alpha2.PolicyStatus
+		name         string
+		expectedReqs int
+	}{
+		{
+			name:         "nil policies",
+			expectedReqs: 0,
+		},
+		{
+			name: "accepted, conflicted and invalid policies",
+			policies: map[graph.PolicyKey]*graph.Policy{
+				getPolicyKey("accepted", cspGVK): {
+					Source:     &ngfAPI.ClientSettingsPolicy{ObjectMeta: objectMeta("accepted")},
+					Ancestor:   gwAncestor,
+					Conditions: []conditions.Condition{staticConds.NewPolicyAccepted()},
+				},
+				getPolicyKey("conflicted", cspGVK): {
+					Source:     &ngfAPI.ClientSettingsPolicy{ObjectMeta: objectMeta("conflicted")},
+					Ancestor:   hrAncestor,
+					Conditions: []conditions.Condition{staticConds.NewPolicyConflicted("conflicts with another policy")},
+				},
+				getPolicyKey("invalid", opGVK): {
+					Source:     &ngfAPI.ObservabilityPolicy{ObjectMeta: objectMeta("invalid")},
+					Ancestor:   hrAncestor,
+					Conditions: []conditions.Condition{staticConds.NewPolicyInvalid("invalid span name")},
+				},
+			},
+			expectedReqs: 3,
+			expected: map[graph.PolicyKey]v1alpha2.PolicyStatus{
+				getPolicyKey("accepted", cspGVK): {
+					Ancestors: []v1alpha2.PolicyAncestorStatus{
+						{
+							AncestorRef:    gwAncestor,
+							ControllerName: gatewayCtlrName,
+							Conditions: []metav1.Condition{
+								{
+									Type:               string(v1alpha2.PolicyConditionAccepted),
+									Status:             metav1.ConditionTrue,
+									ObservedGeneration: 1,
+									LastTransitionTime: transitionTime,
+									Reason:             string(v1alpha2.PolicyReasonAccepted),
+									Message:            "Policy is accepted",
+								},
+							},
+						},
+					},
+				},
+				getPolicyKey("conflicted", cspGVK): {
+					Ancestors: []v1alpha2.PolicyAncestorStatus{
+						{
+							AncestorRef:    hrAncestor,
+							ControllerName: gatewayCtlrName,
+							Conditions: []metav1.Condition{
+								{
+									Type:               string(v1alpha2.PolicyConditionAccepted),
+									Status:             metav1.ConditionFalse,
+									ObservedGeneration: 1,
+									LastTransitionTime: transitionTime,
+									Reason:             string(v1alpha2.PolicyReasonConflicted),
+									Message:            "conflicts with another policy",
+								},
+							},
+						},
+					},
+				},
+				getPolicyKey("invalid", opGVK): {
+					Ancestors: []v1alpha2.PolicyAncestorStatus{
+						{
+							AncestorRef:    hrAncestor,
+							ControllerName: gatewayCtlrName,
+							Conditions: []metav1.Condition{
+								{
+									Type:               string(v1alpha2.PolicyConditionAccepted),
+									Status:             metav1.ConditionFalse,
+									ObservedGeneration: 1,
+									LastTransitionTime: transitionTime,
+									Reason:             string(v1alpha2.PolicyReasonInvalid),
+									Message:            "invalid span name",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			k8sClient := createK8sClientFor(&ngfAPI.ClientSettingsPolicy{}, &ngfAPI.ObservabilityPolicy{})
+
+			for _, pol := range test.policies {
+				err := k8sClient.Create(context.Background(), pol.Source)
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+
+			updater := statusFramework.NewUpdater(k8sClient, zap.New())
+
+			reqs := PrepareNGFPolicyRequests(test.policies, transitionTime, gatewayCtlrName)
+
+			g.Expect(reqs).To(HaveLen(test.expectedReqs))
+
+			updater.Update(context.Background(), reqs...)
+
+			for key, expected := range test.expected {
+				var pol policies.Policy
+
+				switch key.GVK {
+				case cspGVK:
+					pol = &ngfAPI.ClientSettingsPolicy{}
+				case opGVK:
+					pol = &ngfAPI.ObservabilityPolicy{}
+				}
+
+				err := k8sClient.Get(context.Background(), key.NsName, pol)
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(helpers.Diff(expected, pol.GetPolicyStatus())).To(BeEmpty())
+			}
+		})
+	}
+}
+
 func TestBuildNginxGatewayStatus(t *testing.T) {
 	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
 
@@ -10,6 +10,7 @@ import (
 	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	frameworkStatus "github.com/nginxinc/nginx-gateway-fabric/internal/framework/status"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
 )
 
 func newNginxGatewayStatusSetter(status ngfAPI.NginxGatewayStatus) frameworkStatus.Setter {
@@ -89,7 +90,7 @@ func newHTTPRouteStatusSetter(status gatewayv1.HTTPRouteStatus, gatewayCtlrName
 			}
 		}
 
-		if hrStatusEqual(gatewayCtlrName, hr.Status, status) {
+		if routeStatusEqual(gatewayCtlrName, hr.Status.RouteStatus, status.RouteStatus) {
 			return false
 		}
 
@@ -99,8 +100,92 @@ func newHTTPRouteStatusSetter(status gatewayv1.HTTPRouteStatus, gatewayCtlrName
 	}
 }
 
-func hrStatusEqual(gatewayCtlrName string, prev, cur gatewayv1.HTTPRouteStatus) bool {
-	// Since other controllers may update HTTPRoute status we can't assume anything about the order of the statuses,
+func newGRPCRouteStatusSetter(status gatewayv1alpha2.GRPCRouteStatus, gatewayCtlrName string) frameworkStatus.Setter {
+	return func(object client.Object) (wasSet bool) {
+		gr := helpers.MustCastObject[*gatewayv1alpha2.GRPCRoute](object)
+
+		// keep all the parent statuses that belong to other controllers
+		for _, os := range gr.Status.Parents {
+			if string(os.ControllerName) != gatewayCtlrName {
+				status.Parents = append(status.Parents, os)
+			}
+		}
+
+		if routeStatusEqual(gatewayCtlrName, gr.Status.RouteStatus, status.RouteStatus) {
+			return false
+		}
+
+		gr.Status = status
+
+		return true
+	}
+}
+
+func newTLSRouteStatusSetter(status gatewayv1alpha2.TLSRouteStatus, gatewayCtlrName string) frameworkStatus.Setter {
+	return func(object client.Object) (wasSet bool) {
+		tr := helpers.MustCastObject[*gatewayv1alpha2.TLSRoute](object)
+
+		// keep all the parent statuses that belong to other controllers
+		for _, os := range tr.Status.Parents {
+			if string(os.ControllerName) != gatewayCtlrName {
+				status.Parents = append(status.Parents, os)
+			}
+		}
+
+		if routeStatusEqual(gatewayCtlrName, tr.Status.RouteStatus, status.RouteStatus) {
+			return false
+		}
+
+		tr.Status = status
+
+		return true
+	}
+}
+
+func newTCPRouteStatusSetter(status gatewayv1alpha2.TCPRouteStatus, gatewayCtlrName string) frameworkStatus.Setter {
+	return func(object client.Object) (wasSet bool) {
+		tcr := helpers.MustCastObject[*gatewayv1alpha2.TCPRoute](object)
+
+		// keep all the parent statuses that belong to other controllers
+		for _, os := range tcr.Status.Parents {
+			if string(os.ControllerName) != gatewayCtlrName {
+				status.Parents = append(status.Parents, os)
+			}
+		}
+
+		if routeStatusEqual(gatewayCtlrName, tcr.Status.RouteStatus, status.RouteStatus) {
+			return false
+		}
+
+		tcr.Status = status
+
+		return true
+	}
+}
+
+func newUDPRouteStatusSetter(status gatewayv1alpha2.UDPRouteStatus, gatewayCtlrName string) frameworkStatus.Setter {
+	return func(object client.Object) (wasSet bool) {
+		ur := helpers.MustCastObject[*gatewayv1alpha2.UDPRoute](object)
+
+		// keep all the parent statuses that belong to other controllers
+		for _, os := range ur.Status.Parents {
+			if string(os.ControllerName) != gatewayCtlrName {
+				status.Parents = append(status.Parents, os)
+			}
+		}
+
+		if routeStatusEqual(gatewayCtlrName, ur.Status.RouteStatus, status.RouteStatus) {
+			return false
+		}
+
+		ur.Status = status
+
+		return true
+	}
+}
+
+func routeStatusEqual(gatewayCtlrName string, prev, cur gatewayv1.RouteStatus) bool {
+	// Since other controllers may update Route status we can't assume anything about the order of the statuses,
 	// and we have to ignore statuses written by other controllers when checking for equality.
 	// Therefore, we can't use slices.EqualFunc here because it cares about the order.
 
@@ -175,32 +260,59 @@ func newBackendTLSPolicyStatusSetter(
 	return func(object client.Object) (wasSet bool) {
 		btp := helpers.MustCastObject[*gatewayv1alpha2.BackendTLSPolicy](object)
 
-		// maxAncestors is the max number of ancestor statuses which is the sum of all new ancestor statuses and all old
-		// ancestor statuses.
-		maxAncestors := 1 + len(btp.Status.Ancestors)
-		ancestors := make([]gatewayv1alpha2.PolicyAncestorStatus, 0, maxAncestors)
-
-		// keep all the ancestor statuses that belong to other controllers
-		for _, os := range btp.Status.Ancestors {
-			if string(os.ControllerName) != gatewayCtlrName {
-				ancestors = append(ancestors, os)
-			}
-		}
+		return setPolicyStatus(&btp.Status, status, gatewayCtlrName)
+	}
+}
 
-		ancestors = append(ancestors, status.Ancestors...)
-		status.Ancestors = ancestors
+func newNGFPolicyStatusSetter(
+	status gatewayv1alpha2.PolicyStatus,
+	gatewayCtlrName string,
+) frameworkStatus.Setter {
+	return func(object client.Object) (wasSet bool) {
+		policy := helpers.MustCastObject[policies.Policy](object)
+		policyStatus := policy.GetPolicyStatus()
 
-		if btpStatusEqual(gatewayCtlrName, btp.Status, status) {
+		if !setPolicyStatus(&policyStatus, status, gatewayCtlrName) {
 			return false
 		}
 
-		btp.Status = status
+		policy.SetPolicyStatus(policyStatus)
 		return true
 	}
 }
 
-func btpStatusEqual(gatewayCtlrName string, prev, cur gatewayv1alpha2.PolicyStatus) bool {
-	// Since other controllers may update BackendTLSPolicy status we can't assume anything about the order of the
+// setPolicyStatus sets the ancestor statuses of the controller in the policy status, keeping the ancestor statuses
+// of other controllers. It returns false if the status hasn't changed.
+func setPolicyStatus(
+	policyStatus *gatewayv1alpha2.PolicyStatus,
+	status gatewayv1alpha2.PolicyStatus,
+	gatewayCtlrName string,
+) bool {
+	// maxAncestors is the max number of ancestor statuses which is the sum of all new ancestor statuses and all old
+	// ancestor statuses.
+	maxAncestors := len(status.Ancestors) + len(policyStatus.Ancestors)
+	ancestors := make([]gatewayv1alpha2.PolicyAncestorStatus, 0, maxAncestors)
+
+	// keep all the ancestor statuses that belong to other controllers
+	for _, os := range policyStatus.Ancestors {
+		if string(os.ControllerName) != gatewayCtlrName {
+			ancestors = append(ancestors, os)
+		}
+	}
+
+	ancestors = append(ancestors, status.Ancestors...)
+	status.Ancestors = ancestors
+
+	if policyStatusEqual(gatewayCtlrName, *policyStatus, status) {
+		return false
+	}
+
+	*policyStatus = status
+	return true
+}
+
+func policyStatusEqual(gatewayCtlrName string, prev, cur gatewayv1alpha2.PolicyStatus) bool {
+	// Since other controllers may update policy status we can't assume anything about the order of the
 	// statuses, and we have to ignore statuses written by other controllers when checking for equality.
 	// Therefore, we can't use slices.EqualFunc here because it cares about the order.
 
@@ -211,7 +323,7 @@ func btpStatusEqual(gatewayCtlrName string, prev, cur gatewayv1alpha2.PolicyStat
 		}
 
 		exists := slices.ContainsFunc(cur.Ancestors, func(curAncestor gatewayv1alpha2.PolicyAncestorStatus) bool {
-			return btpAncestorStatusEqual(prevAncestor, curAncestor)
+			return policyAncestorStatusEqual(prevAncestor, curAncestor)
 		})
 
 		if !exists {
@@ -222,7 +334,7 @@ func btpStatusEqual(gatewayCtlrName string, prev, cur gatewayv1alpha2.PolicyStat
 	// Then, we check if the cur status has any PolicyAncestorStatuses that are no longer present in the prev status.
 	for _, curParent := range cur.Ancestors {
 		exists := slices.ContainsFunc(prev.Ancestors, func(prevAncestor gatewayv1alpha2.PolicyAncestorStatus) bool {
-			return btpAncestorStatusEqual(curParent, prevAncestor)
+			return policyAncestorStatusEqual(curParent, prevAncestor)
 		})
 
 		if !exists {
@@ -233,7 +345,7 @@ func btpStatusEqual(gatewayCtlrName string, prev, cur gatewayv1alpha2.PolicyStat
 	return true
 }
 
-func btpAncestorStatusEqual(p1, p2 gatewayv1alpha2.PolicyAncestorStatus) bool {
+func policyAncestorStatusEqual(p1, p2 gatewayv1alpha2.PolicyAncestorStatus) bool {
 	if p1.ControllerName != p2.ControllerName {
 		return false
 	}
@@ -246,6 +358,18 @@ func btpAncestorStatusEqual(p1, p2 gatewayv1alpha2.PolicyAncestorStatus) bool {
 		return false
 	}
 
+	if !equalPointers(p1.AncestorRef.Kind, p2.AncestorRef.Kind) {
+		return false
+	}
+
+	if !equalPointers(p1.AncestorRef.Group, p2.AncestorRef.Group) {
+		return false
+	}
+
+	if !equalPointers(p1.AncestorRef.SectionName, p2.AncestorRef.SectionName) {
+		return false
+	}
+
 	// we ignore the rest of the AncestorRef fields because we do not set them
 
 	return frameworkStatus.ConditionsEqual(p1.Conditions, p2.Conditions)
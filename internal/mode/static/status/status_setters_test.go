@@ -10,6 +10,7 @@ import (
 
 	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/policies"
 )
 
 func TestNewNginxGatewayStatusSetter(t *testing.T) {
@@ -299,6 +300,706 @@ func TestNewHTTPRouteStatusSetter(t *testing.T) {
 	}
 }
 
+func TestNewGRPCRouteStatusSetter(t *testing.T) {
+	const (
+		controllerName      = "controller"
+		otherControllerName = "different"
+	)
+
+	tests := []struct {
+		name                         string
+		status, newStatus, expStatus gatewayv1alpha2.GRPCRouteStatus
+		expStatusSet                 bool
+	}{
+		{
+			name: "GRPCRoute has no status",
+			newStatus: gatewayv1alpha2.GRPCRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			expStatus: gatewayv1alpha2.GRPCRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			expStatusSet: true,
+		},
+		{
+			name: "GRPCRoute has old status",
+			newStatus: gatewayv1alpha2.GRPCRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			status: gatewayv1alpha2.GRPCRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "old condition"}},
+						},
+					},
+				},
+			},
+			expStatus: gatewayv1alpha2.GRPCRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			expStatusSet: true,
+		},
+		{
+			name: "GRPCRoute has old status, keep other controller statuses",
+			newStatus: gatewayv1alpha2.GRPCRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			status: gatewayv1alpha2.GRPCRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(otherControllerName),
+							Conditions:     []metav1.Condition{{Message: "some condition"}},
+						},
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "old condition"}},
+						},
+					},
+				},
+			},
+			expStatus: gatewayv1alpha2.GRPCRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(otherControllerName),
+							Conditions:     []metav1.Condition{{Message: "some condition"}},
+						},
+					},
+				},
+			},
+			expStatusSet: true,
+		},
+		{
+			name: "GRPCRoute has same status",
+			newStatus: gatewayv1alpha2.GRPCRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "same condition"}},
+						},
+					},
+				},
+			},
+			status: gatewayv1alpha2.GRPCRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "same condition"}},
+						},
+					},
+				},
+			},
+			expStatus: gatewayv1alpha2.GRPCRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "same condition"}},
+						},
+					},
+				},
+			},
+			expStatusSet: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			setter := newGRPCRouteStatusSetter(test.newStatus, controllerName)
+			obj := &gatewayv1alpha2.GRPCRoute{Status: test.status}
+
+			statusSet := setter(obj)
+
+			g.Expect(statusSet).To(Equal(test.expStatusSet))
+			g.Expect(obj.Status).To(Equal(test.expStatus))
+		})
+	}
+}
+
+func TestNewTLSRouteStatusSetter(t *testing.T) {
+	const (
+		controllerName      = "controller"
+		otherControllerName = "different"
+	)
+
+	tests := []struct {
+		name                         string
+		status, newStatus, expStatus gatewayv1alpha2.TLSRouteStatus
+		expStatusSet                 bool
+	}{
+		{
+			name: "TLSRoute has no status",
+			newStatus: gatewayv1alpha2.TLSRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			expStatus: gatewayv1alpha2.TLSRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			expStatusSet: true,
+		},
+		{
+			name: "TLSRoute has old status",
+			newStatus: gatewayv1alpha2.TLSRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			status: gatewayv1alpha2.TLSRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "old condition"}},
+						},
+					},
+				},
+			},
+			expStatus: gatewayv1alpha2.TLSRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			expStatusSet: true,
+		},
+		{
+			name: "TLSRoute has old status, keep other controller statuses",
+			newStatus: gatewayv1alpha2.TLSRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			status: gatewayv1alpha2.TLSRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(otherControllerName),
+							Conditions:     []metav1.Condition{{Message: "some condition"}},
+						},
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "old condition"}},
+						},
+					},
+				},
+			},
+			expStatus: gatewayv1alpha2.TLSRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(otherControllerName),
+							Conditions:     []metav1.Condition{{Message: "some condition"}},
+						},
+					},
+				},
+			},
+			expStatusSet: true,
+		},
+		{
+			name: "TLSRoute has same status",
+			newStatus: gatewayv1alpha2.TLSRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "same condition"}},
+						},
+					},
+				},
+			},
+			status: gatewayv1alpha2.TLSRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "same condition"}},
+						},
+					},
+				},
+			},
+			expStatus: gatewayv1alpha2.TLSRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "same condition"}},
+						},
+					},
+				},
+			},
+			expStatusSet: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			setter := newTLSRouteStatusSetter(test.newStatus, controllerName)
+			obj := &gatewayv1alpha2.TLSRoute{Status: test.status}
+
+			statusSet := setter(obj)
+
+			g.Expect(statusSet).To(Equal(test.expStatusSet))
+			g.Expect(obj.Status).To(Equal(test.expStatus))
+		})
+	}
+}
+
+func TestNewTCPRouteStatusSetter(t *testing.T) {
+	const (
+		controllerName      = "controller"
+		otherControllerName = "different"
+	)
+
+	tests := []struct {
+		name                         string
+		status, newStatus, expStatus gatewayv1alpha2.TCPRouteStatus
+		expStatusSet                 bool
+	}{
+		{
+			name: "TCPRoute has no status",
+			newStatus: gatewayv1alpha2.TCPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			expStatus: gatewayv1alpha2.TCPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			expStatusSet: true,
+		},
+		{
+			name: "TCPRoute has old status",
+			newStatus: gatewayv1alpha2.TCPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			status: gatewayv1alpha2.TCPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "old condition"}},
+						},
+					},
+				},
+			},
+			expStatus: gatewayv1alpha2.TCPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			expStatusSet: true,
+		},
+		{
+			name: "TCPRoute has old status, keep other controller statuses",
+			newStatus: gatewayv1alpha2.TCPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			status: gatewayv1alpha2.TCPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(otherControllerName),
+							Conditions:     []metav1.Condition{{Message: "some condition"}},
+						},
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "old condition"}},
+						},
+					},
+				},
+			},
+			expStatus: gatewayv1alpha2.TCPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(otherControllerName),
+							Conditions:     []metav1.Condition{{Message: "some condition"}},
+						},
+					},
+				},
+			},
+			expStatusSet: true,
+		},
+		{
+			name: "TCPRoute has same status",
+			newStatus: gatewayv1alpha2.TCPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "same condition"}},
+						},
+					},
+				},
+			},
+			status: gatewayv1alpha2.TCPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "same condition"}},
+						},
+					},
+				},
+			},
+			expStatus: gatewayv1alpha2.TCPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "same condition"}},
+						},
+					},
+				},
+			},
+			expStatusSet: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			setter := newTCPRouteStatusSetter(test.newStatus, controllerName)
+			obj := &gatewayv1alpha2.TCPRoute{Status: test.status}
+
+			statusSet := setter(obj)
+
+			g.Expect(statusSet).To(Equal(test.expStatusSet))
+			g.Expect(obj.Status).To(Equal(test.expStatus))
+		})
+	}
+}
+
+func TestNewUDPRouteStatusSetter(t *testing.T) {
+	const (
+		controllerName      = "controller"
+		otherControllerName = "different"
+	)
+
+	tests := []struct {
+		name                         string
+		status, newStatus, expStatus gatewayv1alpha2.UDPRouteStatus
+		expStatusSet                 bool
+	}{
+		{
+			name: "UDPRoute has no status",
+			newStatus: gatewayv1alpha2.UDPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			expStatus: gatewayv1alpha2.UDPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			expStatusSet: true,
+		},
+		{
+			name: "UDPRoute has old status",
+			newStatus: gatewayv1alpha2.UDPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			status: gatewayv1alpha2.UDPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "old condition"}},
+						},
+					},
+				},
+			},
+			expStatus: gatewayv1alpha2.UDPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			expStatusSet: true,
+		},
+		{
+			name: "UDPRoute has old status, keep other controller statuses",
+			newStatus: gatewayv1alpha2.UDPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			status: gatewayv1alpha2.UDPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(otherControllerName),
+							Conditions:     []metav1.Condition{{Message: "some condition"}},
+						},
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "old condition"}},
+						},
+					},
+				},
+			},
+			expStatus: gatewayv1alpha2.UDPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(otherControllerName),
+							Conditions:     []metav1.Condition{{Message: "some condition"}},
+						},
+					},
+				},
+			},
+			expStatusSet: true,
+		},
+		{
+			name: "UDPRoute has same status",
+			newStatus: gatewayv1alpha2.UDPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "same condition"}},
+						},
+					},
+				},
+			},
+			status: gatewayv1alpha2.UDPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "same condition"}},
+						},
+					},
+				},
+			},
+			expStatus: gatewayv1alpha2.UDPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "same condition"}},
+						},
+					},
+				},
+			},
+			expStatusSet: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			setter := newUDPRouteStatusSetter(test.newStatus, controllerName)
+			obj := &gatewayv1alpha2.UDPRoute{Status: test.status}
+
+			statusSet := setter(obj)
+
+			g.Expect(statusSet).To(Equal(test.expStatusSet))
+			g.Expect(obj.Status).To(Equal(test.expStatus))
+		})
+	}
+}
+
 func TestNewGatewayClassStatusSetter(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -489,6 +1190,63 @@ func TestNewBackendTLSPolicyStatusSetter(t *testing.T) {
 	}
 }
 
+func TestNewNGFPolicyStatusSetter(t *testing.T) {
+	const controllerName = "controller"
+
+	newStatus := gatewayv1alpha2.PolicyStatus{
+		Ancestors: []gatewayv1alpha2.PolicyAncestorStatus{
+			{
+				ControllerName: controllerName,
+				Conditions:     []metav1.Condition{{Message: "new condition"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		policy       policies.Policy
+		name         string
+		expStatusSet bool
+	}{
+		{
+			name:         "ClientSettingsPolicy has no status",
+			policy:       &ngfAPI.ClientSettingsPolicy{},
+			expStatusSet: true,
+		},
+		{
+			name: "ObservabilityPolicy has old status",
+			policy: &ngfAPI.ObservabilityPolicy{
+				Status: gatewayv1alpha2.PolicyStatus{
+					Ancestors: []gatewayv1alpha2.PolicyAncestorStatus{
+						{
+							ControllerName: controllerName,
+							Conditions:     []metav1.Condition{{Message: "old condition"}},
+						},
+					},
+				},
+			},
+			expStatusSet: true,
+		},
+		{
+			name:         "ObservabilityPolicy has same status",
+			policy:       &ngfAPI.ObservabilityPolicy{Status: newStatus},
+			expStatusSet: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			setter := newNGFPolicyStatusSetter(newStatus, controllerName)
+
+			statusSet := setter(test.policy)
+
+			g.Expect(statusSet).To(Equal(test.expStatusSet))
+			g.Expect(test.policy.GetPolicyStatus()).To(Equal(newStatus))
+		})
+	}
+}
+
 func TestGWStatusEqual(t *testing.T) {
 	getDefaultStatus := func() gatewayv1.GatewayStatus {
 		return gatewayv1.GatewayStatus{
@@ -688,7 +1446,7 @@ func TestGWStatusEqual(t *testing.T) {
 	}
 }
 
-func TestHRStatusEqual(t *testing.T) {
+func TestRouteStatusEqual(t *testing.T) {
 	testConds := []metav1.Condition{
 		{
 			Type: "type", /* conditions are covered by another test*/
@@ -818,7 +1576,7 @@ func TestHRStatusEqual(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
-			equal := hrStatusEqual("ours", test.prevStatus, test.curStatus)
+			equal := routeStatusEqual("ours", test.prevStatus.RouteStatus, test.curStatus.RouteStatus)
 			g.Expect(equal).To(Equal(test.expEqual))
 		})
 	}
@@ -976,7 +1734,7 @@ func TestEqualPointers(t *testing.T) {
 	}
 }
 
-func TestBtpStatusEqual(t *testing.T) {
+func TestPolicyStatusEqual(t *testing.T) {
 	getPolicyStatus := func(ancestorName, ancestorNs, ctlrName string) gatewayv1alpha2.PolicyStatus {
 		return gatewayv1alpha2.PolicyStatus{
 			Ancestors: []gatewayv1alpha2.PolicyAncestorStatus{
@@ -997,6 +1755,12 @@ func TestBtpStatusEqual(t *testing.T) {
 	currMultiple := getPolicyStatus("ancestor1", "ns1", "ctlr1")
 	currMultiple.Ancestors = append(currMultiple.Ancestors, getPolicyStatus("ancestor3", "ns3", "ctlr2").Ancestors...)
 
+	routeAncestor := getPolicyStatus("ancestor1", "ns1", "ctlr1")
+	routeAncestor.Ancestors[0].AncestorRef.Kind = helpers.GetPointer[gatewayv1.Kind]("HTTPRoute")
+
+	listenerAncestor := getPolicyStatus("ancestor1", "ns1", "ctlr1")
+	listenerAncestor.Ancestors[0].AncestorRef.SectionName = helpers.GetPointer[gatewayv1.SectionName]("listener")
+
 	tests := []struct {
 		name           string
 		controllerName string
@@ -1025,6 +1789,20 @@ func TestBtpStatusEqual(t *testing.T) {
 			controllerName: "ctlr1",
 			expEqual:       false,
 		},
+		{
+			name:           "status not equal, different ancestor kind",
+			previous:       getPolicyStatus("ancestor1", "ns1", "ctlr1"),
+			current:        routeAncestor,
+			controllerName: "ctlr1",
+			expEqual:       false,
+		},
+		{
+			name:           "status not equal, different ancestor section name",
+			previous:       getPolicyStatus("ancestor1", "ns1", "ctlr1"),
+			current:        listenerAncestor,
+			controllerName: "ctlr1",
+			expEqual:       false,
+		},
 		{
 			name:           "status not equal, different controller name on current",
 			previous:       getPolicyStatus("ancestor1", "ns1", "ctlr1"),
@@ -1051,7 +1829,7 @@ func TestBtpStatusEqual(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
-			equal := btpStatusEqual(test.controllerName, test.previous, test.current)
+			equal := policyStatusEqual(test.controllerName, test.previous, test.current)
 			g.Expect(equal).To(Equal(test.expEqual))
 		})
 	}
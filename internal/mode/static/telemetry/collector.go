@@ -174,7 +174,12 @@ func collectGraphResourceCount(
 		ngfResourceCounts.GatewayCount++
 	}
 
-	ngfResourceCounts.HTTPRouteCount = int64(len(g.Routes))
+	for _, r := range g.Routes {
+		if r.RouteType == graph.RouteTypeHTTP {
+			ngfResourceCounts.HTTPRouteCount++
+		}
+	}
+
 	ngfResourceCounts.SecretCount = int64(len(g.ReferencedSecrets))
 	ngfResourceCounts.ServiceCount = int64(len(g.ReferencedServices))
 
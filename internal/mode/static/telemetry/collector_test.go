@@ -278,10 +278,11 @@ var _ = Describe("Collector", Ordered, func() {
 						{Name: "ignoredGw1"}: {},
 						{Name: "ignoredGw2"}: {},
 					},
-					Routes: map[types.NamespacedName]*graph.Route{
-						{Namespace: "test", Name: "hr-1"}: {},
-						{Namespace: "test", Name: "hr-2"}: {},
-						{Namespace: "test", Name: "hr-3"}: {},
+					Routes: map[graph.RouteKey]*graph.L7Route{
+						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-1"}}: {RouteType: graph.RouteTypeHTTP},
+						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-2"}}: {RouteType: graph.RouteTypeHTTP},
+						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-3"}}: {RouteType: graph.RouteTypeHTTP},
+						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "gr-1"}}: {RouteType: graph.RouteTypeGRPC},
 					},
 					ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
 						client.ObjectKeyFromObject(secret1): {
@@ -292,7 +293,7 @@ var _ = Describe("Collector", Ordered, func() {
 						},
 						client.ObjectKeyFromObject(nilsecret): nil,
 					},
-					ReferencedServices: map[types.NamespacedName]struct{}{
+					ReferencedServices: map[types.NamespacedName]*graph.ReferencedService{
 						client.ObjectKeyFromObject(svc1):   {},
 						client.ObjectKeyFromObject(svc2):   {},
 						client.ObjectKeyFromObject(nilsvc): {},
@@ -475,15 +476,15 @@ var _ = Describe("Collector", Ordered, func() {
 			graph1 = &graph.Graph{
 				GatewayClass: &graph.GatewayClass{},
 				Gateway:      &graph.Gateway{},
-				Routes: map[types.NamespacedName]*graph.Route{
-					{Namespace: "test", Name: "hr-1"}: {},
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-1"}}: {RouteType: graph.RouteTypeHTTP},
 				},
 				ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
 					client.ObjectKeyFromObject(secret): {
 						Source: secret,
 					},
 				},
-				ReferencedServices: map[types.NamespacedName]struct{}{
+				ReferencedServices: map[types.NamespacedName]*graph.ReferencedService{
 					client.ObjectKeyFromObject(svc): {},
 				},
 			}